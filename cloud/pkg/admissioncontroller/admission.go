@@ -397,6 +397,10 @@ func (ac *AdmissionController) getRuleEndpoint(namespace, name string) (*v1.Rule
 	return ac.CrdClient.RulesV1().RuleEndpoints(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 }
 
+func (ac *AdmissionController) getDeviceModel(namespace, name string) (*v1beta1.DeviceModel, error) {
+	return ac.CrdClient.DevicesV1beta1().DeviceModels(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 func (ac *AdmissionController) listRule(namespace string) ([]v1.Rule, error) {
 	rules, err := ac.CrdClient.RulesV1().Rules(namespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
@@ -1,10 +1,14 @@
 package admissioncontroller
 
 import (
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
@@ -54,9 +58,98 @@ func validateDevice(device *devicesv1beta1.Device, response *admissionv1.Admissi
 		}
 	}
 
+	if err := validateDesiredValues(device); err != nil {
+		msg = err.Error()
+		response.Allowed = false
+	}
 	return msg
 }
 
+// validateDesiredValues checks the desired values of the device properties against
+// the type and the range declared by the device model.
+func validateDesiredValues(device *devicesv1beta1.Device) error {
+	hasDesired := false
+	for _, property := range device.Spec.Properties {
+		if property.Desired.Value != "" {
+			hasDesired = true
+			break
+		}
+	}
+	if !hasDesired || device.Spec.DeviceModelRef == nil || device.Spec.DeviceModelRef.Name == "" {
+		return nil
+	}
+
+	model, err := controller.getDeviceModel(device.Namespace, device.Spec.DeviceModelRef.Name)
+	if apierrors.IsNotFound(err) {
+		// the device model may be created after the device, it's checked by the mapper then
+		klog.V(4).Infof("device model %s/%s not found, skip checking desired values", device.Namespace, device.Spec.DeviceModelRef.Name)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("can't get device model %s/%s. Reason: %w", device.Namespace, device.Spec.DeviceModelRef.Name, err)
+	}
+
+	modelProperties := make(map[string]devicesv1beta1.ModelProperty, len(model.Spec.Properties))
+	for _, property := range model.Spec.Properties {
+		modelProperties[property.Name] = property
+	}
+	for _, property := range device.Spec.Properties {
+		if property.Desired.Value == "" {
+			continue
+		}
+		modelProperty, ok := modelProperties[property.Name]
+		if !ok {
+			continue
+		}
+		if err := validatePropertyValue(&modelProperty, property.Desired.Value); err != nil {
+			return fmt.Errorf("desired value of property %s is invalid: %v", property.Name, err)
+		}
+	}
+	return nil
+}
+
+// validatePropertyValue checks that the value is of the type of the model property
+// and is in the range between its minimum and maximum.
+func validatePropertyValue(property *devicesv1beta1.ModelProperty, value string) error {
+	var number float64
+	var err error
+	switch property.Type {
+	case devicesv1beta1.INT:
+		var i int64
+		if i, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%s is not of type INT", value)
+		}
+		number = float64(i)
+	case devicesv1beta1.FLOAT, devicesv1beta1.DOUBLE:
+		if number, err = strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s is not of type %s", value, property.Type)
+		}
+	case devicesv1beta1.BOOLEAN:
+		if _, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%s is not of type BOOLEAN", value)
+		}
+		return nil
+	case devicesv1beta1.BYTES:
+		if _, err = base64.StdEncoding.DecodeString(value); err != nil {
+			return fmt.Errorf("%s is not base64 encoded BYTES", value)
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	if property.Minimum != "" {
+		if minimum, err := strconv.ParseFloat(property.Minimum, 64); err == nil && number < minimum {
+			return fmt.Errorf("%s is less than the minimum %s", value, property.Minimum)
+		}
+	}
+	if property.Maximum != "" {
+		if maximum, err := strconv.ParseFloat(property.Maximum, 64); err == nil && number > maximum {
+			return fmt.Errorf("%s is greater than the maximum %s", value, property.Maximum)
+		}
+	}
+	return nil
+}
+
 func serveDevice(w http.ResponseWriter, r *http.Request) {
 	serve(w, r, admitDevice)
 }
@@ -17,10 +17,16 @@ package admissioncontroller
 
 import (
 	"encoding/json"
+	"errors"
+	"reflect"
 	"testing"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	devicesv1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
@@ -191,3 +197,114 @@ func TestValidateDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDesiredValues(t *testing.T) {
+	assert := assert.New(t)
+
+	model := &devicesv1beta1.DeviceModel{
+		Spec: devicesv1beta1.DeviceModelSpec{
+			Properties: []devicesv1beta1.ModelProperty{
+				{Name: "temperature", Type: devicesv1beta1.FLOAT, Minimum: "-40", Maximum: "125"},
+				{Name: "enabled", Type: devicesv1beta1.BOOLEAN},
+			},
+		},
+	}
+	newDevice := func(name, value string) *devicesv1beta1.Device {
+		return &devicesv1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: devicesv1beta1.DeviceSpec{
+				DeviceModelRef: &v1.LocalObjectReference{Name: "sensor"},
+				Properties: []devicesv1beta1.DeviceProperty{
+					{Name: name, Desired: devicesv1beta1.TwinProperty{Value: value}},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		device      *devicesv1beta1.Device
+		modelErr    error
+		expectedErr string
+	}{
+		{
+			name:   "Desired value in range",
+			device: newDevice("temperature", "21.5"),
+		},
+		{
+			name:        "Desired value greater than maximum",
+			device:      newDevice("temperature", "130"),
+			expectedErr: "desired value of property temperature is invalid: 130 is greater than the maximum 125",
+		},
+		{
+			name:        "Desired value less than minimum",
+			device:      newDevice("temperature", "-41"),
+			expectedErr: "desired value of property temperature is invalid: -41 is less than the minimum -40",
+		},
+		{
+			name:        "Desired value of wrong type",
+			device:      newDevice("enabled", "on"),
+			expectedErr: "desired value of property enabled is invalid: on is not of type BOOLEAN",
+		},
+		{
+			name:   "Property not declared by model",
+			device: newDevice("humidity", "abc"),
+		},
+		{
+			name:     "Device model not found",
+			device:   newDevice("temperature", "130"),
+			modelErr: apierrors.NewNotFound(devicesv1beta1.Resource("devicemodels"), "sensor"),
+		},
+		{
+			name:        "Get device model failed",
+			device:      newDevice("temperature", "21.5"),
+			modelErr:    errors.New("connection refused"),
+			expectedErr: "can't get device model default/sensor. Reason: connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(controller), "getDeviceModel",
+				func(_ *AdmissionController, _, _ string) (*devicesv1beta1.DeviceModel, error) {
+					if tc.modelErr != nil {
+						return nil, tc.modelErr
+					}
+					return model, nil
+				})
+			defer patches.Reset()
+
+			err := validateDesiredValues(tc.device)
+			if tc.expectedErr == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidatePropertyValue(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		name     string
+		property devicesv1beta1.ModelProperty
+		value    string
+		wantErr  bool
+	}{
+		{name: "Int in range", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.INT, Minimum: "0", Maximum: "10"}, value: "10"},
+		{name: "Int not integral", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.INT}, value: "1.5", wantErr: true},
+		{name: "Double out of range", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.DOUBLE, Maximum: "1"}, value: "1.01", wantErr: true},
+		{name: "Bytes base64", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.BYTES}, value: "aGVsbG8="},
+		{name: "Bytes invalid", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.BYTES}, value: "hello!", wantErr: true},
+		{name: "String ignores range", property: devicesv1beta1.ModelProperty{Type: devicesv1beta1.STRING, Maximum: "1"}, value: "2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePropertyValue(&tc.property, tc.value)
+			assert.Equal(tc.wantErr, err != nil)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package admissioncontroller
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -51,10 +53,71 @@ func validateDeviceModel(devicemodel *devicesv1beta1.DeviceModel, response *admi
 			msg = "property names must be unique."
 			response.Allowed = false
 		}
+		if m := validatePropertyRange(&property); m != "" {
+			msg = fmt.Sprintf("property %s %s", property.Name, m)
+			response.Allowed = false
+		}
+	}
+	methodNameMap := make(map[string]bool)
+	for _, method := range devicemodel.Spec.Methods {
+		if _, ok := methodNameMap[method.Name]; ok {
+			msg = "method names must be unique."
+			response.Allowed = false
+			continue
+		}
+		methodNameMap[method.Name] = true
+		if m := validateMethodParameters(method.Inputs); m != "" {
+			msg = fmt.Sprintf("inputs of method %s %s", method.Name, m)
+			response.Allowed = false
+		}
+		if m := validateMethodParameters(method.Outputs); m != "" {
+			msg = fmt.Sprintf("outputs of method %s %s", method.Name, m)
+			response.Allowed = false
+		}
 	}
 	return msg
 }
 
+// validatePropertyRange checks that the minimum and the maximum of a numeric property
+// are numbers and the minimum is not greater than the maximum.
+func validatePropertyRange(property *devicesv1beta1.ModelProperty) string {
+	switch property.Type {
+	case devicesv1beta1.INT, devicesv1beta1.FLOAT, devicesv1beta1.DOUBLE:
+	default:
+		return ""
+	}
+	var minimum, maximum float64
+	var err error
+	if property.Minimum != "" {
+		if minimum, err = strconv.ParseFloat(property.Minimum, 64); err != nil {
+			return "must have a numeric minimum."
+		}
+	}
+	if property.Maximum != "" {
+		if maximum, err = strconv.ParseFloat(property.Maximum, 64); err != nil {
+			return "must have a numeric maximum."
+		}
+	}
+	if property.Minimum != "" && property.Maximum != "" && minimum > maximum {
+		return "must have a minimum not greater than the maximum."
+	}
+	return ""
+}
+
+func validateMethodParameters(params []devicesv1beta1.MethodParameter) string {
+	paramNameMap := make(map[string]bool)
+	for _, param := range params {
+		if _, ok := paramNameMap[param.Name]; ok {
+			return "must have unique names."
+		}
+		paramNameMap[param.Name] = true
+		if param.Type == devicesv1beta1.STREAM {
+			return "must not be of type STREAM."
+		}
+	}
+	return ""
+}
+
 func serveDeviceModel(w http.ResponseWriter, r *http.Request) {
 	serve(w, r, admitDeviceModel)
 }
@@ -147,6 +147,106 @@ func TestValidateDeviceModel(t *testing.T) {
 			expectedAllowed: false,
 			expectedMessage: "property names must be unique.",
 		},
+		{
+			name: "Device model with valid range",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "temperature", Type: devicesv1beta1.FLOAT, Minimum: "-40", Maximum: "125.5"},
+						{Name: "label", Type: devicesv1beta1.STRING, Minimum: "a"},
+					},
+				},
+			},
+			expectedAllowed: true,
+			expectedMessage: "",
+		},
+		{
+			name: "Device model with non-numeric minimum",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "temperature", Type: devicesv1beta1.INT, Minimum: "low"},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "property temperature must have a numeric minimum.",
+		},
+		{
+			name: "Device model with minimum greater than maximum",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "temperature", Type: devicesv1beta1.DOUBLE, Minimum: "10", Maximum: "5"},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "property temperature must have a minimum not greater than the maximum.",
+		},
+		{
+			name: "Device model with valid methods",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Methods: []devicesv1beta1.ModelMethod{
+						{
+							Name:    "calibrate",
+							Inputs:  []devicesv1beta1.MethodParameter{{Name: "offset", Type: devicesv1beta1.INT}},
+							Outputs: []devicesv1beta1.MethodParameter{{Name: "applied", Type: devicesv1beta1.BOOLEAN}},
+						},
+						{Name: "reset"},
+					},
+				},
+			},
+			expectedAllowed: true,
+			expectedMessage: "",
+		},
+		{
+			name: "Device model with duplicate methods",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Methods: []devicesv1beta1.ModelMethod{
+						{Name: "reset"},
+						{Name: "reset"},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "method names must be unique.",
+		},
+		{
+			name: "Device model with duplicate method inputs",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Methods: []devicesv1beta1.ModelMethod{
+						{
+							Name: "calibrate",
+							Inputs: []devicesv1beta1.MethodParameter{
+								{Name: "offset", Type: devicesv1beta1.INT},
+								{Name: "offset", Type: devicesv1beta1.FLOAT},
+							},
+						},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "inputs of method calibrate must have unique names.",
+		},
+		{
+			name: "Device model with stream method output",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Methods: []devicesv1beta1.ModelMethod{
+						{
+							Name:    "snapshot",
+							Outputs: []devicesv1beta1.MethodParameter{{Name: "video", Type: devicesv1beta1.STREAM}},
+						},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "outputs of method snapshot must not be of type STREAM.",
+		},
 		{
 			name: "Device model with no properties",
 			deviceModel: &devicesv1beta1.DeviceModel{
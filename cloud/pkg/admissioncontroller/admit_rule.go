@@ -5,9 +5,12 @@ import (
 	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
 	rulesv1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider/kafka"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/transform"
 )
 
 var (
@@ -15,6 +18,8 @@ var (
 		{rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeEventBus},
 		{rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeServiceBus},
 		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeRest},
+		{rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeKafka},
+		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeKafka},
 	}
 )
 
@@ -79,6 +84,37 @@ func validateRule(rule *rulesv1.Rule) error {
 		return fmt.Errorf("the rule which is from source ruleEndpoint type %s to target ruleEndpoint type %s is not validate ",
 			sourceEndpoint.Spec.RuleEndpointType, targetEndpoint.Spec.RuleEndpointType)
 	}
+	if rule.Spec.Transform != nil {
+		if _, err := transform.New(rule.Namespace, rule.Spec.Transform, nil); err != nil {
+			return fmt.Errorf("invalid transform: %w", err)
+		}
+	}
+	return validateRetryPolicy(rule.Spec.RetryPolicy)
+}
+
+func validateRetryPolicy(policy *rulesv1.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxAttempts < 0 {
+		return fmt.Errorf("retryPolicy.maxAttempts must not be negative")
+	}
+	if policy.DeadLetterQueueSize < 0 {
+		return fmt.Errorf("retryPolicy.deadLetterQueueSize must not be negative")
+	}
+	durations := map[string]*metav1.Duration{
+		"initialBackoff": policy.InitialBackoff,
+		"maxBackoff":     policy.MaxBackoff,
+		"timeout":        policy.Timeout,
+	}
+	for name, d := range durations {
+		if d != nil && d.Duration < 0 {
+			return fmt.Errorf("retryPolicy.%s must not be negative", name)
+		}
+	}
+	if policy.InitialBackoff != nil && policy.MaxBackoff != nil && policy.InitialBackoff.Duration > policy.MaxBackoff.Duration {
+		return fmt.Errorf("retryPolicy.initialBackoff must not be greater than retryPolicy.maxBackoff")
+	}
 	return nil
 }
 func validateSourceRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint, sourceResource map[string]string) error {
@@ -136,6 +172,8 @@ func validateTargetRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint, targetResour
 		if !exist {
 			return fmt.Errorf("\"path\" property missed in targetResource when ruleEndpoint is \"servicebus\"")
 		}
+	case rulesv1.RuleEndpointTypeKafka:
+		return kafka.ValidateTargetResource(targetResource)
 	}
 	return nil
 }
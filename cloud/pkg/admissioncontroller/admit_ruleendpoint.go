@@ -9,6 +9,7 @@ import (
 	"k8s.io/klog/v2"
 
 	rulesv1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider/kafka"
 )
 
 func admitRuleEndpoint(review admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -53,6 +54,10 @@ func validateRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint) error {
 		if port < 1 || port > 65535 {
 			return fmt.Errorf("port must be in range 1-65535")
 		}
+	case rulesv1.RuleEndpointTypeKafka:
+		if _, err := kafka.ParseConfig(ruleEndpoint.Spec.Properties); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -163,6 +163,73 @@ func Test_admitRuleEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("create kafka ruleEndpoint successful", func(t *testing.T) {
+		ruleEndpoint := rulesv1.RuleEndpoint{
+			TypeMeta: v1.TypeMeta{
+				Kind:       "RuleEndpoint",
+				APIVersion: "rules.kubeedge.io/v1",
+			},
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "kafka-test",
+				Namespace: "test",
+			},
+			Spec: rulesv1.RuleEndpointSpec{
+				RuleEndpointType: rulesv1.RuleEndpointTypeKafka,
+				Properties: map[string]string{
+					"brokers": "kafka-0:9092,kafka-1:9092",
+					"acks":    "all",
+				},
+			},
+		}
+		jsonData, _ := json.Marshal(ruleEndpoint)
+		admissionReview := admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object: runtime.RawExtension{
+					Raw:    jsonData,
+					Object: nil,
+				},
+			},
+			Response: nil,
+		}
+		admissionResp := admitRuleEndpoint(admissionReview)
+		if admissionResp.Allowed != true {
+			t.Fatalf("create kafka ruleEndpoint error:%v", admissionResp.Result.Message)
+		}
+	})
+
+	t.Run("brokers is nil,create kafka ruleEndpoint failed", func(t *testing.T) {
+		ruleEndpoint := rulesv1.RuleEndpoint{
+			TypeMeta: v1.TypeMeta{
+				Kind:       "RuleEndpoint",
+				APIVersion: "rules.kubeedge.io/v1",
+			},
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "kafka-test",
+				Namespace: "test",
+			},
+			Spec: rulesv1.RuleEndpointSpec{
+				RuleEndpointType: rulesv1.RuleEndpointTypeKafka,
+				Properties:       map[string]string{},
+			},
+		}
+		jsonData, _ := json.Marshal(ruleEndpoint)
+		admissionReview := admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object: runtime.RawExtension{
+					Raw:    jsonData,
+					Object: nil,
+				},
+			},
+			Response: nil,
+		}
+		admissionResp := admitRuleEndpoint(admissionReview)
+		if admissionResp.Allowed == true {
+			t.Fatalf("create kafka ruleEndpoint should not success")
+		}
+	})
+
 	t.Run("update ruleEndpoint failed", func(t *testing.T) {
 		admissionReview := admissionv1.AdmissionReview{
 			Request: &admissionv1.AdmissionRequest{
@@ -0,0 +1,244 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+const (
+	// AnnotationBandwidthLimit is the node annotation overriding the byte rate
+	// limit of the node in bytes per second, "0" disables the limit of the node
+	AnnotationBandwidthLimit = "cloudhub.kubeedge.io/bandwidth-limit"
+
+	// labelBelongingTo is the label of the NodeGroup a node belongs to,
+	// it is set by the NodeGroup controller
+	labelBelongingTo = "apps.kubeedge.io/belonging-to"
+)
+
+// Manager holds the byte rate limits of the messages sent to edge nodes.
+// The limiters of the NodeGroups are shared by all the nodes of a group.
+type Manager struct {
+	config     *v1alpha1.CloudHubBandwidthLimit
+	nodeLister corelisters.NodeLister
+
+	mu     sync.Mutex
+	groups map[string]*rate.Limiter
+	// nodes counts the open limiters of each node, a node has more than one
+	// while its old session is terminating after it reconnects
+	nodes map[string]int
+}
+
+// NewManager returns the Manager of the config, it returns nil if the
+// bandwidth limit is not enabled. nodeLister may be nil, then the node
+// annotations and NodeGroups are ignored.
+func NewManager(config *v1alpha1.CloudHubBandwidthLimit, nodeLister corelisters.NodeLister) *Manager {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	return &Manager{
+		config:     config,
+		nodeLister: nodeLister,
+		groups:     make(map[string]*rate.Limiter),
+		nodes:      make(map[string]int),
+	}
+}
+
+// Limiter returns the limiter of the messages sent to the node, it must be
+// closed when the session of the node is terminated
+func (m *Manager) Limiter(nodeID string) *Limiter {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	m.nodes[nodeID]++
+	m.mu.Unlock()
+	return &Limiter{
+		nodeID:  nodeID,
+		manager: m,
+	}
+}
+
+// limits returns the limit of the node and the limiter of its NodeGroup,
+// they are resolved on each message so that the changes of the node
+// annotation and labels take effect without reconnection
+func (m *Manager) limits(nodeID string) (int64, *rate.Limiter) {
+	limit := m.config.NodeBytesPerSecond
+	if m.nodeLister == nil {
+		return limit, nil
+	}
+	node, err := m.nodeLister.Get(nodeID)
+	if err != nil {
+		klog.V(4).Infof("failed to get node %s for bandwidth limit: %v", nodeID, err)
+		return limit, nil
+	}
+
+	if v, ok := node.Annotations[AnnotationBandwidthLimit]; ok {
+		annotated, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || annotated < 0 {
+			klog.Warningf("invalid annotation %s=%q of node %s, use the default bandwidth limit",
+				AnnotationBandwidthLimit, v, nodeID)
+		} else {
+			limit = annotated
+		}
+	}
+
+	group := node.Labels[labelBelongingTo]
+	if group == "" {
+		return limit, nil
+	}
+	return limit, m.groupLimiter(group)
+}
+
+func (m *Manager) groupLimiter(group string) *rate.Limiter {
+	limit := m.config.NodeGroups[group]
+	if limit <= 0 {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.groups[group]
+	if !ok {
+		l = newLimiter(limit)
+		m.groups[group] = l
+	} else {
+		setLimit(l, limit)
+	}
+	return l
+}
+
+// Limiter limits the bytes sent to an edge node. A nil Limiter does not limit.
+type Limiter struct {
+	nodeID  string
+	manager *Manager
+
+	// node is only used by the session sending messages to the node,
+	// it is guarded by mu because the ack and no-ack messages are sent concurrently
+	mu   sync.Mutex
+	node *rate.Limiter
+}
+
+// Wait waits until n bytes are allowed to the node, both the limit of the
+// node and the limit of its NodeGroup apply. The session calls it with the
+// size of each frame after writing the frame, so the frames following it are
+// delayed until the limits allow its bytes. The throttled bytes and the time
+// waited are reported to the metrics of the node.
+func (l *Limiter) Wait(ctx context.Context, n int) error {
+	if l == nil || n <= 0 {
+		return nil
+	}
+	nodeLimit, groupLimiter := l.manager.limits(l.nodeID)
+	nodeLimiter := l.nodeLimiter(nodeLimit)
+
+	start := time.Now()
+	for _, limiter := range []*rate.Limiter{nodeLimiter, groupLimiter} {
+		if err := waitN(ctx, limiter, n); err != nil {
+			return err
+		}
+	}
+
+	// a wait shorter than the timer resolution is not a throttle
+	if waited := time.Since(start); waited > time.Millisecond {
+		monitor.ThrottledBytes.WithLabelValues(l.nodeID).Add(float64(n))
+		monitor.ThrottleWaitSeconds.WithLabelValues(l.nodeID).Add(waited.Seconds())
+	}
+	return nil
+}
+
+// Close releases the limiter, the throttle metrics of the node are deleted
+// when its last limiter is closed
+func (l *Limiter) Close() {
+	if l == nil {
+		return
+	}
+	m := l.manager
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[l.nodeID]--
+	if m.nodes[l.nodeID] > 0 {
+		return
+	}
+	delete(m.nodes, l.nodeID)
+	monitor.ThrottledBytes.DeleteLabelValues(l.nodeID)
+	monitor.ThrottleWaitSeconds.DeleteLabelValues(l.nodeID)
+}
+
+func (l *Limiter) nodeLimiter(limit int64) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limit <= 0 {
+		l.node = nil
+		return nil
+	}
+	if l.node == nil {
+		l.node = newLimiter(limit)
+	} else {
+		setLimit(l.node, limit)
+	}
+	return l.node
+}
+
+// newLimiter returns a limiter of limit bytes per second, the burst is the
+// bytes of one second
+func newLimiter(limit int64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(limit), burstOf(limit))
+}
+
+func setLimit(l *rate.Limiter, limit int64) {
+	if l.Limit() != rate.Limit(limit) {
+		l.SetLimit(rate.Limit(limit))
+		l.SetBurst(burstOf(limit))
+	}
+}
+
+func burstOf(limit int64) int {
+	const maxBurst = int64(^uint32(0) >> 1)
+	if limit > maxBurst {
+		return int(maxBurst)
+	}
+	return int(limit)
+}
+
+// waitN waits for n bytes in chunks of the burst, so that the messages
+// larger than the burst are sent at the limited rate instead of failing
+func waitN(ctx context.Context, l *rate.Limiter, n int) error {
+	if l == nil {
+		return nil
+	}
+	for n > 0 {
+		chunk := n
+		if burst := l.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := l.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandwidth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+func newNodeLister(t *testing.T, nodes ...*v1.Node) corelisters.NodeLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range nodes {
+		if err := indexer.Add(node); err != nil {
+			t.Fatalf("failed to add node: %v", err)
+		}
+	}
+	return corelisters.NewNodeLister(indexer)
+}
+
+func TestNewManager(t *testing.T) {
+	if m := NewManager(nil, nil); m != nil {
+		t.Errorf("expected nil manager of nil config")
+	}
+	if m := NewManager(&v1alpha1.CloudHubBandwidthLimit{Enable: false}, nil); m != nil {
+		t.Errorf("expected nil manager of disabled config")
+	}
+
+	var m *Manager
+	l := m.Limiter("node1")
+	if l != nil {
+		t.Errorf("expected nil limiter of nil manager")
+	}
+	if err := l.Wait(context.Background(), 1<<20); err != nil {
+		t.Errorf("nil limiter wait err: %v", err)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	lister := newNodeLister(t,
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        "node2",
+			Annotations: map[string]string{AnnotationBandwidthLimit: "500"},
+			Labels:      map[string]string{labelBelongingTo: "group1"},
+		}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        "node3",
+			Annotations: map[string]string{AnnotationBandwidthLimit: "invalid"},
+			Labels:      map[string]string{labelBelongingTo: "group1"},
+		}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   "node4",
+			Labels: map[string]string{labelBelongingTo: "group2"},
+		}},
+	)
+	m := NewManager(&v1alpha1.CloudHubBandwidthLimit{
+		Enable:             true,
+		NodeBytesPerSecond: 1000,
+		NodeGroups:         map[string]int64{"group1": 2000},
+	}, lister)
+
+	cases := []struct {
+		name      string
+		nodeID    string
+		nodeLimit int64
+		group     bool
+	}{
+		{name: "case1 default limit", nodeID: "node1", nodeLimit: 1000},
+		{name: "case2 annotated limit", nodeID: "node2", nodeLimit: 500, group: true},
+		{name: "case3 invalid annotation", nodeID: "node3", nodeLimit: 1000, group: true},
+		{name: "case4 unlimited group", nodeID: "node4", nodeLimit: 1000},
+		{name: "case5 node not found", nodeID: "node5", nodeLimit: 1000},
+	}
+	for _, c := range cases {
+		nodeLimit, groupLimiter := m.limits(c.nodeID)
+		if nodeLimit != c.nodeLimit {
+			t.Errorf("%s: expected node limit %d, but got %d", c.name, c.nodeLimit, nodeLimit)
+		}
+		if (groupLimiter != nil) != c.group {
+			t.Errorf("%s: expected group limiter %v, but got %v", c.name, c.group, groupLimiter)
+		}
+	}
+
+	_, l2 := m.limits("node2")
+	_, l3 := m.limits("node3")
+	if l2 != l3 {
+		t.Errorf("expected the nodes of a group to share the group limiter")
+	}
+}
+
+func TestWait(t *testing.T) {
+	m := NewManager(&v1alpha1.CloudHubBandwidthLimit{
+		Enable:             true,
+		NodeBytesPerSecond: 1000,
+	}, nil)
+	l := m.Limiter("node1")
+
+	// the burst of one second is sent at once
+	start := time.Now()
+	if err := l.Wait(context.Background(), 1000); err != nil {
+		t.Fatalf("wait err: %v", err)
+	}
+	if waited := time.Since(start); waited > 50*time.Millisecond {
+		t.Errorf("expected the burst not to wait, but waited %v", waited)
+	}
+
+	// a message larger than the burst waits instead of failing
+	start = time.Now()
+	if err := l.Wait(context.Background(), 1200); err != nil {
+		t.Fatalf("wait err: %v", err)
+	}
+	if waited := time.Since(start); waited < time.Second {
+		t.Errorf("expected to wait about 1.2s, but waited %v", waited)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, 1000); err == nil {
+		t.Errorf("expected wait err of canceled context")
+	}
+}
+
+func TestLimiterMetrics(t *testing.T) {
+	m := NewManager(&v1alpha1.CloudHubBandwidthLimit{
+		Enable:             true,
+		NodeBytesPerSecond: 1000,
+	}, nil)
+	old := m.Limiter("metrics-node")
+	l := m.Limiter("metrics-node")
+
+	// the burst is not throttled, the bytes after it wait about 0.1s
+	if err := l.Wait(context.Background(), 1100); err != nil {
+		t.Fatalf("wait err: %v", err)
+	}
+	if bytes := testutil.ToFloat64(monitor.ThrottledBytes.WithLabelValues("metrics-node")); bytes != 1100 {
+		t.Errorf("expected 1100 throttled bytes of the node, but got %v", bytes)
+	}
+	if seconds := testutil.ToFloat64(monitor.ThrottleWaitSeconds.WithLabelValues("metrics-node")); seconds <= 0 {
+		t.Errorf("expected the wait seconds of the node, but got %v", seconds)
+	}
+
+	// the series are kept while the node has an open limiter
+	old.Close()
+	if !monitor.ThrottledBytes.DeleteLabelValues("metrics-node") {
+		t.Errorf("expected the throttled bytes of the node to be kept")
+	}
+	monitor.ThrottledBytes.WithLabelValues("metrics-node").Add(1)
+
+	l.Close()
+	if monitor.ThrottledBytes.DeleteLabelValues("metrics-node") {
+		t.Errorf("expected the throttled bytes of the node to be deleted")
+	}
+	if monitor.ThrottleWaitSeconds.DeleteLabelValues("metrics-node") {
+		t.Errorf("expected the wait seconds of the node to be deleted")
+	}
+
+	var nilLimiter *Limiter
+	nilLimiter.Close()
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/authorization"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/bandwidth"
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/dispatcher"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/handler"
@@ -56,10 +57,18 @@ func newCloudHub(enable bool) *cloudHub {
 		panic(fmt.Sprintf("unable to create new authorizer for CloudHub: %v", err))
 	}
 
+	var bandwidthManager *bandwidth.Manager
+	var nodeInformer cache.SharedIndexInformer
+	if limit := hubconfig.Config.BandwidthLimit; limit != nil && limit.Enable {
+		nodes := informers.GetInformersManager().GetKubeInformerFactory().Core().V1().Nodes()
+		nodeInformer = nodes.Informer()
+		bandwidthManager = bandwidth.NewManager(limit, nodes.Lister())
+	}
+
 	messageHandler := handler.NewMessageHandler(
 		int(hubconfig.Config.KeepaliveInterval),
 		sessionManager, client.GetCRDClient(),
-		messageDispatcher, authorizer)
+		messageDispatcher, authorizer, bandwidthManager)
 	sessionMgr = sessionManager
 
 	ch := &cloudHub{
@@ -67,6 +76,9 @@ func newCloudHub(enable bool) *cloudHub {
 		dispatcher:     messageDispatcher,
 		messageHandler: messageHandler,
 	}
+	if nodeInformer != nil {
+		ch.informersSyncedFuncs = append(ch.informersSyncedFuncs, nodeInformer.HasSynced)
+	}
 
 	ch.informersSyncedFuncs = append(ch.informersSyncedFuncs, clusterObjectSyncInformer.Informer().HasSynced)
 	ch.informersSyncedFuncs = append(ch.informersSyncedFuncs, objectSyncInformer.Informer().HasSynced)
@@ -28,29 +28,30 @@ import (
 // NodeMessagePool is a collection of all downstream messages sent to an
 // edge node. There are two types of messages, one that requires an ack
 // and one that does not. For each type of message, we use the `queue` to
-// mark the order of sending, and use the `store` to store specific messages
+// mark the order of sending, and use the `store` to store specific messages.
+// The queues send the messages of higher priority classes first, see MessagePriority.
 type NodeMessagePool struct {
 	// AckMessageStore store message that will send to edge node
 	// and require acknowledgement from edge node.
 	AckMessageStore cache.Store
 	// AckMessageQueue store message key that will send to edge node
 	// and require acknowledgement from edge node.
-	AckMessageQueue workqueue.RateLimitingInterface
+	AckMessageQueue *PriorityQueue
 	// NoAckMessageStore store message that will send to edge node
 	// and do not require acknowledgement from edge node.
 	NoAckMessageStore cache.Store
 	// NoAckMessageQueue store message key that will send to edge node
 	// and do not require acknowledgement from edge node.
-	NoAckMessageQueue workqueue.RateLimitingInterface
+	NoAckMessageQueue *PriorityQueue
 }
 
 // InitNodeMessagePool init node message pool for node
 func InitNodeMessagePool(nodeID string) *NodeMessagePool {
 	return &NodeMessagePool{
 		AckMessageStore:   cache.NewStore(AckMessageKeyFunc),
-		AckMessageQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), nodeID),
+		AckMessageQueue:   NewPriorityQueue(nodeID, "ack", workqueue.DefaultControllerRateLimiter()),
 		NoAckMessageStore: cache.NewStore(NoAckMessageKeyFunc),
-		NoAckMessageQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), nodeID),
+		NoAckMessageQueue: NewPriorityQueue(nodeID, "noack", workqueue.DefaultControllerRateLimiter()),
 	}
 }
 
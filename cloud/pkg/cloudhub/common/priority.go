@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
+	dcconstants "github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+)
+
+// PriorityClass is the priority of a downstream message, a lower value is a higher priority
+type PriorityClass int
+
+const (
+	// PriorityHigh is for the messages the edge node is waiting for:
+	// deletions, responses to edge requests and device twin updates
+	PriorityHigh PriorityClass = iota
+	// PriorityNormal is for the other messages
+	PriorityNormal
+	// PriorityLow is for the configmap and secret messages, which are
+	// resynced in bulk and must not hold back the other messages
+	PriorityLow
+
+	numPriorityClasses
+)
+
+// priorityWeights are the weights of the classes in the weighted round robin,
+// out of 10 messages sent while all classes are pending, 6 are high priority,
+// 3 are normal priority and 1 is low priority
+var priorityWeights = [numPriorityClasses]int{
+	PriorityHigh:   6,
+	PriorityNormal: 3,
+	PriorityLow:    1,
+}
+
+func (c PriorityClass) String() string {
+	switch c {
+	case PriorityHigh:
+		return "high"
+	case PriorityNormal:
+		return "normal"
+	case PriorityLow:
+		return "low"
+	}
+	return "unknown"
+}
+
+// MessagePriority returns the priority class of a downstream message by its
+// resource type and operation
+func MessagePriority(msg *beehivemodel.Message) PriorityClass {
+	switch msg.GetOperation() {
+	case beehivemodel.DeleteOperation, beehivemodel.ResponseOperation, beehivemodel.ResponseErrorOperation:
+		return PriorityHigh
+	}
+	if msg.GetGroup() == dcconstants.GroupTwin {
+		return PriorityHigh
+	}
+
+	resourceType, _ := messagelayer.GetResourceType(*msg)
+	switch resourceType {
+	case beehivemodel.ResourceTypeConfigmap, beehivemodel.ResourceTypeSecret:
+		return PriorityLow
+	}
+	return PriorityNormal
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+// PriorityQueue is a work queue keeping the items of each priority class in
+// its own FIFO. Get dequeues the classes by smooth weighted round robin, so
+// that the higher classes are served more often while the lower classes are
+// never starved. Like the client-go work queue, an item is queued at most
+// once, and an item added while it is processed is queued again on Done.
+type PriorityQueue struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	// node and name are the node and queue labels of the depth metric,
+	// the name is ack or noack
+	node string
+	name string
+
+	queues [numPriorityClasses][]interface{}
+	// current are the current weights of the smooth weighted round robin
+	current [numPriorityClasses]int
+	// dirty are the items to be processed and their class
+	dirty map[interface{}]PriorityClass
+	// processing are the items being processed and their class
+	processing map[interface{}]PriorityClass
+	// classes are the classes the items were added with last,
+	// they are used when items are added again without a class
+	classes map[interface{}]PriorityClass
+
+	rateLimiter  workqueue.RateLimiter
+	shuttingDown bool
+	drain        bool
+}
+
+var _ workqueue.RateLimitingInterface = &PriorityQueue{}
+
+var (
+	// liveQueues counts the queues not shut down of each node and queue
+	// label pair. A reconnected node gets new queues before the old ones
+	// are shut down, so the depth series of a pair are deleted only when
+	// its last queue is shut down.
+	liveQueuesMu sync.Mutex
+	liveQueues   = make(map[[2]string]int)
+)
+
+// NewPriorityQueue returns a PriorityQueue of node, name is the queue label of the depth metric
+func NewPriorityQueue(node, name string, rateLimiter workqueue.RateLimiter) *PriorityQueue {
+	q := &PriorityQueue{
+		node:        node,
+		name:        name,
+		dirty:       make(map[interface{}]PriorityClass),
+		processing:  make(map[interface{}]PriorityClass),
+		classes:     make(map[interface{}]PriorityClass),
+		rateLimiter: rateLimiter,
+	}
+	q.cond = sync.NewCond(&q.mu)
+
+	liveQueuesMu.Lock()
+	liveQueues[[2]string{node, name}]++
+	liveQueuesMu.Unlock()
+	return q
+}
+
+// Add adds item with the class it was added with last, or PriorityNormal
+func (q *PriorityQueue) Add(item interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	class, ok := q.classes[item]
+	if !ok {
+		class = PriorityNormal
+	}
+	q.add(item, class)
+}
+
+// AddWithPriority adds item to the queue of class. If item is queued in a lower
+// class already, it is moved to class, otherwise it keeps its position.
+func (q *PriorityQueue) AddWithPriority(item interface{}, class PriorityClass) {
+	if class < 0 || class >= numPriorityClasses {
+		class = PriorityNormal
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.add(item, class)
+}
+
+func (q *PriorityQueue) add(item interface{}, class PriorityClass) {
+	if q.shuttingDown {
+		return
+	}
+	q.classes[item] = class
+
+	if queued, ok := q.dirty[item]; ok {
+		if _, processing := q.processing[item]; processing {
+			// it is queued again on Done
+			if class < queued {
+				q.dirty[item] = class
+			}
+			return
+		}
+		if class < queued {
+			q.remove(item, queued)
+			q.push(item, class)
+		}
+		return
+	}
+
+	q.dirty[item] = class
+	if _, processing := q.processing[item]; processing {
+		return
+	}
+	q.push(item, class)
+}
+
+func (q *PriorityQueue) push(item interface{}, class PriorityClass) {
+	q.dirty[item] = class
+	q.queues[class] = append(q.queues[class], item)
+	monitor.NodeMessageQueueDepth.WithLabelValues(q.node, q.name, class.String()).Inc()
+	q.cond.Signal()
+}
+
+func (q *PriorityQueue) remove(item interface{}, class PriorityClass) {
+	queue := q.queues[class]
+	for i := range queue {
+		if queue[i] == item {
+			q.queues[class] = append(queue[:i], queue[i+1:]...)
+			monitor.NodeMessageQueueDepth.WithLabelValues(q.node, q.name, class.String()).Dec()
+			return
+		}
+	}
+}
+
+// next selects the class to dequeue by smooth weighted round robin among the
+// classes with queued items, it returns -1 if all queues are empty
+func (q *PriorityQueue) next() PriorityClass {
+	selected, total := PriorityClass(-1), 0
+	for class := PriorityClass(0); class < numPriorityClasses; class++ {
+		if len(q.queues[class]) == 0 {
+			q.current[class] = 0
+			continue
+		}
+		weight := priorityWeights[class]
+		q.current[class] += weight
+		total += weight
+		if selected < 0 || q.current[class] > q.current[selected] {
+			selected = class
+		}
+	}
+	if selected >= 0 {
+		q.current[selected] -= total
+	}
+	return selected
+}
+
+// Len returns the number of queued items
+func (q *PriorityQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, queue := range q.queues {
+		n += len(queue)
+	}
+	return n
+}
+
+// LenOf returns the number of queued items of class
+func (q *PriorityQueue) LenOf(class PriorityClass) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queues[class])
+}
+
+// Get blocks until an item can be processed, shutdown is true if the queue is shut down
+func (q *PriorityQueue) Get() (interface{}, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if q.shuttingDown && !q.drain {
+			return nil, true
+		}
+		if class := q.next(); class >= 0 {
+			item := q.queues[class][0]
+			q.queues[class][0] = nil
+			q.queues[class] = q.queues[class][1:]
+			monitor.NodeMessageQueueDepth.WithLabelValues(q.node, q.name, class.String()).Dec()
+
+			delete(q.dirty, item)
+			q.processing[item] = class
+			return item, false
+		}
+		if q.shuttingDown {
+			return nil, true
+		}
+		q.cond.Wait()
+	}
+}
+
+// Done marks item as processed, it is queued again if it was added while processed
+func (q *PriorityQueue) Done(item interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.processing, item)
+	if class, ok := q.dirty[item]; ok && (!q.shuttingDown || q.drain) {
+		q.push(item, class)
+	} else if len(q.processing) == 0 {
+		// wake up ShutDownWithDrain
+		q.cond.Broadcast()
+	}
+}
+
+// ShutDown stops the queue, the queued items are dropped
+func (q *PriorityQueue) ShutDown() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.shutDown()
+}
+
+func (q *PriorityQueue) shutDown() {
+	if !q.shuttingDown {
+		q.shuttingDown = true
+
+		key := [2]string{q.node, q.name}
+		liveQueuesMu.Lock()
+		liveQueues[key]--
+		last := liveQueues[key] == 0
+		if last {
+			delete(liveQueues, key)
+		}
+		for class := range q.queues {
+			if last {
+				monitor.NodeMessageQueueDepth.DeleteLabelValues(q.node, q.name, PriorityClass(class).String())
+			} else {
+				monitor.NodeMessageQueueDepth.WithLabelValues(q.node, q.name, PriorityClass(class).String()).
+					Sub(float64(len(q.queues[class])))
+			}
+			q.queues[class] = nil
+		}
+		liveQueuesMu.Unlock()
+	}
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain stops adding items, and waits until the queued items
+// and the items being processed are done before shutting down the queue
+func (q *PriorityQueue) ShutDownWithDrain() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) > 0 || len(q.dirty) > 0 {
+		q.cond.Wait()
+	}
+	q.drain = false
+	q.shuttingDown = false
+	q.shutDown()
+}
+
+// ShuttingDown returns whether the queue is shut down
+func (q *PriorityQueue) ShuttingDown() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds item after duration with the class it was added with last
+func (q *PriorityQueue) AddAfter(item interface{}, duration time.Duration) {
+	if q.ShuttingDown() {
+		return
+	}
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+	time.AfterFunc(duration, func() {
+		q.Add(item)
+	})
+}
+
+// AddRateLimited adds item after the rate limiter says it's ok
+func (q *PriorityQueue) AddRateLimited(item interface{}) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Forget stops tracking the retries of item
+func (q *PriorityQueue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.dirty[item]; !ok {
+		delete(q.classes, item)
+	}
+}
+
+// NumRequeues returns how many times item was requeued
+func (q *PriorityQueue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+func newTestPriorityQueue() *PriorityQueue {
+	return NewPriorityQueue("node", "test", workqueue.NewItemFastSlowRateLimiter(time.Millisecond, time.Millisecond, 1))
+}
+
+func getAndDone(q *PriorityQueue) interface{} {
+	item, _ := q.Get()
+	q.Done(item)
+	return item
+}
+
+func TestPriorityQueueWeightedFair(t *testing.T) {
+	q := newTestPriorityQueue()
+	for i := 0; i < 10; i++ {
+		q.AddWithPriority(fmt.Sprintf("low-%d", i), PriorityLow)
+		q.AddWithPriority(fmt.Sprintf("normal-%d", i), PriorityNormal)
+		q.AddWithPriority(fmt.Sprintf("high-%d", i), PriorityHigh)
+	}
+	assert.Equal(t, 30, q.Len())
+
+	counts := map[string]int{}
+	for i := 0; i < 10; i++ {
+		item := getAndDone(q).(string)
+		counts[item[:len(item)-2]]++
+	}
+	assert.Equal(t, map[string]int{"high": 6, "normal": 3, "low": 1}, counts)
+
+	// the items of a class are dequeued in order
+	assert.Equal(t, 4, q.LenOf(PriorityHigh))
+	assert.Equal(t, 7, q.LenOf(PriorityNormal))
+	assert.Equal(t, 9, q.LenOf(PriorityLow))
+}
+
+func TestPriorityQueueOnlyLowPending(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("low-0", PriorityLow)
+	q.AddWithPriority("low-1", PriorityLow)
+	assert.Equal(t, "low-0", getAndDone(q))
+
+	q.AddWithPriority("high-0", PriorityHigh)
+	assert.Equal(t, "high-0", getAndDone(q))
+	assert.Equal(t, "low-1", getAndDone(q))
+}
+
+func TestPriorityQueueAddExisting(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("a", PriorityLow)
+	q.AddWithPriority("b", PriorityLow)
+	// an item is queued once, and it is moved to a higher class
+	q.AddWithPriority("a", PriorityLow)
+	q.AddWithPriority("b", PriorityHigh)
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, 1, q.LenOf(PriorityHigh))
+	// but not to a lower class
+	q.AddWithPriority("b", PriorityLow)
+	assert.Equal(t, 1, q.LenOf(PriorityHigh))
+
+	assert.Equal(t, "b", getAndDone(q))
+	assert.Equal(t, "a", getAndDone(q))
+}
+
+func TestPriorityQueueAddWhileProcessing(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("a", PriorityNormal)
+	item, _ := q.Get()
+	q.AddWithPriority("a", PriorityHigh)
+	assert.Equal(t, 0, q.Len())
+
+	q.Done(item)
+	assert.Equal(t, 1, q.LenOf(PriorityHigh))
+}
+
+func TestPriorityQueueAddRateLimited(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("a", PriorityHigh)
+	item, _ := q.Get()
+	// the item is requeued in the class it was added with
+	q.AddRateLimited(item)
+	q.Done(item)
+	assert.Equal(t, 1, q.NumRequeues(item))
+
+	assert.Eventually(t, func() bool {
+		return q.LenOf(PriorityHigh) == 1
+	}, time.Second, time.Millisecond)
+	q.Forget(getAndDone(q))
+	assert.Equal(t, 0, q.NumRequeues(item))
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("a", PriorityNormal)
+
+	done := make(chan bool)
+	go func() {
+		getAndDone(q)
+		_, shutdown := q.Get()
+		done <- shutdown
+	}()
+
+	q.ShutDown()
+	assert.True(t, <-done)
+	assert.True(t, q.ShuttingDown())
+
+	q.Add("b")
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestMessagePriority(t *testing.T) {
+	cases := []struct {
+		name     string
+		msg      *beehivemodel.Message
+		expected PriorityClass
+	}{
+		{
+			name: "pod deletion",
+			msg: beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+				"node/n1/default/pod/p1", beehivemodel.DeleteOperation),
+			expected: PriorityHigh,
+		},
+		{
+			name: "device twin update",
+			msg: beehivemodel.NewMessage("").BuildRouter("devicecontroller", "twin",
+				"$hw/events/device/d1/twin/cloud_updated", beehivemodel.UpdateOperation),
+			expected: PriorityHigh,
+		},
+		{
+			name: "response to edge request",
+			msg: beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+				"node/n1/default/configmap/c1", beehivemodel.ResponseOperation),
+			expected: PriorityHigh,
+		},
+		{
+			name: "configmap update",
+			msg: beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+				"node/n1/default/configmap/c1", beehivemodel.UpdateOperation),
+			expected: PriorityLow,
+		},
+		{
+			name: "secret insert",
+			msg: beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+				"node/n1/default/secret/s1", beehivemodel.InsertOperation),
+			expected: PriorityLow,
+		},
+		{
+			name: "pod update",
+			msg: beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+				"node/n1/default/pod/p1", beehivemodel.UpdateOperation),
+			expected: PriorityNormal,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, MessagePriority(c.msg))
+		})
+	}
+}
+
+func TestPriorityQueueDepthMetric(t *testing.T) {
+	depth := func(node string) float64 {
+		m := &dto.Metric{}
+		if err := monitor.NodeMessageQueueDepth.WithLabelValues(node, "test", PriorityHigh.String()).Write(m); err != nil {
+			t.Fatalf("failed to read depth metric: %v", err)
+		}
+		return m.GetGauge().GetValue()
+	}
+
+	q1 := NewPriorityQueue("node-1", "test", workqueue.DefaultControllerRateLimiter())
+	q2 := NewPriorityQueue("node-2", "test", workqueue.DefaultControllerRateLimiter())
+	q1.AddWithPriority("a", PriorityHigh)
+	q1.AddWithPriority("b", PriorityHigh)
+	q2.AddWithPriority("a", PriorityHigh)
+	assert.Equal(t, float64(2), depth("node-1"))
+	assert.Equal(t, float64(1), depth("node-2"))
+
+	getAndDone(q1)
+	assert.Equal(t, float64(1), depth("node-1"))
+
+	// a reconnected node gets a new queue before the old one is shut down
+	reconnected := NewPriorityQueue("node-1", "test", workqueue.DefaultControllerRateLimiter())
+	reconnected.AddWithPriority("c", PriorityHigh)
+	q1.ShutDown()
+	assert.Equal(t, float64(1), depth("node-1"))
+
+	// the series are deleted when the last queue of the node is shut down
+	reconnected.ShutDown()
+	q2.ShutDown()
+	for _, node := range []string{"node-1", "node-2"} {
+		for class := PriorityClass(0); class < numPriorityClasses; class++ {
+			assert.False(t, monitor.NodeMessageQueueDepth.DeleteLabelValues(node, "test", class.String()),
+				"depth series of %s/%s is not deleted", node, class)
+		}
+	}
+}
@@ -41,6 +41,7 @@ import (
 	taskutil "github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/v1alpha1/util"
 	commonconst "github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	deviceconstants "github.com/kubeedge/kubeedge/pkg/device/constants"
 	"github.com/kubeedge/kubeedge/pkg/metaserver"
 	"github.com/kubeedge/kubeedge/pkg/metaserver/util"
 	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
@@ -225,7 +226,7 @@ func (md *messageDispatcher) enqueueNoAckMessage(nodeID string, msg *beehivemode
 		klog.Errorf("failed to add message %v to NoAckMessageStore, err: %v", msg, err)
 		return
 	}
-	nodeMessagePool.NoAckMessageQueue.Add(messageKey)
+	nodeMessagePool.NoAckMessageQueue.AddWithPriority(messageKey, common.MessagePriority(msg))
 }
 
 func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.Message) {
@@ -251,7 +252,7 @@ func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.
 				klog.Errorf("fail to add message %v nodeStore, err: %v", msg, err)
 				return
 			}
-			nodeQueue.Add(messageKey)
+			nodeQueue.AddWithPriority(messageKey, common.MessagePriority(msg))
 		}
 	}()
 
@@ -454,6 +455,10 @@ func noAckRequired(msg *beehivemodel.Message) bool {
 		return true
 	case strings.Contains(msgResource, "twin/cloud_updated"):
 		return true
+	case isDeviceGroupMessage(msg):
+		// device group messages carry the devices of a group in a batch without resource version,
+		// the devicecontroller resends the devices which are not observed by the edge node
+		return true
 	case strings.Contains(msgResource, beehivemodel.ResourceTypeServiceAccountToken):
 		return true
 	case strings.Contains(msgResource, beehivemodel.ResourceTypeK8sCA):
@@ -496,6 +501,14 @@ func noAckRequired(msg *beehivemodel.Message) bool {
 	return false
 }
 
+func isDeviceGroupMessage(msg *beehivemodel.Message) bool {
+	if msg.GetSource() != modules.DeviceControllerModuleName {
+		return false
+	}
+	resourceType, err := messagelayer.GetResourceType(*msg)
+	return err == nil && resourceType == deviceconstants.ResourceTypeDeviceGroup
+}
+
 func isVolumeOperation(op string) bool {
 	return op == commonconst.CSIOperationTypeCreateVolume ||
 		op == commonconst.CSIOperationTypeDeleteVolume ||
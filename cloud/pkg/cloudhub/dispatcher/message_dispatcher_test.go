@@ -92,6 +92,16 @@ func TestNoAckRequired(t *testing.T) {
 			message: beehivemodel.NewMessage("").SetResourceOperation("node/edge-node/default/node/edge-node", "response").FillBody(fmt.Errorf("error")),
 			want:    true,
 		},
+		{
+			name:    "device group message",
+			message: beehivemodel.NewMessage("").SetResourceOperation("node/edge-node/default/devicegroup/plcs", "update").SetRoute("devicecontroller", "resource"),
+			want:    true,
+		},
+		{
+			name:    "device named like a device group",
+			message: beehivemodel.NewMessage("").SetResourceOperation("node/edge-node/default/device/devicegroup", "update").SetRoute("devicecontroller", "resource"),
+			want:    false,
+		},
 		{
 			name:    "normal pod update",
 			message: beehivemodel.NewMessage("").SetResourceOperation("node/edge-node/default/pod/test-pod", "update").SetRoute("edgecontroller", "resource"),
@@ -25,6 +25,7 @@ import (
 
 	reliableclient "github.com/kubeedge/api/client/clientset/versioned"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/authorization"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/bandwidth"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/dispatcher"
@@ -56,13 +57,15 @@ func NewMessageHandler(
 	manager *session.Manager,
 	reliableClient reliableclient.Interface,
 	dispatcher dispatcher.MessageDispatcher,
-	authorizer authorization.Authorizer) Handler {
+	authorizer authorization.Authorizer,
+	bandwidthManager *bandwidth.Manager) Handler {
 	messageHandler := &messageHandler{
 		KeepaliveInterval: KeepaliveInterval,
 		SessionManager:    manager,
 		MessageDispatcher: dispatcher,
 		reliableClient:    reliableClient,
 		authorizer:        authorizer,
+		bandwidthManager:  bandwidthManager,
 	}
 
 	// init handler that process upstream message
@@ -85,6 +88,9 @@ type messageHandler struct {
 
 	// authorizer
 	authorizer authorization.Authorizer
+
+	// bandwidthManager limits the bytes sent to the edge nodes, nil is unlimited
+	bandwidthManager *bandwidth.Manager
 }
 
 // initServerEntries register handler func
@@ -150,6 +156,7 @@ func (mh *messageHandler) HandleConnection(connection conn.Connection) {
 		// create a node session for each edge node
 		nodeSession := session.NewNodeSession(nodeID, projectID, connection,
 			keepaliveInterval, nodeMessagePool, mh.reliableClient)
+		nodeSession.SetBandwidthLimiter(mh.bandwidthManager.Limiter(nodeID))
 		// add node session to the session manager
 		mh.SessionManager.AddSession(nodeSession)
 		go func() {
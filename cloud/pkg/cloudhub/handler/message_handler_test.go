@@ -138,6 +138,10 @@ func (c *fakeConn) WriteMessageAsync(msg *beehivemodel.Message) error {
 	return nil
 }
 
+func (c *fakeConn) WriteFrameAsync(msg *beehivemodel.Message) (int, error) {
+	return 0, c.WriteMessageAsync(msg)
+}
+
 func (c *fakeConn) WriteMessageSync(_ *beehivemodel.Message) (*beehivemodel.Message, error) {
 	return nil, nil
 }
@@ -214,7 +218,7 @@ func newMessageHandlerForTest(manager *session.Manager, d dispatcher.MessageDisp
 }
 
 func TestNewMessageHandler(t *testing.T) {
-	h := NewMessageHandler(10, session.NewSessionManager(10), nil, &fakeDispatcher{}, &fakeAuthorizer{})
+	h := NewMessageHandler(10, session.NewSessionManager(10), nil, &fakeDispatcher{}, &fakeAuthorizer{}, nil)
 	assert.NotNil(t, h)
 }
 
@@ -12,6 +12,7 @@ import (
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/handler"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/server"
 )
 
@@ -66,11 +67,25 @@ func startWebsocketServer(messageHandler handler.Handler) {
 		OnReadTransportErr: messageHandler.OnReadTransportErr,
 		Addr:               fmt.Sprintf("%s:%d", hubconfig.Config.WebSocket.Address, hubconfig.Config.WebSocket.Port),
 		ExOpts:             api.WSServerOption{Path: "/"},
+		Compression:        compressionOptions(),
 	}
 	klog.Infof("Starting cloudhub %s server on %s", api.ProtocolTypeWS, svc.Addr)
 	klog.Exit(svc.ListenAndServeTLS("", ""))
 }
 
+// compressionOptions returns the compression accepted from edgeHub, nil if it is disabled
+func compressionOptions() *compress.Options {
+	c := hubconfig.Config.Compression
+	if c == nil || !c.Enable {
+		return nil
+	}
+	opts := &compress.Options{Threshold: int(c.Threshold)}
+	for _, a := range c.Algorithms {
+		opts.Algorithms = append(opts.Algorithms, compress.Algorithm(a))
+	}
+	return opts
+}
+
 func startQuicServer(messageHandler handler.Handler) {
 	tlsConfig := createTLSConfig(hubconfig.Config.Ca, hubconfig.Config.Cert, hubconfig.Config.Key)
 	svc := server.Server{
@@ -81,6 +96,7 @@ func startQuicServer(messageHandler handler.Handler) {
 		OnReadTransportErr: messageHandler.OnReadTransportErr,
 		Addr:               fmt.Sprintf("%s:%d", hubconfig.Config.Quic.Address, hubconfig.Config.Quic.Port),
 		ExOpts:             api.QuicServerOption{MaxIncomingStreams: int(hubconfig.Config.Quic.MaxIncomingStreams)},
+		Compression:        compressionOptions(),
 	}
 	klog.Infof("Starting cloudhub %s server on %s", api.ProtocolTypeQuic, svc.Addr)
 	klog.Exit(svc.ListenAndServeTLS("", ""))
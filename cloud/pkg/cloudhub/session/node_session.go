@@ -31,6 +31,7 @@ import (
 	"github.com/kubeedge/api/apis/reliablesyncs/v1alpha1"
 	reliableclient "github.com/kubeedge/api/client/clientset/versioned"
 	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/bandwidth"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
@@ -82,6 +83,9 @@ type NodeSession struct {
 	// reliableClient the objectSync client for interacting with Kubernetes API servers
 	reliableClient reliableclient.Interface
 
+	// bandwidthLimiter limits the bytes sent to the edge node, nil is unlimited
+	bandwidthLimiter *bandwidth.Limiter
+
 	// terminateErr records the error type of session termination
 	terminateErr int32
 
@@ -114,6 +118,12 @@ func NewNodeSession(
 	}
 }
 
+// SetBandwidthLimiter sets the limiter of the bytes sent to the edge node,
+// it must be called before Start
+func (ns *NodeSession) SetBandwidthLimiter(limiter *bandwidth.Limiter) {
+	ns.bandwidthLimiter = limiter
+}
+
 // KeepAliveMessage receive keepalive message from edge node
 func (ns *NodeSession) KeepAliveMessage() {
 	select {
@@ -237,6 +247,8 @@ func (ns *NodeSession) Terminating() {
 
 		ns.nodeMessagePool.ShutDown()
 
+		ns.bandwidthLimiter.Close()
+
 		// ignore close error
 		_ = ns.connection.Close()
 	})
@@ -290,11 +302,17 @@ func (ns *NodeSession) syncNoAckMessage() (bool, error) {
 
 	common.TrimMessage(msg)
 
-	if err := ns.connection.WriteMessageAsync(msg); err != nil {
+	n, err := ns.connection.WriteFrameAsync(msg)
+	if err != nil {
 		ns.SetTerminateErr(TransportErr)
 		return true, fmt.Errorf("send message to edge node %s err: %v", ns.nodeID, err)
 	}
 
+	if err := ns.bandwidthLimiter.Wait(ns.ctx, n); err != nil {
+		// the wait is only canceled when the session is terminating
+		return false, fmt.Errorf("wait bandwidth limit of node %s err: %v", ns.nodeID, err)
+	}
+
 	return false, nil
 }
 
@@ -329,6 +347,8 @@ func (ns *NodeSession) syncAckMessage() (bool, error) {
 		return false, fmt.Errorf("send message to node %s err: %v, message: %s", ns.nodeID, err, msg.String())
 
 	default:
+		// the session is terminated, stop tracking the retries of the key
+		ns.nodeMessagePool.AckMessageQueue.Forget(key)
 		ns.SetTerminateErr(TransportErr)
 		// if err is Transport Error, we will terminating node session
 		return true, err
@@ -339,15 +359,15 @@ func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message)
 	ackChan := make(chan struct{})
 	ns.ackMessageCache.Store(copyMsg.GetID(), ackChan)
 
-	// initialize retry count and timer for sending message
-	retryCount := 0
-	ticker := time.NewTimer(sendRetryInterval)
-
-	err := ns.connection.WriteMessageAsync(copyMsg)
-	if err != nil {
+	if err := ns.writeLimited(copyMsg); err != nil {
 		return err
 	}
 
+	// initialize retry count and timer for sending message,
+	// the time waited for the bandwidth limit is not counted
+	retryCount := 0
+	ticker := time.NewTimer(sendRetryInterval)
+
 	for {
 		select {
 		case <-ackChan:
@@ -359,8 +379,7 @@ func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message)
 				return ErrWaitTimeout
 			}
 
-			err := ns.connection.WriteMessageAsync(copyMsg)
-			if err != nil {
+			if err := ns.writeLimited(copyMsg); err != nil {
 				return err
 			}
 
@@ -370,6 +389,20 @@ func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message)
 	}
 }
 
+// writeLimited writes the message to the edge node, then waits for the bandwidth
+// limit of the node with the size of the frame written
+func (ns *NodeSession) writeLimited(msg *beehivemodel.Message) error {
+	n, err := ns.connection.WriteFrameAsync(msg)
+	if err != nil {
+		return err
+	}
+	if err := ns.bandwidthLimiter.Wait(ns.ctx, n); err != nil {
+		// the wait is only canceled when the session is terminating
+		return fmt.Errorf("wait bandwidth limit of node %s err: %v", ns.nodeID, err)
+	}
+	return nil
+}
+
 func (ns *NodeSession) saveSuccessPoint(msg *beehivemodel.Message) {
 	switch {
 	case msg.GetGroup() == deviceconst.GroupTwin:
@@ -132,9 +132,9 @@ func TestNodeSessionSendNoAckMessage(t *testing.T) {
 			defer mockController.Finish()
 
 			if tt.SimulateNormal {
-				mockConn.EXPECT().WriteMessageAsync(gomock.Any()).Return(nil).Times(1)
+				mockConn.EXPECT().WriteFrameAsync(gomock.Any()).Return(1, nil).Times(1)
 			} else {
-				mockConn.EXPECT().WriteMessageAsync(gomock.Any()).Return(errors.New("write err")).Times(1)
+				mockConn.EXPECT().WriteFrameAsync(gomock.Any()).Return(0, errors.New("write err")).Times(1)
 			}
 
 			mockConn.EXPECT().Close().Return(nil).AnyTimes()
@@ -303,13 +303,13 @@ func TestNodeSessionSendAckMessage(t *testing.T) {
 		mockConn.EXPECT().Close().AnyTimes()
 
 		if test.InjectedConnErrTimes > 0 {
-			mockConn.EXPECT().WriteMessageAsync(gomock.Any()).
-				Return(errors.New("write err")).Times(test.InjectedConnErrTimes)
+			mockConn.EXPECT().WriteFrameAsync(gomock.Any()).
+				Return(0, errors.New("write err")).Times(test.InjectedConnErrTimes)
 		}
 
-		mockConn.EXPECT().WriteMessageAsync(gomock.Any()).DoAndReturn(func(msg *beehivemodel.Message) error {
+		mockConn.EXPECT().WriteFrameAsync(gomock.Any()).DoAndReturn(func(msg *beehivemodel.Message) (int, error) {
 			session.ReceiveMessageAck(msg.GetID())
-			return nil
+			return 1, nil
 		}).AnyTimes()
 
 		reactor := tf.NewObjectSyncReactor(client, test.ReactorErrors)
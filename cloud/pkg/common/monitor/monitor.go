@@ -47,6 +47,36 @@ var (
 			Help:      "Number of nodes that connected to the cloudHub instance",
 		},
 	)
+
+	NodeMessageQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "node_message_queue_depth",
+			Help:      "Number of downstream messages waiting to be sent to the connected nodes, by node, queue and priority class",
+		},
+		[]string{"node", "queue", "priority"},
+	)
+
+	ThrottledBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "throttled_bytes_total",
+			Help:      "Number of downstream message bytes delayed by the bandwidth limits of the nodes and NodeGroups, by node",
+		},
+		[]string{"node"},
+	)
+
+	ThrottleWaitSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "throttle_wait_seconds_total",
+			Help:      "Total time downstream messages waited for the bandwidth limits of the nodes and NodeGroups, by node",
+		},
+		[]string{"node"},
+	)
 )
 
 var registerOnce sync.Once
@@ -56,6 +86,9 @@ func registerMetrics() {
 	registerOnce.Do(func() {
 		prometheus.MustRegister(
 			ConnectedNodes,
+			NodeMessageQueueDepth,
+			ThrottledBytes,
+			ThrottleWaitSeconds,
 		)
 	})
 }
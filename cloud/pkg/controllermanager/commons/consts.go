@@ -22,10 +22,12 @@ import "time"
 const (
 	LoggerFieldInstanceName = "name"
 
-	LoggerNameNodeUpgradeJob   = "node-upgrade-job"
-	LoggerNameImagePrePullJob  = "image-prepull-job"
-	LoggerNameConfigeUpdateJob = "config-update-job"
-	LoggerFieldNodeJobType     = "jobtype"
+	LoggerNameNodeUpgradeJob        = "node-upgrade-job"
+	LoggerNameImagePrePullJob       = "image-prepull-job"
+	LoggerNameConfigeUpdateJob      = "config-update-job"
+	LoggerNameCommandJob            = "command-job"
+	LoggerNameEdgeCoreConfigProfile = "edgecore-config-profile"
+	LoggerFieldNodeJobType          = "jobtype"
 )
 
 // Constants for the default values.
@@ -0,0 +1,352 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/commons"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/configprofile"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events.
+	ControllerName = "edgecoreconfigprofile-controller"
+
+	// EventReasonConfigDrifted is the reason of the warning event when the edgecore config
+	// of an edge node drifts from the profile.
+	EventReasonConfigDrifted = "EdgeCoreConfigDrifted"
+	// EventReasonUpdateJobCreated is the reason of the event when a ConfigUpdateJob is created
+	// for the drifted edge nodes.
+	EventReasonUpdateJobCreated = "ConfigUpdateJobCreated"
+)
+
+// Controller is to detect the edgecore config drift of the edge nodes bound to EdgeCoreConfigProfiles.
+type Controller struct {
+	client.Client
+	recorder record.EventRecorder
+}
+
+func NewController(cli client.Client) *Controller {
+	return &Controller{
+		Client: cli,
+	}
+}
+
+// Reconcile compares the edgecore config reported by the edge nodes of the NodeGroups with the profile,
+// and records the drift in the status of the profile. With the AutoUpdate drift policy, a ConfigUpdateJob
+// is created for the drifted edge nodes.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	logger := klog.FromContext(ctx).
+		WithName(commons.LoggerNameEdgeCoreConfigProfile).
+		WithValues(commons.LoggerFieldInstanceName, req.Name)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(2).Info("reconciling the edgecore config profile")
+
+	profile := &operationsv1alpha2.EdgeCoreConfigProfile{}
+	if err := c.Client.Get(ctx, req.NamespacedName, profile); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !profile.DeletionTimestamp.IsZero() {
+		return controllerruntime.Result{}, nil
+	}
+
+	nodes, err := c.getNodesOfNodeGroups(ctx, profile.Spec.NodeGroups)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+	status := calculateStatus(profile, nodes)
+
+	if profile.Spec.DriftPolicy == operationsv1alpha2.EdgeCoreConfigDriftPolicyAutoUpdate &&
+		status.DriftedNodes > 0 {
+		jobName, err := c.ensureUpdateJob(ctx, profile, status.NodeStatus)
+		if err != nil {
+			return controllerruntime.Result{}, err
+		}
+		status.LastUpdateJob = jobName
+	}
+
+	if equality.Semantic.DeepEqual(profile.Status, status) {
+		logger.V(4).Info("status of the edgecore config profile is unchanged, skip update")
+		return controllerruntime.Result{}, nil
+	}
+	c.alertDriftedNodes(profile, status.NodeStatus)
+	profile.Status = status
+	if err := c.Status().Update(ctx, profile); err != nil {
+		return controllerruntime.Result{}, fmt.Errorf("failed to update status of edgecore config profile %s, err: %v",
+			profile.Name, err)
+	}
+	return controllerruntime.Result{}, nil
+}
+
+// getNodesOfNodeGroups returns the nodes that belong to the NodeGroups, keyed by the NodeGroup names.
+func (c *Controller) getNodesOfNodeGroups(ctx context.Context, nodeGroups []string,
+) (map[string][]corev1.Node, error) {
+	res := make(map[string][]corev1.Node, len(nodeGroups))
+	for _, ng := range nodeGroups {
+		nodeList := &corev1.NodeList{}
+		selector := labels.SelectorFromSet(map[string]string{nodegroup.LabelBelongingTo: ng})
+		if err := c.Client.List(ctx, nodeList, &client.ListOptions{LabelSelector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list nodes of nodegroup %s, err: %v", ng, err)
+		}
+		res[ng] = nodeList.Items
+	}
+	return res, nil
+}
+
+// calculateStatus calculates the config state of the nodes. The diff of a node is only recalculated
+// when the hash reported by the node or the generation of the profile changes.
+func calculateStatus(
+	profile *operationsv1alpha2.EdgeCoreConfigProfile,
+	nodes map[string][]corev1.Node,
+) operationsv1alpha2.EdgeCoreConfigProfileStatus {
+	unchanged := profile.Status.ObservedGeneration == profile.Generation
+	previous := make(map[string]operationsv1alpha2.EdgeCoreConfigNodeStatus, len(profile.Status.NodeStatus))
+	for _, it := range profile.Status.NodeStatus {
+		previous[it.NodeName] = it
+	}
+
+	status := operationsv1alpha2.EdgeCoreConfigProfileStatus{
+		ObservedGeneration: profile.Generation,
+		LastUpdateJob:      profile.Status.LastUpdateJob,
+	}
+	for ng, items := range nodes {
+		for i := range items {
+			node := &items[i]
+			hash := node.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfigHash]
+			nodeStatus, ok := previous[node.Name]
+			if !ok || !unchanged || hash == "" || nodeStatus.ConfigHash != hash ||
+				nodeStatus.State == operationsv1alpha2.EdgeCoreConfigStateUnknown {
+				nodeStatus = calculateNodeStatus(profile.Spec.Fields, node)
+			}
+			nodeStatus.NodeGroup = ng
+			if nodeStatus.State == operationsv1alpha2.EdgeCoreConfigStateDrifted {
+				status.DriftedNodes++
+			}
+			status.NodeStatus = append(status.NodeStatus, nodeStatus)
+		}
+	}
+	sort.Slice(status.NodeStatus, func(i, j int) bool {
+		return status.NodeStatus[i].NodeName < status.NodeStatus[j].NodeName
+	})
+	return status
+}
+
+func calculateNodeStatus(fields map[string]string, node *corev1.Node) operationsv1alpha2.EdgeCoreConfigNodeStatus {
+	res := operationsv1alpha2.EdgeCoreConfigNodeStatus{
+		NodeName:   node.Name,
+		ConfigHash: node.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfigHash],
+		State:      operationsv1alpha2.EdgeCoreConfigStateUnknown,
+	}
+	if res.ConfigHash == "" {
+		res.Reason = "the edge node has not reported its edgecore config"
+		return res
+	}
+	snapshot := []byte(node.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfig])
+	if configprofile.Hash(snapshot) != res.ConfigHash {
+		res.Reason = "the edgecore config reported by the edge node does not match its hash"
+		return res
+	}
+	diff, err := configprofile.Diff(fields, snapshot)
+	if err != nil {
+		res.Reason = err.Error()
+		return res
+	}
+	if len(diff) > 0 {
+		res.State = operationsv1alpha2.EdgeCoreConfigStateDrifted
+		res.Diff = diff
+	} else {
+		res.State = operationsv1alpha2.EdgeCoreConfigStateInSync
+	}
+	return res
+}
+
+// ensureUpdateJob creates a ConfigUpdateJob for the drifted nodes, and returns the name of it.
+// The name of the job is derived from the desired fields and the drifted nodes, so a new job is
+// only created when they change. It avoids updating the nodes again and again when the update fails.
+// No job is created while the last job created by the profile is still running.
+func (c *Controller) ensureUpdateJob(
+	ctx context.Context,
+	profile *operationsv1alpha2.EdgeCoreConfigProfile,
+	nodeStatus []operationsv1alpha2.EdgeCoreConfigNodeStatus,
+) (string, error) {
+	logger := klog.FromContext(ctx)
+	if last := profile.Status.LastUpdateJob; last != "" {
+		var job operationsv1alpha2.ConfigUpdateJob
+		err := c.Client.Get(ctx, types.NamespacedName{Name: last}, &job)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get config update job %s, err: %v", last, err)
+		}
+		if err == nil && job.Status.Phase != operationsv1alpha2.JobPhaseCompleted &&
+			job.Status.Phase != operationsv1alpha2.JobPhaseFailure {
+			logger.V(2).Info("the last config update job is still running", "job", last)
+			return last, nil
+		}
+	}
+
+	var nodeNames []string
+	for _, it := range nodeStatus {
+		if it.State == operationsv1alpha2.EdgeCoreConfigStateDrifted {
+			nodeNames = append(nodeNames, it.NodeName)
+		}
+	}
+	name, err := updateJobName(profile.Name, profile.Spec.Fields, nodeNames)
+	if err != nil {
+		return "", err
+	}
+	job := &operationsv1alpha2.ConfigUpdateJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				operationsv1alpha2.LabelEdgeCoreConfigProfile: profile.Name,
+			},
+		},
+		Spec: operationsv1alpha2.ConfigUpdateJobSpec{
+			NodeNames:    nodeNames,
+			UpdateFields: profile.Spec.Fields,
+		},
+	}
+	if s := profile.Spec.UpdateStrategy; s != nil {
+		job.Spec.TimeoutSeconds = s.TimeoutSeconds
+		job.Spec.Concurrency = s.Concurrency
+		job.Spec.FailureTolerate = s.FailureTolerate
+		job.Spec.MaintenanceWindow = s.MaintenanceWindow
+	}
+	if err := controllerutil.SetControllerReference(profile, job, c.Client.Scheme()); err != nil {
+		return "", fmt.Errorf("failed to set owner of config update job %s, err: %v", name, err)
+	}
+	if err := c.Client.Create(ctx, job); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return name, nil
+		}
+		return "", fmt.Errorf("failed to create config update job %s, err: %v", name, err)
+	}
+	logger.Info("created the config update job for the drifted nodes", "job", name, "nodes", nodeNames)
+	if c.recorder != nil {
+		c.recorder.Eventf(profile, corev1.EventTypeNormal, EventReasonUpdateJobCreated,
+			"created ConfigUpdateJob %s for the drifted nodes %s", name, strings.Join(nodeNames, ","))
+	}
+	return name, nil
+}
+
+func updateJobName(profileName string, fields map[string]string, nodeNames []string) (string, error) {
+	data, err := json.Marshal(struct {
+		Fields    map[string]string `json:"fields"`
+		NodeNames []string          `json:"nodeNames"`
+	}{fields, nodeNames})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the desired fields, err: %v", err)
+	}
+	return fmt.Sprintf("%s-%s", profileName, configprofile.Hash(data)[:10]), nil
+}
+
+// alertDriftedNodes records the warning events for the nodes that drift since the last status.
+func (c *Controller) alertDriftedNodes(
+	profile *operationsv1alpha2.EdgeCoreConfigProfile,
+	nodeStatus []operationsv1alpha2.EdgeCoreConfigNodeStatus,
+) {
+	previous := make(map[string]operationsv1alpha2.EdgeCoreConfigNodeStatus, len(profile.Status.NodeStatus))
+	for _, it := range profile.Status.NodeStatus {
+		previous[it.NodeName] = it
+	}
+	for _, it := range nodeStatus {
+		if it.State != operationsv1alpha2.EdgeCoreConfigStateDrifted {
+			continue
+		}
+		if prev, ok := previous[it.NodeName]; ok && equality.Semantic.DeepEqual(prev.Diff, it.Diff) {
+			continue
+		}
+		fields := make([]string, 0, len(it.Diff))
+		for _, d := range it.Diff {
+			fields = append(fields, d.Field)
+		}
+		klog.Warningf("the edgecore config of node %s drifts from profile %s, fields: %s",
+			it.NodeName, profile.Name, strings.Join(fields, ","))
+		if c.recorder != nil {
+			c.recorder.Eventf(profile, corev1.EventTypeWarning, EventReasonConfigDrifted,
+				"the edgecore config of node %s drifts from the profile, fields: %s",
+				it.NodeName, strings.Join(fields, ","))
+		}
+	}
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(_ context.Context, mgr controllerruntime.Manager) error {
+	c.recorder = mgr.GetEventRecorderFor(ControllerName)
+	return controllerruntime.NewControllerManagedBy(mgr).
+		For(&operationsv1alpha2.EdgeCoreConfigProfile{}).
+		Owns(&operationsv1alpha2.ConfigUpdateJob{}).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(c.nodeMapFunc),
+			builder.WithPredicates(predicate.Funcs{UpdateFunc: nodeConfigChanged})).
+		Complete(c)
+}
+
+// nodeConfigChanged filters the node updates that change the reported edgecore config
+// or the NodeGroup of the node, the frequent node status updates are ignored.
+func nodeConfigChanged(e event.UpdateEvent) bool {
+	return e.ObjectOld.GetAnnotations()[operationsv1alpha2.AnnotationEdgeCoreConfigHash] !=
+		e.ObjectNew.GetAnnotations()[operationsv1alpha2.AnnotationEdgeCoreConfigHash] ||
+		e.ObjectOld.GetLabels()[nodegroup.LabelBelongingTo] != e.ObjectNew.GetLabels()[nodegroup.LabelBelongingTo]
+}
+
+// nodeMapFunc maps the node to the profiles bound to the NodeGroup of the node.
+func (c *Controller) nodeMapFunc(ctx context.Context, obj client.Object) []controllerruntime.Request {
+	ng, ok := obj.GetLabels()[nodegroup.LabelBelongingTo]
+	if !ok {
+		return nil
+	}
+	profiles := &operationsv1alpha2.EdgeCoreConfigProfileList{}
+	if err := c.Client.List(ctx, profiles); err != nil {
+		klog.Errorf("failed to list edgecore config profiles, %v", err)
+		return nil
+	}
+	var reqs []controllerruntime.Request
+	for _, it := range profiles.Items {
+		if slices.Contains(it.Spec.NodeGroups, ng) {
+			reqs = append(reqs, controllerruntime.Request{
+				NamespacedName: types.NamespacedName{Name: it.Name},
+			})
+		}
+	}
+	return reqs
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/configprofile"
+)
+
+const testProfileName = "test-profile"
+
+func newNode(name, ng, snapshot string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{nodegroup.LabelBelongingTo: ng},
+		},
+	}
+	if snapshot != "" {
+		node.Annotations = map[string]string{
+			operationsv1alpha2.AnnotationEdgeCoreConfigHash: configprofile.Hash([]byte(snapshot)),
+			operationsv1alpha2.AnnotationEdgeCoreConfig:     snapshot,
+		}
+	}
+	return node
+}
+
+func newProfile(policy operationsv1alpha2.EdgeCoreConfigDriftPolicy) *operationsv1alpha2.EdgeCoreConfigProfile {
+	return &operationsv1alpha2.EdgeCoreConfigProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testProfileName,
+			Generation: 1,
+		},
+		Spec: operationsv1alpha2.EdgeCoreConfigProfileSpec{
+			NodeGroups:  []string{"ng1"},
+			Fields:      map[string]string{"modules.edgeHub.heartbeat": "15"},
+			DriftPolicy: policy,
+		},
+	}
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = operationsv1alpha2.AddToScheme(scheme)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&operationsv1alpha2.EdgeCoreConfigProfile{}, &operationsv1alpha2.ConfigUpdateJob{}).
+		Build()
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	req := controllerruntime.Request{NamespacedName: types.NamespacedName{Name: testProfileName}}
+	nodes := []client.Object{
+		newNode("node1", "ng1", `{"modules":{"edgeHub":{"heartbeat":15}}}`),
+		newNode("node2", "ng1", `{"modules":{"edgeHub":{"heartbeat":30}}}`),
+		newNode("node3", "ng1", ""),
+		newNode("node4", "ng2", `{"modules":{"edgeHub":{"heartbeat":30}}}`),
+	}
+
+	t.Run("case1 alert the drifted nodes", func(t *testing.T) {
+		cli := newFakeClient(append(nodes, newProfile(""))...)
+		c := NewController(cli)
+		_, err := c.Reconcile(ctx, req)
+		require.NoError(t, err)
+
+		var profile operationsv1alpha2.EdgeCoreConfigProfile
+		require.NoError(t, cli.Get(ctx, req.NamespacedName, &profile))
+		assert.Equal(t, int32(1), profile.Status.DriftedNodes)
+		assert.Empty(t, profile.Status.LastUpdateJob)
+		require.Len(t, profile.Status.NodeStatus, 3)
+		assert.Equal(t, operationsv1alpha2.EdgeCoreConfigStateInSync, profile.Status.NodeStatus[0].State)
+		assert.Equal(t, operationsv1alpha2.EdgeCoreConfigStateDrifted, profile.Status.NodeStatus[1].State)
+		assert.Equal(t, []operationsv1alpha2.EdgeCoreConfigFieldDiff{
+			{Field: "modules.edgeHub.heartbeat", Desired: "15", Actual: "30"},
+		}, profile.Status.NodeStatus[1].Diff)
+		assert.Equal(t, "ng1", profile.Status.NodeStatus[1].NodeGroup)
+		assert.Equal(t, operationsv1alpha2.EdgeCoreConfigStateUnknown, profile.Status.NodeStatus[2].State)
+
+		var jobs operationsv1alpha2.ConfigUpdateJobList
+		require.NoError(t, cli.List(ctx, &jobs))
+		assert.Empty(t, jobs.Items)
+	})
+
+	t.Run("case2 create a config update job for the drifted nodes", func(t *testing.T) {
+		cli := newFakeClient(append(nodes, newProfile(operationsv1alpha2.EdgeCoreConfigDriftPolicyAutoUpdate))...)
+		c := NewController(cli)
+		_, err := c.Reconcile(ctx, req)
+		require.NoError(t, err)
+
+		var jobs operationsv1alpha2.ConfigUpdateJobList
+		require.NoError(t, cli.List(ctx, &jobs))
+		require.Len(t, jobs.Items, 1)
+		job := jobs.Items[0]
+		assert.Equal(t, []string{"node2"}, job.Spec.NodeNames)
+		assert.Equal(t, map[string]string{"modules.edgeHub.heartbeat": "15"}, job.Spec.UpdateFields)
+		assert.Equal(t, testProfileName, job.Labels[operationsv1alpha2.LabelEdgeCoreConfigProfile])
+		require.Len(t, job.OwnerReferences, 1)
+		assert.Equal(t, testProfileName, job.OwnerReferences[0].Name)
+
+		var profile operationsv1alpha2.EdgeCoreConfigProfile
+		require.NoError(t, cli.Get(ctx, req.NamespacedName, &profile))
+		assert.Equal(t, job.Name, profile.Status.LastUpdateJob)
+
+		// The job is failed, but the drifted nodes are not changed, so no job is created again.
+		job.Status.Phase = operationsv1alpha2.JobPhaseFailure
+		require.NoError(t, cli.Status().Update(ctx, &job))
+		_, err = c.Reconcile(ctx, req)
+		require.NoError(t, err)
+		require.NoError(t, cli.List(ctx, &jobs))
+		assert.Len(t, jobs.Items, 1)
+	})
+
+	t.Run("case3 wait for the running config update job", func(t *testing.T) {
+		profile := newProfile(operationsv1alpha2.EdgeCoreConfigDriftPolicyAutoUpdate)
+		profile.Status.LastUpdateJob = "running-job"
+		running := &operationsv1alpha2.ConfigUpdateJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "running-job"},
+			Status:     operationsv1alpha2.ConfigUpdateJobStatus{Phase: operationsv1alpha2.JobPhaseInProgress},
+		}
+		cli := newFakeClient(append(nodes, profile, running)...)
+		c := NewController(cli)
+		_, err := c.Reconcile(ctx, req)
+		require.NoError(t, err)
+
+		var jobs operationsv1alpha2.ConfigUpdateJobList
+		require.NoError(t, cli.List(ctx, &jobs))
+		assert.Len(t, jobs.Items, 1)
+		require.NoError(t, cli.Get(ctx, req.NamespacedName, profile))
+		assert.Equal(t, "running-job", profile.Status.LastUpdateJob)
+	})
+
+	t.Run("case4 the profile is not found", func(t *testing.T) {
+		c := NewController(newFakeClient())
+		_, err := c.Reconcile(ctx, req)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCalculateStatusReusesDiff(t *testing.T) {
+	node := newNode("node1", "ng1", `{"modules":{"edgeHub":{"heartbeat":30}}}`)
+	profile := newProfile("")
+	profile.Status = operationsv1alpha2.EdgeCoreConfigProfileStatus{
+		ObservedGeneration: 1,
+		NodeStatus: []operationsv1alpha2.EdgeCoreConfigNodeStatus{{
+			NodeName:   "node1",
+			State:      operationsv1alpha2.EdgeCoreConfigStateInSync,
+			ConfigHash: node.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfigHash],
+		}},
+	}
+	nodes := map[string][]corev1.Node{"ng1": {*node}}
+
+	// The hash and the generation are unchanged, so the previous state is kept.
+	status := calculateStatus(profile, nodes)
+	assert.Equal(t, operationsv1alpha2.EdgeCoreConfigStateInSync, status.NodeStatus[0].State)
+
+	profile.Generation = 2
+	status = calculateStatus(profile, nodes)
+	assert.Equal(t, operationsv1alpha2.EdgeCoreConfigStateDrifted, status.NodeStatus[0].State)
+	assert.Equal(t, int64(2), status.ObservedGeneration)
+}
+
+func TestNodeConfigChanged(t *testing.T) {
+	old := newNode("node1", "ng1", `{"modules":{"edgeHub":{"heartbeat":15}}}`)
+
+	heartbeat := old.DeepCopy()
+	heartbeat.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady}}
+	assert.False(t, nodeConfigChanged(event.UpdateEvent{ObjectOld: old, ObjectNew: heartbeat}))
+
+	reported := newNode("node1", "ng1", `{"modules":{"edgeHub":{"heartbeat":30}}}`)
+	assert.True(t, nodeConfigChanged(event.UpdateEvent{ObjectOld: old, ObjectNew: reported}))
+
+	moved := newNode("node1", "ng2", `{"modules":{"edgeHub":{"heartbeat":15}}}`)
+	assert.True(t, nodeConfigChanged(event.UpdateEvent{ObjectOld: old, ObjectNew: moved}))
+}
+
+func TestNodeMapFunc(t *testing.T) {
+	c := NewController(newFakeClient(newProfile("")))
+	reqs := c.nodeMapFunc(context.TODO(), newNode("node1", "ng1", ""))
+	assert.Equal(t, []controllerruntime.Request{
+		{NamespacedName: types.NamespacedName{Name: testProfileName}},
+	}, reqs)
+
+	assert.Empty(t, c.nodeMapFunc(context.TODO(), newNode("node1", "ng2", "")))
+	assert.Empty(t, c.nodeMapFunc(context.TODO(), &corev1.Node{}))
+}
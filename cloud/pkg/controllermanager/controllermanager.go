@@ -21,6 +21,7 @@ import (
 
 	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
 	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/configprofile"
 	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication"
 	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
 	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodetask"
@@ -113,6 +114,8 @@ func setupControllers(ctx context.Context, mgr manager.Manager, che cache.Cache)
 		ctls = append(ctls, nodetask.NewImagePrePullJobController(cli, che))
 		ctls = append(ctls, nodetask.NewConfigUpdateJobController(cli, che))
 		ctls = append(ctls, nodetask.NewNodeUpgradeJobController(cli, che))
+		ctls = append(ctls, nodetask.NewCommandJobController(cli, che))
+		ctls = append(ctls, configprofile.NewController(cli))
 	} else {
 		klog.V(1).Info("disabled the node task v1alpha2")
 	}
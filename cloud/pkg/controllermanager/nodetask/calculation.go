@@ -38,6 +38,19 @@ func CalculatePhaseWithCounts(total, proc, fail int64,
 	if proc > 0 {
 		return operationsv1alpha2.JobPhaseInProgress
 	}
+	if ExceedsFailureTolerate(total, fail, failureTolerateSpec) {
+		return operationsv1alpha2.JobPhaseFailure
+	}
+	// succ == total || fail / total <= failureTolerate
+	return operationsv1alpha2.JobPhaseCompleted
+}
+
+// ExceedsFailureTolerate returns whether the ratio of the failed node tasks
+// exceeds the failure tolerance.
+func ExceedsFailureTolerate(total, fail int64, failureTolerateSpec string) bool {
+	if total == 0 {
+		return false
+	}
 	// If failureTolerate is not specified, all node tasks must succeed.
 	var failureTolerate float64 = 0
 	if failureTolerateSpec != "" {
@@ -49,12 +62,24 @@ func CalculatePhaseWithCounts(total, proc, fail int64,
 		}
 	}
 	// fail / total > failureTolerate
-	if fail > 0 && decimal.NewFromInt(fail).
+	return fail > 0 && decimal.NewFromInt(fail).
 		Div(decimal.NewFromInt(total)).
 		Round(2).
-		Cmp(decimal.NewFromFloat(failureTolerate)) == 1 {
-		return operationsv1alpha2.JobPhaseFailure
+		Cmp(decimal.NewFromFloat(failureTolerate)) == 1
+}
+
+// CalculateWaveSize calculates the number of nodes of a rollout wave by the percentage
+// of the total nodes, rounded up. A wave with a positive percentage has at least one node.
+func CalculateWaveSize(total int64, percent int32) int64 {
+	if total <= 0 || percent <= 0 {
+		return 0
 	}
-	// succ == total || fail / total <= failureTolerate
-	return operationsv1alpha2.JobPhaseCompleted
+	if percent >= 100 {
+		return total
+	}
+	return decimal.NewFromInt(total).
+		Mul(decimal.NewFromInt32(percent)).
+		Div(decimal.NewFromInt(100)).
+		Ceil().
+		IntPart()
 }
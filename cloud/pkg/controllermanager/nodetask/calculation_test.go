@@ -80,3 +80,24 @@ func TestCalculateStatusWithCounts(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateWaveSize(t *testing.T) {
+	cases := []struct {
+		name    string
+		total   int64
+		percent int32
+		want    int64
+	}{
+		{name: "no nodes", total: 0, percent: 10, want: 0},
+		{name: "no percent", total: 10, percent: 0, want: 0},
+		{name: "rounded up", total: 15, percent: 10, want: 2},
+		{name: "at least one node", total: 3, percent: 1, want: 1},
+		{name: "all nodes", total: 3, percent: 120, want: 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, CalculateWaveSize(c.total, c.percent))
+		})
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/commons"
+)
+
+type CommandJobReconcileHandler struct {
+	cli client.Client
+	che cache.Cache
+}
+
+var _ ReconcileHandler[operationsv1alpha2.CommandJob] = (*CommandJobReconcileHandler)(nil)
+
+func NewCommandJobReconcileHandler(cli client.Client, che cache.Cache) *CommandJobReconcileHandler {
+	return &CommandJobReconcileHandler{
+		cli: cli,
+		che: che,
+	}
+}
+
+func (CommandJobReconcileHandler) GetResource() string {
+	return operationsv1alpha2.ResourceCommandJob
+}
+
+func (h *CommandJobReconcileHandler) GetJob(ctx context.Context, req controllerruntime.Request,
+) (*operationsv1alpha2.CommandJob, error) {
+	var job operationsv1alpha2.CommandJob
+	if err := h.cli.Get(ctx, req.NamespacedName, &job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get command job %s, err: %v",
+			req.NamespacedName, err)
+	}
+	return &job, nil
+}
+
+func (CommandJobReconcileHandler) NoFinalizer(job *operationsv1alpha2.CommandJob) bool {
+	return !controllerutil.ContainsFinalizer(job, operationsv1alpha2.FinalizerCommandJob)
+}
+
+func (h *CommandJobReconcileHandler) AddFinalizer(ctx context.Context, job *operationsv1alpha2.CommandJob) error {
+	newOne := job.DeepCopy()
+	controllerutil.AddFinalizer(newOne, operationsv1alpha2.FinalizerCommandJob)
+	return h.cli.Patch(ctx, newOne, client.MergeFrom(job))
+}
+
+func (h *CommandJobReconcileHandler) RemoveFinalizer(ctx context.Context, job *operationsv1alpha2.CommandJob) error {
+	newOne := job.DeepCopy()
+	controllerutil.RemoveFinalizer(newOne, operationsv1alpha2.FinalizerCommandJob)
+	return h.cli.Patch(ctx, newOne, client.MergeFrom(job))
+}
+
+func (h *CommandJobReconcileHandler) NotInitialized(job *operationsv1alpha2.CommandJob) bool {
+	return job.Status.Phase == ""
+}
+
+func (h *CommandJobReconcileHandler) InitNodesStatus(ctx context.Context, job *operationsv1alpha2.CommandJob) {
+	if err := verifyCommandBundle(job.Name, job.Spec.Bundle, time.Now()); err != nil {
+		job.Status.Phase = operationsv1alpha2.JobPhaseFailure
+		job.Status.Reason = err.Error()
+		return
+	}
+	verifyResult, err := VerifyNodeDefine(ctx, h.che, job.Spec.NodeNames, job.Spec.LabelSelector)
+	if err != nil {
+		job.Status.Phase = operationsv1alpha2.JobPhaseFailure
+		job.Status.Reason = err.Error()
+		return
+	}
+	job.Status.Phase = operationsv1alpha2.JobPhaseInit
+	now := time.Now()
+	nodeStatus := make([]operationsv1alpha2.CommandJobNodeTaskStatus, 0, len(verifyResult))
+	for _, it := range verifyResult {
+		var phase operationsv1alpha2.NodeTaskPhase
+		reason := it.ErrorMessage
+		if it.ErrorMessage == "" {
+			phase, reason = initialNodeTaskPhase(ctx, h.che, job.Spec.MaintenanceWindow, it.NodeName, now)
+		} else {
+			phase = operationsv1alpha2.NodeTaskPhaseFailure
+		}
+		nodeStatus = append(nodeStatus, operationsv1alpha2.CommandJobNodeTaskStatus{
+			NodeName: it.NodeName,
+			Phase:    phase,
+			Reason:   reason,
+		})
+	}
+	job.Status.NodeStatus = nodeStatus
+}
+
+// verifyCommandBundle verifies the required fields of the command bundle, and that it is
+// signed for the job and has not expired. The allow list, the node names and the signature
+// of the command bundle are verified by the edge nodes.
+func verifyCommandBundle(jobName string, bundle operationsv1alpha2.CommandBundle, now time.Time) error {
+	if bundle.Name == "" {
+		return errors.New("the name of command bundle must not be blank")
+	}
+	if len(bundle.Commands) == 0 {
+		return errors.New("the command bundle must contain at least one command")
+	}
+	if bundle.Signature == "" {
+		return errors.New("the command bundle must be signed")
+	}
+	if bundle.JobName != jobName {
+		return fmt.Errorf("the command bundle is signed for job %q, not for job %q", bundle.JobName, jobName)
+	}
+	if bundle.ExpirationTime.IsZero() {
+		return errors.New("the expiration time of command bundle must be set")
+	}
+	if !now.Before(bundle.ExpirationTime.Time) {
+		return fmt.Errorf("the command bundle expired at %s", bundle.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (h *CommandJobReconcileHandler) IsFinalPhase(job *operationsv1alpha2.CommandJob) bool {
+	return job.Status.Phase == operationsv1alpha2.JobPhaseCompleted ||
+		job.Status.Phase == operationsv1alpha2.JobPhaseFailure
+}
+
+func (CommandJobReconcileHandler) IsDeleted(job *operationsv1alpha2.CommandJob) bool {
+	return job.DeletionTimestamp != nil && !job.DeletionTimestamp.IsZero()
+}
+
+func (h *CommandJobReconcileHandler) CalculateStatus(ctx context.Context, job *operationsv1alpha2.CommandJob) bool {
+	var processingCount, failedCount int64
+	for _, it := range job.Status.NodeStatus {
+		if it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
+			it.Phase == operationsv1alpha2.NodeTaskPhaseUnknown {
+			failedCount++
+			continue
+		}
+		if it.Phase != operationsv1alpha2.NodeTaskPhaseSuccessful {
+			processingCount++
+			continue
+		}
+	}
+
+	phase := CalculatePhaseWithCounts(int64(len(job.Status.NodeStatus)),
+		processingCount, failedCount, job.Spec.FailureTolerate)
+	var reason string
+	if phase == operationsv1alpha2.JobPhaseFailure {
+		reason = fmt.Sprintf("the number of failed nodes is %d/%d, which exceeds the failure tolerance threshold",
+			failedCount, len(job.Status.NodeStatus))
+	}
+	var changed bool
+	if job.Status.Phase != phase {
+		job.Status.Phase = phase
+		changed = true
+	}
+	if job.Status.Reason != reason {
+		job.Status.Reason = reason
+		changed = true
+	}
+	return changed
+}
+
+func (h *CommandJobReconcileHandler) UpdateJobStatus(ctx context.Context, job *operationsv1alpha2.CommandJob) error {
+	if err := h.cli.Status().Update(ctx, job); err != nil {
+		return fmt.Errorf("failed to update command job %s status, err: %v",
+			job.Name, err)
+	}
+	return nil
+}
+
+func (h *CommandJobReconcileHandler) CheckTimeout(ctx context.Context, jobName string) error {
+	logger := klog.FromContext(ctx)
+	job, err := h.GetJob(ctx, controllerruntime.Request{
+		NamespacedName: types.NamespacedName{Name: jobName},
+	})
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return nil
+	}
+	if job.Status.Phase != operationsv1alpha2.JobPhaseInProgress {
+		logger.V(2).Info("job is not in InProgress phase, no need to check timeout")
+		return nil
+	}
+
+	// The commands may hang on the edge node, so the node task always has a timeout.
+	timeoutSeconds := int64(commons.DefaultNodeJobTimeout.Seconds())
+	if ts := job.Spec.TimeoutSeconds; ts != nil && *ts > 0 {
+		timeoutSeconds = int64(*ts)
+	}
+	tasks := make([]nodeTaskRef, 0, len(job.Status.NodeStatus))
+	for i := range job.Status.NodeStatus {
+		it := &job.Status.NodeStatus[i]
+		task := nodeTaskRef{
+			nodeName:  it.NodeName,
+			phase:     &it.Phase,
+			reason:    &it.Reason,
+			startTime: job.CreationTimestamp.Time,
+			started:   true,
+		}
+		if len(it.ActionFlow) > 0 {
+			task.lastActionTime = it.ActionFlow[len(it.ActionFlow)-1].Time
+		}
+		tasks = append(tasks, task)
+	}
+
+	// The node tasks waiting for the maintenance window are released when the window opens.
+	changed, windowStarts := checkMaintenanceWindows(ctx, h.che, job.Spec.MaintenanceWindow, tasks, time.Now())
+	timedOut, err := markTimeoutNodeTasks(tasks, timeoutSeconds, windowStarts)
+	if err != nil {
+		return err
+	}
+	if changed || timedOut {
+		if err := h.UpdateJobStatus(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+func TestCommandJobInitNodesStatus(t *testing.T) {
+	ctx := context.TODO()
+	bundle := operationsv1alpha2.CommandBundle{
+		Name:           "restart-app",
+		Commands:       []string{"systemctl restart app"},
+		JobName:        "restart-app-job",
+		ExpirationTime: metav1.NewTime(time.Now().Add(time.Hour)),
+		Signature:      "c2lnbmF0dXJl",
+	}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(VerifyNodeDefine,
+		func(ctx context.Context,
+			che cache.Cache,
+			nodeNames []string,
+			nodeSelector *metav1.LabelSelector,
+		) (res []NodeVerificationResult, err error) {
+			return []NodeVerificationResult{
+				{NodeName: "node1"},
+				{NodeName: "node2", ErrorMessage: "failed to init node2"},
+			}, nil
+		})
+
+	cases := []struct {
+		name       string
+		bundle     func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle
+		wantPhase  operationsv1alpha2.JobPhase
+		wantReason string
+	}{
+		{
+			name: "case1 the command bundle has no name",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.Name = ""
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: "the name of command bundle must not be blank",
+		},
+		{
+			name: "case2 the command bundle has no command",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.Commands = nil
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: "the command bundle must contain at least one command",
+		},
+		{
+			name: "case3 the command bundle is not signed",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.Signature = ""
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: "the command bundle must be signed",
+		},
+		{
+			name: "case4 the command bundle is signed for another job",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.JobName = "another-job"
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: `the command bundle is signed for job "another-job", not for job "restart-app-job"`,
+		},
+		{
+			name: "case5 the command bundle has no expiration time",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.ExpirationTime = metav1.Time{}
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: "the expiration time of command bundle must be set",
+		},
+		{
+			name: "case6 the command bundle has expired",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.ExpirationTime = metav1.NewTime(time.Date(2025, 1, 2, 10, 4, 5, 0, time.UTC))
+				return b
+			},
+			wantPhase:  operationsv1alpha2.JobPhaseFailure,
+			wantReason: "the command bundle expired at 2025-01-02T10:04:05Z",
+		},
+		{
+			name: "case7 init nodes status successful",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				return b
+			},
+			wantPhase: operationsv1alpha2.JobPhaseInit,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			handler := NewCommandJobReconcileHandler(nil, nil)
+			job := &operationsv1alpha2.CommandJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "restart-app-job"},
+				Spec:       operationsv1alpha2.CommandJobSpec{Bundle: c.bundle(bundle)},
+			}
+			handler.InitNodesStatus(ctx, job)
+			assert.Equal(t, c.wantPhase, job.Status.Phase)
+			assert.Equal(t, c.wantReason, job.Status.Reason)
+			if c.wantPhase != operationsv1alpha2.JobPhaseInit {
+				assert.Empty(t, job.Status.NodeStatus)
+				return
+			}
+			require.Len(t, job.Status.NodeStatus, 2)
+			assert.Equal(t, operationsv1alpha2.NodeTaskPhasePending, job.Status.NodeStatus[0].Phase)
+			assert.Equal(t, operationsv1alpha2.NodeTaskPhaseFailure, job.Status.NodeStatus[1].Phase)
+			assert.Equal(t, "failed to init node2", job.Status.NodeStatus[1].Reason)
+		})
+	}
+}
+
+func TestCommandJobCheckTimeout(t *testing.T) {
+	ctx := context.TODO()
+	newJob := func(timeoutSeconds *uint32) *operationsv1alpha2.CommandJob {
+		return &operationsv1alpha2.CommandJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-job",
+				CreationTimestamp: metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+			Spec: operationsv1alpha2.CommandJobSpec{
+				TimeoutSeconds: timeoutSeconds,
+			},
+			Status: operationsv1alpha2.CommandJobStatus{
+				Phase: operationsv1alpha2.JobPhaseInProgress,
+				NodeStatus: []operationsv1alpha2.CommandJobNodeTaskStatus{
+					{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseInProgress},
+					{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhaseSuccessful},
+				},
+			},
+		}
+	}
+
+	t.Run("case1 the default timeout is used", func(t *testing.T) {
+		cli := fakeCommandJobClient(newJob(nil))
+		handler := NewCommandJobReconcileHandler(cli, nil)
+		require.NoError(t, handler.CheckTimeout(ctx, "test-job"))
+
+		var found operationsv1alpha2.CommandJob
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "test-job"}, &found))
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseInProgress, found.Status.NodeStatus[0].Phase)
+	})
+
+	t.Run("case2 the node task has timed out", func(t *testing.T) {
+		ts := uint32(10)
+		cli := fakeCommandJobClient(newJob(&ts))
+		handler := NewCommandJobReconcileHandler(cli, nil)
+		require.NoError(t, handler.CheckTimeout(ctx, "test-job"))
+
+		var found operationsv1alpha2.CommandJob
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "test-job"}, &found))
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseUnknown, found.Status.NodeStatus[0].Phase)
+		assert.Equal(t, NodeTaskReasonTimeout, found.Status.NodeStatus[0].Reason)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseSuccessful, found.Status.NodeStatus[1].Phase)
+	})
+
+	t.Run("case3 the job is not found", func(t *testing.T) {
+		handler := NewCommandJobReconcileHandler(fakeCommandJobClient(), nil)
+		assert.NoError(t, handler.CheckTimeout(ctx, "test-job"))
+	})
+}
+
+func fakeCommandJobClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(operationsv1alpha2.SchemeGroupVersion,
+		&operationsv1alpha2.CommandJob{},
+		&operationsv1alpha2.CommandJobList{},
+	)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(objs...).
+		Build()
+}
@@ -91,18 +91,20 @@ func (h *ConfigUpdateJobReconcileHandler) InitNodesStatus(ctx context.Context, j
 		return
 	}
 	job.Status.Phase = operationsv1alpha2.JobPhaseInit
+	now := time.Now()
 	nodeStatus := make([]operationsv1alpha2.ConfigUpdateJobNodeTaskStatus, 0, len(verifyResult))
 	for _, it := range verifyResult {
 		var phase operationsv1alpha2.NodeTaskPhase
+		reason := it.ErrorMessage
 		if it.ErrorMessage == "" {
-			phase = operationsv1alpha2.NodeTaskPhasePending
+			phase, reason = initialNodeTaskPhase(ctx, h.che, job.Spec.MaintenanceWindow, it.NodeName, now)
 		} else {
 			phase = operationsv1alpha2.NodeTaskPhaseFailure
 		}
 		nodeStatus = append(nodeStatus, operationsv1alpha2.ConfigUpdateJobNodeTaskStatus{
 			NodeName: it.NodeName,
 			Phase:    phase,
-			Reason:   it.ErrorMessage,
+			Reason:   reason,
 		})
 	}
 	job.Status.NodeStatus = nodeStatus
@@ -175,42 +177,32 @@ func (h *ConfigUpdateJobReconcileHandler) CheckTimeout(ctx context.Context, jobN
 	if ts := job.Spec.TimeoutSeconds; ts != nil && *ts > 0 {
 		timeoutSeconds = int64(*ts)
 	}
-	if timeoutSeconds <= 0 {
-		logger.V(2).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
-		return nil
-	}
-
-	var changed bool
+	tasks := make([]nodeTaskRef, 0, len(job.Status.NodeStatus))
 	for i := range job.Status.NodeStatus {
 		it := &job.Status.NodeStatus[i]
-		if it.Phase == operationsv1alpha2.NodeTaskPhaseSuccessful ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseUnknown {
-			continue
+		task := nodeTaskRef{
+			nodeName:  it.NodeName,
+			phase:     &it.Phase,
+			reason:    &it.Reason,
+			startTime: job.CreationTimestamp.Time,
+			started:   true,
 		}
-		now := time.Now().UTC()
 		if len(it.ActionFlow) > 0 {
-			// check last action update time
-			lastAction := it.ActionFlow[len(it.ActionFlow)-1]
-			lastUpdateTime, err := time.Parse(time.RFC3339, lastAction.Time)
-			if err != nil {
-				return fmt.Errorf("failed to parse last action update time %s, err: %v",
-					lastAction.Time, err)
-			}
-			timeout := lastUpdateTime.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
-		} else {
-			timeout := job.CreationTimestamp.Time.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
+			task.lastActionTime = it.ActionFlow[len(it.ActionFlow)-1].Time
+		}
+		tasks = append(tasks, task)
+	}
+
+	// The node tasks waiting for the maintenance window are released when the window opens.
+	changed, windowStarts := checkMaintenanceWindows(ctx, h.che, job.Spec.MaintenanceWindow, tasks, time.Now())
+	if timeoutSeconds <= 0 {
+		logger.V(2).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
+	} else {
+		timedOut, err := markTimeoutNodeTasks(tasks, timeoutSeconds, windowStarts)
+		if err != nil {
+			return err
 		}
+		changed = changed || timedOut
 	}
 
 	if changed {
@@ -92,21 +92,26 @@ func (h *ImagePrePullJobReconcileHandler) InitNodesStatus(ctx context.Context, j
 		return
 	}
 	job.Status.Phase = operationsv1alpha2.JobPhaseInit
+	now := time.Now()
 	nodeStatus := make([]operationsv1alpha2.ImagePrePullNodeTaskStatus, 0, len(verifyResult))
 	for _, it := range verifyResult {
 		var phase operationsv1alpha2.NodeTaskPhase
+		reason := it.ErrorMessage
 		if it.ErrorMessage == "" {
-			phase = operationsv1alpha2.NodeTaskPhasePending
+			phase, reason = initialNodeTaskPhase(ctx, h.che, job.Spec.ImagePrePullTemplate.MaintenanceWindow, it.NodeName, now)
 		} else {
 			phase = operationsv1alpha2.NodeTaskPhaseFailure
 		}
 		nodeStatus = append(nodeStatus, operationsv1alpha2.ImagePrePullNodeTaskStatus{
 			NodeName: it.NodeName,
 			Phase:    phase,
-			Reason:   it.ErrorMessage,
+			Reason:   reason,
 		})
 	}
 	job.Status.NodeStatus = nodeStatus
+	if job.Spec.ImagePrePullTemplate.PullMode == operationsv1alpha2.ImagePullModePeer {
+		h.electImagePrePullPeers(ctx, job)
+	}
 }
 
 func (ImagePrePullJobReconcileHandler) IsFinalPhase(job *operationsv1alpha2.ImagePrePullJob) bool {
@@ -119,6 +124,9 @@ func (ImagePrePullJobReconcileHandler) IsDeleted(job *operationsv1alpha2.ImagePr
 }
 
 func (ImagePrePullJobReconcileHandler) CalculateStatus(ctx context.Context, job *operationsv1alpha2.ImagePrePullJob) bool {
+	// The node tasks waiting for the elected nodes are released when the elected nodes finish.
+	changed := releaseImagePrePullPeers(job, time.Now())
+
 	var processingCount, failedCount int64
 	for _, it := range job.Status.NodeStatus {
 		if it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
@@ -139,7 +147,6 @@ func (ImagePrePullJobReconcileHandler) CalculateStatus(ctx context.Context, job
 		reason = fmt.Sprintf("the number of failed nodes is %d/%d, which exceeds the failure tolerance threshold",
 			failedCount, len(job.Status.NodeStatus))
 	}
-	var changed bool
 	if job.Status.Phase != phase {
 		job.Status.Phase = phase
 		changed = true
@@ -176,42 +183,32 @@ func (h *ImagePrePullJobReconcileHandler) CheckTimeout(ctx context.Context, jobN
 	if ts := job.Spec.ImagePrePullTemplate.TimeoutSeconds; ts != nil && *ts > 0 {
 		timeoutSeconds = int64(*ts)
 	}
-	if timeoutSeconds <= 0 {
-		logger.V(2).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
-		return nil
-	}
-
-	var changed bool
+	tasks := make([]nodeTaskRef, 0, len(job.Status.NodeStatus))
 	for i := range job.Status.NodeStatus {
 		it := &job.Status.NodeStatus[i]
-		if it.Phase == operationsv1alpha2.NodeTaskPhaseSuccessful ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseUnknown {
-			continue
+		task := nodeTaskRef{
+			nodeName: it.NodeName,
+			phase:    &it.Phase,
+			reason:   &it.Reason,
 		}
-		now := time.Now().UTC()
+		// The node tasks waiting for the elected nodes of their NodeGroups are not timed.
+		task.startTime, task.started = imagePrePullTaskStarted(job, it)
 		if len(it.ActionFlow) > 0 {
-			// check last action update time
-			lastAction := it.ActionFlow[len(it.ActionFlow)-1]
-			lastUpdateTime, err := time.Parse(time.RFC3339, lastAction.Time)
-			if err != nil {
-				return fmt.Errorf("failed to parse last action update time %s, err: %v",
-					lastAction.Time, err)
-			}
-			timeout := lastUpdateTime.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
-		} else {
-			timeout := job.CreationTimestamp.Time.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
+			task.lastActionTime = it.ActionFlow[len(it.ActionFlow)-1].Time
+		}
+		tasks = append(tasks, task)
+	}
+
+	// The node tasks waiting for the maintenance window are released when the window opens.
+	changed, windowStarts := checkMaintenanceWindows(ctx, h.che, job.Spec.ImagePrePullTemplate.MaintenanceWindow, tasks, time.Now())
+	if timeoutSeconds <= 0 {
+		logger.V(2).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
+	} else {
+		timedOut, err := markTimeoutNodeTasks(tasks, timeoutSeconds, windowStarts)
+		if err != nil {
+			return err
 		}
+		changed = changed || timedOut
 	}
 
 	if changed {
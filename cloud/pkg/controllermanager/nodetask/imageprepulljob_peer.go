@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/nodes"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+)
+
+// imageMirrorEndpoint returns the endpoint of the image mirror run by the edge node.
+// It returns false if the image mirror of the node is not enabled or the node has no internal IP.
+func imageMirrorEndpoint(node *corev1.Node) (string, bool) {
+	port, err := strconv.ParseUint(node.Annotations[operationsv1alpha2.AnnotationImageMirrorPort], 10, 16)
+	if err != nil || port == 0 {
+		return "", false
+	}
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP && addr.Address != "" {
+			return net.JoinHostPort(addr.Address, strconv.FormatUint(port, 10)), true
+		}
+	}
+	return "", false
+}
+
+// electImagePrePullPeers elects an edge node of each NodeGroup to pull the images from the upstream
+// registries first, and serve the images for the other edge nodes of the NodeGroup in the Peer pull mode.
+// The elected node is the first ready node by name with the image mirror enabled, the nodes in the
+// Pending phase are preferred to the nodes waiting for the maintenance windows. The other nodes of
+// the NodeGroup wait for the elected node. The nodes that do not belong to any NodeGroup, or whose
+// NodeGroup has no node can be elected, pull the images from the upstream registries.
+func (h *ImagePrePullJobReconcileHandler) electImagePrePullPeers(ctx context.Context,
+	job *operationsv1alpha2.ImagePrePullJob,
+) {
+	logger := klog.FromContext(ctx)
+	type candidate struct {
+		task     *operationsv1alpha2.ImagePrePullNodeTaskStatus
+		endpoint string
+		eligible bool
+	}
+	groups := make(map[string][]candidate)
+	for i := range job.Status.NodeStatus {
+		it := &job.Status.NodeStatus[i]
+		if it.Phase == operationsv1alpha2.NodeTaskPhaseFailure {
+			continue
+		}
+		var node corev1.Node
+		if err := h.che.Get(ctx, client.ObjectKey{Name: it.NodeName}, &node); err != nil {
+			logger.Error(err, "failed to get node, pull the images from the upstream registries",
+				"node", it.NodeName)
+			it.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+			continue
+		}
+		group := node.Labels[nodegroup.LabelBelongingTo]
+		if group == "" {
+			it.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+			continue
+		}
+		endpoint, ok := imageMirrorEndpoint(&node)
+		groups[group] = append(groups[group], candidate{
+			task:     it,
+			endpoint: endpoint,
+			eligible: ok && nodes.IsReadyNode(&node),
+		})
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for name := range groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+	for _, name := range groupNames {
+		candidates := groups[name]
+		sort.SliceStable(candidates, func(i, j int) bool {
+			pi := candidates[i].task.Phase == operationsv1alpha2.NodeTaskPhasePending
+			pj := candidates[j].task.Phase == operationsv1alpha2.NodeTaskPhasePending
+			if pi != pj {
+				return pi
+			}
+			return candidates[i].task.NodeName < candidates[j].task.NodeName
+		})
+		var elected *candidate
+		for i := range candidates {
+			if candidates[i].eligible {
+				elected = &candidates[i]
+				break
+			}
+		}
+		if elected == nil {
+			logger.V(2).Info("no node of the NodeGroup can be elected, pull the images from the upstream registries",
+				"nodegroup", name)
+			for _, c := range candidates {
+				c.task.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+			}
+			continue
+		}
+		job.Status.Peers = append(job.Status.Peers, operationsv1alpha2.ImagePrePullPeer{
+			NodeGroup: name,
+			NodeName:  elected.task.NodeName,
+			Endpoint:  elected.endpoint,
+		})
+		for _, c := range candidates {
+			if c.task == elected.task {
+				c.task.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+				continue
+			}
+			c.task.PeerNode = elected.task.NodeName
+		}
+	}
+}
+
+// releaseImagePrePullPeers releases the node tasks waiting for the elected nodes that have finished.
+// The node tasks pull the images from the elected node if it succeeds, otherwise from the upstream
+// registries. Returns whether any node task is released.
+func releaseImagePrePullPeers(job *operationsv1alpha2.ImagePrePullJob, now time.Time) bool {
+	phases := make(map[string]operationsv1alpha2.NodeTaskPhase, len(job.Status.NodeStatus))
+	for _, it := range job.Status.NodeStatus {
+		phases[it.NodeName] = it.Phase
+	}
+	var changed bool
+	for i := range job.Status.Peers {
+		peer := &job.Status.Peers[i]
+		phase := phases[peer.NodeName]
+		if peer.ReleaseTime != nil || !isFinalNodeTaskPhase(phase) {
+			continue
+		}
+		source := operationsv1alpha2.ImagePullSourcePeer
+		if phase != operationsv1alpha2.NodeTaskPhaseSuccessful {
+			source = operationsv1alpha2.ImagePullSourceRegistry
+		}
+		peer.ReleaseTime = &metav1.Time{Time: now}
+		for j := range job.Status.NodeStatus {
+			it := &job.Status.NodeStatus[j]
+			if it.PeerNode == peer.NodeName && it.PullSource == "" {
+				it.PullSource = source
+			}
+		}
+		changed = true
+	}
+	return changed
+}
+
+// imagePrePullTaskStarted returns whether the node task has started. The node tasks waiting for
+// the elected nodes have not started, and the released node tasks start at the release time.
+func imagePrePullTaskStarted(job *operationsv1alpha2.ImagePrePullJob,
+	taskStatus *operationsv1alpha2.ImagePrePullNodeTaskStatus,
+) (time.Time, bool) {
+	if taskStatus.PeerNode == "" {
+		return job.CreationTimestamp.Time, true
+	}
+	for _, peer := range job.Status.Peers {
+		if peer.NodeName != taskStatus.PeerNode {
+			continue
+		}
+		if peer.ReleaseTime == nil {
+			return time.Time{}, false
+		}
+		return peer.ReleaseTime.Time, true
+	}
+	return job.CreationTimestamp.Time, true
+}
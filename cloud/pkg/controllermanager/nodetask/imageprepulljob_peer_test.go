@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+)
+
+func newImageMirrorNode(name, group, port, ip string, ready bool) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{},
+		},
+	}
+	if group != "" {
+		node.Labels[nodegroup.LabelBelongingTo] = group
+	}
+	if port != "" {
+		node.Annotations[operationsv1alpha2.AnnotationImageMirrorPort] = port
+	}
+	if ip != "" {
+		node.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}}
+	}
+	if ready {
+		node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+	}
+	return node
+}
+
+func TestImagePrePullJobElectPeers(t *testing.T) {
+	cli := fakeNodeClient(
+		// group1: node1 is not ready, node2 waits for the window, so node3 is elected.
+		newImageMirrorNode("node1", "group1", "10552", "192.168.1.1", false),
+		newImageMirrorNode("node2", "group1", "10552", "192.168.1.2", true),
+		newImageMirrorNode("node3", "group1", "10552", "192.168.1.3", true),
+		newImageMirrorNode("node4", "group1", "", "192.168.1.4", true),
+		// group2: no node has the image mirror enabled.
+		newImageMirrorNode("node5", "group2", "", "192.168.2.1", true),
+		newImageMirrorNode("node6", "group2", "invalid", "192.168.2.2", true),
+		// node7 does not belong to any NodeGroup.
+		newImageMirrorNode("node7", "", "10552", "192.168.3.1", true),
+		// group3: the IPv6 address
+		newImageMirrorNode("node8", "group3", "10552", "fd00::1", true),
+	)
+	handler := NewImagePrePullJobReconcileHandler(nil, fakeNodeCache{cli: cli})
+	job := &operationsv1alpha2.ImagePrePullJob{
+		Status: operationsv1alpha2.ImagePrePullJobStatus{
+			NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+				{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhaseWaitingWindow},
+				{NodeName: "node3", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node4", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node5", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node6", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node7", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node8", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node9", Phase: operationsv1alpha2.NodeTaskPhaseFailure, Reason: "not found"},
+			},
+		},
+	}
+	handler.electImagePrePullPeers(context.TODO(), job)
+
+	assert.Equal(t, []operationsv1alpha2.ImagePrePullPeer{
+		{NodeGroup: "group1", NodeName: "node3", Endpoint: "192.168.1.3:10552"},
+		{NodeGroup: "group3", NodeName: "node8", Endpoint: "[fd00::1]:10552"},
+	}, job.Status.Peers)
+
+	want := []struct {
+		source   operationsv1alpha2.ImagePullSource
+		peerNode string
+	}{
+		{"", "node3"},
+		{"", "node3"},
+		{operationsv1alpha2.ImagePullSourceRegistry, ""},
+		{"", "node3"},
+		{operationsv1alpha2.ImagePullSourceRegistry, ""},
+		{operationsv1alpha2.ImagePullSourceRegistry, ""},
+		{operationsv1alpha2.ImagePullSourceRegistry, ""},
+		{operationsv1alpha2.ImagePullSourceRegistry, ""},
+		{"", ""},
+	}
+	require.Len(t, job.Status.NodeStatus, len(want))
+	for i, w := range want {
+		it := job.Status.NodeStatus[i]
+		assert.Equal(t, w.source, it.PullSource, it.NodeName)
+		assert.Equal(t, w.peerNode, it.PeerNode, it.NodeName)
+	}
+}
+
+func TestReleaseImagePrePullPeers(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-time.Hour)
+	job := &operationsv1alpha2.ImagePrePullJob{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: operationsv1alpha2.ImagePrePullJobStatus{
+			NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+				{
+					NodeName:   "node1",
+					Phase:      operationsv1alpha2.NodeTaskPhaseSuccessful,
+					PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+				},
+				{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending, PeerNode: "node1"},
+				{
+					NodeName:   "node3",
+					Phase:      operationsv1alpha2.NodeTaskPhaseUnknown,
+					PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+				},
+				{NodeName: "node4", Phase: operationsv1alpha2.NodeTaskPhasePending, PeerNode: "node3"},
+				{
+					NodeName:   "node5",
+					Phase:      operationsv1alpha2.NodeTaskPhaseInProgress,
+					PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+				},
+				{NodeName: "node6", Phase: operationsv1alpha2.NodeTaskPhasePending, PeerNode: "node5"},
+			},
+			Peers: []operationsv1alpha2.ImagePrePullPeer{
+				{NodeGroup: "group1", NodeName: "node1", Endpoint: "192.168.1.1:10552"},
+				{NodeGroup: "group2", NodeName: "node3", Endpoint: "192.168.2.1:10552"},
+				{NodeGroup: "group3", NodeName: "node5", Endpoint: "192.168.3.1:10552"},
+			},
+		},
+	}
+
+	t.Run("case1 the waiting node tasks are not started", func(t *testing.T) {
+		_, started := imagePrePullTaskStarted(job, &job.Status.NodeStatus[1])
+		assert.False(t, started)
+		startTime, started := imagePrePullTaskStarted(job, &job.Status.NodeStatus[0])
+		assert.True(t, started)
+		assert.Equal(t, created, startTime)
+	})
+
+	t.Run("case2 release the node tasks of the finished elected nodes", func(t *testing.T) {
+		assert.True(t, releaseImagePrePullPeers(job, now))
+		assert.Equal(t, operationsv1alpha2.ImagePullSourcePeer, job.Status.NodeStatus[1].PullSource)
+		assert.Equal(t, operationsv1alpha2.ImagePullSourceRegistry, job.Status.NodeStatus[3].PullSource)
+		assert.Empty(t, job.Status.NodeStatus[5].PullSource)
+		assert.NotNil(t, job.Status.Peers[0].ReleaseTime)
+		assert.NotNil(t, job.Status.Peers[1].ReleaseTime)
+		assert.Nil(t, job.Status.Peers[2].ReleaseTime)
+
+		startTime, started := imagePrePullTaskStarted(job, &job.Status.NodeStatus[1])
+		assert.True(t, started)
+		assert.Equal(t, now, startTime)
+	})
+
+	t.Run("case3 the released node tasks are not released again", func(t *testing.T) {
+		assert.False(t, releaseImagePrePullPeers(job, now.Add(time.Minute)))
+		assert.Equal(t, now, job.Status.Peers[0].ReleaseTime.Time)
+	})
+}
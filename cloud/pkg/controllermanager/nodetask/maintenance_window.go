@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/maintenancewindow"
+)
+
+// nodeMaintenanceWindow returns the maintenance window of the node. The window in the
+// node annotation overrides the window of the node job.
+func nodeMaintenanceWindow(
+	ctx context.Context,
+	che cache.Cache,
+	spec *operationsv1alpha2.MaintenanceWindow,
+	nodeName string,
+) (*maintenancewindow.Window, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	var node corev1.Node
+	if err := che.Get(ctx, client.ObjectKey{Name: nodeName}, &node); err != nil {
+		return nil, fmt.Errorf("failed to get node %s, err: %v", nodeName, err)
+	}
+	return maintenancewindow.ForNode(spec, node.Annotations)
+}
+
+// initialNodeTaskPhase returns the initial phase and reason of the node task. The node task
+// waits in the WaitingWindow phase if the maintenance window of the node is closed.
+func initialNodeTaskPhase(
+	ctx context.Context,
+	che cache.Cache,
+	spec *operationsv1alpha2.MaintenanceWindow,
+	nodeName string,
+	now time.Time,
+) (operationsv1alpha2.NodeTaskPhase, string) {
+	window, err := nodeMaintenanceWindow(ctx, che, spec, nodeName)
+	if err != nil {
+		return operationsv1alpha2.NodeTaskPhaseFailure,
+			fmt.Sprintf("invalid maintenance window, err: %v", err)
+	}
+	if _, open := window.Open(now); !open {
+		return operationsv1alpha2.NodeTaskPhaseWaitingWindow, window.WaitingReason(now)
+	}
+	return operationsv1alpha2.NodeTaskPhasePending, ""
+}
+
+// checkMaintenanceWindows checks the maintenance windows of the node tasks that have not
+// reported any action. The node tasks in the WaitingWindow phase are released to Pending
+// when the windows of their nodes open. Returns whether any node task is changed, and the
+// start times of the open windows by node names, nil if the node job has no maintenance window.
+func checkMaintenanceWindows(
+	ctx context.Context,
+	che cache.Cache,
+	spec *operationsv1alpha2.MaintenanceWindow,
+	tasks []nodeTaskRef,
+	now time.Time,
+) (bool, map[string]time.Time) {
+	if spec == nil {
+		return false, nil
+	}
+	logger := klog.FromContext(ctx)
+	var changed bool
+	windowStarts := make(map[string]time.Time)
+	for _, task := range tasks {
+		if task.lastActionTime != "" || isFinalNodeTaskPhase(*task.phase) {
+			continue
+		}
+		window, err := nodeMaintenanceWindow(ctx, che, spec, task.nodeName)
+		if err != nil {
+			logger.Error(err, "failed to get the maintenance window", "node", task.nodeName)
+			continue
+		}
+		start, open := window.Open(now)
+		if !open {
+			if *task.phase == operationsv1alpha2.NodeTaskPhaseWaitingWindow {
+				if reason := window.WaitingReason(now); *task.reason != reason {
+					*task.reason = reason
+					changed = true
+				}
+			}
+			continue
+		}
+		windowStarts[task.nodeName] = start
+		if *task.phase == operationsv1alpha2.NodeTaskPhaseWaitingWindow {
+			*task.phase = operationsv1alpha2.NodeTaskPhasePending
+			*task.reason = ""
+			changed = true
+		}
+	}
+	return changed, windowStarts
+}
+
+// windowTimeoutBase returns the base time of the timeout of the node task that has not
+// reported any action. The timeout of the node task starts when the window of the node opens,
+// returns false if the window of the node is closed.
+func windowTimeoutBase(base time.Time, windowStarts map[string]time.Time, nodeName string,
+) (time.Time, bool) {
+	if windowStarts == nil {
+		return base, true
+	}
+	start, ok := windowStarts[nodeName]
+	if !ok {
+		return time.Time{}, false
+	}
+	if start.After(base) {
+		return start, true
+	}
+	return base, true
+}
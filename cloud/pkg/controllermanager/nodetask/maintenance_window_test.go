@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+func fakeMaintenanceWindowCache() fakeNodeCache {
+	return fakeNodeCache{cli: fakeNodeClient(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "node2",
+			Annotations: map[string]string{
+				operationsv1alpha2.AnnotationMaintenanceWindow: `{"schedules":["0 10 * * *"],"durationSeconds":3600}`,
+			},
+		}},
+	)}
+}
+
+func TestInitialNodeTaskPhase(t *testing.T) {
+	ctx := context.TODO()
+	che := fakeMaintenanceWindowCache()
+	spec := &operationsv1alpha2.MaintenanceWindow{Schedules: []string{"0 22 * * *"}, DurationSeconds: 3600}
+	now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		spec       *operationsv1alpha2.MaintenanceWindow
+		nodeName   string
+		wantPhase  operationsv1alpha2.NodeTaskPhase
+		wantReason string
+	}{
+		{
+			name:      "case1 no maintenance window",
+			nodeName:  "node1",
+			wantPhase: operationsv1alpha2.NodeTaskPhasePending,
+		},
+		{
+			name:       "case2 the window is closed",
+			spec:       spec,
+			nodeName:   "node1",
+			wantPhase:  operationsv1alpha2.NodeTaskPhaseWaitingWindow,
+			wantReason: "waiting for the maintenance window, which opens at 2025-03-14T22:00:00Z",
+		},
+		{
+			name:      "case3 the window of the node annotation is open",
+			spec:      spec,
+			nodeName:  "node2",
+			wantPhase: operationsv1alpha2.NodeTaskPhasePending,
+		},
+		{
+			name:       "case4 the node is not found",
+			spec:       spec,
+			nodeName:   "node3",
+			wantPhase:  operationsv1alpha2.NodeTaskPhaseFailure,
+			wantReason: "invalid maintenance window, err: failed to get node node3, err: nodes \"node3\" not found",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			phase, reason := initialNodeTaskPhase(ctx, che, c.spec, c.nodeName, now)
+			assert.Equal(t, c.wantPhase, phase)
+			assert.Equal(t, c.wantReason, reason)
+		})
+	}
+}
+
+func TestCheckMaintenanceWindows(t *testing.T) {
+	ctx := context.TODO()
+	che := fakeMaintenanceWindowCache()
+	spec := &operationsv1alpha2.MaintenanceWindow{Schedules: []string{"0 22 * * *"}, DurationSeconds: 3600}
+
+	newTasks := func() []nodeTaskRef {
+		phases := []operationsv1alpha2.NodeTaskPhase{
+			operationsv1alpha2.NodeTaskPhaseWaitingWindow,
+			operationsv1alpha2.NodeTaskPhaseWaitingWindow,
+			operationsv1alpha2.NodeTaskPhaseSuccessful,
+		}
+		reasons := make([]string, len(phases))
+		return []nodeTaskRef{
+			{nodeName: "node1", phase: &phases[0], reason: &reasons[0]},
+			{nodeName: "node2", phase: &phases[1], reason: &reasons[1]},
+			{nodeName: "node3", phase: &phases[2], reason: &reasons[2]},
+		}
+	}
+
+	t.Run("case1 no maintenance window", func(t *testing.T) {
+		tasks := newTasks()
+		changed, windowStarts := checkMaintenanceWindows(ctx, che, nil, tasks, time.Now())
+		assert.False(t, changed)
+		assert.Nil(t, windowStarts)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseWaitingWindow, *tasks[0].phase)
+	})
+
+	t.Run("case2 release the node tasks whose windows are open", func(t *testing.T) {
+		tasks := newTasks()
+		now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+		changed, windowStarts := checkMaintenanceWindows(ctx, che, spec, tasks, now)
+		assert.True(t, changed)
+		assert.Equal(t, map[string]time.Time{
+			"node2": time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC),
+		}, windowStarts)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseWaitingWindow, *tasks[0].phase)
+		assert.Equal(t, "waiting for the maintenance window, which opens at 2025-03-14T22:00:00Z", *tasks[0].reason)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhasePending, *tasks[1].phase)
+		assert.Empty(t, *tasks[1].reason)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseSuccessful, *tasks[2].phase)
+	})
+
+	t.Run("case3 skip the node tasks that have reported actions", func(t *testing.T) {
+		tasks := newTasks()
+		tasks[1].lastActionTime = "2025-03-14T10:10:00Z"
+		now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+		_, windowStarts := checkMaintenanceWindows(ctx, che, spec, tasks, now)
+		assert.Empty(t, windowStarts)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseWaitingWindow, *tasks[1].phase)
+	})
+}
+
+func TestMarkTimeoutNodeTasks(t *testing.T) {
+	now := time.Now().UTC()
+	newTask := func(nodeName string, phase operationsv1alpha2.NodeTaskPhase) nodeTaskRef {
+		var reason string
+		return nodeTaskRef{
+			nodeName:  nodeName,
+			phase:     &phase,
+			reason:    &reason,
+			startTime: now.Add(-time.Hour),
+			started:   true,
+		}
+	}
+
+	t.Run("case1 the node task waiting for the window is not timed", func(t *testing.T) {
+		tasks := []nodeTaskRef{newTask("node1", operationsv1alpha2.NodeTaskPhaseWaitingWindow)}
+		changed, err := markTimeoutNodeTasks(tasks, 60, nil)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("case2 the timeout starts when the window opens", func(t *testing.T) {
+		tasks := []nodeTaskRef{
+			newTask("node1", operationsv1alpha2.NodeTaskPhaseInProgress),
+			newTask("node2", operationsv1alpha2.NodeTaskPhasePending),
+			newTask("node3", operationsv1alpha2.NodeTaskPhasePending),
+		}
+		windowStarts := map[string]time.Time{
+			"node1": now.Add(-30 * time.Second),
+			"node2": now.Add(-2 * time.Minute),
+		}
+		changed, err := markTimeoutNodeTasks(tasks, 60, windowStarts)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseInProgress, *tasks[0].phase)
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhaseUnknown, *tasks[1].phase)
+		assert.Equal(t, NodeTaskReasonTimeout, *tasks[1].reason)
+		// The window of node3 is closed.
+		assert.Equal(t, operationsv1alpha2.NodeTaskPhasePending, *tasks[2].phase)
+	})
+
+	t.Run("case3 invalid last action time", func(t *testing.T) {
+		task := newTask("node1", operationsv1alpha2.NodeTaskPhaseInProgress)
+		task.lastActionTime = "invalid"
+		_, err := markTimeoutNodeTasks([]nodeTaskRef{task}, 60, nil)
+		assert.Error(t, err)
+	})
+}
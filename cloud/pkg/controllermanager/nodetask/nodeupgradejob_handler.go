@@ -91,21 +91,29 @@ func (h *NodeUpgradeJobReconcileHandler) InitNodesStatus(ctx context.Context, jo
 		return
 	}
 	job.Status.Phase = operationsv1alpha2.JobPhaseInit
+	now := time.Now()
 	nodeStatus := make([]operationsv1alpha2.NodeUpgradeJobNodeTaskStatus, 0, len(verifyResult))
 	for _, it := range verifyResult {
 		var phase operationsv1alpha2.NodeTaskPhase
+		reason := it.ErrorMessage
 		if it.ErrorMessage == "" {
-			phase = operationsv1alpha2.NodeTaskPhasePending
+			phase, reason = initialNodeTaskPhase(ctx, h.che, job.Spec.MaintenanceWindow, it.NodeName, now)
 		} else {
 			phase = operationsv1alpha2.NodeTaskPhaseFailure
 		}
 		nodeStatus = append(nodeStatus, operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
 			NodeName: it.NodeName,
 			Phase:    phase,
-			Reason:   it.ErrorMessage,
+			Reason:   reason,
 		})
 	}
 	job.Status.NodeStatus = nodeStatus
+	if job.Spec.Rollout != nil {
+		if err := h.initRollout(ctx, job); err != nil {
+			job.Status.Phase = operationsv1alpha2.JobPhaseFailure
+			job.Status.Reason = fmt.Sprintf("invalid rollout, err: %v", err)
+		}
+	}
 }
 
 func (NodeUpgradeJobReconcileHandler) IsFinalPhase(job *operationsv1alpha2.NodeUpgradeJob) bool {
@@ -117,7 +125,12 @@ func (NodeUpgradeJobReconcileHandler) IsDeleted(job *operationsv1alpha2.NodeUpgr
 	return job.DeletionTimestamp != nil && !job.DeletionTimestamp.IsZero()
 }
 
-func (NodeUpgradeJobReconcileHandler) CalculateStatus(ctx context.Context, job *operationsv1alpha2.NodeUpgradeJob) bool {
+func (h *NodeUpgradeJobReconcileHandler) CalculateStatus(ctx context.Context, job *operationsv1alpha2.NodeUpgradeJob) bool {
+	var changed bool
+	if job.Spec.Rollout != nil && job.Status.Rollout != nil {
+		changed = h.calculateRollout(ctx, job)
+	}
+
 	var processingCount, failedCount int64
 	for _, it := range job.Status.NodeStatus {
 		if it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
@@ -134,11 +147,20 @@ func (NodeUpgradeJobReconcileHandler) CalculateStatus(ctx context.Context, job *
 	phase := CalculatePhaseWithCounts(int64(len(job.Status.NodeStatus)),
 		processingCount, failedCount, job.Spec.FailureTolerate)
 	var reason string
+	if rollout := job.Status.Rollout; rollout != nil && int(rollout.CurrentWave) < len(rollout.Waves) {
+		// The job is in progress until all rollout waves are completed.
+		phase = operationsv1alpha2.JobPhaseInProgress
+		if wave := rollout.Waves[rollout.CurrentWave]; rollout.Paused {
+			reason = fmt.Sprintf("the rollout is paused at wave %s", wave.Name)
+			if wave.Reason != "" {
+				reason += ": " + wave.Reason
+			}
+		}
+	}
 	if phase == operationsv1alpha2.JobPhaseFailure {
 		reason = fmt.Sprintf("the number of failed nodes is %d/%d, which exceeds the failure tolerance threshold",
 			failedCount, len(job.Status.NodeStatus))
 	}
-	var changed bool
 	if job.Status.Phase != phase {
 		job.Status.Phase = phase
 		changed = true
@@ -175,44 +197,35 @@ func (h *NodeUpgradeJobReconcileHandler) CheckTimeout(ctx context.Context, jobNa
 	if ts := job.Spec.TimeoutSeconds; ts != nil && *ts > 0 {
 		timeoutSeconds = int64(*ts)
 	}
-	if timeoutSeconds <= 0 {
-		logger.V(3).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
-		return nil
-	}
-
-	var changed bool
+	tasks := make([]nodeTaskRef, 0, len(job.Status.NodeStatus))
 	for i := range job.Status.NodeStatus {
 		it := &job.Status.NodeStatus[i]
-		if it.Phase == operationsv1alpha2.NodeTaskPhaseSuccessful ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseFailure ||
-			it.Phase == operationsv1alpha2.NodeTaskPhaseUnknown {
-			continue
-		}
-		now := time.Now().UTC()
+		task := nodeTaskRef{nodeName: it.NodeName, phase: &it.Phase, reason: &it.Reason}
 		if len(it.ActionFlow) > 0 {
-			// check last action update time
-			lastAction := it.ActionFlow[len(it.ActionFlow)-1]
-			lastUpdateTime, err := time.Parse(time.RFC3339, lastAction.Time)
-			if err != nil {
-				return fmt.Errorf("failed to parse last action update time %s, err: %v",
-					lastAction.Time, err)
-			}
-			timeout := lastUpdateTime.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
-		} else {
-			timeout := job.CreationTimestamp.Time.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
-			if now.After(timeout) {
-				it.Phase = operationsv1alpha2.NodeTaskPhaseUnknown
-				it.Reason = NodeTaskReasonTimeout
-				changed = true
-			}
+			task.lastActionTime = it.ActionFlow[len(it.ActionFlow)-1].Time
 		}
+		// The node tasks of the rollout waves that have not started are not timed.
+		task.startTime, task.started = rolloutWaveStarted(job, it.NodeName)
+		tasks = append(tasks, task)
 	}
 
+	// The node tasks waiting for the maintenance window are released when the window opens.
+	changed, windowStarts := checkMaintenanceWindows(ctx, h.che, job.Spec.MaintenanceWindow, tasks, time.Now())
+	if timeoutSeconds <= 0 {
+		logger.V(3).Info("the timeout seconds is not a value greater than zero, no need to check timeout")
+	} else {
+		timedOut, err := markTimeoutNodeTasks(tasks, timeoutSeconds, windowStarts)
+		if err != nil {
+			return err
+		}
+		changed = changed || timedOut
+	}
+
+	// The soak time of a rollout wave may be over without any status update of the node tasks,
+	// so the rollout is calculated periodically as well.
+	if job.Spec.Rollout != nil && h.CalculateStatus(ctx, job) {
+		changed = true
+	}
 	if changed {
 		if err := h.UpdateJobStatus(ctx, job); err != nil {
 			return err
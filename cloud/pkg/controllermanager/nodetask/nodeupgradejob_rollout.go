@@ -0,0 +1,366 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/nodes"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+)
+
+const (
+	// RemainingRolloutWaveName is the name of the extra last wave that contains
+	// the nodes that are not selected by any wave.
+	RemainingRolloutWaveName = "remaining"
+
+	// podNodeNameField is the pod field index registered by the nodegroup controller.
+	podNodeNameField = "spec.nodeName"
+)
+
+// assignRolloutWaves assigns the nodes of the job to the rollout waves. The waves with NodeNames
+// or NodeGroup select their nodes first, then the waves with Percent select from the remaining nodes
+// in name order. The nodes that are not selected by any wave are put into an extra last wave.
+// The nodeGroups maps the node name to the name of the NodeGroup it belongs to.
+func assignRolloutWaves(waves []operationsv1alpha2.NodeUpgradeWave, nodeNames []string, nodeGroups map[string]string,
+) ([]operationsv1alpha2.NodeUpgradeJobWaveStatus, error) {
+	if len(waves) == 0 {
+		return nil, errors.New("the rollout must have at least one wave")
+	}
+	names := make(map[string]bool, len(waves))
+	for _, wave := range waves {
+		if wave.Name == "" {
+			return nil, errors.New("the name of the rollout wave cannot be empty")
+		}
+		if names[wave.Name] || wave.Name == RemainingRolloutWaveName {
+			return nil, fmt.Errorf("duplicate rollout wave name %s", wave.Name)
+		}
+		names[wave.Name] = true
+		var selectors int
+		if len(wave.NodeNames) > 0 {
+			selectors++
+		}
+		if wave.NodeGroup != "" {
+			selectors++
+		}
+		if wave.Percent != nil {
+			selectors++
+		}
+		if selectors != 1 {
+			return nil, fmt.Errorf("the rollout wave %s must specify only one of nodeNames, nodeGroup and percent",
+				wave.Name)
+		}
+	}
+
+	sorted := make([]string, len(nodeNames))
+	copy(sorted, nodeNames)
+	sort.Strings(sorted)
+	assigned := make(map[string]bool, len(sorted))
+	res := make([]operationsv1alpha2.NodeUpgradeJobWaveStatus, len(waves))
+	for i, wave := range waves {
+		res[i] = operationsv1alpha2.NodeUpgradeJobWaveStatus{
+			Name:  wave.Name,
+			Phase: operationsv1alpha2.RolloutWavePhasePending,
+		}
+		if wave.Percent != nil {
+			continue
+		}
+		selected := make(map[string]bool, len(wave.NodeNames))
+		for _, name := range wave.NodeNames {
+			selected[name] = true
+		}
+		for _, name := range sorted {
+			if assigned[name] {
+				continue
+			}
+			if selected[name] || (wave.NodeGroup != "" && nodeGroups[name] == wave.NodeGroup) {
+				res[i].NodeNames = append(res[i].NodeNames, name)
+				assigned[name] = true
+			}
+		}
+	}
+	for i, wave := range waves {
+		if wave.Percent == nil {
+			continue
+		}
+		size := CalculateWaveSize(int64(len(sorted)), *wave.Percent)
+		for _, name := range sorted {
+			if int64(len(res[i].NodeNames)) >= size {
+				break
+			}
+			if !assigned[name] {
+				res[i].NodeNames = append(res[i].NodeNames, name)
+				assigned[name] = true
+			}
+		}
+	}
+	var remaining []string
+	for _, name := range sorted {
+		if !assigned[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	if len(remaining) > 0 {
+		res = append(res, operationsv1alpha2.NodeUpgradeJobWaveStatus{
+			Name:      RemainingRolloutWaveName,
+			Phase:     operationsv1alpha2.RolloutWavePhasePending,
+			NodeNames: remaining,
+		})
+	}
+	return res, nil
+}
+
+// initRollout assigns the nodes of the job to the rollout waves and starts the first wave.
+func (h *NodeUpgradeJobReconcileHandler) initRollout(ctx context.Context, job *operationsv1alpha2.NodeUpgradeJob,
+) error {
+	nodeNames := make([]string, 0, len(job.Status.NodeStatus))
+	for _, it := range job.Status.NodeStatus {
+		nodeNames = append(nodeNames, it.NodeName)
+	}
+	nodeGroups := make(map[string]string)
+	for _, wave := range job.Spec.Rollout.Waves {
+		if wave.NodeGroup == "" {
+			continue
+		}
+		for _, name := range nodeNames {
+			var node corev1.Node
+			if err := h.che.Get(ctx, client.ObjectKey{Name: name}, &node); err != nil {
+				// The node that failed to get has been marked as failed by the verification.
+				continue
+			}
+			nodeGroups[name] = node.Labels[nodegroup.LabelBelongingTo]
+		}
+		break
+	}
+	waves, err := assignRolloutWaves(job.Spec.Rollout.Waves, nodeNames, nodeGroups)
+	if err != nil {
+		return err
+	}
+	job.Status.Rollout = &operationsv1alpha2.NodeUpgradeJobRolloutStatus{
+		Waves: waves,
+	}
+	h.calculateRollout(ctx, job)
+	return nil
+}
+
+// rolloutWaveStarted returns whether the wave of the node has started. If the job has no rollout,
+// the wave is considered started when the job is created. The time returned is the start time.
+func rolloutWaveStarted(job *operationsv1alpha2.NodeUpgradeJob, nodeName string) (time.Time, bool) {
+	if job.Status.Rollout == nil {
+		return job.CreationTimestamp.Time, true
+	}
+	for _, wave := range job.Status.Rollout.Waves {
+		for _, name := range wave.NodeNames {
+			if name != nodeName {
+				continue
+			}
+			if wave.StartTime == nil {
+				return time.Time{}, false
+			}
+			return wave.StartTime.Time, true
+		}
+	}
+	return job.CreationTimestamp.Time, true
+}
+
+// calculateRollout calculates the progress of the rollout waves. It starts the next wave when
+// the current wave is upgraded, its health gate is passed and the soak time is over, and pauses
+// the rollout when the health gate fails. Returns whether the rollout status is changed.
+func (h *NodeUpgradeJobReconcileHandler) calculateRollout(ctx context.Context, job *operationsv1alpha2.NodeUpgradeJob,
+) bool {
+	logger := klog.FromContext(ctx)
+	rollout := job.Status.Rollout
+	phases := make(map[string]operationsv1alpha2.NodeTaskPhase, len(job.Status.NodeStatus))
+	for _, it := range job.Status.NodeStatus {
+		phases[it.NodeName] = it.Phase
+	}
+
+	var changed bool
+	for int(rollout.CurrentWave) < len(rollout.Waves) {
+		wave := &rollout.Waves[rollout.CurrentWave]
+		var succeeded, failed int32
+		for _, name := range wave.NodeNames {
+			switch phases[name] {
+			case operationsv1alpha2.NodeTaskPhaseSuccessful:
+				succeeded++
+			case operationsv1alpha2.NodeTaskPhaseFailure, operationsv1alpha2.NodeTaskPhaseUnknown:
+				failed++
+			}
+		}
+		if wave.SucceededNodes != succeeded || wave.FailedNodes != failed {
+			wave.SucceededNodes, wave.FailedNodes = succeeded, failed
+			changed = true
+		}
+		upgraded := int(succeeded+failed) == len(wave.NodeNames)
+		now := metav1.Now()
+
+		var next bool
+		switch wave.Phase {
+		case operationsv1alpha2.RolloutWavePhasePending:
+			if job.Spec.Rollout.Paused {
+				break
+			}
+			wave.Phase = operationsv1alpha2.RolloutWavePhaseInProgress
+			wave.StartTime = &now
+			changed, next = true, true
+		case operationsv1alpha2.RolloutWavePhaseInProgress, operationsv1alpha2.RolloutWavePhaseSoaking:
+			if !upgraded {
+				break
+			}
+			reason, err := h.checkHealthGate(ctx, job, wave)
+			if err != nil {
+				logger.Error(err, "failed to check the health gate of the rollout wave", "wave", wave.Name)
+				break
+			}
+			if reason != "" {
+				logger.Info("the health gate of the rollout wave failed, pause the rollout",
+					"wave", wave.Name, "reason", reason)
+				wave.Phase = operationsv1alpha2.RolloutWavePhasePaused
+				wave.Reason = reason
+				changed = true
+				break
+			}
+			soak := rolloutWaveSoakTime(job, int(rollout.CurrentWave))
+			if wave.Phase == operationsv1alpha2.RolloutWavePhaseInProgress && soak > 0 {
+				wave.Phase = operationsv1alpha2.RolloutWavePhaseSoaking
+				wave.SoakStartTime = &now
+				changed = true
+				break
+			}
+			if wave.SoakStartTime != nil && now.Time.Before(wave.SoakStartTime.Add(soak)) {
+				break
+			}
+			completeRolloutWave(rollout, wave, now)
+			changed, next = true, true
+		case operationsv1alpha2.RolloutWavePhasePaused:
+			if job.Annotations[operationsv1alpha2.AnnotationResumeRolloutWave] != wave.Name {
+				break
+			}
+			logger.Info("the rollout wave is resumed", "wave", wave.Name)
+			completeRolloutWave(rollout, wave, now)
+			changed, next = true, true
+		}
+		if !next {
+			break
+		}
+	}
+
+	var paused bool
+	if int(rollout.CurrentWave) < len(rollout.Waves) {
+		wave := rollout.Waves[rollout.CurrentWave]
+		paused = wave.Phase == operationsv1alpha2.RolloutWavePhasePaused ||
+			(wave.Phase == operationsv1alpha2.RolloutWavePhasePending && job.Spec.Rollout.Paused)
+	}
+	if rollout.Paused != paused {
+		rollout.Paused = paused
+		changed = true
+	}
+	return changed
+}
+
+// rolloutWaveSoakTime returns the soak time of the wave at the index.
+// The extra last wave of the remaining nodes has no soak time.
+func rolloutWaveSoakTime(job *operationsv1alpha2.NodeUpgradeJob, index int) time.Duration {
+	if index >= len(job.Spec.Rollout.Waves) {
+		return 0
+	}
+	return time.Duration(job.Spec.Rollout.Waves[index].SoakSeconds) * time.Second
+}
+
+func completeRolloutWave(rollout *operationsv1alpha2.NodeUpgradeJobRolloutStatus,
+	wave *operationsv1alpha2.NodeUpgradeJobWaveStatus, now metav1.Time,
+) {
+	wave.Phase = operationsv1alpha2.RolloutWavePhaseCompleted
+	wave.CompletionTime = &now
+	rollout.CurrentWave++
+}
+
+// checkHealthGate checks the health gate of the upgraded wave.
+// Returns the reason if the health gate fails, or empty if it passes.
+func (h *NodeUpgradeJobReconcileHandler) checkHealthGate(ctx context.Context, job *operationsv1alpha2.NodeUpgradeJob,
+	wave *operationsv1alpha2.NodeUpgradeJobWaveStatus,
+) (string, error) {
+	gate := job.Spec.Rollout.HealthGate
+	if gate == nil {
+		gate = &operationsv1alpha2.NodeUpgradeHealthGate{}
+	}
+	failureTolerate := gate.FailureTolerate
+	if failureTolerate == "" {
+		failureTolerate = job.Spec.FailureTolerate
+	}
+	if ExceedsFailureTolerate(int64(len(wave.NodeNames)), int64(wave.FailedNodes), failureTolerate) {
+		return fmt.Sprintf("the number of failed nodes is %d/%d, which exceeds the failure tolerance threshold",
+			wave.FailedNodes, len(wave.NodeNames)), nil
+	}
+
+	succeeded := make(map[string]bool, wave.SucceededNodes)
+	for _, it := range job.Status.NodeStatus {
+		if it.Phase == operationsv1alpha2.NodeTaskPhaseSuccessful {
+			succeeded[it.NodeName] = true
+		}
+	}
+	for _, name := range wave.NodeNames {
+		if !succeeded[name] {
+			continue
+		}
+		if !gate.SkipNodeReady {
+			var node corev1.Node
+			if err := h.che.Get(ctx, client.ObjectKey{Name: name}, &node); err != nil {
+				return "", fmt.Errorf("failed to get node %s, err: %v", name, err)
+			}
+			if !nodes.IsReadyNode(&node) {
+				return fmt.Sprintf("the node %s is not ready after upgrade", name), nil
+			}
+		}
+		if gate.MaxRestartedPods != nil && wave.StartTime != nil {
+			var pods corev1.PodList
+			if err := h.cli.List(ctx, &pods, client.MatchingFields{podNodeNameField: name}); err != nil {
+				return "", fmt.Errorf("failed to list pods of node %s, err: %v", name, err)
+			}
+			if restarted := countRestartedPods(pods.Items, wave.StartTime.Time); restarted > *gate.MaxRestartedPods {
+				return fmt.Sprintf("%d pods on the node %s restarted after upgrade, which exceeds the maximum %d",
+					restarted, name, *gate.MaxRestartedPods), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// countRestartedPods returns the number of pods whose containers restarted after the given time.
+func countRestartedPods(pods []corev1.Pod, since time.Time) int32 {
+	var count int32
+	for i := range pods {
+		for _, it := range pods[i].Status.ContainerStatuses {
+			terminated := it.LastTerminationState.Terminated
+			if it.RestartCount > 0 && terminated != nil && terminated.FinishedAt.Time.After(since) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetask
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+// fakeNodeCache is a cache that gets the objects from the fake client.
+type fakeNodeCache struct {
+	cache.Cache
+	cli client.Client
+}
+
+func (c fakeNodeCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.cli.Get(ctx, key, obj, opts...)
+}
+
+func fakeNodeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithIndex(&corev1.Pod{}, podNodeNameField, func(o client.Object) []string {
+			return []string{o.(*corev1.Pod).Spec.NodeName}
+		}).
+		Build()
+}
+
+func TestAssignRolloutWaves(t *testing.T) {
+	nodeNames := []string{"node5", "node4", "node3", "node2", "node1"}
+	nodeGroups := map[string]string{"node4": "beijing", "node5": "beijing"}
+	cases := []struct {
+		name    string
+		waves   []operationsv1alpha2.NodeUpgradeWave
+		want    map[string][]string
+		wantErr bool
+	}{
+		{
+			name: "canary, node group and percent",
+			waves: []operationsv1alpha2.NodeUpgradeWave{
+				{Name: "canary", NodeNames: []string{"node3"}},
+				{Name: "half", Percent: ptr.To[int32](40)},
+				{Name: "beijing", NodeGroup: "beijing"},
+			},
+			want: map[string][]string{
+				"canary":  {"node3"},
+				"half":    {"node1", "node2"},
+				"beijing": {"node4", "node5"},
+			},
+		},
+		{
+			name: "remaining nodes",
+			waves: []operationsv1alpha2.NodeUpgradeWave{
+				{Name: "canary", Percent: ptr.To[int32](10)},
+			},
+			want: map[string][]string{
+				"canary":                 {"node1"},
+				RemainingRolloutWaveName: {"node2", "node3", "node4", "node5"},
+			},
+		},
+		{
+			name: "duplicate wave name",
+			waves: []operationsv1alpha2.NodeUpgradeWave{
+				{Name: "canary", NodeNames: []string{"node1"}},
+				{Name: "canary", Percent: ptr.To[int32](10)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple selectors",
+			waves: []operationsv1alpha2.NodeUpgradeWave{
+				{Name: "canary", NodeNames: []string{"node1"}, Percent: ptr.To[int32](10)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			waves, err := assignRolloutWaves(c.waves, nodeNames, nodeGroups)
+			if c.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			got := make(map[string][]string)
+			for _, wave := range waves {
+				assert.Equal(t, operationsv1alpha2.RolloutWavePhasePending, wave.Phase)
+				got[wave.Name] = wave.NodeNames
+			}
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestNodeUpgradeJobCalculateRollout(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	newJob := func(phases []operationsv1alpha2.NodeTaskPhase, wave operationsv1alpha2.NodeUpgradeJobWaveStatus,
+	) *operationsv1alpha2.NodeUpgradeJob {
+		job := &operationsv1alpha2.NodeUpgradeJob{
+			Spec: operationsv1alpha2.NodeUpgradeJobSpec{
+				Rollout: &operationsv1alpha2.NodeUpgradeRollout{
+					Waves: []operationsv1alpha2.NodeUpgradeWave{
+						{Name: "canary", NodeNames: []string{"node1", "node2"}},
+						{Name: "rest", Percent: ptr.To[int32](100)},
+					},
+					HealthGate: &operationsv1alpha2.NodeUpgradeHealthGate{SkipNodeReady: true},
+				},
+			},
+			Status: operationsv1alpha2.NodeUpgradeJobStatus{
+				Phase: operationsv1alpha2.JobPhaseInProgress,
+				NodeStatus: []operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
+					{NodeName: "node1", Phase: phases[0]},
+					{NodeName: "node2", Phase: phases[1]},
+					{NodeName: "node3", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				},
+				Rollout: &operationsv1alpha2.NodeUpgradeJobRolloutStatus{
+					Waves: []operationsv1alpha2.NodeUpgradeJobWaveStatus{
+						wave,
+						{Name: "rest", Phase: operationsv1alpha2.RolloutWavePhasePending, NodeNames: []string{"node3"}},
+					},
+				},
+			},
+		}
+		return job
+	}
+	canary := operationsv1alpha2.NodeUpgradeJobWaveStatus{
+		Name:      "canary",
+		Phase:     operationsv1alpha2.RolloutWavePhaseInProgress,
+		NodeNames: []string{"node1", "node2"},
+		StartTime: &started,
+	}
+	successful := []operationsv1alpha2.NodeTaskPhase{
+		operationsv1alpha2.NodeTaskPhaseSuccessful, operationsv1alpha2.NodeTaskPhaseSuccessful,
+	}
+
+	cases := []struct {
+		name        string
+		job         *operationsv1alpha2.NodeUpgradeJob
+		prepare     func(job *operationsv1alpha2.NodeUpgradeJob)
+		wantWave    int32
+		wantPhases  []operationsv1alpha2.RolloutWavePhase
+		wantPaused  bool
+		wantReason  string
+		wantChanged bool
+	}{
+		{
+			name: "case1 the wave is in progress",
+			job: newJob([]operationsv1alpha2.NodeTaskPhase{
+				operationsv1alpha2.NodeTaskPhaseSuccessful, operationsv1alpha2.NodeTaskPhaseInProgress,
+			}, canary),
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseInProgress, operationsv1alpha2.RolloutWavePhasePending,
+			},
+			wantChanged: true,
+		},
+		{
+			name:     "case2 the wave is upgraded and the next wave starts",
+			job:      newJob(successful, canary),
+			wantWave: 1,
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseCompleted, operationsv1alpha2.RolloutWavePhaseInProgress,
+			},
+			wantChanged: true,
+		},
+		{
+			name: "case3 the wave starts to soak",
+			job:  newJob(successful, canary),
+			prepare: func(job *operationsv1alpha2.NodeUpgradeJob) {
+				job.Spec.Rollout.Waves[0].SoakSeconds = 60
+			},
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseSoaking, operationsv1alpha2.RolloutWavePhasePending,
+			},
+			wantChanged: true,
+		},
+		{
+			name: "case4 the soak time is over",
+			job:  newJob(successful, canary),
+			prepare: func(job *operationsv1alpha2.NodeUpgradeJob) {
+				job.Spec.Rollout.Waves[0].SoakSeconds = 60
+				wave := &job.Status.Rollout.Waves[0]
+				wave.Phase = operationsv1alpha2.RolloutWavePhaseSoaking
+				wave.SoakStartTime = &started
+				wave.SucceededNodes = 2
+			},
+			wantWave: 1,
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseCompleted, operationsv1alpha2.RolloutWavePhaseInProgress,
+			},
+			wantChanged: true,
+		},
+		{
+			name: "case5 the health gate fails",
+			job: newJob([]operationsv1alpha2.NodeTaskPhase{
+				operationsv1alpha2.NodeTaskPhaseSuccessful, operationsv1alpha2.NodeTaskPhaseFailure,
+			}, canary),
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhasePaused, operationsv1alpha2.RolloutWavePhasePending,
+			},
+			wantPaused: true,
+			wantReason: "the rollout is paused at wave canary: " +
+				"the number of failed nodes is 1/2, which exceeds the failure tolerance threshold",
+			wantChanged: true,
+		},
+		{
+			name: "case6 the paused wave is resumed",
+			job: newJob([]operationsv1alpha2.NodeTaskPhase{
+				operationsv1alpha2.NodeTaskPhaseSuccessful, operationsv1alpha2.NodeTaskPhaseFailure,
+			}, canary),
+			prepare: func(job *operationsv1alpha2.NodeUpgradeJob) {
+				job.Annotations = map[string]string{operationsv1alpha2.AnnotationResumeRolloutWave: "canary"}
+				job.Status.Rollout.Waves[0].Phase = operationsv1alpha2.RolloutWavePhasePaused
+				job.Status.Rollout.Paused = true
+			},
+			wantWave: 1,
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseCompleted, operationsv1alpha2.RolloutWavePhaseInProgress,
+			},
+			wantChanged: true,
+		},
+		{
+			name: "case7 the rollout is paused by the spec",
+			job:  newJob(successful, canary),
+			prepare: func(job *operationsv1alpha2.NodeUpgradeJob) {
+				job.Spec.Rollout.Paused = true
+			},
+			wantWave: 1,
+			wantPhases: []operationsv1alpha2.RolloutWavePhase{
+				operationsv1alpha2.RolloutWavePhaseCompleted, operationsv1alpha2.RolloutWavePhasePending,
+			},
+			wantPaused:  true,
+			wantReason:  "the rollout is paused at wave rest",
+			wantChanged: true,
+		},
+	}
+
+	ctx := context.TODO()
+	handler := NewNodeUpgradeJobReconcileHandler(nil, nil)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.prepare != nil {
+				c.prepare(c.job)
+			}
+			changed := handler.CalculateStatus(ctx, c.job)
+			assert.Equal(t, c.wantChanged, changed)
+			rollout := c.job.Status.Rollout
+			assert.Equal(t, c.wantWave, rollout.CurrentWave)
+			phases := make([]operationsv1alpha2.RolloutWavePhase, 0, len(rollout.Waves))
+			for _, wave := range rollout.Waves {
+				phases = append(phases, wave.Phase)
+			}
+			assert.Equal(t, c.wantPhases, phases)
+			assert.Equal(t, c.wantPaused, rollout.Paused)
+			assert.Equal(t, operationsv1alpha2.JobPhaseInProgress, c.job.Status.Phase)
+			assert.Equal(t, c.wantReason, c.job.Status.Reason)
+		})
+	}
+}
+
+func TestNodeUpgradeJobCheckHealthGate(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-time.Minute))
+	readyNode := func(name string, status corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			},
+		}
+	}
+	restartedPod := func(name string, finishedAt time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node1"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					RestartCount: 1,
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finishedAt)},
+					},
+				}},
+			},
+		}
+	}
+	job := &operationsv1alpha2.NodeUpgradeJob{
+		Spec: operationsv1alpha2.NodeUpgradeJobSpec{
+			Rollout: &operationsv1alpha2.NodeUpgradeRollout{
+				HealthGate: &operationsv1alpha2.NodeUpgradeHealthGate{MaxRestartedPods: ptr.To[int32](1)},
+			},
+		},
+		Status: operationsv1alpha2.NodeUpgradeJobStatus{
+			NodeStatus: []operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
+				{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseSuccessful},
+			},
+		},
+	}
+	wave := &operationsv1alpha2.NodeUpgradeJobWaveStatus{
+		Name:           "canary",
+		NodeNames:      []string{"node1"},
+		SucceededNodes: 1,
+		StartTime:      &started,
+	}
+
+	cases := []struct {
+		name       string
+		objs       []client.Object
+		wantReason string
+	}{
+		{
+			name: "case1 passed",
+			objs: []client.Object{
+				readyNode("node1", corev1.ConditionTrue),
+				restartedPod("pod1", time.Now()),
+				restartedPod("pod2", time.Now().Add(-time.Hour)),
+			},
+		},
+		{
+			name:       "case2 the node is not ready",
+			objs:       []client.Object{readyNode("node1", corev1.ConditionFalse)},
+			wantReason: "the node node1 is not ready after upgrade",
+		},
+		{
+			name: "case3 too many pods restarted",
+			objs: []client.Object{
+				readyNode("node1", corev1.ConditionTrue),
+				restartedPod("pod1", time.Now()),
+				restartedPod("pod2", time.Now()),
+			},
+			wantReason: "2 pods on the node node1 restarted after upgrade, which exceeds the maximum 1",
+		},
+	}
+
+	ctx := context.TODO()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cli := fakeNodeClient(c.objs...)
+			handler := NewNodeUpgradeJobReconcileHandler(cli, fakeNodeCache{cli: cli})
+			reason, err := handler.checkHealthGate(ctx, job, wave)
+			assert.NoError(t, err)
+			assert.Equal(t, c.wantReason, reason)
+		})
+	}
+}
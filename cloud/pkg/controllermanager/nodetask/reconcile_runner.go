@@ -34,7 +34,7 @@ const (
 // NodeJobType uses to constrain paradigm type of node jobs.
 type NodeJobType interface {
 	operationsv1alpha2.NodeUpgradeJob | operationsv1alpha2.ImagePrePullJob |
-		operationsv1alpha2.ConfigUpdateJob
+		operationsv1alpha2.ConfigUpdateJob | operationsv1alpha2.CommandJob
 }
 
 type ReconcileHandler[T NodeJobType] interface {
@@ -18,10 +18,13 @@ package nodetask
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"k8s.io/klog/v2"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
 )
 
 var timeoutJobs sync.Map
@@ -114,3 +117,64 @@ func (job *TimeoutJob[T]) Stop(ctx context.Context) {
 func (job TimeoutJob[T]) IsStopped() bool {
 	return job.stopped
 }
+
+// nodeTaskRef references the common fields of the node task status of the node jobs.
+type nodeTaskRef struct {
+	nodeName string
+	phase    *operationsv1alpha2.NodeTaskPhase
+	reason   *string
+	// lastActionTime is the time of the last action reported by the node task,
+	// it is empty if the node task has not reported any action.
+	lastActionTime string
+	// startTime is the time when the node task starts, used as the base time of the timeout
+	// if the node task has not reported any action.
+	startTime time.Time
+	// started indicates whether the node task has started, e.g., the node tasks of the rollout
+	// waves that have not started are not timed.
+	started bool
+}
+
+// markTimeoutNodeTasks sets the phase of the node tasks that have timed out to unknown.
+// The windowStarts are the start times of the open maintenance windows by node names.
+// Returns whether any node task has timed out.
+func markTimeoutNodeTasks(tasks []nodeTaskRef, timeoutSeconds int64, windowStarts map[string]time.Time,
+) (bool, error) {
+	var changed bool
+	now := time.Now().UTC()
+	for _, it := range tasks {
+		if isFinalNodeTaskPhase(*it.phase) ||
+			*it.phase == operationsv1alpha2.NodeTaskPhaseWaitingWindow ||
+			!it.started {
+			continue
+		}
+		var startTime time.Time
+		if it.lastActionTime != "" {
+			// check last action update time
+			lastUpdateTime, err := time.Parse(time.RFC3339, it.lastActionTime)
+			if err != nil {
+				return false, fmt.Errorf("failed to parse last action update time %s, err: %v",
+					it.lastActionTime, err)
+			}
+			startTime = lastUpdateTime
+		} else {
+			var open bool
+			if startTime, open = windowTimeoutBase(it.startTime, windowStarts, it.nodeName); !open {
+				continue
+			}
+		}
+		timeout := startTime.Add(time.Duration(timeoutSeconds) * time.Second).UTC()
+		if now.After(timeout) {
+			*it.phase = operationsv1alpha2.NodeTaskPhaseUnknown
+			*it.reason = NodeTaskReasonTimeout
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// isFinalNodeTaskPhase returns whether the node task phase is final.
+func isFinalNodeTaskPhase(phase operationsv1alpha2.NodeTaskPhase) bool {
+	return phase == operationsv1alpha2.NodeTaskPhaseSuccessful ||
+		phase == operationsv1alpha2.NodeTaskPhaseFailure ||
+		phase == operationsv1alpha2.NodeTaskPhaseUnknown
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+	deviceconstants "github.com/kubeedge/kubeedge/pkg/device/constants"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+const (
+	// deviceGroupResyncPeriod is the period to refresh the status of the device groups
+	// and resend the desired values which are not observed by the edge nodes yet
+	deviceGroupResyncPeriod = 30 * time.Second
+	// maxPendingDevices is the max number of names listed in DeviceGroupStatus.PendingDevices
+	maxPendingDevices = 100
+)
+
+// syncDeviceGroup is used to get device group events from informer
+func (dc *DownstreamController) syncDeviceGroup() {
+	ticker := time.NewTicker(deviceGroupResyncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Info("Stop syncDeviceGroup")
+			return
+		case e := <-dc.deviceGroupManager.Events():
+			deviceGroup, ok := e.Object.(*v1beta1.DeviceGroup)
+			if !ok {
+				klog.Warningf("Object type: %T unsupported", e.Object)
+				continue
+			}
+			switch e.Type {
+			case watch.Added, watch.Modified:
+				dc.deviceGroupUpdated(deviceGroup)
+			case watch.Deleted:
+				dc.deviceGroupDeleted(deviceGroup)
+			default:
+				klog.Warningf("DeviceGroup event type: %s unsupported", e.Type)
+			}
+		case <-ticker.C:
+			dc.deviceGroupManager.DeviceGroup.Range(func(_, value interface{}) bool {
+				if deviceGroup, ok := value.(*v1beta1.DeviceGroup); ok {
+					dc.reconcileDeviceGroup(deviceGroup, true)
+				}
+				return true
+			})
+		}
+	}
+}
+
+// deviceGroupUpdated stores the device group and reconciles it if its spec changed,
+// the events caused by the status updates are not reconciled
+func (dc *DownstreamController) deviceGroupUpdated(deviceGroup *v1beta1.DeviceGroup) {
+	deviceGroupID := util.GetResourceID(deviceGroup.Namespace, deviceGroup.Name)
+	value, ok := dc.deviceGroupManager.DeviceGroup.Load(deviceGroupID)
+	dc.deviceGroupManager.DeviceGroup.Store(deviceGroupID, deviceGroup)
+	if ok {
+		if cached, isGroup := value.(*v1beta1.DeviceGroup); isGroup && reflect.DeepEqual(cached.Spec, deviceGroup.Spec) {
+			return
+		}
+	}
+	dc.reconcileDeviceGroup(deviceGroup, false)
+}
+
+// deviceGroupDeleted removes the device group from the cache, and deletes it from the edge nodes
+// it was sent to, so that they remove the group from their metadata
+func (dc *DownstreamController) deviceGroupDeleted(deviceGroup *v1beta1.DeviceGroup) {
+	deviceGroupID := util.GetResourceID(deviceGroup.Namespace, deviceGroup.Name)
+	dc.deviceGroupManager.DeviceGroup.Delete(deviceGroupID)
+	value, ok := dc.deviceGroupManager.Nodes.LoadAndDelete(deviceGroupID)
+	if !ok {
+		return
+	}
+	for _, nodeName := range sets.List(value.(sets.Set[string])) {
+		dc.sendDeviceGroupDeleteMsg(deviceGroup, nodeName)
+	}
+}
+
+// reconcileDeviceGroup applies the template of the device group to the selected devices,
+// sends the changed devices to the edge nodes in one message per node and updates the
+// group status. If resend is true, the devices whose desired values are not observed by
+// the mapper are sent again.
+func (dc *DownstreamController) reconcileDeviceGroup(deviceGroup *v1beta1.DeviceGroup, resend bool) {
+	selector, err := metav1.LabelSelectorAsSelector(deviceGroup.Spec.Selector)
+	if err != nil {
+		klog.Errorf("Invalid selector of device group %s/%s: %v", deviceGroup.Namespace, deviceGroup.Name, err)
+		return
+	}
+
+	status := v1beta1.DeviceGroupStatus{ObservedGeneration: deviceGroup.Generation}
+	batches := make(map[string][]v1beta1.Device)
+	for _, device := range dc.selectDevices(deviceGroup.Namespace, selector) {
+		status.MatchedDevices++
+		updated, changed := applyDeviceGroupTemplate(device, &deviceGroup.Spec.Template)
+		if changed {
+			if updated, err = dc.updateGroupDevice(device, updated); err != nil {
+				klog.Errorf("Failed to apply device group %s/%s to device %s: %v", deviceGroup.Namespace, deviceGroup.Name, device.Name, err)
+				appendPendingDevice(&status, device.Name)
+				continue
+			}
+		}
+		status.UpdatedDevices++
+
+		converged, observed := dc.deviceGroupConvergence(updated, &deviceGroup.Spec.Template)
+		if converged {
+			status.ConvergedDevices++
+		} else {
+			appendPendingDevice(&status, device.Name)
+		}
+		if updated.Spec.NodeName != "" && (changed || (resend && !observed)) {
+			batches[updated.Spec.NodeName] = append(batches[updated.Spec.NodeName], *updated)
+		}
+	}
+
+	for nodeName, devices := range batches {
+		dc.sendDeviceGroupMsg(deviceGroup, nodeName, devices)
+	}
+	dc.updateDeviceGroupStatus(deviceGroup, status)
+}
+
+// selectDevices returns the devices in the namespace matching the selector, sorted by name
+func (dc *DownstreamController) selectDevices(namespace string, selector labels.Selector) []*v1beta1.Device {
+	var devices []*v1beta1.Device
+	dc.deviceManager.Device.Range(func(_, value interface{}) bool {
+		device, ok := value.(*v1beta1.Device)
+		if ok && device.Namespace == namespace && selector.Matches(labels.Set(device.Labels)) {
+			devices = append(devices, device)
+		}
+		return true
+	})
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Name < devices[j].Name
+	})
+	return devices
+}
+
+// applyDeviceGroupTemplate returns a copy of the device with the desired values of the template,
+// and whether any desired value is changed
+func applyDeviceGroupTemplate(device *v1beta1.Device, template *v1beta1.DeviceGroupTemplate) (*v1beta1.Device, bool) {
+	updated := device.DeepCopy()
+	changed := false
+	for _, property := range template.Properties {
+		for i := range updated.Spec.Properties {
+			if updated.Spec.Properties[i].Name != property.Name {
+				continue
+			}
+			desired := &updated.Spec.Properties[i].Desired
+			if desired.Value != property.Desired.Value {
+				desired.Value = property.Desired.Value
+				changed = true
+			}
+			for k, v := range property.Desired.Metadata {
+				if desired.Metadata == nil {
+					desired.Metadata = make(map[string]string)
+				}
+				if desired.Metadata[k] != v {
+					desired.Metadata[k] = v
+					changed = true
+				}
+			}
+		}
+	}
+	return updated, changed
+}
+
+// updateGroupDevice updates the device in the api server, and caches the updated device after the
+// update succeeds, so that the device event from the informer is not sent to the edge node again.
+func (dc *DownstreamController) updateGroupDevice(device, updated *v1beta1.Device) (*v1beta1.Device, error) {
+	result, err := dc.crdClient.DevicesV1beta1().Devices(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the cached device may be replaced by the device event of the update meanwhile, the device
+	// is then sent to the edge node by the event too, which is harmless
+	dc.deviceManager.Device.CompareAndSwap(util.GetResourceID(device.Namespace, device.Name), device, result)
+	return result, nil
+}
+
+// deviceGroupConvergence returns whether the reported values and the desired values observed by
+// the mapper reached the template for all the properties of the device in the template
+func (dc *DownstreamController) deviceGroupConvergence(device *v1beta1.Device, template *v1beta1.DeviceGroupTemplate) (bool, bool) {
+	var twins []v1beta1.Twin
+	if value, ok := dc.deviceStatusManager.DeviceStatus.Load(util.GetResourceID(device.Namespace, device.Name)); ok {
+		if deviceStatus, isStatus := value.(*v1beta1.DeviceStatus); isStatus {
+			twins = deviceStatus.Status.Twins
+		}
+	}
+
+	converged, observed := true, true
+	for _, property := range template.Properties {
+		if !hasProperty(device, property.Name) {
+			continue
+		}
+		twin := findTwin(twins, property.Name)
+		if twin == nil {
+			return false, false
+		}
+		if !twinValueEqual(twin.Reported.Value, property.Desired.Value) {
+			converged = false
+		}
+		if !twinValueEqual(twin.ObservedDesired.Value, property.Desired.Value) {
+			observed = false
+		}
+	}
+	return converged, observed
+}
+
+func hasProperty(device *v1beta1.Device, name string) bool {
+	for _, property := range device.Spec.Properties {
+		if property.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func findTwin(twins []v1beta1.Twin, propertyName string) *v1beta1.Twin {
+	for i := range twins {
+		if twins[i].PropertyName == propertyName {
+			return &twins[i]
+		}
+	}
+	return nil
+}
+
+// twinValueEqual compares the values as numbers if both of them are numbers, since mappers
+// may report a number in another format than the desired one, e.g. 1.0 for 1
+func twinValueEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	return errX == nil && errY == nil && x == y
+}
+
+func appendPendingDevice(status *v1beta1.DeviceGroupStatus, name string) {
+	if len(status.PendingDevices) < maxPendingDevices {
+		status.PendingDevices = append(status.PendingDevices, name)
+	}
+}
+
+// sendDeviceGroupMsg sends the devices of the group on the node to the edge node in one message
+func (dc *DownstreamController) sendDeviceGroupMsg(deviceGroup *v1beta1.DeviceGroup, nodeName string, devices []v1beta1.Device) {
+	for i := range devices {
+		devices[i].SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   v1beta1.GroupName,
+			Version: v1beta1.Version,
+			Kind:    deviceconstants.KindTypeDevice,
+		})
+	}
+	deviceList := &v1beta1.DeviceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       deviceconstants.KindTypeDeviceList,
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+		},
+		Items: devices,
+	}
+	modelResource, err := messagelayer.BuildResource(nodeName, deviceGroup.Namespace, deviceconstants.ResourceTypeDeviceGroup, deviceGroup.Name)
+	if err != nil {
+		klog.Warningf("Built message resource failed for device group %s, node: %s, error: %s", deviceGroup.Name, nodeName, err)
+		return
+	}
+	modelMsg := model.NewMessage("").FillBody(deviceList)
+	modelMsg.BuildRouter(modules.DeviceControllerModuleName, constants.GroupResource, modelResource, model.UpdateOperation)
+
+	if err = dc.messageLayer.Send(*modelMsg); err != nil {
+		klog.Errorf("Failed to send device group %s/%s to node %s: %v", deviceGroup.Namespace, deviceGroup.Name, nodeName, err)
+		return
+	}
+	deviceGroupID := util.GetResourceID(deviceGroup.Namespace, deviceGroup.Name)
+	value, _ := dc.deviceGroupManager.Nodes.LoadOrStore(deviceGroupID, sets.New[string]())
+	value.(sets.Set[string]).Insert(nodeName)
+}
+
+// sendDeviceGroupDeleteMsg deletes the device group from the edge node, the devices of the group are kept
+func (dc *DownstreamController) sendDeviceGroupDeleteMsg(deviceGroup *v1beta1.DeviceGroup, nodeName string) {
+	resource, err := messagelayer.BuildResource(nodeName, deviceGroup.Namespace, deviceconstants.ResourceTypeDeviceGroup, deviceGroup.Name)
+	if err != nil {
+		klog.Warningf("Built message resource failed for device group %s, node: %s, error: %s", deviceGroup.Name, nodeName, err)
+		return
+	}
+	msg := model.NewMessage("").FillBody(deviceGroup)
+	msg.BuildRouter(modules.DeviceControllerModuleName, constants.GroupResource, resource, model.DeleteOperation)
+
+	if err = dc.messageLayer.Send(*msg); err != nil {
+		klog.Errorf("Failed to delete device group %s/%s from node %s: %v", deviceGroup.Namespace, deviceGroup.Name, nodeName, err)
+	}
+}
+
+// updateDeviceGroupStatus updates the status of the device group if it changed
+func (dc *DownstreamController) updateDeviceGroupStatus(deviceGroup *v1beta1.DeviceGroup, status v1beta1.DeviceGroupStatus) {
+	status.LastUpdateTime = deviceGroup.Status.LastUpdateTime
+	if reflect.DeepEqual(deviceGroup.Status, status) {
+		return
+	}
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+
+	updated := deviceGroup.DeepCopy()
+	updated.Status = status
+	result, err := dc.crdClient.DevicesV1beta1().DeviceGroups(updated.Namespace).UpdateStatus(context.Background(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Errorf("Failed to update status of device group %s/%s: %v", deviceGroup.Namespace, deviceGroup.Name, err)
+		return
+	}
+	dc.deviceGroupManager.DeviceGroup.Store(util.GetResourceID(result.Namespace, result.Name), result)
+}
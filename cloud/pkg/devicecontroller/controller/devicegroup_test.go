@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	crdfake "github.com/kubeedge/api/client/clientset/versioned/fake"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/manager"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+type fakeMessageLayer struct {
+	sent []model.Message
+}
+
+func (f *fakeMessageLayer) Send(message model.Message) error {
+	f.sent = append(f.sent, message)
+	return nil
+}
+
+func (f *fakeMessageLayer) Receive() (model.Message, error) {
+	return model.Message{}, nil
+}
+
+func (f *fakeMessageLayer) Response(model.Message) error {
+	return nil
+}
+
+func newGroupDevice(name, nodeName, setpoint string, labels map[string]string) *v1beta1.Device {
+	return &v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: v1beta1.DeviceSpec{
+			NodeName: nodeName,
+			Properties: []v1beta1.DeviceProperty{
+				{Name: "setpoint", Desired: v1beta1.TwinProperty{Value: setpoint}},
+				{Name: "temperature"},
+			},
+		},
+	}
+}
+
+func TestApplyDeviceGroupTemplate(t *testing.T) {
+	template := &v1beta1.DeviceGroupTemplate{Properties: []v1beta1.DeviceGroupProperty{
+		{Name: "setpoint", Desired: v1beta1.TwinProperty{Value: "20", Metadata: map[string]string{"type": "int"}}},
+		{Name: "unknown", Desired: v1beta1.TwinProperty{Value: "1"}},
+	}}
+
+	cases := []struct {
+		name            string
+		device          *v1beta1.Device
+		expectedChanged bool
+	}{
+		{
+			name:            "case1 desired value changed",
+			device:          newGroupDevice("plc-1", "node-1", "18", nil),
+			expectedChanged: true,
+		},
+		{
+			name: "case2 desired value reached",
+			device: func() *v1beta1.Device {
+				device := newGroupDevice("plc-1", "node-1", "20", nil)
+				device.Spec.Properties[0].Desired.Metadata = map[string]string{"type": "int"}
+				return device
+			}(),
+			expectedChanged: false,
+		},
+		{
+			name:            "case3 metadata changed",
+			device:          newGroupDevice("plc-1", "node-1", "20", nil),
+			expectedChanged: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			original := c.device.DeepCopy()
+			updated, changed := applyDeviceGroupTemplate(c.device, template)
+			assert.Equal(t, c.expectedChanged, changed)
+			assert.Equal(t, original, c.device)
+			assert.Equal(t, "20", updated.Spec.Properties[0].Desired.Value)
+			assert.Equal(t, "int", updated.Spec.Properties[0].Desired.Metadata["type"])
+			assert.Len(t, updated.Spec.Properties, 2)
+		})
+	}
+}
+
+func TestTwinValueEqual(t *testing.T) {
+	assert.True(t, twinValueEqual("on", "on"))
+	assert.True(t, twinValueEqual("1", "1.0"))
+	assert.False(t, twinValueEqual("1", "2"))
+	assert.False(t, twinValueEqual("on", "off"))
+}
+
+func TestReconcileDeviceGroup(t *testing.T) {
+	labels := map[string]string{"line": "a"}
+	devices := []*v1beta1.Device{
+		newGroupDevice("plc-1", "node-1", "18", labels),
+		newGroupDevice("plc-2", "node-1", "18", labels),
+		newGroupDevice("plc-3", "node-2", "20", labels),
+		newGroupDevice("plc-4", "node-2", "18", map[string]string{"line": "b"}),
+	}
+	group := &v1beta1.DeviceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "line-a", Namespace: "default", Generation: 2},
+		Spec: v1beta1.DeviceGroupSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1beta1.DeviceGroupTemplate{Properties: []v1beta1.DeviceGroupProperty{
+				{Name: "setpoint", Desired: v1beta1.TwinProperty{Value: "20"}},
+			}},
+		},
+	}
+
+	crdClient := crdfake.NewSimpleClientset(group, devices[0], devices[1], devices[2], devices[3])
+	messageLayer := &fakeMessageLayer{}
+	dc := &DownstreamController{
+		crdClient:           crdClient,
+		messageLayer:        messageLayer,
+		deviceManager:       &manager.DeviceManager{},
+		deviceStatusManager: &manager.DeviceStatusManager{},
+		deviceGroupManager:  &manager.DeviceGroupManager{},
+	}
+	for _, device := range devices {
+		dc.deviceManager.Device.Store(util.GetResourceID(device.Namespace, device.Name), device)
+	}
+	// plc-3 already reports the desired value
+	dc.deviceStatusManager.DeviceStatus.Store("default/plc-3", &v1beta1.DeviceStatus{
+		Status: v1beta1.DeviceStatusStatus{Twins: []v1beta1.Twin{{
+			PropertyName:    "setpoint",
+			Reported:        v1beta1.TwinProperty{Value: "20.0"},
+			ObservedDesired: v1beta1.TwinProperty{Value: "20"},
+		}}},
+	})
+
+	dc.reconcileDeviceGroup(group, false)
+
+	// only the changed devices are sent, in one message per node
+	assert.Len(t, messageLayer.sent, 1)
+	msg := messageLayer.sent[0]
+	assert.Equal(t, "node/node-1/default/devicegroup/line-a", msg.GetResource())
+	assert.Equal(t, model.UpdateOperation, msg.GetOperation())
+	deviceList, ok := msg.GetContent().(*v1beta1.DeviceList)
+	assert.True(t, ok)
+	assert.Len(t, deviceList.Items, 2)
+	for _, device := range deviceList.Items {
+		assert.Equal(t, "20", device.Spec.Properties[0].Desired.Value)
+		assert.Equal(t, "Device", device.Kind)
+	}
+
+	// the devices are updated in the api server and in the cache
+	for _, name := range []string{"plc-1", "plc-2"} {
+		device, err := crdClient.DevicesV1beta1().Devices("default").Get(context.Background(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "20", device.Spec.Properties[0].Desired.Value)
+		cached, _ := dc.deviceManager.Device.Load("default/" + name)
+		assert.Equal(t, "20", cached.(*v1beta1.Device).Spec.Properties[0].Desired.Value)
+	}
+	untouched, err := crdClient.DevicesV1beta1().Devices("default").Get(context.Background(), "plc-4", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "18", untouched.Spec.Properties[0].Desired.Value)
+
+	updatedGroup, err := crdClient.DevicesV1beta1().DeviceGroups("default").Get(context.Background(), "line-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updatedGroup.Status.ObservedGeneration)
+	assert.Equal(t, int32(3), updatedGroup.Status.MatchedDevices)
+	assert.Equal(t, int32(3), updatedGroup.Status.UpdatedDevices)
+	assert.Equal(t, int32(1), updatedGroup.Status.ConvergedDevices)
+	assert.Equal(t, []string{"plc-1", "plc-2"}, updatedGroup.Status.PendingDevices)
+	assert.NotNil(t, updatedGroup.Status.LastUpdateTime)
+
+	// the devices which are not observed by the mapper are resent on resync
+	messageLayer.sent = nil
+	dc.reconcileDeviceGroup(updatedGroup, true)
+	assert.Len(t, messageLayer.sent, 1)
+	assert.Equal(t, "node/node-1/default/devicegroup/line-a", messageLayer.sent[0].GetResource())
+
+	messageLayer.sent = nil
+	dc.reconcileDeviceGroup(updatedGroup, false)
+	assert.Empty(t, messageLayer.sent)
+
+	// the group is deleted from the nodes it was sent to
+	messageLayer.sent = nil
+	dc.deviceGroupManager.DeviceGroup.Store("default/line-a", updatedGroup)
+	dc.deviceGroupDeleted(updatedGroup)
+	assert.Len(t, messageLayer.sent, 1)
+	assert.Equal(t, "node/node-1/default/devicegroup/line-a", messageLayer.sent[0].GetResource())
+	assert.Equal(t, model.DeleteOperation, messageLayer.sent[0].GetOperation())
+	_, ok = dc.deviceGroupManager.DeviceGroup.Load("default/line-a")
+	assert.False(t, ok)
+	_, ok = dc.deviceGroupManager.Nodes.Load("default/line-a")
+	assert.False(t, ok)
+}
+
+func TestUpdateGroupDeviceFailure(t *testing.T) {
+	device := newGroupDevice("plc-1", "node-1", "18", nil)
+	crdClient := crdfake.NewSimpleClientset(device)
+	crdClient.PrependReactor("update", "devices", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("update err")
+	})
+	dc := &DownstreamController{
+		crdClient:     crdClient,
+		deviceManager: &manager.DeviceManager{},
+	}
+	dc.deviceManager.Device.Store("default/plc-1", device)
+
+	updated := device.DeepCopy()
+	updated.Spec.Properties[0].Desired.Value = "20"
+	_, err := dc.updateGroupDevice(device, updated)
+	assert.Error(t, err)
+
+	// the cache is not touched if the update fails
+	cached, _ := dc.deviceManager.Device.Load("default/plc-1")
+	assert.Same(t, device, cached)
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/manager"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+	deviceconstants "github.com/kubeedge/kubeedge/pkg/device/constants"
 	"github.com/kubeedge/kubeedge/pkg/util"
 )
 
@@ -58,6 +59,7 @@ type DownstreamController struct {
 	deviceManager       *manager.DeviceManager
 	deviceModelManager  *manager.DeviceModelManager
 	deviceStatusManager *manager.DeviceStatusManager
+	deviceGroupManager  *manager.DeviceGroupManager
 }
 
 // syncDeviceModel is used to get events from informer
@@ -195,7 +197,7 @@ func (dc *DownstreamController) getOrCreateDeviceStatusForDevice(device *v1beta1
 
 	deviceStatus = &v1beta1.DeviceStatus{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       constants.KindTypeDeviceStatus,
+			Kind:       deviceconstants.KindTypeDeviceStatus,
 			APIVersion: v1beta1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
@@ -329,7 +331,7 @@ func (dc *DownstreamController) sendDeviceMsg(device *v1beta1.Device, operation
 	device.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   v1beta1.GroupName,
 		Version: v1beta1.Version,
-		Kind:    constants.KindTypeDevice,
+		Kind:    deviceconstants.KindTypeDevice,
 	})
 	modelMsg := model.NewMessage("").
 		SetResourceVersion(device.ResourceVersion).
@@ -337,7 +339,7 @@ func (dc *DownstreamController) sendDeviceMsg(device *v1beta1.Device, operation
 	modelResource, err := messagelayer.BuildResource(
 		device.Spec.NodeName,
 		device.Namespace,
-		constants.ResourceTypeDevice,
+		deviceconstants.ResourceTypeDevice,
 		device.Name)
 	if err != nil {
 		klog.Warningf("Built message resource failed for device, device: %s, operation: %s, error: %s", device.Name, operation, err)
@@ -397,7 +399,7 @@ func (dc *DownstreamController) sendDeviceModelMsg(device *v1beta1.Device, opera
 	deviceModel.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   v1beta1.GroupName,
 		Version: v1beta1.Version,
-		Kind:    constants.KindTypeDeviceModel,
+		Kind:    deviceconstants.KindTypeDeviceModel,
 	})
 	modelMsg := model.NewMessage("").
 		SetResourceVersion(deviceModel.ResourceVersion).
@@ -405,7 +407,7 @@ func (dc *DownstreamController) sendDeviceModelMsg(device *v1beta1.Device, opera
 	modelResource, err := messagelayer.BuildResource(
 		device.Spec.NodeName,
 		deviceModel.Namespace,
-		constants.ResourceTypeDeviceModel,
+		deviceconstants.ResourceTypeDeviceModel,
 		deviceModel.Name)
 	if err != nil {
 		klog.Warningf("Built message resource failed for device model, device: %s, operation: %s, error: %s", device.Name, operation, err)
@@ -498,6 +500,7 @@ func (dc *DownstreamController) Start() error {
 	time.Sleep(1 * time.Second)
 	go dc.syncDevice()
 	go dc.syncDeviceStatus()
+	go dc.syncDeviceGroup()
 
 	return nil
 }
@@ -522,12 +525,19 @@ func NewDownstreamController(crdInformerFactory crdinformers.SharedInformerFacto
 		return nil, err
 	}
 
+	deviceGroupManager, err := manager.NewDeviceGroupManager(crdInformerFactory.Devices().V1beta1().DeviceGroups().Informer())
+	if err != nil {
+		klog.Warningf("Create device group manager failed with error: %s", err)
+		return nil, err
+	}
+
 	dc := &DownstreamController{
 		kubeClient:          client.GetKubeClient(),
 		crdClient:           client.GetCRDClient(),
 		deviceManager:       deviceManager,
 		deviceModelManager:  deviceModelManager,
 		deviceStatusManager: deviceStatusManager,
+		deviceGroupManager:  deviceGroupManager,
 		messageLayer:        messagelayer.DeviceControllerMessageLayer(),
 	}
 	return dc, nil
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
+)
+
+// DeviceGroupManager is a manager watch DeviceGroup change event
+type DeviceGroupManager struct {
+	// events from watch kubernetes api server
+	events chan watch.Event
+
+	// DeviceGroup, key is DeviceGroup.Namespace+"/"+deviceGroup.Name, value is *v1beta1.DeviceGroup{}
+	DeviceGroup sync.Map
+
+	// Nodes, key is the same as DeviceGroup, value is sets.Set[string] of the nodes the group is sent to
+	Nodes sync.Map
+}
+
+// Events return a channel, can receive all DeviceGroup event
+func (dgm *DeviceGroupManager) Events() chan watch.Event {
+	return dgm.events
+}
+
+// NewDeviceGroupManager create DeviceGroupManager from config
+func NewDeviceGroupManager(si cache.SharedIndexInformer) (*DeviceGroupManager, error) {
+	events := make(chan watch.Event, config.Config.Buffer.DeviceGroupEvent)
+	rh := NewCommonResourceEventHandler(events)
+	_, err := si.AddEventHandler(rh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceGroupManager{events: events}, nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
+)
+
+func TestNewDeviceGroupManager(t *testing.T) {
+	config.Config = config.Configure{
+		DeviceController: v1alpha1.DeviceController{
+			Buffer: &v1alpha1.DeviceControllerBuffer{
+				DeviceGroupEvent: 2,
+			},
+		},
+	}
+
+	dgm, err := NewDeviceGroupManager(&mockInformer{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cap(dgm.Events()))
+
+	group := &v1beta1.DeviceGroup{ObjectMeta: metav1.ObjectMeta{Name: "line-a", Namespace: "default"}}
+	NewCommonResourceEventHandler(dgm.Events()).OnAdd(group, false)
+	e := <-dgm.Events()
+	assert.Equal(t, watch.Added, e.Type)
+	assert.Equal(t, group, e.Object)
+}
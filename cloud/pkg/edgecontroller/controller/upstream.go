@@ -371,20 +371,7 @@ func (uc *UpstreamController) updateRuleStatus() {
 				klog.Warningf("message: %s process failure, get rule content with error: %s, namespaces: %s name: %s", msg.GetID(), err, namespace, ruleID)
 				continue
 			}
-			if content.Status == "SUCCESS" {
-				rule.Status.SuccessMessages++
-			}
-			if content.Status == "FAIL" {
-				rule.Status.FailMessages++
-				errSlice := make([]string, 0)
-				rule.Status.Errors = append(errSlice, content.Error.Detail)
-			}
-			newStatus := &rulesv1.RuleStatus{
-				SuccessMessages: rule.Status.SuccessMessages,
-				FailMessages:    rule.Status.FailMessages,
-				Errors:          rule.Status.Errors,
-			}
-			body, err := json.Marshal(newStatus)
+			body, err := json.Marshal(newRuleStatus(rule.Status, content))
 			if err != nil {
 				klog.Warningf("message: %s process failure, content marshal err: %s", msg.GetID(), err)
 				continue
@@ -399,6 +386,32 @@ func (uc *UpstreamController) updateRuleStatus() {
 	}
 }
 
+// newRuleStatus returns the status of a rule updated with an execution result
+func newRuleStatus(status rulesv1.RuleStatus, result routerrule.ExecResult) *rulesv1.RuleStatus {
+	switch result.Status {
+	case "SUCCESS":
+		status.SuccessMessages++
+	case "FAIL":
+		status.FailMessages++
+		status.Errors = []string{result.Error.Detail}
+	case "FILTERED":
+		status.FilteredMessages++
+	case "REPLAYED":
+		// a replayed dead letter was counted as failed when its delivery failed
+		status.SuccessMessages++
+		if status.FailMessages > 0 {
+			status.FailMessages--
+		}
+	}
+	return &rulesv1.RuleStatus{
+		SuccessMessages:  status.SuccessMessages,
+		FailMessages:     status.FailMessages,
+		FilteredMessages: status.FilteredMessages,
+		Errors:           status.Errors,
+		DeadLetters:      result.DeadLetters,
+	}
+}
+
 func (uc *UpstreamController) podStatusResponse(msg model.Message, content interface{}) {
 	resMsg := model.NewMessage(msg.GetID()).
 		FillBody(content).
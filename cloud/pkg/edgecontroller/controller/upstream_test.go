@@ -45,6 +45,7 @@ import (
 	messagelayer "github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
 	"github.com/kubeedge/kubeedge/cloud/pkg/edgecontroller/constants"
 	edgectypes "github.com/kubeedge/kubeedge/cloud/pkg/edgecontroller/types"
+	routerrule "github.com/kubeedge/kubeedge/cloud/pkg/router/rule"
 	edgeapi "github.com/kubeedge/kubeedge/common/types"
 )
 
@@ -680,6 +681,31 @@ func TestSortedContainerStatuses(t *testing.T) {
 	}
 }
 
+func TestNewRuleStatus(t *testing.T) {
+	status := rulesv1.RuleStatus{SuccessMessages: 1, FailMessages: 2, Errors: []string{"old"}}
+
+	got := newRuleStatus(status, routerrule.ExecResult{Status: "FAIL", Error: routerrule.ErrorMsg{Detail: "new"}, DeadLetters: 3})
+	if got.FailMessages != 3 || len(got.Errors) != 1 || got.Errors[0] != "new" || got.DeadLetters != 3 {
+		t.Errorf("newRuleStatus() with FAIL = %+v", got)
+	}
+
+	got = newRuleStatus(status, routerrule.ExecResult{Status: "FILTERED"})
+	if got.FilteredMessages != 1 || got.SuccessMessages != 1 || got.FailMessages != 2 {
+		t.Errorf("newRuleStatus() with FILTERED = %+v", got)
+	}
+
+	// a replayed dead letter moves from the failed to the successful messages
+	got = newRuleStatus(status, routerrule.ExecResult{Status: "REPLAYED", DeadLetters: 1})
+	if got.SuccessMessages != 2 || got.FailMessages != 1 || got.DeadLetters != 1 {
+		t.Errorf("newRuleStatus() with REPLAYED = %+v", got)
+	}
+
+	got = newRuleStatus(rulesv1.RuleStatus{}, routerrule.ExecResult{Status: "REPLAYED"})
+	if got.SuccessMessages != 1 || got.FailMessages != 0 {
+		t.Errorf("newRuleStatus() with REPLAYED and no failed messages = %+v", got)
+	}
+}
+
 func TestSortInitContainerStatuses(t *testing.T) {
 	pod := &corev1.Pod{
 		Spec: corev1.PodSpec{
@@ -5,9 +5,26 @@ const (
 	EventbusProvider   string = "eventbus"
 	GroupResource      string = "resource"
 	ServicebusProvider string = "servicebus"
+	KafkaProvider      string = "kafka"
 	TargetURL          string = "target_url"
 	NodeName           string = "node_name"
 	Topic              string = "topic"
 	Path               string = "path"
 	Resource           string = "resource"
+	Key                string = "key"
+	Brokers            string = "brokers"
+	ClientID           string = "client_id"
+	Acks               string = "acks"
+	TimeoutMS          string = "timeout_ms"
+	BatchSize          string = "batch_size"
+	LingerMS           string = "linger_ms"
+	TLS                string = "tls"
+	TLSCAFile          string = "tls_ca_file"
+	TLSCertFile        string = "tls_cert_file"
+	TLSKeyFile         string = "tls_key_file"
+	TLSServerName      string = "tls_server_name"
+	TLSSkipVerify      string = "tls_insecure_skip_verify"
+	SASLMechanism      string = "sasl_mechanism"
+	SASLUsername       string = "sasl_username"
+	SASLPassword       string = "sasl_password"
 )
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -28,13 +30,26 @@ const MaxMessageBytes = 12 * (1 << 20)
 
 var (
 	RestHandlerInstance = &RestHandler{}
+	serverStarted       int32
 )
 
 type RestHandler struct {
 	restTimeout time.Duration
 	handlers    sync.Map
-	port        int
-	bindAddress string
+	// adminHandlers are served beside the rule paths, they are keyed by
+	// http.ServeMux patterns and must be added before Serve is called
+	adminHandlers sync.Map
+	port          int
+	bindAddress   string
+}
+
+// StartServer initializes the router http listener and serves it,
+// it is executed only once no matter how many times it is called.
+func StartServer() {
+	if atomic.CompareAndSwapInt32(&serverStarted, 0, 1) {
+		InitHandler()
+		go RestHandlerInstance.Serve()
+	}
 }
 
 func InitHandler() {
@@ -54,6 +69,10 @@ func InitHandler() {
 func (rh *RestHandler) Serve() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", rh.httpHandler)
+	rh.adminHandlers.Range(func(key, value interface{}) bool {
+		mux.Handle(key.(string), localOnly(value.(http.Handler)))
+		return true
+	})
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", rh.bindAddress, rh.port),
@@ -76,6 +95,30 @@ func (rh *RestHandler) AddListener(key interface{}, han Handle) {
 	rh.handlers.Store(path, han)
 }
 
+// AddAdminHandler adds a handler for operating the router itself. The pattern
+// must not be a valid node name prefix, so that it never shadows rule paths.
+// The router http listener is not authenticated, so admin handlers are only
+// served to the clients on the loopback interface.
+func (rh *RestHandler) AddAdminHandler(pattern string, handler http.Handler) {
+	rh.adminHandlers.Store(pattern, handler)
+}
+
+// localOnly rejects the requests which do not come from the loopback interface
+func localOnly(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			klog.Warningf("reject admin request %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
+			http.Error(w, "admin api is only served on the loopback interface", http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
 func (rh *RestHandler) RemoveListener(key interface{}) {
 	path, ok := key.(string)
 	if !ok {
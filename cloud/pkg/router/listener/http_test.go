@@ -0,0 +1,35 @@
+package listener
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalOnly(t *testing.T) {
+	handler := localOnly(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		name       string
+		remoteAddr string
+		want       int
+	}{
+		{name: "case1: ipv4 loopback", remoteAddr: "127.0.0.1:34567", want: http.StatusOK},
+		{name: "case2: ipv6 loopback", remoteAddr: "[::1]:34567", want: http.StatusOK},
+		{name: "case3: remote client", remoteAddr: "10.0.0.8:34567", want: http.StatusForbidden},
+		{name: "case4: invalid address", remoteAddr: "invalid", want: http.StatusForbidden},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/_deadletters/default/rule", nil)
+			req.RemoteAddr = c.remoteAddr
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, c.want, rec.Code)
+		})
+	}
+}
@@ -95,7 +95,7 @@ func (eb *EventBus) Name() string {
 	return constants.EventbusProvider
 }
 
-func (*EventBus) Forward(target provider.Target, data interface{}) (response interface{}, err error) {
+func (eb *EventBus) Forward(target provider.Target, data interface{}) (response interface{}, err error) {
 	message, ok := data.(*model.Message)
 	if !ok {
 		klog.Errorf("message type %T error", data)
@@ -108,6 +108,8 @@ func (*EventBus) Forward(target provider.Target, data interface{}) (response int
 		return nil, fmt.Errorf("get message %s content err: %v", message.GetID(), err)
 	}
 	res["data"] = content
+	res["messageID"] = message.GetID()
+	res["nodeName"] = eb.nodeName
 	resp, err := target.GoToTarget(res, nil)
 	if err != nil {
 		klog.Errorf("message is send to target failed. msgID: %s, target: %s, err:%v", message.GetID(), target.Name(), err)
@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+// SASL mechanisms supported by the kafka ruleEndpoint
+const (
+	SASLPlain       = "PLAIN"
+	SASLScramSHA256 = "SCRAM-SHA-256"
+	SASLScramSHA512 = "SCRAM-SHA-512"
+)
+
+// clientConfig is the connection part of a kafka ruleEndpoint
+type clientConfig struct {
+	brokers  []string
+	clientID string
+	acks     int16
+	timeout  time.Duration
+	tls      TLSConfig
+	sasl     SASLConfig
+}
+
+// newClient creates the kafka client of a ruleEndpoint. Records are batched
+// per rule by the producer, so the client sends them without lingering.
+func newClient(cfg clientConfig) (*kgo.Client, error) {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.brokers...),
+		kgo.ClientID(cfg.clientID),
+		kgo.DialTimeout(cfg.timeout),
+		kgo.ProduceRequestTimeout(cfg.timeout),
+		kgo.RecordDeliveryTimeout(cfg.timeout),
+		kgo.ProducerLinger(0),
+	}
+	switch cfg.acks {
+	case 0:
+		opts = append(opts, kgo.RequiredAcks(kgo.NoAck()), kgo.DisableIdempotentWrite())
+	case 1:
+		opts = append(opts, kgo.RequiredAcks(kgo.LeaderAck()), kgo.DisableIdempotentWrite())
+	default:
+		opts = append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	}
+	if cfg.tls.Enabled {
+		tlsConfig, err := cfg.tls.load()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	if cfg.sasl.Mechanism != "" {
+		opts = append(opts, kgo.SASL(cfg.sasl.mechanism()))
+	}
+	return kgo.NewClient(opts...)
+}
+
+// load reads the certificates of the TLS settings
+func (c TLSConfig) load() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read kafka CA file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in kafka CA file %s", c.CAFile)
+		}
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func (c SASLConfig) mechanism() sasl.Mechanism {
+	switch c.Mechanism {
+	case SASLScramSHA256:
+		return scram.Auth{User: c.Username, Pass: c.Password}.AsSha256Mechanism()
+	case SASLScramSHA512:
+		return scram.Auth{User: c.Username, Pass: c.Password}.AsSha512Mechanism()
+	default:
+		return plain.Auth{User: c.Username, Pass: c.Password}.AsMechanism()
+	}
+}
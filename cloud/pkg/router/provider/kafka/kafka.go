@@ -0,0 +1,439 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"k8s.io/klog/v2"
+
+	v1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+)
+
+const (
+	defaultClientID     = "kubeedge-router"
+	defaultAcks         = 1
+	defaultBatchSize    = 100
+	defaultLinger       = 10 * time.Millisecond
+	defaultTimeout      = 10 * time.Second
+	messageIDHeader     = "messageID"
+	nodeNameHeader      = "nodeName"
+	templateMissingKeys = "missingkey=zero"
+)
+
+type kafkaFactory struct {
+	// clients caches the broker connections of every kafka ruleEndpoint,
+	// so that the rules sharing a ruleEndpoint share its connections.
+	mu      sync.Mutex
+	clients map[string]*endpointClient
+}
+
+// endpointClient is the client of a ruleEndpoint and the number of the
+// targets holding it, the client is closed when the last target releases it.
+type endpointClient struct {
+	cfg    clientConfig
+	client *kgo.Client
+	refs   int
+}
+
+// Kafka is a rule target producing messages to a kafka compatible broker
+type Kafka struct {
+	namespace string
+	topic     *template.Template
+	key       *template.Template
+	producer  *producer
+	release   sync.Once
+	releaseFn func()
+}
+
+// TemplateData is the data used to render topic and key templates
+type TemplateData struct {
+	Namespace string
+	NodeName  string
+	MessageID string
+	Param     string
+	Method    string
+}
+
+// Config holds the settings of a kafka ruleEndpoint
+type Config struct {
+	Brokers   []string
+	ClientID  string
+	Acks      int16
+	BatchSize int
+	Linger    time.Duration
+	Timeout   time.Duration
+	TLS       TLSConfig
+	SASL      SASLConfig
+}
+
+// TLSConfig holds the TLS settings of a kafka ruleEndpoint. The files are
+// read by cloudcore when a rule targeting the ruleEndpoint is created.
+type TLSConfig struct {
+	Enabled            bool
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// SASLConfig holds the SASL settings of a kafka ruleEndpoint
+type SASLConfig struct {
+	Mechanism string
+	Username  string
+	Password  string
+}
+
+func init() {
+	provider.RegisterTarget(&kafkaFactory{clients: make(map[string]*endpointClient)})
+}
+
+func (*kafkaFactory) Type() v1.RuleEndpointTypeDef {
+	return v1.RuleEndpointTypeKafka
+}
+
+func (f *kafkaFactory) GetTarget(ep *v1.RuleEndpoint, targetResource map[string]string) provider.Target {
+	cfg, err := ParseConfig(ep.Spec.Properties)
+	if err != nil {
+		klog.Errorf("invalid kafka ruleEndpoint %s/%s: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	// batching can be tuned per rule
+	if err := parseBatching(targetResource, &cfg); err != nil {
+		klog.Errorf("invalid kafka target resource: %v", err)
+		return nil
+	}
+	topic, exist := targetResource[constants.Topic]
+	if !exist || topic == "" {
+		klog.Errorf("target resource attributes \"topic\" does not exist")
+		return nil
+	}
+	topicTmpl, err := ParseTemplate(constants.Topic, topic)
+	if err != nil {
+		klog.Errorf("invalid kafka topic template: %v", err)
+		return nil
+	}
+	var keyTmpl *template.Template
+	if key := targetResource[constants.Key]; key != "" {
+		keyTmpl, err = ParseTemplate(constants.Key, key)
+		if err != nil {
+			klog.Errorf("invalid kafka key template: %v", err)
+			return nil
+		}
+	}
+
+	key := ep.Namespace + "/" + ep.Name
+	ec, err := f.acquireClient(key, cfg)
+	if err != nil {
+		klog.Errorf("failed to create kafka client of ruleEndpoint %s/%s: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	return &Kafka{
+		namespace: ep.Namespace,
+		topic:     topicTmpl,
+		key:       keyTmpl,
+		producer:  newProducer(ec.client, cfg.BatchSize, cfg.Linger),
+		releaseFn: func() { f.releaseClient(key, ec) },
+	}
+}
+
+// acquireClient returns the client of a ruleEndpoint, replacing it when the
+// connection settings of the ruleEndpoint changed. The replaced client is
+// closed when the targets holding it release it.
+func (f *kafkaFactory) acquireClient(key string, cfg Config) (*endpointClient, error) {
+	cc := clientConfig{
+		brokers:  cfg.Brokers,
+		clientID: cfg.ClientID,
+		acks:     cfg.Acks,
+		timeout:  cfg.Timeout,
+		tls:      cfg.TLS,
+		sasl:     cfg.SASL,
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if ec, ok := f.clients[key]; ok && reflect.DeepEqual(ec.cfg, cc) {
+		ec.refs++
+		return ec, nil
+	}
+	client, err := newClient(cc)
+	if err != nil {
+		return nil, err
+	}
+	ec := &endpointClient{cfg: cc, client: client, refs: 1}
+	f.clients[key] = ec
+	return ec, nil
+}
+
+// releaseClient releases a client acquired by acquireClient
+func (f *kafkaFactory) releaseClient(key string, ec *endpointClient) {
+	f.mu.Lock()
+	ec.refs--
+	last := ec.refs == 0
+	if last && f.clients[key] == ec {
+		delete(f.clients, key)
+	}
+	f.mu.Unlock()
+	if last {
+		ec.client.Close()
+	}
+}
+
+func (*Kafka) Name() string {
+	return constants.KafkaProvider
+}
+
+// Release releases the client of the ruleEndpoint held by the target
+func (k *Kafka) Release() {
+	k.release.Do(k.releaseFn)
+}
+
+func (k *Kafka) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	body, ok := data["data"].([]byte)
+	if !ok {
+		return nil, errors.New("input data does not exist valid value \"data\"")
+	}
+	td := TemplateData{Namespace: k.namespace}
+	td.NodeName, _ = data["nodeName"].(string)
+	td.MessageID, _ = data["messageID"].(string)
+	td.Param, _ = data["param"].(string)
+	td.Method, _ = data["method"].(string)
+
+	topic, err := render(k.topic, td)
+	if err != nil {
+		return nil, err
+	}
+	if topic == "" {
+		return nil, errors.New("kafka topic rendered to empty string")
+	}
+	record := &kgo.Record{
+		Topic:     topic,
+		Value:     body,
+		Timestamp: time.Now(),
+	}
+	if k.key != nil {
+		key, err := render(k.key, td)
+		if err != nil {
+			return nil, err
+		}
+		record.Key = []byte(key)
+	}
+	if td.MessageID != "" {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: messageIDHeader, Value: []byte(td.MessageID)})
+	}
+	if td.NodeName != "" {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: nodeNameHeader, Value: []byte(td.NodeName)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := k.producer.send(ctx, record)
+	select {
+	case err := <-done:
+		if err != nil {
+			klog.Errorf("failed to deliver message %s to kafka topic %s: %v", td.MessageID, topic, err)
+			return nil, err
+		}
+		return nil, nil
+	case <-stop:
+		// a record already sent to the broker can not be recalled, the
+		// outcome of the record is waited for so that the caller knows
+		// whether the message was delivered
+		cancel()
+		if err := <-done; err != nil {
+			return nil, fmt.Errorf("delivery of message %s to kafka topic %s is cancelled: %w", td.MessageID, topic, err)
+		}
+		return nil, nil
+	}
+}
+
+func render(tmpl *template.Template, td TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, td); err != nil {
+		return "", fmt.Errorf("render kafka %s template: %w", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+// ParseTemplate parses a topic or key template. The fields of TemplateData
+// can be referenced, for example "devices.{{.NodeName}}".
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option(templateMissingKeys).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	// reject references to unknown fields up front instead of failing
+	// every message at delivery time
+	if err := tmpl.Execute(&bytes.Buffer{}, TemplateData{}); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// ParseConfig parses the properties of a kafka ruleEndpoint
+func ParseConfig(properties map[string]string) (Config, error) {
+	cfg := Config{
+		ClientID:  defaultClientID,
+		Acks:      defaultAcks,
+		BatchSize: defaultBatchSize,
+		Linger:    defaultLinger,
+		Timeout:   defaultTimeout,
+	}
+	brokers, exist := properties[constants.Brokers]
+	if !exist {
+		return cfg, fmt.Errorf("\"%s\" property missed when ruleEndpoint is \"kafka\"", constants.Brokers)
+	}
+	for _, b := range strings.Split(brokers, ",") {
+		b = strings.TrimSpace(b)
+		if b == "" {
+			continue
+		}
+		if err := validateBroker(b); err != nil {
+			return cfg, err
+		}
+		cfg.Brokers = append(cfg.Brokers, b)
+	}
+	if len(cfg.Brokers) == 0 {
+		return cfg, fmt.Errorf("\"%s\" property should contain at least one broker", constants.Brokers)
+	}
+	if v, ok := properties[constants.ClientID]; ok && v != "" {
+		cfg.ClientID = v
+	}
+	if v, ok := properties[constants.Acks]; ok {
+		switch v {
+		case "all", "-1":
+			cfg.Acks = -1
+		case "0":
+			cfg.Acks = 0
+		case "1":
+			cfg.Acks = 1
+		default:
+			return cfg, fmt.Errorf("\"%s\" property should be one of 0, 1, -1 or all", constants.Acks)
+		}
+	}
+	if v, ok := properties[constants.TimeoutMS]; ok {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms <= 0 {
+			return cfg, fmt.Errorf("\"%s\" property should be a positive integer", constants.TimeoutMS)
+		}
+		cfg.Timeout = time.Duration(ms) * time.Millisecond
+	}
+	if err := parseBatching(properties, &cfg); err != nil {
+		return cfg, err
+	}
+	if err := parseTLS(properties, &cfg.TLS); err != nil {
+		return cfg, err
+	}
+	if err := parseSASL(properties, &cfg.SASL); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// parseTLS parses the TLS settings of a kafka ruleEndpoint. Setting any of
+// the certificate files enables TLS.
+func parseTLS(properties map[string]string, cfg *TLSConfig) error {
+	cfg.CAFile = properties[constants.TLSCAFile]
+	cfg.CertFile = properties[constants.TLSCertFile]
+	cfg.KeyFile = properties[constants.TLSKeyFile]
+	cfg.ServerName = properties[constants.TLSServerName]
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return fmt.Errorf("\"%s\" and \"%s\" properties should be set together", constants.TLSCertFile, constants.TLSKeyFile)
+	}
+	var err error
+	if v, ok := properties[constants.TLS]; ok {
+		if cfg.Enabled, err = strconv.ParseBool(v); err != nil {
+			return fmt.Errorf("\"%s\" property should be true or false", constants.TLS)
+		}
+	}
+	if v, ok := properties[constants.TLSSkipVerify]; ok {
+		if cfg.InsecureSkipVerify, err = strconv.ParseBool(v); err != nil {
+			return fmt.Errorf("\"%s\" property should be true or false", constants.TLSSkipVerify)
+		}
+	}
+	cfg.Enabled = cfg.Enabled || cfg.CAFile != "" || cfg.CertFile != ""
+	return nil
+}
+
+// parseSASL parses the SASL settings of a kafka ruleEndpoint
+func parseSASL(properties map[string]string, cfg *SASLConfig) error {
+	cfg.Mechanism = properties[constants.SASLMechanism]
+	cfg.Username = properties[constants.SASLUsername]
+	cfg.Password = properties[constants.SASLPassword]
+	switch cfg.Mechanism {
+	case "":
+		if cfg.Username != "" || cfg.Password != "" {
+			return fmt.Errorf("\"%s\" property missed when SASL credentials are set", constants.SASLMechanism)
+		}
+		return nil
+	case SASLPlain, SASLScramSHA256, SASLScramSHA512:
+	default:
+		return fmt.Errorf("\"%s\" property should be one of %s, %s or %s",
+			constants.SASLMechanism, SASLPlain, SASLScramSHA256, SASLScramSHA512)
+	}
+	if cfg.Username == "" || cfg.Password == "" {
+		return fmt.Errorf("\"%s\" and \"%s\" properties are required by SASL", constants.SASLUsername, constants.SASLPassword)
+	}
+	return nil
+}
+
+// parseBatching parses the batching settings which may be set on both the
+// ruleEndpoint properties and the rule target resource.
+func parseBatching(attrs map[string]string, cfg *Config) error {
+	if v, ok := attrs[constants.BatchSize]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("\"%s\" should be a positive integer", constants.BatchSize)
+		}
+		cfg.BatchSize = n
+	}
+	if v, ok := attrs[constants.LingerMS]; ok {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			return fmt.Errorf("\"%s\" should be a non-negative integer", constants.LingerMS)
+		}
+		cfg.Linger = time.Duration(ms) * time.Millisecond
+	}
+	return nil
+}
+
+func validateBroker(broker string) error {
+	i := strings.LastIndex(broker, ":")
+	if i <= 0 {
+		return fmt.Errorf("broker %q should be in host:port format", broker)
+	}
+	port, err := strconv.Atoi(broker[i+1:])
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("port of broker %q must be in range 1-65535", broker)
+	}
+	return nil
+}
+
+// ValidateTargetResource validates the target resource of a rule whose
+// target is a kafka ruleEndpoint.
+func ValidateTargetResource(targetResource map[string]string) error {
+	topic, exist := targetResource[constants.Topic]
+	if !exist || topic == "" {
+		return fmt.Errorf("\"%s\" property missed in targetResource when ruleEndpoint is \"kafka\"", constants.Topic)
+	}
+	if _, err := ParseTemplate(constants.Topic, topic); err != nil {
+		return fmt.Errorf("invalid topic template: %w", err)
+	}
+	if key := targetResource[constants.Key]; key != "" {
+		if _, err := ParseTemplate(constants.Key, key); err != nil {
+			return fmt.Errorf("invalid key template: %w", err)
+		}
+	}
+	var cfg Config
+	return parseBatching(targetResource, &cfg)
+}
@@ -0,0 +1,644 @@
+package kafka
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/stretchr/testify/assert"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	v1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+)
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// fakeBroker is an in-process kafka broker answering the requests a
+// producer sends. Every topic has the configured number of partitions, all
+// led by the fake broker itself.
+type fakeBroker struct {
+	t          *testing.T
+	listener   net.Listener
+	partitions int
+	// user and password enable SASL/PLAIN authentication
+	user     string
+	password string
+
+	mu      sync.Mutex
+	records map[topicPartition][]kmsg.Record
+	// failNext makes the next produce answer every partition with the error
+	failNext int16
+}
+
+func newFakeBroker(t *testing.T, partitions int) *fakeBroker {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	b := &fakeBroker{
+		t:          t,
+		listener:   l,
+		partitions: partitions,
+		records:    make(map[topicPartition][]kmsg.Record),
+	}
+	t.Cleanup(func() { b.listener.Close() })
+	return b
+}
+
+func (b *fakeBroker) start() *fakeBroker {
+	go b.serve()
+	return b
+}
+
+func (b *fakeBroker) addr() string {
+	return b.listener.Addr().String()
+}
+
+func (b *fakeBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *fakeBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	authenticated := b.user == ""
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		buf := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		req := kmsg.RequestForKey(int16(binary.BigEndian.Uint16(buf)))
+		if req == nil {
+			b.t.Errorf("unexpected api key %d", binary.BigEndian.Uint16(buf))
+			return
+		}
+		req.SetVersion(int16(binary.BigEndian.Uint16(buf[2:])))
+		correlationID := buf[4:8]
+		body := buf[8:]
+		// skip the client id and the tagged fields of the request header
+		if l := int16(binary.BigEndian.Uint16(body)); l > 0 {
+			body = body[l:]
+		}
+		body = body[2:]
+		if req.IsFlexible() {
+			body = body[1:]
+		}
+		if err := req.ReadFrom(body); err != nil {
+			b.t.Errorf("failed to decode %T: %v", req, err)
+			return
+		}
+		if !authenticated && req.Key() != kmsg.ApiVersions.Int16() && req.Key() != kmsg.SASLHandshake.Int16() &&
+			req.Key() != kmsg.SASLAuthenticate.Int16() {
+			b.t.Errorf("unauthenticated %T", req)
+			return
+		}
+
+		var resp kmsg.Response
+		switch req := req.(type) {
+		case *kmsg.ApiVersionsRequest:
+			resp = b.apiVersions()
+		case *kmsg.SASLHandshakeRequest:
+			r := kmsg.NewPtrSASLHandshakeResponse()
+			r.SupportedMechanisms = []string{SASLPlain}
+			if req.Mechanism != SASLPlain {
+				r.ErrorCode = kerr.UnsupportedSaslMechanism.Code
+			}
+			resp = r
+		case *kmsg.SASLAuthenticateRequest:
+			r := kmsg.NewPtrSASLAuthenticateResponse()
+			if string(req.SASLAuthBytes) != "\x00"+b.user+"\x00"+b.password {
+				r.ErrorCode = kerr.SaslAuthenticationFailed.Code
+			} else {
+				authenticated = true
+			}
+			resp = r
+		case *kmsg.MetadataRequest:
+			resp = b.metadata(req)
+		case *kmsg.InitProducerIDRequest:
+			r := kmsg.NewPtrInitProducerIDResponse()
+			r.ProducerID = 1
+			resp = r
+		case *kmsg.ProduceRequest:
+			resp = b.produce(req)
+			if req.Acks == 0 {
+				continue
+			}
+		default:
+			b.t.Errorf("unexpected request %T", req)
+			return
+		}
+		resp.SetVersion(req.GetVersion())
+		out := append([]byte{0, 0, 0, 0}, correlationID...)
+		// the response header of ApiVersions is never flexible
+		if resp.IsFlexible() && req.Key() != kmsg.ApiVersions.Int16() {
+			out = append(out, 0)
+		}
+		out = resp.AppendTo(out)
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+func (b *fakeBroker) apiVersions() kmsg.Response {
+	resp := kmsg.NewPtrApiVersionsResponse()
+	for _, req := range []kmsg.Request{
+		kmsg.NewPtrApiVersionsRequest(),
+		kmsg.NewPtrSASLHandshakeRequest(),
+		kmsg.NewPtrSASLAuthenticateRequest(),
+		kmsg.NewPtrMetadataRequest(),
+		kmsg.NewPtrInitProducerIDRequest(),
+		kmsg.NewPtrProduceRequest(),
+	} {
+		key := kmsg.NewApiVersionsResponseApiKey()
+		key.ApiKey = req.Key()
+		key.MaxVersion = req.MaxVersion()
+		resp.ApiKeys = append(resp.ApiKeys, key)
+	}
+	return resp
+}
+
+func (b *fakeBroker) metadata(req *kmsg.MetadataRequest) kmsg.Response {
+	host, portStr, _ := net.SplitHostPort(b.addr())
+	port, _ := strconv.Atoi(portStr)
+
+	resp := kmsg.NewPtrMetadataResponse()
+	broker := kmsg.NewMetadataResponseBroker()
+	broker.Host, broker.Port = host, int32(port)
+	resp.Brokers = append(resp.Brokers, broker)
+	for _, rt := range req.Topics {
+		topic := kmsg.NewMetadataResponseTopic()
+		topic.Topic = rt.Topic
+		for p := 0; p < b.partitions; p++ {
+			partition := kmsg.NewMetadataResponseTopicPartition()
+			partition.Partition = int32(p)
+			partition.Replicas = []int32{0}
+			partition.ISR = []int32{0}
+			topic.Partitions = append(topic.Partitions, partition)
+		}
+		resp.Topics = append(resp.Topics, topic)
+	}
+	return resp
+}
+
+func (b *fakeBroker) produce(req *kmsg.ProduceRequest) kmsg.Response {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	failCode := b.failNext
+	b.failNext = 0
+
+	resp := kmsg.NewPtrProduceResponse()
+	for _, rt := range req.Topics {
+		topic := kmsg.NewProduceResponseTopic()
+		topic.Topic = rt.Topic
+		for _, rp := range rt.Partitions {
+			partition := kmsg.NewProduceResponseTopicPartition()
+			partition.Partition = rp.Partition
+			partition.ErrorCode = failCode
+			if failCode == 0 {
+				tp := topicPartition{topic: rt.Topic, partition: rp.Partition}
+				b.records[tp] = append(b.records[tp], b.decodeBatch(rp.Records)...)
+			}
+			topic.Partitions = append(topic.Partitions, partition)
+		}
+		resp.Topics = append(resp.Topics, topic)
+	}
+	return resp
+}
+
+func (b *fakeBroker) decodeBatch(raw []byte) []kmsg.Record {
+	var batch kmsg.RecordBatch
+	if err := batch.ReadFrom(raw); err != nil {
+		b.t.Errorf("failed to decode record batch: %v", err)
+		return nil
+	}
+	data := batch.Records
+	switch codec := batch.Attributes & 0x07; codec {
+	case 0:
+	case 2:
+		var err error
+		if data, err = s2.Decode(nil, data); err != nil {
+			b.t.Errorf("failed to decompress record batch: %v", err)
+			return nil
+		}
+	default:
+		b.t.Errorf("unexpected compression codec %d", codec)
+		return nil
+	}
+	var records []kmsg.Record
+	for i := 0; i < int(batch.NumRecords); i++ {
+		length, n := binary.Varint(data)
+		var record kmsg.Record
+		if err := record.ReadFrom(data[:n+int(length)]); err != nil {
+			b.t.Errorf("failed to decode record: %v", err)
+			return nil
+		}
+		records = append(records, record)
+		data = data[n+int(length):]
+	}
+	return records
+}
+
+func (b *fakeBroker) topicRecords(topic string) []kmsg.Record {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var records []kmsg.Record
+	for tp, rs := range b.records {
+		if tp.topic == topic {
+			records = append(records, rs...)
+		}
+	}
+	return records
+}
+
+func header(r kmsg.Record, key string) string {
+	for _, h := range r.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func newTarget(t *testing.T, properties, targetResource map[string]string) *Kafka {
+	factory := &kafkaFactory{clients: make(map[string]*endpointClient)}
+	ep := &v1.RuleEndpoint{Spec: v1.RuleEndpointSpec{RuleEndpointType: v1.RuleEndpointTypeKafka, Properties: properties}}
+	ep.Namespace, ep.Name = "default", "my-kafka"
+	target := factory.GetTarget(ep, targetResource)
+	if target == nil {
+		t.Fatalf("failed to get kafka target")
+	}
+	t.Cleanup(target.(*Kafka).Release)
+	return target.(*Kafka)
+}
+
+func TestGoToTarget(t *testing.T) {
+	broker := newFakeBroker(t, 3).start()
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.LingerMS: "0"},
+		map[string]string{constants.Topic: "{{.Namespace}}.devices", constants.Key: "{{.NodeName}}"})
+
+	for i := 0; i < 3; i++ {
+		_, err := target.GoToTarget(map[string]interface{}{
+			"messageID": "msg-" + strconv.Itoa(i),
+			"nodeName":  "edge-node",
+			"data":      []byte(`{"temperature":"20"}`),
+		}, nil)
+		assert.NoError(t, err)
+	}
+
+	records := broker.topicRecords("default.devices")
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, "edge-node", string(records[0].Key))
+		assert.Equal(t, `{"temperature":"20"}`, string(records[0].Value))
+		assert.Equal(t, "msg-0", header(records[0], messageIDHeader))
+		assert.Equal(t, "edge-node", header(records[0], nodeNameHeader))
+	}
+
+	// records with the same key always go to the same partition
+	broker.mu.Lock()
+	assert.Len(t, broker.records, 1)
+	broker.mu.Unlock()
+}
+
+func TestGoToTargetBatching(t *testing.T) {
+	broker := newFakeBroker(t, 1).start()
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.BatchSize: "5", constants.LingerMS: "5000"},
+		map[string]string{constants.Topic: "batched"})
+
+	// full batches are sent without waiting for the linger
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := target.GoToTarget(map[string]interface{}{"data": []byte(strconv.Itoa(i))}, nil)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Len(t, broker.topicRecords("batched"), 10)
+}
+
+func TestGoToTargetLinger(t *testing.T) {
+	broker := newFakeBroker(t, 1).start()
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.BatchSize: "100", constants.LingerMS: "20"},
+		map[string]string{constants.Topic: "lingered"})
+
+	start := time.Now()
+	_, err := target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Len(t, broker.topicRecords("lingered"), 1)
+}
+
+func TestGoToTargetRetryStaleMetadata(t *testing.T) {
+	broker := newFakeBroker(t, 1).start()
+	broker.failNext = kerr.NotLeaderForPartition.Code
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.LingerMS: "0"},
+		map[string]string{constants.Topic: "retried"})
+
+	_, err := target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, broker.topicRecords("retried"), 1)
+}
+
+func TestGoToTargetBrokerError(t *testing.T) {
+	broker := newFakeBroker(t, 1).start()
+	broker.failNext = kerr.InvalidRecord.Code
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.LingerMS: "0"},
+		map[string]string{constants.Topic: "failed"})
+
+	_, err := target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.True(t, errors.Is(err, kerr.InvalidRecord), "error: %v", err)
+}
+
+func TestGoToTargetAcksNone(t *testing.T) {
+	broker := newFakeBroker(t, 1).start()
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.Acks: "0", constants.LingerMS: "0"},
+		map[string]string{constants.Topic: "fire-and-forget"})
+
+	_, err := target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(broker.topicRecords("fire-and-forget")) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGoToTargetStop(t *testing.T) {
+	// a broker which never answers keeps the record from being sent
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		var conns []net.Conn
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				for _, c := range conns {
+					c.Close()
+				}
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+	target := newTarget(t,
+		map[string]string{constants.Brokers: l.Addr().String(), constants.LingerMS: "0"},
+		map[string]string{constants.Topic: "stopped"})
+
+	stop := make(chan struct{})
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		stop <- struct{}{}
+	}()
+	start := time.Now()
+	_, err = target.GoToTarget(map[string]interface{}{"data": []byte("a")}, stop)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), defaultTimeout)
+}
+
+func TestGoToTargetSASL(t *testing.T) {
+	broker := newFakeBroker(t, 1)
+	broker.user, broker.password = "router", "secret"
+	broker.start()
+
+	target := newTarget(t,
+		map[string]string{
+			constants.Brokers: broker.addr(), constants.LingerMS: "0", constants.TimeoutMS: "1000",
+			constants.SASLMechanism: SASLPlain, constants.SASLUsername: "router", constants.SASLPassword: "secret",
+		},
+		map[string]string{constants.Topic: "authenticated"})
+	_, err := target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, broker.topicRecords("authenticated"), 1)
+
+	target = newTarget(t,
+		map[string]string{
+			constants.Brokers: broker.addr(), constants.LingerMS: "0", constants.TimeoutMS: "1000",
+			constants.SASLMechanism: SASLPlain, constants.SASLUsername: "router", constants.SASLPassword: "wrong",
+		},
+		map[string]string{constants.Topic: "rejected"})
+	_, err = target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.Error(t, err)
+	assert.Empty(t, broker.topicRecords("rejected"))
+}
+
+func TestGoToTargetTLS(t *testing.T) {
+	certFile, keyFile := writeCertificate(t)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("failed to load certificate: %v", err)
+	}
+	broker := newFakeBroker(t, 1)
+	broker.listener = tls.NewListener(broker.listener, &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	})
+	broker.start()
+
+	target := newTarget(t,
+		map[string]string{constants.Brokers: broker.addr(), constants.LingerMS: "0", constants.TLSCAFile: certFile},
+		map[string]string{constants.Topic: "encrypted"})
+	_, err = target.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, broker.topicRecords("encrypted"), 1)
+}
+
+// writeCertificate writes a self-signed certificate of 127.0.0.1 and its key
+func writeCertificate(t *testing.T) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kafka"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestGoToTargetInvalidData(t *testing.T) {
+	target := newTarget(t,
+		map[string]string{constants.Brokers: "127.0.0.1:9092"},
+		map[string]string{constants.Topic: "test"})
+	_, err := target.GoToTarget(map[string]interface{}{"data": "not bytes"}, nil)
+	assert.Error(t, err)
+}
+
+func TestGetTarget(t *testing.T) {
+	factory := &kafkaFactory{clients: make(map[string]*endpointClient)}
+	ep := &v1.RuleEndpoint{Spec: v1.RuleEndpointSpec{
+		RuleEndpointType: v1.RuleEndpointTypeKafka,
+		Properties:       map[string]string{constants.Brokers: "127.0.0.1:9092"},
+	}}
+
+	assert.Nil(t, factory.GetTarget(ep, map[string]string{}))
+	assert.Nil(t, factory.GetTarget(ep, map[string]string{constants.Topic: "{{.Unknown}}"}))
+	assert.Nil(t, factory.GetTarget(ep, map[string]string{constants.Topic: "t", constants.BatchSize: "0"}))
+
+	t1 := factory.GetTarget(ep, map[string]string{constants.Topic: "t"}).(*Kafka)
+	t2 := factory.GetTarget(ep, map[string]string{constants.Topic: "t2"}).(*Kafka)
+	assert.Same(t, t1.producer.client, t2.producer.client)
+
+	ep.Spec.Properties[constants.ClientID] = "changed"
+	t3 := factory.GetTarget(ep, map[string]string{constants.Topic: "t"}).(*Kafka)
+	assert.NotSame(t, t1.producer.client, t3.producer.client)
+
+	// the certificate files are read when the client is created
+	ep.Spec.Properties[constants.TLSCAFile] = filepath.Join(t.TempDir(), "missing.crt")
+	assert.Nil(t, factory.GetTarget(ep, map[string]string{constants.Topic: "t"}))
+
+	for _, target := range []*Kafka{t1, t2, t3} {
+		target.Release()
+	}
+}
+
+func TestReleaseTarget(t *testing.T) {
+	factory := &kafkaFactory{clients: make(map[string]*endpointClient)}
+	ep := &v1.RuleEndpoint{Spec: v1.RuleEndpointSpec{
+		RuleEndpointType: v1.RuleEndpointTypeKafka,
+		Properties:       map[string]string{constants.Brokers: "127.0.0.1:9092"},
+	}}
+	ep.Namespace, ep.Name = "default", "my-kafka"
+
+	t1 := factory.GetTarget(ep, map[string]string{constants.Topic: "t"}).(*Kafka)
+	t2 := factory.GetTarget(ep, map[string]string{constants.Topic: "t2"}).(*Kafka)
+	ec := factory.clients["default/my-kafka"]
+	assert.Equal(t, 2, ec.refs)
+
+	// the replaced client is kept until the targets holding it release it
+	ep.Spec.Properties[constants.ClientID] = "changed"
+	t3 := factory.GetTarget(ep, map[string]string{constants.Topic: "t"}).(*Kafka)
+	assert.NotSame(t, ec, factory.clients["default/my-kafka"])
+	t1.Release()
+	t1.Release()
+	assert.Equal(t, 1, ec.refs)
+	t2.Release()
+	assert.Equal(t, 0, ec.refs)
+	assert.Len(t, factory.clients, 1)
+
+	t3.Release()
+	assert.Empty(t, factory.clients)
+}
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		wantErr    bool
+	}{
+		{name: "missing brokers", properties: map[string]string{}, wantErr: true},
+		{name: "empty brokers", properties: map[string]string{constants.Brokers: " , "}, wantErr: true},
+		{name: "broker without port", properties: map[string]string{constants.Brokers: "kafka"}, wantErr: true},
+		{name: "broker port out of range", properties: map[string]string{constants.Brokers: "kafka:70000"}, wantErr: true},
+		{name: "invalid acks", properties: map[string]string{constants.Brokers: "kafka:9092", constants.Acks: "2"}, wantErr: true},
+		{name: "invalid timeout", properties: map[string]string{constants.Brokers: "kafka:9092", constants.TimeoutMS: "-1"}, wantErr: true},
+		{name: "invalid linger", properties: map[string]string{constants.Brokers: "kafka:9092", constants.LingerMS: "x"}, wantErr: true},
+		{name: "invalid tls", properties: map[string]string{constants.Brokers: "kafka:9092", constants.TLS: "yes please"}, wantErr: true},
+		{
+			name:       "client certificate without key",
+			properties: map[string]string{constants.Brokers: "kafka:9092", constants.TLSCertFile: "/etc/kafka/tls.crt"},
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported sasl mechanism",
+			properties: map[string]string{constants.Brokers: "kafka:9092", constants.SASLMechanism: "GSSAPI"},
+			wantErr:    true,
+		},
+		{
+			name:       "sasl without password",
+			properties: map[string]string{constants.Brokers: "kafka:9092", constants.SASLMechanism: SASLPlain, constants.SASLUsername: "u"},
+			wantErr:    true,
+		},
+		{
+			name:       "sasl credentials without mechanism",
+			properties: map[string]string{constants.Brokers: "kafka:9092", constants.SASLUsername: "u", constants.SASLPassword: "p"},
+			wantErr:    true,
+		},
+		{
+			name: "valid",
+			properties: map[string]string{
+				constants.Brokers: "kafka-0:9092, kafka-1:9092", constants.Acks: "all", constants.BatchSize: "10",
+				constants.TLS: "true", constants.SASLMechanism: SASLScramSHA512, constants.SASLUsername: "u", constants.SASLPassword: "p",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig(tt.properties)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+		})
+	}
+
+	cfg, _ := ParseConfig(map[string]string{constants.Brokers: "kafka-0:9092, kafka-1:9092", constants.Acks: "all"})
+	assert.Equal(t, []string{"kafka-0:9092", "kafka-1:9092"}, cfg.Brokers)
+	assert.Equal(t, int16(-1), cfg.Acks)
+	assert.Equal(t, defaultBatchSize, cfg.BatchSize)
+	assert.False(t, cfg.TLS.Enabled)
+
+	cfg, _ = ParseConfig(map[string]string{constants.Brokers: "kafka:9093", constants.TLSCAFile: "/etc/kafka/ca.crt"})
+	assert.True(t, cfg.TLS.Enabled)
+}
@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type pendingRecord struct {
+	ctx    context.Context
+	record *kgo.Record
+	done   chan error
+}
+
+// producer accumulates records and hands them to the kafka client in
+// batches. A batch is handed over when it reaches batchSize records or when
+// linger has elapsed since its first record, whichever comes first. No
+// goroutine is kept while idle, so a producer needs no explicit shutdown
+// when its rule is deleted.
+type producer struct {
+	client    *kgo.Client
+	batchSize int
+	linger    time.Duration
+
+	mu      sync.Mutex
+	pending []*pendingRecord
+	timer   *time.Timer
+}
+
+func newProducer(c *kgo.Client, batchSize int, linger time.Duration) *producer {
+	return &producer{
+		client:    c,
+		batchSize: batchSize,
+		linger:    linger,
+	}
+}
+
+// send queues record and returns a channel receiving its delivery result.
+// The record fails with the error of ctx if ctx is done before the record is
+// sent to the broker.
+func (p *producer) send(ctx context.Context, record *kgo.Record) <-chan error {
+	pr := &pendingRecord{ctx: ctx, record: record, done: make(chan error, 1)}
+
+	p.mu.Lock()
+	p.pending = append(p.pending, pr)
+	if len(p.pending) >= p.batchSize || p.linger <= 0 {
+		batch := p.takeLocked()
+		p.mu.Unlock()
+		go p.flush(batch)
+		return pr.done
+	}
+	if p.timer == nil {
+		p.timer = time.AfterFunc(p.linger, p.flushPending)
+	}
+	p.mu.Unlock()
+	return pr.done
+}
+
+func (p *producer) takeLocked() []*pendingRecord {
+	batch := p.pending
+	p.pending = nil
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	return batch
+}
+
+func (p *producer) flushPending() {
+	p.mu.Lock()
+	batch := p.takeLocked()
+	p.mu.Unlock()
+	p.flush(batch)
+}
+
+// flush hands batch to the kafka client. The client partitions the records,
+// retries them on retriable errors and reports every record through its
+// done channel.
+func (p *producer) flush(batch []*pendingRecord) {
+	for _, pr := range batch {
+		done := pr.done
+		p.client.Produce(pr.ctx, pr.record, func(_ *kgo.Record, err error) {
+			done <- err
+		})
+	}
+}
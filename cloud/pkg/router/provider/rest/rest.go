@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -22,8 +21,6 @@ import (
 	commonType "github.com/kubeedge/kubeedge/common/types"
 )
 
-var inited int32
-
 type restFactory struct {
 }
 
@@ -50,11 +47,7 @@ func (*restFactory) GetSource(ep *v1.RuleEndpoint, sourceResource map[string]str
 		return nil
 	}
 	cli := &Rest{Namespace: ep.Namespace, Path: normalizeResource(path)}
-	if atomic.CompareAndSwapInt32(&inited, 0, 1) {
-		listener.InitHandler()
-		// guarantee that it will be executed only once
-		go listener.RestHandlerInstance.Serve()
-	}
+	listener.StartServer()
 	return cli
 }
 
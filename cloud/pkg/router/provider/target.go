@@ -16,6 +16,20 @@ type Target interface {
 	GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error)
 }
 
+// Releaser is implemented by the targets holding resources shared with the
+// other targets of a ruleEndpoint, like connections.
+type Releaser interface {
+	// Release is called when the target is no longer used by its rule
+	Release()
+}
+
+// ReleaseTarget releases the target if it implements Releaser
+func ReleaseTarget(t Target) {
+	if r, ok := t.(Releaser); ok {
+		r.Release()
+	}
+}
+
 var (
 	// Modules map
 	targets map[v1.RuleEndpointTypeDef]TargetFactory
@@ -5,13 +5,16 @@ import (
 
 	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
 	"github.com/kubeedge/beehive/pkg/core"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/informers"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
 	routerconfig "github.com/kubeedge/kubeedge/cloud/pkg/router/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/router/listener"
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/eventbus"   // init eventbus
+	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/kafka"      // init kafka
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/rest"       // init rest
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/servicebus" // init servicebus
-	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/rule"                // init rule
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/rule"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/transform"
 )
 
 type router struct {
@@ -28,6 +31,14 @@ func newRouter(enable bool) *router {
 
 func Register(router *v1alpha1.Router) {
 	routerconfig.InitConfigure(router)
+	if router.Enable {
+		// listers must be created before informers are started
+		gis := informers.GetInformersManager()
+		rule.InitLabelGetter(transform.NewListerLabelGetter(
+			gis.GetKubeInformerFactory().Core().V1().Nodes().Lister(),
+			gis.GetKubeEdgeInformerFactory().Devices().V1beta1().Devices().Lister(),
+		))
+	}
 	core.Register(newRouter(router.Enable))
 }
 
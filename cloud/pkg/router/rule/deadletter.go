@@ -0,0 +1,191 @@
+package rule
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/listener"
+)
+
+// The dead-letter api is served by the router http listener. Its path starts
+// with "_", which can never be a node name, so it does not shadow rule paths.
+const (
+	listDeadLettersPattern   = "GET /_deadletters/{namespace}/{name}"
+	replayDeadLettersPattern = "POST /_deadletters/{namespace}/{name}/replay"
+)
+
+var (
+	// retryTargets holds the retry targets of rules, keyed by rule key
+	retryTargets sync.Map
+)
+
+// DeadLetter is a message which could not be delivered to the target of a rule
+type DeadLetter struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Attempts  int       `json:"attempts"`
+	Error     string    `json:"error"`
+	MessageID string    `json:"messageID,omitempty"`
+	NodeName  string    `json:"nodeName,omitempty"`
+	Payload   []byte    `json:"payload,omitempty"`
+
+	// data is the original data passed to the target, used for replay
+	data map[string]interface{}
+}
+
+// ReplayResult is the response of a dead-letter replay
+type ReplayResult struct {
+	Delivered int `json:"delivered"`
+	Failed    int `json:"failed"`
+	Remaining int `json:"remaining"`
+}
+
+// deadLetterQueue is a bounded queue of dead letters, the oldest dead letter
+// is discarded when a new one is pushed into a full queue.
+type deadLetterQueue struct {
+	mu      sync.Mutex
+	size    int
+	letters []*DeadLetter
+}
+
+func newDeadLetterQueue(size int) *deadLetterQueue {
+	return &deadLetterQueue{size: size}
+}
+
+func (q *deadLetterQueue) push(data map[string]interface{}, attempts int, err error) {
+	l := &DeadLetter{
+		ID:        uuid.New().String(),
+		Timestamp: time.Now(),
+		Attempts:  attempts,
+		Error:     err.Error(),
+		data:      data,
+	}
+	l.MessageID, _ = data["messageID"].(string)
+	l.NodeName, _ = data["nodeName"].(string)
+	l.Payload, _ = data["data"].([]byte)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.letters) >= q.size {
+		dropped := q.letters[0]
+		q.letters = q.letters[1:]
+		klog.Warningf("dead-letter queue is full, discard dead letter %s of message %s", dropped.ID, dropped.MessageID)
+	}
+	q.letters = append(q.letters, l)
+}
+
+func (q *deadLetterQueue) list() []*DeadLetter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	letters := make([]*DeadLetter, len(q.letters))
+	copy(letters, q.letters)
+	return letters
+}
+
+func (q *deadLetterQueue) update(id string, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, l := range q.letters {
+		if l.ID == id {
+			l.Attempts++
+			l.Error = err.Error()
+			l.Timestamp = time.Now()
+			return
+		}
+	}
+}
+
+func (q *deadLetterQueue) remove(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, l := range q.letters {
+		if l.ID == id {
+			q.letters = append(q.letters[:i], q.letters[i+1:]...)
+			return
+		}
+	}
+}
+
+func (q *deadLetterQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.letters)
+}
+
+// deadLetterDepth returns the number of dead letters of a rule
+func deadLetterDepth(ruleKey string) int64 {
+	v, ok := retryTargets.Load(ruleKey)
+	if !ok {
+		return 0
+	}
+	return int64(v.(*retryTarget).deadLetters.len())
+}
+
+func registerDeadLetterHandlers() {
+	listener.RestHandlerInstance.AddAdminHandler(listDeadLettersPattern, http.HandlerFunc(listDeadLetters))
+	listener.RestHandlerInstance.AddAdminHandler(replayDeadLettersPattern, http.HandlerFunc(replayDeadLetters))
+}
+
+func getRetryTarget(w http.ResponseWriter, r *http.Request) (*retryTarget, bool) {
+	ruleKey := getKey(r.PathValue("namespace"), r.PathValue("name"))
+	v, ok := retryTargets.Load(ruleKey)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{
+			"error": fmt.Sprintf("rule %s does not exist or has no retry policy", ruleKey),
+		})
+		return nil, false
+	}
+	return v.(*retryTarget), true
+}
+
+// listDeadLetters lists the dead letters of a rule
+func listDeadLetters(w http.ResponseWriter, r *http.Request) {
+	rt, ok := getRetryTarget(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, rt.deadLetters.list())
+}
+
+// replayDeadLetters delivers the dead letters of a rule again. Only the dead
+// letter given by the "id" query parameter is replayed if it is set.
+func replayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	rt, ok := getRetryTarget(w, r)
+	if !ok {
+		return
+	}
+	namespace, name := r.PathValue("namespace"), r.PathValue("name")
+	delivered, failed, err := rt.replay(r.URL.Query().Get("id"))
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+		return
+	}
+	remaining := rt.deadLetters.len()
+	// the replayed messages were counted as failed when they were moved into
+	// the dead-letter queue, they are reported as replayed to move them from
+	// the failed to the successful messages of the rule status
+	for i := 0; i < delivered; i++ {
+		ResultChannel <- ExecResult{RuleID: name, ProjectID: namespace, Status: "REPLAYED", DeadLetters: int64(remaining)}
+	}
+	klog.Infof("replay dead letters of rule %s: %d delivered, %d failed", getKey(namespace, name), delivered, failed)
+	writeJSON(w, http.StatusOK, ReplayResult{Delivered: delivered, Failed: failed, Remaining: remaining})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(body); err != nil {
+		klog.Errorf("failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	routerv1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+)
+
+const (
+	defaultMaxAttempts         = 3
+	defaultInitialBackoff      = time.Second
+	defaultMaxBackoff          = 30 * time.Second
+	defaultDeadLetterQueueSize = 100
+)
+
+var (
+	errAttemptTimeout   = errors.New("delivery attempt timed out")
+	errAttemptCancelled = errors.New("delivery attempt is cancelled")
+)
+
+// retryTarget wraps the target of a rule with a retry policy. Failed
+// deliveries are retried with exponential backoff, and messages which still
+// fail after all attempts are moved into the dead-letter queue of the rule.
+type retryTarget struct {
+	provider.Target
+
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	timeout        time.Duration
+	deadLetters    *deadLetterQueue
+}
+
+func newRetryTarget(target provider.Target, policy *routerv1.RetryPolicy, deadLetters *deadLetterQueue) *retryTarget {
+	rt := &retryTarget{
+		Target:         target,
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		deadLetters:    deadLetters,
+	}
+	if policy.MaxAttempts > 0 {
+		rt.maxAttempts = int(policy.MaxAttempts)
+	}
+	if policy.InitialBackoff != nil {
+		rt.initialBackoff = policy.InitialBackoff.Duration
+	}
+	if policy.MaxBackoff != nil {
+		rt.maxBackoff = policy.MaxBackoff.Duration
+	}
+	if policy.Timeout != nil {
+		rt.timeout = policy.Timeout.Duration
+	}
+	return rt
+}
+
+// deadLetterQueueSize returns the dead-letter queue size of a retry policy
+func deadLetterQueueSize(policy *routerv1.RetryPolicy) int {
+	if policy.DeadLetterQueueSize > 0 {
+		return int(policy.DeadLetterQueueSize)
+	}
+	return defaultDeadLetterQueueSize
+}
+
+func (t *retryTarget) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	resp, attempts, err := t.deliver(data, stop)
+	if err != nil {
+		t.deadLetters.push(data, attempts, err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// deliver tries to deliver data until it succeeds, the attempts are used up
+// or stop is received. It returns the number of attempts made.
+func (t *retryTarget) deliver(data map[string]interface{}, stop chan struct{}) (interface{}, int, error) {
+	backoff := t.initialBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(data, stop)
+		if err == nil {
+			return resp, attempt, nil
+		}
+		if attempt >= t.maxAttempts || errors.Is(err, errAttemptCancelled) {
+			return nil, attempt, err
+		}
+		klog.Warningf("delivery attempt %d/%d to target %s failed, retry in %s: %v", attempt, t.maxAttempts, t.Name(), backoff, err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-stop:
+			timer.Stop()
+			return nil, attempt, fmt.Errorf("delivery is cancelled after %d attempts: %w", attempt, err)
+		}
+		backoff *= 2
+		if backoff > t.maxBackoff {
+			backoff = t.maxBackoff
+		}
+	}
+}
+
+// attempt delivers data once. The target gets a stop channel of its own, it
+// is told to stop when the attempt timeout elapses or stop is received, and
+// the attempt only ends when the target returns, so that a delivery still in
+// progress is neither left behind nor made again by the next attempt.
+func (t *retryTarget) attempt(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	if t.timeout <= 0 {
+		return t.Target.GoToTarget(data, stop)
+	}
+	type result struct {
+		resp interface{}
+		err  error
+	}
+	attemptStop := make(chan struct{})
+	done := make(chan result, 1)
+	go func() {
+		resp, err := t.Target.GoToTarget(data, attemptStop)
+		done <- result{resp: resp, err: err}
+	}()
+	timer := time.NewTimer(t.timeout)
+	defer timer.Stop()
+	var cause error
+	select {
+	case r := <-done:
+		return r.resp, r.err
+	case <-timer.C:
+		cause = errAttemptTimeout
+	case <-stop:
+		cause = errAttemptCancelled
+	}
+
+	var r result
+	select {
+	case attemptStop <- struct{}{}:
+		r = <-done
+	case r = <-done:
+	}
+	if r.err == nil {
+		// delivered before the target stopped
+		return r.resp, nil
+	}
+	return nil, fmt.Errorf("%w: %v", cause, r.err)
+}
+
+// replay delivers the dead letter with id once more, or all dead letters when
+// id is empty. Delivered letters are removed from the queue, the others are
+// kept with their attempts and error updated. It returns the number of
+// delivered and failed letters.
+func (t *retryTarget) replay(id string) (delivered, failed int, err error) {
+	letters := t.deadLetters.list()
+	if id != "" {
+		var found []*DeadLetter
+		for _, l := range letters {
+			if l.ID == id {
+				found = append(found, l)
+			}
+		}
+		if len(found) == 0 {
+			return 0, 0, fmt.Errorf("dead letter %s does not exist", id)
+		}
+		letters = found
+	}
+	for _, l := range letters {
+		if _, err := t.attempt(l.data, nil); err != nil {
+			t.deadLetters.update(l.ID, err)
+			failed++
+			continue
+		}
+		t.deadLetters.remove(l.ID)
+		delivered++
+	}
+	return delivered, failed, nil
+}
@@ -0,0 +1,288 @@
+package rule
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	routerv1 "github.com/kubeedge/api/apis/rules/v1"
+)
+
+// fakeTarget fails the first failures deliveries and then succeeds
+type fakeTarget struct {
+	mu        sync.Mutex
+	failures  int
+	calls     int
+	delay     time.Duration
+	delivered [][]byte
+}
+
+func (*fakeTarget) Name() string {
+	return "fake"
+}
+
+func (t *fakeTarget) GoToTarget(data map[string]interface{}, _ chan struct{}) (interface{}, error) {
+	time.Sleep(t.delay)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.calls++
+	if t.calls <= t.failures {
+		return nil, errors.New("target unavailable")
+	}
+	t.delivered = append(t.delivered, data["data"].([]byte))
+	return nil, nil
+}
+
+func (t *fakeTarget) callCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.calls
+}
+
+func policy(maxAttempts int32) *routerv1.RetryPolicy {
+	return &routerv1.RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: &metav1.Duration{Duration: time.Millisecond},
+		MaxBackoff:     &metav1.Duration{Duration: 2 * time.Millisecond},
+	}
+}
+
+func TestRetryTargetSucceedsAfterRetries(t *testing.T) {
+	target := &fakeTarget{failures: 2}
+	rt := newRetryTarget(target, policy(3), newDeadLetterQueue(10))
+
+	_, err := rt.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, target.callCount())
+	assert.Equal(t, 0, rt.deadLetters.len())
+}
+
+func TestRetryTargetDeadLetter(t *testing.T) {
+	target := &fakeTarget{failures: 10}
+	rt := newRetryTarget(target, policy(2), newDeadLetterQueue(10))
+
+	_, err := rt.GoToTarget(map[string]interface{}{
+		"messageID": "msg-1",
+		"nodeName":  "edge-node",
+		"data":      []byte("a"),
+	}, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 2, target.callCount())
+
+	letters := rt.deadLetters.list()
+	if assert.Len(t, letters, 1) {
+		assert.Equal(t, 2, letters[0].Attempts)
+		assert.Equal(t, "msg-1", letters[0].MessageID)
+		assert.Equal(t, "edge-node", letters[0].NodeName)
+		assert.Equal(t, []byte("a"), letters[0].Payload)
+		assert.Equal(t, "target unavailable", letters[0].Error)
+	}
+}
+
+func TestRetryTargetStop(t *testing.T) {
+	target := &fakeTarget{failures: 10}
+	p := policy(5)
+	p.InitialBackoff = &metav1.Duration{Duration: time.Hour}
+	p.MaxBackoff = &metav1.Duration{Duration: time.Hour}
+	rt := newRetryTarget(target, p, newDeadLetterQueue(10))
+
+	stop := make(chan struct{})
+	go func() {
+		stop <- struct{}{}
+	}()
+	_, err := rt.GoToTarget(map[string]interface{}{"data": []byte("a")}, stop)
+	assert.Error(t, err)
+	assert.Equal(t, 1, target.callCount())
+	assert.Equal(t, 1, rt.deadLetters.len())
+}
+
+// stoppableTarget blocks every delivery until it is told to stop
+type stoppableTarget struct {
+	mu      sync.Mutex
+	running int
+	stopped int
+}
+
+func (*stoppableTarget) Name() string {
+	return "stoppable"
+}
+
+func (t *stoppableTarget) GoToTarget(_ map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	t.mu.Lock()
+	t.running++
+	t.mu.Unlock()
+	<-stop
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running--
+	t.stopped++
+	return nil, errors.New("delivery is cancelled")
+}
+
+func TestRetryTargetAttemptTimeout(t *testing.T) {
+	target := &stoppableTarget{}
+	p := policy(2)
+	p.Timeout = &metav1.Duration{Duration: 10 * time.Millisecond}
+	rt := newRetryTarget(target, p, newDeadLetterQueue(10))
+
+	_, err := rt.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.ErrorIs(t, err, errAttemptTimeout)
+	// every timed out attempt is stopped before the next one starts
+	target.mu.Lock()
+	assert.Equal(t, 0, target.running)
+	assert.Equal(t, 2, target.stopped)
+	target.mu.Unlock()
+}
+
+func TestRetryTargetAttemptTimeoutLateDelivery(t *testing.T) {
+	target := &fakeTarget{delay: 50 * time.Millisecond}
+	p := policy(3)
+	p.Timeout = &metav1.Duration{Duration: 10 * time.Millisecond}
+	rt := newRetryTarget(target, p, newDeadLetterQueue(10))
+
+	// the target ignores stop and delivers after the timeout, the delivery
+	// is not made again
+	_, err := rt.GoToTarget(map[string]interface{}{"data": []byte("a")}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, target.callCount())
+	assert.Equal(t, [][]byte{[]byte("a")}, target.delivered)
+	assert.Equal(t, 0, rt.deadLetters.len())
+}
+
+func TestRetryTargetStopDuringAttempt(t *testing.T) {
+	target := &stoppableTarget{}
+	p := policy(3)
+	p.Timeout = &metav1.Duration{Duration: time.Hour}
+	rt := newRetryTarget(target, p, newDeadLetterQueue(10))
+
+	stop := make(chan struct{})
+	go func() {
+		stop <- struct{}{}
+	}()
+	_, err := rt.GoToTarget(map[string]interface{}{"data": []byte("a")}, stop)
+	assert.ErrorIs(t, err, errAttemptCancelled)
+	target.mu.Lock()
+	assert.Equal(t, 0, target.running)
+	assert.Equal(t, 1, target.stopped)
+	target.mu.Unlock()
+	assert.Equal(t, 1, rt.deadLetters.len())
+}
+
+func TestRetryTargetDefaults(t *testing.T) {
+	rt := newRetryTarget(&fakeTarget{}, &routerv1.RetryPolicy{}, newDeadLetterQueue(1))
+	assert.Equal(t, defaultMaxAttempts, rt.maxAttempts)
+	assert.Equal(t, defaultInitialBackoff, rt.initialBackoff)
+	assert.Equal(t, defaultMaxBackoff, rt.maxBackoff)
+	assert.Equal(t, time.Duration(0), rt.timeout)
+	assert.Equal(t, defaultDeadLetterQueueSize, deadLetterQueueSize(&routerv1.RetryPolicy{}))
+}
+
+func TestDeadLetterQueueBounded(t *testing.T) {
+	q := newDeadLetterQueue(2)
+	for _, id := range []string{"1", "2", "3"} {
+		q.push(map[string]interface{}{"messageID": id}, 1, errors.New("failed"))
+	}
+	letters := q.list()
+	if assert.Len(t, letters, 2) {
+		assert.Equal(t, "2", letters[0].MessageID)
+		assert.Equal(t, "3", letters[1].MessageID)
+	}
+}
+
+func TestAddRetryTargetKeepsDeadLetters(t *testing.T) {
+	ruleKey := getKey("default", "keep")
+	defer retryTargets.Delete(ruleKey)
+
+	rt := addRetryTarget(ruleKey, &fakeTarget{}, policy(1)).(*retryTarget)
+	rt.deadLetters.push(map[string]interface{}{"messageID": "1"}, 1, errors.New("failed"))
+	rt.deadLetters.push(map[string]interface{}{"messageID": "2"}, 1, errors.New("failed"))
+	rt.deadLetters.push(map[string]interface{}{"messageID": "3"}, 1, errors.New("failed"))
+
+	p := policy(1)
+	p.DeadLetterQueueSize = 2
+	rt = addRetryTarget(ruleKey, &fakeTarget{}, p).(*retryTarget)
+	assert.Equal(t, int64(2), deadLetterDepth(ruleKey))
+	letters := rt.deadLetters.list()
+	if assert.Len(t, letters, 2) {
+		assert.Equal(t, "2", letters[0].MessageID)
+		assert.Equal(t, "3", letters[1].MessageID)
+	}
+
+	// the kept dead letters are still bounded by the new queue size
+	rt.deadLetters.push(map[string]interface{}{"messageID": "4"}, 1, errors.New("failed"))
+	letters = rt.deadLetters.list()
+	if assert.Len(t, letters, 2) {
+		assert.Equal(t, "3", letters[0].MessageID)
+		assert.Equal(t, "4", letters[1].MessageID)
+	}
+}
+
+func TestDeadLetterAPI(t *testing.T) {
+	ruleKey := getKey("default", "my-rule")
+	defer retryTargets.Delete(ruleKey)
+
+	target := &fakeTarget{failures: 3}
+	rt := addRetryTarget(ruleKey, target, policy(1)).(*retryTarget)
+	for _, payload := range []string{"a", "b", "c"} {
+		_, err := rt.GoToTarget(map[string]interface{}{"data": []byte(payload)}, nil)
+		assert.Error(t, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(listDeadLettersPattern, listDeadLetters)
+	mux.HandleFunc(replayDeadLettersPattern, replayDeadLetters)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/_deadletters/default/my-rule")
+	if !assert.NoError(t, err) {
+		return
+	}
+	var letters []DeadLetter
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&letters))
+	resp.Body.Close()
+	if !assert.Len(t, letters, 3) {
+		return
+	}
+	assert.Equal(t, []byte("a"), letters[0].Payload)
+
+	// replay a single dead letter
+	resp, err = http.Post(server.URL+"/_deadletters/default/my-rule/replay?id="+letters[1].ID, "", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var result ReplayResult
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	resp.Body.Close()
+	assert.Equal(t, ReplayResult{Delivered: 1, Remaining: 2}, result)
+	assert.Equal(t, [][]byte{[]byte("b")}, target.delivered)
+
+	// replay all remaining dead letters
+	resp, err = http.Post(server.URL+"/_deadletters/default/my-rule/replay", "", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	resp.Body.Close()
+	assert.Equal(t, ReplayResult{Delivered: 2}, result)
+	assert.Equal(t, int64(0), deadLetterDepth(ruleKey))
+
+	resp, err = http.Post(server.URL+"/_deadletters/default/my-rule/replay?id=unknown", "", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	resp, err = http.Get(server.URL + "/_deadletters/default/unknown")
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp.Body.Close()
+	}
+}
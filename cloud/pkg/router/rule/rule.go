@@ -12,15 +12,20 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/cloud/pkg/router/listener"
 	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/transform"
 )
 
 var (
 	rules         sync.Map
 	ruleEndpoints sync.Map
+	// ruleTargets holds the target of each rule, it is released when the
+	// rule is deleted or replaced.
+	ruleTargets sync.Map
 )
 
 func init() {
 	registerListener()
+	registerDeadLetterHandlers()
 }
 
 func registerListener() {
@@ -108,12 +113,37 @@ func addRule(rule *routerv1.Rule) error {
 		return err
 	}
 
+	var transformer *transform.Transformer
+	if rule.Spec.Transform != nil {
+		if transformer, err = transform.New(rule.Namespace, rule.Spec.Transform, labelGetter); err != nil {
+			klog.Errorf("invalid transform of rule %s/%s: %v", rule.Namespace, rule.Name, err)
+			provider.ReleaseTarget(target)
+			return err
+		}
+	}
+
 	ruleKey := getKey(rule.Namespace, rule.Name)
+	ruleTarget := target
+	if rule.Spec.RetryPolicy != nil {
+		target = addRetryTarget(ruleKey, target, rule.Spec.RetryPolicy)
+		// serve the dead-letter api
+		listener.StartServer()
+	} else {
+		retryTargets.Delete(ruleKey)
+	}
 	if err := source.RegisterListener(func(data interface{}) (interface{}, error) {
 		//TODO Use goroutine pool later
 		var execResult ExecResult
-		resp, err := source.Forward(target, data)
-		if err != nil {
+		var delivery *transformDelivery
+		msgTarget := target
+		if transformer != nil {
+			delivery = &transformDelivery{Target: target, transformer: transformer}
+			msgTarget = delivery
+		}
+		resp, err := source.Forward(msgTarget, data)
+		if delivery != nil && delivery.filtered {
+			execResult = ExecResult{RuleID: rule.Name, ProjectID: rule.Namespace, Status: "FILTERED"}
+		} else if err != nil {
 			// rule.Status.Fail++
 			// record error info for rule
 			errMsg := ErrorMsg{Detail: err.Error(), Timestamp: time.Now()}
@@ -121,6 +151,7 @@ func addRule(rule *routerv1.Rule) error {
 		} else {
 			execResult = ExecResult{RuleID: rule.Name, ProjectID: rule.Namespace, Status: "SUCCESS"}
 		}
+		execResult.DeadLetters = deadLetterDepth(ruleKey)
 		ResultChannel <- execResult
 		return resp, nil
 	}); err != nil {
@@ -128,9 +159,13 @@ func addRule(rule *routerv1.Rule) error {
 		errMsg := ErrorMsg{Detail: err.Error(), Timestamp: time.Now()}
 		execResult := ExecResult{RuleID: rule.Name, ProjectID: rule.Namespace, Status: "FAIL", Error: errMsg}
 		ResultChannel <- execResult
+		provider.ReleaseTarget(ruleTarget)
 		return nil
 	}
 
+	if old, ok := ruleTargets.Swap(ruleKey, ruleTarget); ok {
+		provider.ReleaseTarget(old.(provider.Target))
+	}
 	rules.Store(ruleKey, rule)
 	klog.Infof("add rule success: %+v", rule)
 	return nil
@@ -156,6 +191,10 @@ func delRule(namespace, name string) {
 	}
 
 	rules.Delete(ruleKey)
+	retryTargets.Delete(ruleKey)
+	if target, ok := ruleTargets.LoadAndDelete(ruleKey); ok {
+		provider.ReleaseTarget(target.(provider.Target))
+	}
 	klog.V(4).Infof("delete rule success: %s", ruleKey)
 }
 
@@ -199,6 +238,24 @@ func getTargetOfRule(rule *routerv1.Rule) (provider.Target, error) {
 	return target, nil
 }
 
+// addRetryTarget wraps target with the retry policy of a rule. The dead letters
+// of the rule are kept when the rule is added again, the oldest ones are
+// discarded if the new dead-letter queue is smaller than the old one.
+func addRetryTarget(ruleKey string, target provider.Target, policy *routerv1.RetryPolicy) provider.Target {
+	size := deadLetterQueueSize(policy)
+	deadLetters := newDeadLetterQueue(size)
+	if v, ok := retryTargets.Load(ruleKey); ok {
+		letters := v.(*retryTarget).deadLetters.list()
+		if len(letters) > size {
+			letters = letters[len(letters)-size:]
+		}
+		deadLetters.letters = letters
+	}
+	rt := newRetryTarget(target, policy, deadLetters)
+	retryTargets.Store(ruleKey, rt)
+	return rt
+}
+
 func getKey(namespace, name string) string {
 	return fmt.Sprintf("%s/%s", namespace, name)
 }
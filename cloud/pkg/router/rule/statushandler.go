@@ -17,6 +17,8 @@ type ExecResult struct {
 	ProjectID string
 	Status    string
 	Error     ErrorMsg
+	// DeadLetters is the depth of the dead-letter queue of the rule
+	DeadLetters int64
 }
 
 type ErrorMsg struct {
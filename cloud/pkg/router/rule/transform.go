@@ -0,0 +1,47 @@
+package rule
+
+import (
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/transform"
+)
+
+// labelGetter provides the labels used by transform enrichment, it is nil
+// until InitLabelGetter is called.
+var labelGetter transform.LabelGetter
+
+// InitLabelGetter sets the label source of transform enrichment
+func InitLabelGetter(getter transform.LabelGetter) {
+	labelGetter = getter
+}
+
+// transformDelivery applies the transform of a rule to a single message
+// before it is delivered, and records whether the message was filtered.
+type transformDelivery struct {
+	provider.Target
+
+	transformer *transform.Transformer
+	filtered    bool
+}
+
+func (d *transformDelivery) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	payload, ok := data["data"].([]byte)
+	if !ok {
+		return d.Target.GoToTarget(data, stop)
+	}
+	nodeName, _ := data["nodeName"].(string)
+	out, keep, err := d.transformer.Apply(payload, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if !keep {
+		d.filtered = true
+		return nil, nil
+	}
+
+	transformed := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		transformed[k] = v
+	}
+	transformed["data"] = out
+	return d.Target.GoToTarget(transformed, stop)
+}
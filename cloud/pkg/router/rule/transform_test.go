@@ -0,0 +1,36 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	routerv1 "github.com/kubeedge/api/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/transform"
+)
+
+func TestTransformDelivery(t *testing.T) {
+	transformer, err := transform.New("default", &routerv1.Transform{
+		Filter: []routerv1.FilterCondition{{Path: "{.value}", Operator: routerv1.FilterOpGreaterThan, Value: "10"}},
+		Rename: map[string]string{"value": "v"},
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	target := &fakeTarget{}
+	d := &transformDelivery{Target: target, transformer: transformer}
+	data := map[string]interface{}{"messageID": "1", "data": []byte(`{"value":20}`)}
+	_, err = d.GoToTarget(data, nil)
+	assert.NoError(t, err)
+	assert.False(t, d.filtered)
+	assert.Equal(t, [][]byte{[]byte(`{"v":20}`)}, target.delivered)
+	// the original data is kept for the source
+	assert.Equal(t, []byte(`{"value":20}`), data["data"])
+
+	d = &transformDelivery{Target: target, transformer: transformer}
+	_, err = d.GoToTarget(map[string]interface{}{"data": []byte(`{"value":5}`)}, nil)
+	assert.NoError(t, err)
+	assert.True(t, d.filtered)
+	assert.Equal(t, 1, target.callCount())
+}
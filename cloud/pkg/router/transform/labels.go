@@ -0,0 +1,33 @@
+package transform
+
+import (
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	devicelisters "github.com/kubeedge/api/client/listers/devices/v1beta1"
+)
+
+type listerLabelGetter struct {
+	nodes   corelisters.NodeLister
+	devices devicelisters.DeviceLister
+}
+
+// NewListerLabelGetter returns a LabelGetter reading labels from informer caches
+func NewListerLabelGetter(nodes corelisters.NodeLister, devices devicelisters.DeviceLister) LabelGetter {
+	return &listerLabelGetter{nodes: nodes, devices: devices}
+}
+
+func (g *listerLabelGetter) NodeLabels(name string) (map[string]string, error) {
+	node, err := g.nodes.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return node.Labels, nil
+}
+
+func (g *listerLabelGetter) DeviceLabels(namespace, name string) (map[string]string, error) {
+	device, err := g.devices.Devices(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return device.Labels, nil
+}
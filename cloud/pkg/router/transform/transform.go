@@ -0,0 +1,278 @@
+package transform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/klog/v2"
+
+	routerv1 "github.com/kubeedge/api/apis/rules/v1"
+)
+
+// LabelGetter gets the labels used to enrich payloads
+type LabelGetter interface {
+	NodeLabels(name string) (map[string]string, error)
+	DeviceLabels(namespace, name string) (map[string]string, error)
+}
+
+// jsonPath is a JSONPath expression. A jsonpath.JSONPath keeps the state of
+// the evaluation and cannot be reused after a range, so the expression is
+// parsed for each evaluation.
+type jsonPath string
+
+type condition struct {
+	path     jsonPath
+	operator routerv1.FilterOperator
+	value    string
+	number   float64
+	regexp   *regexp.Regexp
+}
+
+type selector struct {
+	field string
+	path  jsonPath
+}
+
+// Transformer applies the transform of a rule to message payloads, it is
+// safe for concurrent use
+type Transformer struct {
+	namespace  string
+	filter     []condition
+	selectors  []selector
+	rename     map[string]string
+	enrich     *routerv1.Enrichment
+	deviceName jsonPath
+	labels     LabelGetter
+}
+
+// New builds a Transformer from the transform of a rule in namespace. It
+// returns an error when an expression of the transform is invalid.
+func New(namespace string, spec *routerv1.Transform, labels LabelGetter) (*Transformer, error) {
+	t := &Transformer{
+		namespace: namespace,
+		rename:    spec.Rename,
+		enrich:    spec.Enrich,
+		labels:    labels,
+	}
+	for i, c := range spec.Filter {
+		path, err := parsePath(c.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path of filter[%d]: %w", i, err)
+		}
+		cond := condition{path: path, operator: c.Operator, value: c.Value}
+		switch c.Operator {
+		case routerv1.FilterOpExists, routerv1.FilterOpDoesNotExist, routerv1.FilterOpEquals, routerv1.FilterOpNotEquals:
+		case routerv1.FilterOpGreaterThan, routerv1.FilterOpLessThan:
+			if cond.number, err = strconv.ParseFloat(c.Value, 64); err != nil {
+				return nil, fmt.Errorf("value of filter[%d] must be a number for operator %s", i, c.Operator)
+			}
+		case routerv1.FilterOpMatches:
+			if cond.regexp, err = regexp.Compile(c.Value); err != nil {
+				return nil, fmt.Errorf("invalid regular expression of filter[%d]: %w", i, err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported operator %q of filter[%d]", c.Operator, i)
+		}
+		t.filter = append(t.filter, cond)
+	}
+	for field, p := range spec.Select {
+		path, err := parsePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path of select field %s: %w", field, err)
+		}
+		t.selectors = append(t.selectors, selector{field: field, path: path})
+	}
+	// keep the output stable when several selectors write the same field
+	sort.Slice(t.selectors, func(i, j int) bool { return t.selectors[i].field < t.selectors[j].field })
+
+	if spec.Enrich != nil && spec.Enrich.DeviceNamePath != "" {
+		path, err := parsePath(spec.Enrich.DeviceNamePath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid device name path: %w", err)
+		}
+		t.deviceName = path
+	}
+	return t, nil
+}
+
+func parsePath(text string) (jsonPath, error) {
+	if text == "" {
+		return "", fmt.Errorf("path must not be empty")
+	}
+	if _, err := jsonPath(text).parse(); err != nil {
+		return "", err
+	}
+	return jsonPath(text), nil
+}
+
+func (p jsonPath) parse() (*jsonpath.JSONPath, error) {
+	path := jsonpath.New(string(p)).AllowMissingKeys(true)
+	if err := path.Parse(string(p)); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+// Apply transforms payload of a message sent by nodeName. It returns false
+// when the message is dropped by the filter.
+func (t *Transformer) Apply(payload []byte, nodeName string) ([]byte, bool, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return nil, false, fmt.Errorf("payload is not a JSON object: %w", err)
+	}
+
+	for _, c := range t.filter {
+		if !c.match(obj) {
+			return nil, false, nil
+		}
+	}
+
+	out := obj
+	if len(t.selectors) > 0 {
+		out = make(map[string]interface{}, len(t.selectors))
+		for _, s := range t.selectors {
+			if v, ok := find(s.path, obj); ok {
+				out[s.field] = v
+			}
+		}
+	}
+
+	for from, to := range t.rename {
+		if v, ok := out[from]; ok {
+			delete(out, from)
+			out[to] = v
+		}
+	}
+
+	if t.enrich != nil {
+		t.enrichPayload(out, obj, nodeName)
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// enrichPayload adds the static fields and labels to out. The device name is
+// looked up in the original payload, so that it does not need to be selected.
+func (t *Transformer) enrichPayload(out, original map[string]interface{}, nodeName string) {
+	for k, v := range t.enrich.Static {
+		out[k] = v
+	}
+	if t.labels == nil {
+		return
+	}
+	if t.enrich.NodeLabelsField != "" && nodeName != "" {
+		labels, err := t.labels.NodeLabels(nodeName)
+		if err != nil {
+			klog.V(4).Infof("failed to get labels of node %s: %v", nodeName, err)
+		} else {
+			out[t.enrich.NodeLabelsField] = labels
+		}
+	}
+	if t.enrich.DeviceLabelsField != "" && t.deviceName != "" {
+		v, ok := find(t.deviceName, original)
+		name, isString := v.(string)
+		if !ok || !isString || name == "" {
+			klog.V(4).Infof("device name is not found in payload")
+			return
+		}
+		labels, err := t.labels.DeviceLabels(t.namespace, name)
+		if err != nil {
+			klog.V(4).Infof("failed to get labels of device %s/%s: %v", t.namespace, name, err)
+		} else {
+			out[t.enrich.DeviceLabelsField] = labels
+		}
+	}
+}
+
+// find returns the first value selected by path
+func find(path jsonPath, obj map[string]interface{}) (interface{}, bool) {
+	parsed, err := path.parse()
+	if err != nil {
+		return nil, false
+	}
+	results, err := parsed.FindResults(obj)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return nil, false
+	}
+	v := results[0][0]
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, true
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, false
+	}
+	return v.Interface(), true
+}
+
+func (c condition) match(obj map[string]interface{}) bool {
+	v, found := find(c.path, obj)
+	switch c.operator {
+	case routerv1.FilterOpExists:
+		return found
+	case routerv1.FilterOpDoesNotExist:
+		return !found
+	}
+	if !found {
+		return false
+	}
+	switch c.operator {
+	case routerv1.FilterOpEquals:
+		return toString(v) == c.value
+	case routerv1.FilterOpNotEquals:
+		return toString(v) != c.value
+	case routerv1.FilterOpGreaterThan, routerv1.FilterOpLessThan:
+		n, ok := toNumber(v)
+		if !ok {
+			return false
+		}
+		if c.operator == routerv1.FilterOpGreaterThan {
+			return n > c.number
+		}
+		return n < c.number
+	case routerv1.FilterOpMatches:
+		return c.regexp.MatchString(toString(v))
+	}
+	return false
+}
+
+func toString(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	}
+}
+
+// toNumber converts a value to a number, the twin values reported by
+// mappers are strings so numeric strings are accepted as well
+func toNumber(v interface{}) (float64, bool) {
+	switch value := v.(type) {
+	case float64:
+		return value, true
+	case string:
+		n, err := strconv.ParseFloat(value, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,170 @@
+package transform
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	routerv1 "github.com/kubeedge/api/apis/rules/v1"
+)
+
+type fakeLabelGetter struct {
+	nodes   map[string]map[string]string
+	devices map[string]map[string]string
+}
+
+func (g *fakeLabelGetter) NodeLabels(name string) (map[string]string, error) {
+	labels, ok := g.nodes[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return labels, nil
+}
+
+func (g *fakeLabelGetter) DeviceLabels(namespace, name string) (map[string]string, error) {
+	labels, ok := g.devices[namespace+"/"+name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return labels, nil
+}
+
+const payload = `{"deviceName":"thermometer","twin":{"temperature":{"actual":{"value":"35.5"}}},"status":"online"}`
+
+func TestNewInvalid(t *testing.T) {
+	cases := map[string]*routerv1.Transform{
+		"empty path": {
+			Filter: []routerv1.FilterCondition{{Operator: routerv1.FilterOpExists}},
+		},
+		"invalid path": {
+			Filter: []routerv1.FilterCondition{{Path: "{.a", Operator: routerv1.FilterOpExists}},
+		},
+		"unsupported operator": {
+			Filter: []routerv1.FilterCondition{{Path: "{.a}", Operator: "Contains"}},
+		},
+		"not a number": {
+			Filter: []routerv1.FilterCondition{{Path: "{.a}", Operator: routerv1.FilterOpGreaterThan, Value: "x"}},
+		},
+		"invalid regular expression": {
+			Filter: []routerv1.FilterCondition{{Path: "{.a}", Operator: routerv1.FilterOpMatches, Value: "("}},
+		},
+		"invalid select": {
+			Select: map[string]string{"a": "{.a"},
+		},
+		"invalid device name path": {
+			Enrich: &routerv1.Enrichment{DeviceNamePath: "{.a"},
+		},
+	}
+	for name, spec := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New("default", spec, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition routerv1.FilterCondition
+		keep      bool
+	}{
+		{"exists", routerv1.FilterCondition{Path: "{.status}", Operator: routerv1.FilterOpExists}, true},
+		{"exists missing", routerv1.FilterCondition{Path: "{.missing}", Operator: routerv1.FilterOpExists}, false},
+		{"does not exist", routerv1.FilterCondition{Path: "{.missing}", Operator: routerv1.FilterOpDoesNotExist}, true},
+		{"equals", routerv1.FilterCondition{Path: "{.status}", Operator: routerv1.FilterOpEquals, Value: "online"}, true},
+		{"not equals", routerv1.FilterCondition{Path: "{.status}", Operator: routerv1.FilterOpNotEquals, Value: "online"}, false},
+		{"greater than", routerv1.FilterCondition{Path: "{.twin.temperature.actual.value}", Operator: routerv1.FilterOpGreaterThan, Value: "30"}, true},
+		{"less than", routerv1.FilterCondition{Path: "{.twin.temperature.actual.value}", Operator: routerv1.FilterOpLessThan, Value: "30"}, false},
+		{"matches", routerv1.FilterCondition{Path: "{.deviceName}", Operator: routerv1.FilterOpMatches, Value: "^thermo"}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tf, err := New("default", &routerv1.Transform{Filter: []routerv1.FilterCondition{tc.condition}}, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			out, keep, err := tf.Apply([]byte(payload), "edge-node")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.keep, keep)
+			if tc.keep {
+				assert.JSONEq(t, payload, string(out))
+			}
+		})
+	}
+}
+
+func TestSelectRenameEnrich(t *testing.T) {
+	labels := &fakeLabelGetter{
+		nodes:   map[string]map[string]string{"edge-node": {"zone": "a"}},
+		devices: map[string]map[string]string{"default/thermometer": {"model": "t1"}},
+	}
+	tf, err := New("default", &routerv1.Transform{
+		Select: map[string]string{
+			"temperature": "{.twin.temperature.actual.value}",
+			"missing":     "{.missing}",
+		},
+		Rename: map[string]string{"temperature": "temp"},
+		Enrich: &routerv1.Enrichment{
+			Static:            map[string]string{"site": "factory-1"},
+			NodeLabelsField:   "nodeLabels",
+			DeviceNamePath:    "{.deviceName}",
+			DeviceLabelsField: "deviceLabels",
+		},
+	}, labels)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	out, keep, err := tf.Apply([]byte(payload), "edge-node")
+	assert.NoError(t, err)
+	assert.True(t, keep)
+	expected, _ := json.Marshal(map[string]interface{}{
+		"temp":         "35.5",
+		"site":         "factory-1",
+		"nodeLabels":   map[string]string{"zone": "a"},
+		"deviceLabels": map[string]string{"model": "t1"},
+	})
+	assert.JSONEq(t, string(expected), string(out))
+
+	// labels of unknown node and device are skipped
+	out, _, err = tf.Apply([]byte(`{"deviceName":"unknown"}`), "unknown")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"site":"factory-1"}`, string(out))
+}
+
+func TestApplyInvalidPayload(t *testing.T) {
+	tf, err := New("default", &routerv1.Transform{}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, _, err = tf.Apply([]byte("not json"), "edge-node")
+	assert.Error(t, err)
+}
+
+func TestApplyConcurrently(t *testing.T) {
+	// the range expression changes the state of the jsonpath.JSONPath
+	tr, err := New("default", &routerv1.Transform{
+		Filter: []routerv1.FilterCondition{{Path: "{.status}", Operator: routerv1.FilterOpEquals, Value: "online"}},
+		Select: map[string]string{"first": "{range .readings[*]}{.value}{end}"},
+	}, nil)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				out, keep, err := tr.Apply([]byte(`{"status":"online","readings":[{"value":1},{"value":2}]}`), "")
+				assert.NoError(t, err)
+				assert.True(t, keep)
+				assert.JSONEq(t, `{"first":1}`, string(out))
+			}
+		}()
+	}
+	wg.Wait()
+}
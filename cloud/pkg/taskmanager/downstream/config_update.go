@@ -73,7 +73,13 @@ func (h *ConfigUpdateJobHandler) CanDownstreamPhase(obj any) bool {
 	}
 	// TODO: retry execution is not supported due to some reasons.
 	// To support retry execution, the cloud edge needs to consider many situations.
-	return job.Status.Phase == operationsv1alpha2.JobPhaseInit
+	if job.Status.Phase == operationsv1alpha2.JobPhaseInit {
+		return true
+	}
+	// The node tasks waiting for the maintenance window are performed when the window opens.
+	return job.Status.Phase == operationsv1alpha2.JobPhaseInProgress &&
+		job.Spec.MaintenanceWindow != nil &&
+		hasExecutableTasks(wrap.NewConfigUpdateJob(job))
 }
 
 func (h *ConfigUpdateJobHandler) ExecutorChan() chan wrap.NodeJob {
@@ -30,6 +30,7 @@ import (
 )
 
 func TestConfigUpdateJobCanDownstreamPhase(t *testing.T) {
+	window := &operationsv1alpha2.MaintenanceWindow{Schedules: []string{"0 22 * * *"}, DurationSeconds: 3600}
 	cases := []struct {
 		name string
 		obj  any
@@ -58,6 +59,37 @@ func TestConfigUpdateJobCanDownstreamPhase(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "the node tasks waiting for the window are released",
+			obj: &operationsv1alpha2.ConfigUpdateJob{
+				Spec: operationsv1alpha2.ConfigUpdateJobSpec{
+					MaintenanceWindow: window,
+				},
+				Status: operationsv1alpha2.ConfigUpdateJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ConfigUpdateJobNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseInProgress},
+						{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "the node tasks are waiting for the window",
+			obj: &operationsv1alpha2.ConfigUpdateJob{
+				Spec: operationsv1alpha2.ConfigUpdateJobSpec{
+					MaintenanceWindow: window,
+				},
+				Status: operationsv1alpha2.ConfigUpdateJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ConfigUpdateJobNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseWaitingWindow},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, c := range cases {
@@ -78,6 +78,12 @@ func Init(ctx context.Context) error {
 		return fmt.Errorf("failed to create configupdate job downstream handler, err: %v", err)
 	}
 	downstreamHandlers[operationsv1alpha2.ResourceConfigUpdateJob] = configUpdateJobHandler
+
+	commandJobHandler, err := newCommandJobHandler(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create command job downstream handler, err: %v", err)
+	}
+	downstreamHandlers[operationsv1alpha2.ResourceCommandJob] = commandJobHandler
 	return nil
 }
 
@@ -91,6 +97,8 @@ func MustGetHandlerWithObj(obj any) (DownstreamHandler, error) {
 		downstreamHandler = downstreamHandlers[operationsv1alpha2.ResourceImagePrePullJob]
 	case *operationsv1alpha2.ConfigUpdateJob:
 		downstreamHandler = downstreamHandlers[operationsv1alpha2.ResourceConfigUpdateJob]
+	case *operationsv1alpha2.CommandJob:
+		downstreamHandler = downstreamHandlers[operationsv1alpha2.ResourceCommandJob]
 	default:
 		return nil, fmt.Errorf("invalid node job type %T", obj)
 	}
@@ -136,3 +144,13 @@ func watchJobDownstream(ctx context.Context, handler DownstreamHandler) {
 		}
 	}
 }
+
+// hasExecutableTasks returns whether the node job has any node task that can be executed.
+func hasExecutableTasks(job wrap.NodeJob) bool {
+	for _, task := range job.Tasks() {
+		if task.CanExecute() {
+			return true
+		}
+	}
+	return false
+}
@@ -73,7 +73,15 @@ func (h *ImagePrePullJobHandler) CanDownstreamPhase(obj any) bool {
 	}
 	// TODO: retry execution is not supported due to some reasons.
 	// To support retry execution, the cloud edge needs to consider many situations.
-	return job.Status.Phase == operationsv1alpha2.JobPhaseInit
+	if job.Status.Phase == operationsv1alpha2.JobPhaseInit {
+		return true
+	}
+	// The node tasks waiting for the maintenance window are performed when the window opens,
+	// and the node tasks waiting for the elected nodes of their NodeGroups are performed when released.
+	return job.Status.Phase == operationsv1alpha2.JobPhaseInProgress &&
+		(job.Spec.ImagePrePullTemplate.MaintenanceWindow != nil ||
+			job.Spec.ImagePrePullTemplate.PullMode == operationsv1alpha2.ImagePullModePeer) &&
+		hasExecutableTasks(wrap.NewImagePrepullJob(job))
 }
 
 func (h *ImagePrePullJobHandler) ExecutorChan() chan wrap.NodeJob {
@@ -28,6 +28,7 @@ import (
 )
 
 func TestImagePrePullJobCanDownstreamPhase(t *testing.T) {
+	window := &operationsv1alpha2.MaintenanceWindow{Schedules: []string{"0 22 * * *"}, DurationSeconds: 3600}
 	cases := []struct {
 		name string
 		obj  any
@@ -56,6 +57,94 @@ func TestImagePrePullJobCanDownstreamPhase(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "the node tasks waiting for the window are released",
+			obj: &operationsv1alpha2.ImagePrePullJob{
+				Spec: operationsv1alpha2.ImagePrePullJobSpec{
+					ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+						MaintenanceWindow: window,
+					},
+				},
+				Status: operationsv1alpha2.ImagePrePullJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseInProgress},
+						{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "the node tasks are waiting for the window",
+			obj: &operationsv1alpha2.ImagePrePullJob{
+				Spec: operationsv1alpha2.ImagePrePullJobSpec{
+					ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+						MaintenanceWindow: window,
+					},
+				},
+				Status: operationsv1alpha2.ImagePrePullJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseWaitingWindow},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "the node tasks waiting for the elected node are released",
+			obj: &operationsv1alpha2.ImagePrePullJob{
+				Spec: operationsv1alpha2.ImagePrePullJobSpec{
+					ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+						PullMode: operationsv1alpha2.ImagePullModePeer,
+					},
+				},
+				Status: operationsv1alpha2.ImagePrePullJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+						{
+							NodeName:   "node1",
+							Phase:      operationsv1alpha2.NodeTaskPhaseSuccessful,
+							PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+						},
+						{
+							NodeName:   "node2",
+							Phase:      operationsv1alpha2.NodeTaskPhasePending,
+							PullSource: operationsv1alpha2.ImagePullSourcePeer,
+							PeerNode:   "node1",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "the node tasks are waiting for the elected node",
+			obj: &operationsv1alpha2.ImagePrePullJob{
+				Spec: operationsv1alpha2.ImagePrePullJobSpec{
+					ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+						PullMode: operationsv1alpha2.ImagePullModePeer,
+					},
+				},
+				Status: operationsv1alpha2.ImagePrePullJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+						{
+							NodeName:   "node1",
+							Phase:      operationsv1alpha2.NodeTaskPhaseInProgress,
+							PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+						},
+						{
+							NodeName: "node2",
+							Phase:    operationsv1alpha2.NodeTaskPhasePending,
+							PeerNode: "node1",
+						},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, c := range cases {
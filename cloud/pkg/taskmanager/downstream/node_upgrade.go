@@ -73,7 +73,14 @@ func (h *NodeUpgradeJobHandler) CanDownstreamPhase(obj any) bool {
 	}
 	// TODO: retry execution is not supported due to some reasons.
 	// To support retry execution, the cloud edge needs to consider many situations.
-	return job.Status.Phase == operationsv1alpha2.JobPhaseInit
+	if job.Status.Phase == operationsv1alpha2.JobPhaseInit {
+		return true
+	}
+	// The node tasks of a rollout wave are performed when the wave starts, and the node tasks
+	// waiting for the maintenance window are performed when the window opens.
+	return job.Status.Phase == operationsv1alpha2.JobPhaseInProgress &&
+		(job.Status.Rollout != nil || job.Spec.MaintenanceWindow != nil) &&
+		hasExecutableTasks(wrap.NewNodeUpgradeJob(job))
 }
 
 func (h *NodeUpgradeJobHandler) ExecutorChan() chan wrap.NodeJob {
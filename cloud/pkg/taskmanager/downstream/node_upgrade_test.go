@@ -21,6 +21,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
 	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
@@ -56,6 +57,45 @@ func TestNodeUpgradeJobCanDownstreamPhase(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "rollout wave started",
+			obj: &operationsv1alpha2.NodeUpgradeJob{
+				Status: operationsv1alpha2.NodeUpgradeJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseSuccessful},
+						{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending},
+					},
+					Rollout: &operationsv1alpha2.NodeUpgradeJobRolloutStatus{
+						CurrentWave: 1,
+						Waves: []operationsv1alpha2.NodeUpgradeJobWaveStatus{
+							{Name: "canary", NodeNames: []string{"node1"}, StartTime: &metav1.Time{}},
+							{Name: "rest", NodeNames: []string{"node2"}, StartTime: &metav1.Time{}},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "rollout wave not started",
+			obj: &operationsv1alpha2.NodeUpgradeJob{
+				Status: operationsv1alpha2.NodeUpgradeJobStatus{
+					Phase: operationsv1alpha2.JobPhaseInProgress,
+					NodeStatus: []operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
+						{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhaseSuccessful},
+						{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending},
+					},
+					Rollout: &operationsv1alpha2.NodeUpgradeJobRolloutStatus{
+						Waves: []operationsv1alpha2.NodeUpgradeJobWaveStatus{
+							{Name: "canary", NodeNames: []string{"node1"}, StartTime: &metav1.Time{}},
+							{Name: "rest", NodeNames: []string{"node2"}},
+						},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, c := range cases {
@@ -169,8 +169,12 @@ func (executor *NodeTaskExecutor) executeTask(_ctx context.Context, task wrap.No
 	if err != nil {
 		return fmt.Errorf("failed to get node task action, err: %v", err)
 	}
+	spec := executor.job.Spec()
+	if ts, ok := executor.job.(wrap.NodeJobTaskSpec); ok {
+		spec = ts.TaskSpec(task)
+	}
 	msg := messagelayer.BuildNodeTaskRouter(msgres, action.Name).
-		FillBody(executor.job.Spec())
+		FillBody(spec)
 	if err := executor.messageLayer.Send(*msg); err != nil {
 		return fmt.Errorf("failed to send message to edge, err: %v", err)
 	}
@@ -60,12 +60,20 @@ func tryUpdateImagePrePullJobStatus(ctx context.Context, cli crdcliset.Interface
 	}
 
 	nodeStatus.Phase = opts.Phase
+	nodeStatus.Reason = opts.Reason
 	if opts.ExtendInfo != "" {
 		imageStatus, err := taskmsg.ParseImagePrePullJobExtend(opts.ExtendInfo)
 		if err != nil {
 			return fmt.Errorf("failed to parse image prepull job extend, err: %v", err)
 		}
 		nodeStatus.ImageStatus = imageStatus
+		// The edge node pulls the images from the upstream registries if the elected node cannot serve them.
+		for _, it := range imageStatus {
+			if it.PullSource == operationsv1alpha2.ImagePullSourceRegistry {
+				nodeStatus.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+				break
+			}
+		}
 	}
 
 	_, err = cli.OperationsV1alpha2().ImagePrePullJobs().UpdateStatus(ctx, job, metav1.UpdateOptions{})
@@ -39,6 +39,12 @@ func TestTryUpdateImagePrePullJobStatus(t *testing.T) {
 					NodeName: "node1",
 					Phase:    operationsv1alpha2.NodeTaskPhasePending,
 				},
+				{
+					NodeName:   "node2",
+					Phase:      operationsv1alpha2.NodeTaskPhaseInProgress,
+					PullSource: operationsv1alpha2.ImagePullSourcePeer,
+					PeerNode:   "node1",
+				},
 			},
 		},
 	})
@@ -55,10 +61,10 @@ func TestTryUpdateImagePrePullJobStatus(t *testing.T) {
 	t.Run("unable to match node task", func(t *testing.T) {
 		err := tryUpdateImagePrePullJobStatus(ctx, cli, TryUpdateStatusOptions{
 			JobName:  "test-job1",
-			NodeName: "node2",
+			NodeName: "node3",
 			Phase:    operationsv1alpha2.NodeTaskPhaseInProgress,
 		})
-		require.ErrorContains(t, err, "unable to match node task, invalid node name 'node2'")
+		require.ErrorContains(t, err, "unable to match node task, invalid node name 'node3'")
 	})
 
 	t.Run("invalid action status type", func(t *testing.T) {
@@ -99,4 +105,17 @@ func TestTryUpdateImagePrePullJobStatus(t *testing.T) {
 		require.Equal(t, operationsv1alpha2.ImagePrePullJobActionPull, actionStatus.Action)
 		require.Equal(t, metav1.ConditionTrue, actionStatus.Status)
 	})
+	t.Run("the images fall back to the upstream registries", func(t *testing.T) {
+		err := tryUpdateImagePrePullJobStatus(ctx, cli, TryUpdateStatusOptions{
+			JobName:    "test-job1",
+			NodeName:   "node2",
+			Phase:      operationsv1alpha2.NodeTaskPhaseSuccessful,
+			ExtendInfo: `[{"image":"nginx:latest","status":"True","pullSource":"Registry"}]`,
+		})
+		require.NoError(t, err)
+		job, err := cli.OperationsV1alpha2().ImagePrePullJobs().
+			Get(ctx, "test-job1", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, operationsv1alpha2.ImagePullSourceRegistry, job.Status.NodeStatus[1].PullSource)
+	})
 }
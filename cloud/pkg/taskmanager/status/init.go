@@ -26,6 +26,8 @@ var (
 	nodeUpgradeJobStatusUpdater *StatusUpdater
 
 	configUpdateJobStatusUpdater *StatusUpdater
+
+	commandJobStatusUpdater *StatusUpdater
 )
 
 func Init(ctx context.Context) {
@@ -37,6 +39,9 @@ func Init(ctx context.Context) {
 
 	configUpdateJobStatusUpdater = NewStatusUpdater(ctx, tryUpdateConfigUpdateJobStatus)
 	go configUpdateJobStatusUpdater.WatchUpdateChannel()
+
+	commandJobStatusUpdater = NewStatusUpdater(ctx, tryUpdateCommandJobStatus)
+	go commandJobStatusUpdater.WatchUpdateChannel()
 }
 
 func GetImagePrePullJobStatusUpdater() *StatusUpdater {
@@ -50,3 +55,7 @@ func GetNodeUpgradeJobStatusUpdater() *StatusUpdater {
 func GetConfigeUpdateJobStatusUpdater() *StatusUpdater {
 	return configUpdateJobStatusUpdater
 }
+
+func GetCommandJobStatusUpdater() *StatusUpdater {
+	return commandJobStatusUpdater
+}
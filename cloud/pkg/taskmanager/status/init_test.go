@@ -34,4 +34,5 @@ func TestInit(t *testing.T) {
 	Init(context.Background())
 	assert.NotNil(t, GetImagePrePullJobStatusUpdater())
 	assert.NotNil(t, GetNodeUpgradeJobStatusUpdater())
+	assert.NotNil(t, GetCommandJobStatusUpdater())
 }
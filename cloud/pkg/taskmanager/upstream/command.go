@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	crdcliset "github.com/kubeedge/api/client/clientset/versioned"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/status"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
+)
+
+type CommandJobHandler struct {
+	logger logr.Logger
+	crdcli crdcliset.Interface
+}
+
+// Check that CommandJobHandler implements UpstreamHandler interface.
+var _ UpstreamHandler = (*CommandJobHandler)(nil)
+
+// newCommandJobHandler creates a new CommandJobHandler.
+func newCommandJobHandler(ctx context.Context) *CommandJobHandler {
+	logger := klog.FromContext(ctx).
+		WithName(fmt.Sprintf("upstream-%s", operationsv1alpha2.ResourceCommandJob))
+	return &CommandJobHandler{
+		logger: logger,
+		crdcli: client.GetCRDClient(),
+	}
+}
+
+func (h *CommandJobHandler) Logger() logr.Logger {
+	return h.logger
+}
+
+func (CommandJobHandler) GetAction(name string) *actionflow.Action {
+	return actionflow.FlowCommandJob.Find(name)
+}
+
+func (h *CommandJobHandler) UpdateNodeTaskStatus(
+	jobName, nodeName string,
+	isFinalAction bool,
+	upmsg taskmsg.UpstreamMessage,
+) error {
+	var (
+		actionStatus operationsv1alpha2.CommandJobActionStatus
+		err          error
+		wg           sync.WaitGroup
+	)
+
+	actionStatus.Action = operationsv1alpha2.CommandJobAction(upmsg.Action)
+	if upmsg.Succ {
+		actionStatus.Status = metav1.ConditionTrue
+	} else {
+		actionStatus.Status = metav1.ConditionFalse
+		actionStatus.Reason = upmsg.Reason
+	}
+	actionStatus.Time = upmsg.FinishTime
+
+	phase := operationsv1alpha2.NodeTaskPhaseInProgress
+	var reason string
+	if isFinalAction {
+		if upmsg.Succ {
+			phase = operationsv1alpha2.NodeTaskPhaseSuccessful
+		} else {
+			phase = operationsv1alpha2.NodeTaskPhaseFailure
+			reason = upmsg.Reason
+		}
+	}
+
+	wg.Add(1)
+	opts := status.UpdateStatusOptions{
+		TryUpdateStatusOptions: status.TryUpdateStatusOptions{
+			JobName:      jobName,
+			NodeName:     nodeName,
+			Phase:        phase,
+			Reason:       reason,
+			ExtendInfo:   upmsg.Extend,
+			ActionStatus: &actionStatus,
+		},
+		Callback: func(e error) {
+			if e != nil {
+				err = fmt.Errorf("failed to update command job status, err: %v", e)
+			}
+			wg.Done()
+		},
+	}
+	// Waiting for the maintenance window is not an action of the action flow.
+	if upmsg.Action == taskmsg.ActionWaitingWindow {
+		opts.Phase = operationsv1alpha2.NodeTaskPhaseWaitingWindow
+		opts.Reason = upmsg.Reason
+		opts.ActionStatus = nil
+	}
+	status.GetCommandJobStatusUpdater().UpdateStatus(opts)
+	wg.Wait()
+	return err
+}
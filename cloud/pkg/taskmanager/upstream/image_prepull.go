@@ -104,6 +104,12 @@ func (h *ImagePrePullJobHandler) UpdateNodeTaskStatus(
 			wg.Done()
 		},
 	}
+	// Waiting for the maintenance window is not an action of the action flow.
+	if upmsg.Action == taskmsg.ActionWaitingWindow {
+		opts.Phase = operationsv1alpha2.NodeTaskPhaseWaitingWindow
+		opts.Reason = upmsg.Reason
+		opts.ActionStatus = nil
+	}
 	status.GetImagePrePullJobStatusUpdater().UpdateStatus(opts)
 	wg.Wait()
 	return err
@@ -51,6 +51,7 @@ func Init(ctx context.Context) {
 	upstreamHandlers[operationsv1alpha2.ResourceNodeUpgradeJob] = newNodeUpgradeJobHandler(ctx)
 	upstreamHandlers[operationsv1alpha2.ResourceImagePrePullJob] = newImagePrePullJobHandler(ctx)
 	upstreamHandlers[operationsv1alpha2.ResourceConfigUpdateJob] = newConfigUpdateJobHandler(ctx)
+	upstreamHandlers[operationsv1alpha2.ResourceCommandJob] = newCommandJobHandler(ctx)
 }
 
 // Start starts the upstream handler.
@@ -112,6 +113,14 @@ func handleUpstreamMessage(
 	upmsg taskmsg.UpstreamMessage,
 	res taskmsg.Resource,
 ) error {
+	// The node task is waiting for the maintenance window, it is still running on the edge node.
+	if upmsg.Action == taskmsg.ActionWaitingWindow {
+		if err := handler.UpdateNodeTaskStatus(res.JobName, res.NodeName, false, upmsg); err != nil {
+			return fmt.Errorf("failed to update node task status, err: %v", err)
+		}
+		return nil
+	}
+
 	action := handler.GetAction(upmsg.Action)
 	if action == nil {
 		return fmt.Errorf("invalid %s action %s", res.ResourceType, upmsg.Action)
@@ -128,6 +128,17 @@ func TestHandleUpstreamMessage(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, releaseExecutorCalled)
 	})
+
+	t.Run("waiting for the maintenance window", func(t *testing.T) {
+		releaseExecutorCalled = false
+		upmsg := taskmsg.UpstreamMessage{
+			Action: taskmsg.ActionWaitingWindow,
+			Reason: "waiting for the maintenance window",
+		}
+		err := handleUpstreamMessage(handler, upmsg, res)
+		require.NoError(t, err)
+		assert.False(t, releaseExecutorCalled)
+	})
 }
 
 func TestReleaseExecutorConcurrent(t *testing.T) {
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrap
+
+import (
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
+)
+
+type CommandJobTask struct {
+	Obj *operationsv1alpha2.CommandJobNodeTaskStatus
+}
+
+// Check that CommandJobTask implements the NodeJobTask interface
+var _ NodeJobTask = (*CommandJobTask)(nil)
+
+func (task *CommandJobTask) NodeName() string {
+	return task.Obj.NodeName
+}
+
+func (task *CommandJobTask) CanExecute() bool {
+	// The commands may not be idempotent, so the node tasks in the "InProgress" status
+	// are never executed again.
+	return task.Obj.Phase == operationsv1alpha2.NodeTaskPhasePending
+}
+
+func (task *CommandJobTask) Phase() operationsv1alpha2.NodeTaskPhase {
+	return task.Obj.Phase
+}
+
+func (task *CommandJobTask) SetPhase(phase operationsv1alpha2.NodeTaskPhase, reason ...string) {
+	task.Obj.Phase = phase
+	if len(reason) > 0 {
+		task.Obj.Reason = reason[0]
+	}
+}
+
+func (task *CommandJobTask) Action() (*actionflow.Action, error) {
+	return actionflow.FlowCommandJob.First, nil
+}
+
+func (task *CommandJobTask) GetObject() any {
+	return task.Obj
+}
+
+type CommandJob struct {
+	Obj *operationsv1alpha2.CommandJob
+}
+
+// Check that CommandJob implements the NodeJob interface
+var _ NodeJob = (*CommandJob)(nil)
+
+func NewCommandJob(obj *operationsv1alpha2.CommandJob) *CommandJob {
+	return &CommandJob{Obj: obj}
+}
+
+func (job CommandJob) Name() string {
+	return job.Obj.Name
+}
+
+func (job CommandJob) ResourceType() string {
+	return operationsv1alpha2.ResourceCommandJob
+}
+
+func (job CommandJob) Concurrency() int {
+	return int(job.Obj.Spec.Concurrency)
+}
+
+func (job CommandJob) Spec() any {
+	return job.Obj.Spec
+}
+
+func (job CommandJob) Tasks() []NodeJobTask {
+	res := make([]NodeJobTask, 0, len(job.Obj.Status.NodeStatus))
+	for i := range job.Obj.Status.NodeStatus {
+		pitem := &job.Obj.Status.NodeStatus[i]
+		res = append(res, &CommandJobTask{Obj: pitem})
+	}
+	return res
+}
+
+func (job CommandJob) GetObject() any {
+	return job.Obj
+}
@@ -17,12 +17,21 @@ limitations under the License.
 package wrap
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
 	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
 )
 
 type ImagePrePullJobTask struct {
 	Obj *operationsv1alpha2.ImagePrePullNodeTaskStatus
+	// Waiting indicates whether the node task waits for the elected node of its NodeGroup
+	// to pull the images in the Peer pull mode.
+	Waiting bool
 }
 
 // Check whether ImagePrePullJobTask implements the NodeJobTask interface
@@ -34,7 +43,7 @@ func (task ImagePrePullJobTask) NodeName() string {
 
 func (task ImagePrePullJobTask) CanExecute() bool {
 	// TODO: Consider whether the node tasks in the "InProgress" status should be execute again?
-	return task.Obj.Phase == operationsv1alpha2.NodeTaskPhasePending
+	return !task.Waiting && task.Obj.Phase == operationsv1alpha2.NodeTaskPhasePending
 }
 
 func (task ImagePrePullJobTask) Phase() operationsv1alpha2.NodeTaskPhase {
@@ -60,8 +69,11 @@ type ImagePrePullJob struct {
 	Obj *operationsv1alpha2.ImagePrePullJob
 }
 
-// Check whether ImagePrePullJob implements the NodeJob interface
-var _ NodeJob = (*ImagePrePullJob)(nil)
+// Check whether ImagePrePullJob implements the NodeJob and NodeJobTaskSpec interfaces
+var (
+	_ NodeJob         = (*ImagePrePullJob)(nil)
+	_ NodeJobTaskSpec = (*ImagePrePullJob)(nil)
+)
 
 func NewImagePrepullJob(obj *operationsv1alpha2.ImagePrePullJob) *ImagePrePullJob {
 	return &ImagePrePullJob{Obj: obj}
@@ -83,11 +95,49 @@ func (job ImagePrePullJob) Spec() any {
 	return job.Obj.Spec
 }
 
+// TaskSpec returns the spec of the node task with its pull source. In the Peer pull mode,
+// the elected nodes serve the images for their peers, and the peers pull the images from them.
+// If the CA key of CloudCore is not loaded, the tokens of the image mirrors cannot be derived,
+// so all edge nodes pull the images from the upstream registries.
+func (job ImagePrePullJob) TaskSpec(task NodeJobTask) any {
+	spec := taskmsg.ImagePrePullJobTaskSpec{ImagePrePullJobSpec: job.Obj.Spec}
+	if job.Obj.Spec.ImagePrePullTemplate.PullMode != operationsv1alpha2.ImagePullModePeer ||
+		len(hubconfig.Config.CaKey) == 0 {
+		return spec
+	}
+	taskStatus, ok := task.GetObject().(*operationsv1alpha2.ImagePrePullNodeTaskStatus)
+	if !ok {
+		return spec
+	}
+	for _, peer := range job.Obj.Status.Peers {
+		switch {
+		case peer.NodeName == taskStatus.NodeName:
+			spec.ServePeers = true
+			spec.PeerToken = job.peerToken(peer.NodeName)
+		case peer.NodeName == taskStatus.PeerNode &&
+			taskStatus.PullSource == operationsv1alpha2.ImagePullSourcePeer:
+			spec.PeerEndpoint = peer.Endpoint
+			spec.PeerToken = job.peerToken(peer.NodeName)
+		}
+	}
+	return spec
+}
+
+// peerToken returns the token that authenticates the peers to the image mirror of the elected node.
+// It is derived from the CA key of CloudCore, so that all CloudCore instances derive the same token
+// without storing it in the job, and the token is different for each job and elected node.
+func (job ImagePrePullJob) peerToken(nodeName string) string {
+	mac := hmac.New(sha256.New, hubconfig.Config.CaKey)
+	mac.Write([]byte("image-mirror/" + string(job.Obj.UID) + "/" + nodeName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func (job ImagePrePullJob) Tasks() []NodeJobTask {
+	peerMode := job.Obj.Spec.ImagePrePullTemplate.PullMode == operationsv1alpha2.ImagePullModePeer
 	res := make([]NodeJobTask, 0, len(job.Obj.Status.NodeStatus))
 	for i := range job.Obj.Status.NodeStatus {
 		pitem := &job.Obj.Status.NodeStatus[i]
-		res = append(res, &ImagePrePullJobTask{Obj: pitem})
+		res = append(res, &ImagePrePullJobTask{Obj: pitem, Waiting: peerMode && pitem.PullSource == ""})
 	}
 	return res
 }
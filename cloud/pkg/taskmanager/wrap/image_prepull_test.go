@@ -24,7 +24,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
 )
 
 func TestImagePrePullJob(t *testing.T) {
@@ -86,3 +88,86 @@ func TestImagePrePullJob(t *testing.T) {
 	tasks[1].SetPhase(operationsv1alpha2.NodeTaskPhaseSuccessful)
 	assert.Equal(t, operationsv1alpha2.NodeTaskPhaseSuccessful, tasks[1].Phase())
 }
+
+func TestImagePrePullJobPeerMode(t *testing.T) {
+	hubconfig.Config.CaKey = []byte("test-ca-key")
+	defer func() { hubconfig.Config.CaKey = nil }()
+
+	obj := &operationsv1alpha2.ImagePrePullJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-job",
+			UID:  "test-uid",
+		},
+		Spec: operationsv1alpha2.ImagePrePullJobSpec{
+			ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+				Images:   []string{"nginx:1.25"},
+				PullMode: operationsv1alpha2.ImagePullModePeer,
+			},
+		},
+		Status: operationsv1alpha2.ImagePrePullJobStatus{
+			Phase: operationsv1alpha2.JobPhaseInProgress,
+			NodeStatus: []operationsv1alpha2.ImagePrePullNodeTaskStatus{
+				{ // the elected node
+					NodeName:   "node1",
+					Phase:      operationsv1alpha2.NodeTaskPhasePending,
+					PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+				},
+				{ // waiting for the elected node
+					NodeName: "node2",
+					Phase:    operationsv1alpha2.NodeTaskPhasePending,
+					PeerNode: "node1",
+				},
+				{ // released to pull the images from the elected node
+					NodeName:   "node3",
+					Phase:      operationsv1alpha2.NodeTaskPhasePending,
+					PullSource: operationsv1alpha2.ImagePullSourcePeer,
+					PeerNode:   "node1",
+				},
+				{ // the elected node failed
+					NodeName:   "node4",
+					Phase:      operationsv1alpha2.NodeTaskPhasePending,
+					PullSource: operationsv1alpha2.ImagePullSourceRegistry,
+					PeerNode:   "node1",
+				},
+			},
+			Peers: []operationsv1alpha2.ImagePrePullPeer{
+				{NodeGroup: "group1", NodeName: "node1", Endpoint: "192.168.1.1:10552"},
+			},
+		},
+	}
+	job := ImagePrePullJob{Obj: obj}
+
+	tasks := job.Tasks()
+	assert.Len(t, tasks, 4)
+	assert.True(t, tasks[0].CanExecute())
+	assert.False(t, tasks[1].CanExecute())
+	assert.True(t, tasks[2].CanExecute())
+	assert.True(t, tasks[3].CanExecute())
+
+	token := job.peerToken("node1")
+	assert.Len(t, token, 64)
+	assert.Equal(t, taskmsg.ImagePrePullJobTaskSpec{
+		ImagePrePullJobSpec: obj.Spec,
+		ServePeers:          true,
+		PeerToken:           token,
+	}, job.TaskSpec(tasks[0]))
+	assert.Equal(t, taskmsg.ImagePrePullJobTaskSpec{
+		ImagePrePullJobSpec: obj.Spec,
+		PeerEndpoint:        "192.168.1.1:10552",
+		PeerToken:           token,
+	}, job.TaskSpec(tasks[2]))
+	assert.Equal(t, taskmsg.ImagePrePullJobTaskSpec{
+		ImagePrePullJobSpec: obj.Spec,
+	}, job.TaskSpec(tasks[3]))
+
+	// The token is different for each job.
+	other := ImagePrePullJob{Obj: obj.DeepCopy()}
+	other.Obj.UID = "other-uid"
+	assert.NotEqual(t, token, other.peerToken("node1"))
+
+	// All edge nodes pull the images from the upstream registries without the CA key.
+	hubconfig.Config.CaKey = nil
+	assert.Equal(t, taskmsg.ImagePrePullJobTaskSpec{
+		ImagePrePullJobSpec: obj.Spec,
+	}, job.TaskSpec(tasks[0]))
+}
@@ -54,6 +54,13 @@ type NodeJobTask interface {
 	GetObject() any
 }
 
+// NodeJobTaskSpec is implemented by the node jobs whose node tasks have their own specs.
+// The spec of the node task is sent to the edge node instead of the spec of the node job.
+type NodeJobTaskSpec interface {
+	// TaskSpec returns the spec of the node task.
+	TaskSpec(task NodeJobTask) any
+}
+
 // WithEventObj returns the node job wrap based on the event object.
 func WithEventObj(obj any) (NodeJob, error) {
 	switch obj := obj.(type) {
@@ -63,6 +70,8 @@ func WithEventObj(obj any) (NodeJob, error) {
 		return NewImagePrepullJob(obj), nil
 	case *operationsv1alpha2.ConfigUpdateJob:
 		return NewConfigUpdateJob(obj), nil
+	case *operationsv1alpha2.CommandJob:
+		return NewCommandJob(obj), nil
 	default:
 		return nil, fmt.Errorf("invalid event object type %T", obj)
 	}
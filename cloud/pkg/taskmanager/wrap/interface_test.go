@@ -41,6 +41,11 @@ func TestWithEventObj(t *testing.T) {
 			input:    &operationsv1alpha2.ImagePrePullJob{},
 			wantType: &ImagePrePullJob{},
 		},
+		{
+			name:     "input CommandJob",
+			input:    &operationsv1alpha2.CommandJob{},
+			wantType: &CommandJob{},
+		},
 		{
 			name:     "invalid input type",
 			input:    "",
@@ -23,6 +23,8 @@ import (
 
 type NodeUpgradeJobTask struct {
 	Obj *operationsv1alpha2.NodeUpgradeJobNodeTaskStatus
+	// Waiting indicates whether the rollout wave of the node task has not started yet.
+	Waiting bool
 }
 
 // Check whether NodeUpgradeJobTask implements the NodeJobTask interface
@@ -34,7 +36,7 @@ func (task NodeUpgradeJobTask) NodeName() string {
 
 func (task NodeUpgradeJobTask) CanExecute() bool {
 	// TODO: Consider whether the node tasks in the "InProgress" status should be execute again?
-	return task.Obj.Phase == operationsv1alpha2.NodeTaskPhasePending
+	return !task.Waiting && task.Obj.Phase == operationsv1alpha2.NodeTaskPhasePending
 }
 
 func (task NodeUpgradeJobTask) Phase() operationsv1alpha2.NodeTaskPhase {
@@ -84,10 +86,22 @@ func (job NodeUpgradeJob) Spec() any {
 }
 
 func (job NodeUpgradeJob) Tasks() []NodeJobTask {
+	// The nodes of the rollout waves that have not started need to wait.
+	waiting := make(map[string]bool)
+	if rollout := job.Obj.Status.Rollout; rollout != nil {
+		for _, wave := range rollout.Waves {
+			if wave.StartTime != nil {
+				continue
+			}
+			for _, name := range wave.NodeNames {
+				waiting[name] = true
+			}
+		}
+	}
 	res := make([]NodeJobTask, 0, len(job.Obj.Status.NodeStatus))
 	for i := range job.Obj.Status.NodeStatus {
 		pitem := &job.Obj.Status.NodeStatus[i]
-		res = append(res, &NodeUpgradeJobTask{Obj: pitem})
+		res = append(res, &NodeUpgradeJobTask{Obj: pitem, Waiting: waiting[pitem.NodeName]})
 	}
 	return res
 }
@@ -84,3 +84,26 @@ func TestNodeUpgradeJob(t *testing.T) {
 	tasks[1].SetPhase(operationsv1alpha2.NodeTaskPhaseSuccessful)
 	assert.Equal(t, operationsv1alpha2.NodeTaskPhaseSuccessful, tasks[1].Phase())
 }
+
+func TestNodeUpgradeJobRolloutTasks(t *testing.T) {
+	obj := &operationsv1alpha2.NodeUpgradeJob{
+		Status: operationsv1alpha2.NodeUpgradeJobStatus{
+			Phase: operationsv1alpha2.JobPhaseInit,
+			NodeStatus: []operationsv1alpha2.NodeUpgradeJobNodeTaskStatus{
+				{NodeName: "node1", Phase: operationsv1alpha2.NodeTaskPhasePending},
+				{NodeName: "node2", Phase: operationsv1alpha2.NodeTaskPhasePending},
+			},
+			Rollout: &operationsv1alpha2.NodeUpgradeJobRolloutStatus{
+				Waves: []operationsv1alpha2.NodeUpgradeJobWaveStatus{
+					{Name: "canary", NodeNames: []string{"node1"}, StartTime: &metav1.Time{Time: time.Now()}},
+					{Name: "rest", NodeNames: []string{"node2"}},
+				},
+			},
+		},
+	}
+
+	tasks := NewNodeUpgradeJob(obj).Tasks()
+	assert.Len(t, tasks, 2)
+	assert.True(t, tasks[0].CanExecute())
+	assert.False(t, tasks[1].CanExecute())
+}
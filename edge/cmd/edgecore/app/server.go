@@ -200,9 +200,13 @@ func environmentCheck(skipCheck bool) error {
 
 // registerModules register all the modules started in edgecore
 func registerModules(c *v1alpha2.EdgeCoreConfig) {
+	if c.DataBase.DriverName == v1alpha2.DataBaseDriverKV {
+		dao.InitKV(c.DataBase.KVDataSource)
+	}
 	dao.Init(
 		c.DataBase.DataSource,
 		c.Modules.DeviceTwin,
+		c.Modules.EdgeHub,
 		c.Modules.EventBus,
 		c.Modules.MetaManager,
 		c.Modules.ServiceBus,
@@ -215,7 +219,7 @@ func registerModules(c *v1alpha2.EdgeCoreConfig) {
 	metamanager.Register(c.Modules.MetaManager)
 	servicebus.Register(c.Modules.ServiceBus, buildServiceBusTLSOptions(c.Modules.ServiceBus))
 	edgestream.Register(c.Modules.EdgeStream, c.Modules.Edged.HostnameOverride, c.Modules.Edged.NodeIP)
-	taskmanager.Register(c.Modules.TaskManager)
+	taskmanager.Register(c.Modules.TaskManager, c.Modules.Edged.NodeIP)
 	test.Register(c.Modules.DBTest)
 }
 
@@ -122,6 +122,7 @@ func run(config *hollowEdgeNodeConfig) {
 
 	dao.Init(
 		c.DataBase.DataSource,
+		c.Modules.EdgeHub,
 		c.Modules.MetaManager,
 	)
 	// start all modules
@@ -68,6 +68,17 @@ func (dmiCache *DMICache) GetMapper(name string) (*pb.MapperInfo, bool) {
 	return mapper, exists
 }
 
+// Mappers returns all the mappers in the cache
+func (dmiCache *DMICache) Mappers() []*pb.MapperInfo {
+	dmiCache.mapperMu.RLock()
+	defer dmiCache.mapperMu.RUnlock()
+	mappers := make([]*pb.MapperInfo, 0, len(dmiCache.mapperList))
+	for _, mapper := range dmiCache.mapperList {
+		mappers = append(mappers, mapper)
+	}
+	return mappers
+}
+
 // RemoveMapper removes a mapper from the cache
 func (dmiCache *DMICache) RemoveMapper(name string) {
 	dmiCache.mapperMu.Lock()
@@ -134,6 +145,22 @@ func (dmiCache *DMICache) GetOverriddenDevice(namespace, name string) (*v1beta1.
 	return deviceCopy, deviceModel, nil
 }
 
+// HasDeviceProperty returns whether the device is in the cache and has the property
+func (dmiCache *DMICache) HasDeviceProperty(namespace, name, property string) bool {
+	dmiCache.deviceMu.RLock()
+	defer dmiCache.deviceMu.RUnlock()
+	device, exists := dmiCache.deviceList[util.GetResourceID(namespace, name)]
+	if !exists {
+		return false
+	}
+	for _, p := range device.Spec.Properties {
+		if p.Name == property {
+			return true
+		}
+	}
+	return false
+}
+
 func (dmiCache *DMICache) DeviceIds() []string {
 	dmiCache.deviceMu.RLock()
 	defer dmiCache.deviceMu.RUnlock()
@@ -144,6 +171,20 @@ func (dmiCache *DMICache) DeviceIds() []string {
 	return deviceIDs
 }
 
+// DeviceIdsByProtocol returns the sorted ids of the devices which are managed by the mapper of the protocol
+func (dmiCache *DMICache) DeviceIdsByProtocol(protocol string) []string {
+	dmiCache.deviceMu.RLock()
+	defer dmiCache.deviceMu.RUnlock()
+	var deviceIDs []string
+	for deviceID, device := range dmiCache.deviceList {
+		if device.Spec.Protocol.ProtocolName == protocol {
+			deviceIDs = append(deviceIDs, deviceID)
+		}
+	}
+	sort.Strings(deviceIDs)
+	return deviceIDs
+}
+
 // RemoveDevice removes a device from the cache
 func (dmiCache *DMICache) RemoveDevice(namespace, name string) {
 	dmiCache.deviceMu.Lock()
@@ -171,6 +171,18 @@ func TestDMICache_Mapper_Operations(t *testing.T) {
 		assert.Equal(t, "v2.0.0", retrieved.Version)
 		assert.Equal(t, []byte("tcp://localhost:1503"), retrieved.Address)
 	})
+
+	t.Run("Mappers", func(t *testing.T) {
+		cache := NewDMICache()
+		assert.Empty(t, cache.Mappers())
+
+		mapper1 := &pb.MapperInfo{Name: "test-mapper-1", Protocol: "modbus"}
+		mapper2 := &pb.MapperInfo{Name: "test-mapper-2", Protocol: "opcua"}
+		cache.PutMapper(mapper1)
+		cache.PutMapper(mapper2)
+
+		assert.ElementsMatch(t, []*pb.MapperInfo{mapper1, mapper2}, cache.Mappers())
+	})
 }
 
 func TestDMICache_DeviceModel_Operations(t *testing.T) {
@@ -614,6 +626,24 @@ func TestDMICache_Device_Operations(t *testing.T) {
 		}
 	})
 
+	t.Run("DeviceIdsByProtocol", func(t *testing.T) {
+		cache := NewDMICache()
+		for _, device := range []*v1beta1.Device{
+			{ObjectMeta: metav1.ObjectMeta{Name: "sensor-002", Namespace: "default"},
+				Spec: v1beta1.DeviceSpec{Protocol: v1beta1.ProtocolConfig{ProtocolName: "modbus"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sensor-001", Namespace: "default"},
+				Spec: v1beta1.DeviceSpec{Protocol: v1beta1.ProtocolConfig{ProtocolName: "modbus"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sensor-003", Namespace: "default"},
+				Spec: v1beta1.DeviceSpec{Protocol: v1beta1.ProtocolConfig{ProtocolName: "opcua"}}},
+		} {
+			cache.PutDevice(device)
+		}
+
+		assert.Equal(t, []string{"default/sensor-001", "default/sensor-002"}, cache.DeviceIdsByProtocol("modbus"))
+		assert.Equal(t, []string{"default/sensor-003"}, cache.DeviceIdsByProtocol("opcua"))
+		assert.Empty(t, cache.DeviceIdsByProtocol("bluetooth"))
+	})
+
 	t.Run("RemoveDevice", func(t *testing.T) {
 		cache := NewDMICache()
 
@@ -754,6 +784,21 @@ func TestDMICache_Device_Operations(t *testing.T) {
 		// Remove non-existent device (should not panic)
 		cache.RemoveDevice("default", "non-existent")
 	})
+
+	t.Run("HasDeviceProperty", func(t *testing.T) {
+		cache := NewDMICache()
+		cache.PutDevice(&v1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Name: "sensor-001", Namespace: "default"},
+			Spec: v1beta1.DeviceSpec{
+				Properties: []v1beta1.DeviceProperty{{Name: "temperature"}},
+			},
+		})
+
+		assert.True(t, cache.HasDeviceProperty("default", "sensor-001", "temperature"))
+		assert.False(t, cache.HasDeviceProperty("default", "sensor-001", "humidity"))
+		assert.False(t, cache.HasDeviceProperty("other", "sensor-001", "temperature"))
+		assert.False(t, cache.HasDeviceProperty("default", "non-existent", "temperature"))
+	})
 }
 
 func TestDMICache_DeepCopyCustomizedValue(t *testing.T) {
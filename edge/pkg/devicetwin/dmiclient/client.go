@@ -24,21 +24,30 @@ import (
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
+	dmiv1alpha1 "github.com/kubeedge/api/apis/dmi/v1alpha1"
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
-	deviceconst "github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicompat"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	deviceconst "github.com/kubeedge/kubeedge/pkg/device/constants"
 )
 
 type DMIClient struct {
-	protocol   string
-	socket     string
+	protocol string
+	socket   string
+	// apiVersion is the DMI api version negotiated with the mapper
+	apiVersion string
 	Client     dmiapi.DeviceMapperServiceClient
-	Ctx        context.Context
-	Conn       *grpc.ClientConn
-	CancelFunc context.CancelFunc
+	// V1alpha1Client is used instead of Client if the mapper speaks DMI v1alpha1
+	V1alpha1Client dmiv1alpha1.DeviceMapperServiceClient
+	Ctx            context.Context
+	Conn           *grpc.ClientConn
+	CancelFunc     context.CancelFunc
 }
 
 type DMIClients struct {
@@ -70,6 +79,7 @@ func (dc *DMIClient) connect() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 
 	dc.Client = c
+	dc.V1alpha1Client = dmiv1alpha1.NewDeviceMapperServiceClient(conn)
 	dc.Ctx = ctx
 	dc.Conn = conn
 	dc.CancelFunc = cancel
@@ -85,6 +95,7 @@ func (dc *DMIClient) close() {
 		dc.CancelFunc()
 	}
 	dc.Client = nil
+	dc.V1alpha1Client = nil
 }
 
 func createDeviceRequest(device *v1beta1.Device) (*dmiapi.RegisterDeviceRequest, error) {
@@ -155,24 +166,27 @@ func (dcs *DMIClients) getDMIClientByProtocol(protocol string) (*DMIClient, erro
 	return dc, nil
 }
 
+// The apiVersion is the DMI api version negotiated with the mapper, the DMI messages are translated if it's v1alpha1.
 // If the DMIClient for this protocol does not exist and tryConnect is false, then only the corresponding
 // DMIClient will be created; otherwise, an attempt will be made to connect to the sockPath.
 // If a connection to the given sockPath cannot be established (e.g., the mapper app is not running), it indicates
 // that the mapper corresponding to the sockPath does not need to be re-registered; otherwise, the DMIClient for
 // that protocol will be created normally.
-func (dcs *DMIClients) CreateDMIClient(protocol, sockPath string, tryConnect bool) error {
+func (dcs *DMIClients) CreateDMIClient(protocol, apiVersion, sockPath string, tryConnect bool) error {
 	dc, err := dcs.getDMIClientByProtocol(protocol)
 	if err == nil {
 		dcs.mutex.Lock()
 		dc.protocol = protocol
+		dc.apiVersion = apiVersion
 		dc.socket = sockPath
 		dcs.mutex.Unlock()
 		return nil
 	}
 
 	dc = &DMIClient{
-		protocol: protocol,
-		socket:   sockPath,
+		protocol:   protocol,
+		apiVersion: apiVersion,
+		socket:     sockPath,
 	}
 	if tryConnect {
 		defer dc.close()
@@ -205,6 +219,39 @@ func (dcs *DMIClients) getDMIClientConn(protocol string) (*DMIClient, error) {
 	return dc, nil
 }
 
+// ProbeMapper checks whether the mapper of the protocol is alive with the standard gRPC health service.
+// A mapper which does not implement the health service is alive as long as it answers the call.
+func (dcs *DMIClients) ProbeMapper(protocol string, timeout time.Duration) error {
+	dcs.mutex.Lock()
+	dc, ok := dcs.clients[protocol]
+	if !ok {
+		dcs.mutex.Unlock()
+		return fmt.Errorf("fail to get dmi client of protocol %s", protocol)
+	}
+	// probe with a separate connection so that the probe does not race with the device operations
+	probe := &DMIClient{protocol: dc.protocol, apiVersion: dc.apiVersion, socket: dc.socket}
+	dcs.mutex.Unlock()
+
+	if err := probe.connect(); err != nil {
+		return err
+	}
+	defer probe.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(probe.Conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		return err
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("mapper of protocol %s is %s", protocol, resp.GetStatus())
+	}
+	return nil
+}
+
 func (dcs *DMIClients) RegisterDevice(device *v1beta1.Device) error {
 	protocol := device.Spec.Protocol.ProtocolName
 
@@ -219,6 +266,12 @@ func (dcs *DMIClients) RegisterDevice(device *v1beta1.Device) error {
 	if err != nil {
 		return fmt.Errorf("fail to create createDeviceRequest for device %s with err: %v", device.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.RegisterDevice(dc.Ctx, &dmiv1alpha1.RegisterDeviceRequest{
+			Device: dmicompat.ToV1alpha1Device(cdr.Device),
+		})
+		return err
+	}
 	_, err = dc.Client.RegisterDevice(dc.Ctx, cdr)
 	if err != nil {
 		return err
@@ -240,6 +293,10 @@ func (dcs *DMIClients) RemoveDevice(device *v1beta1.Device) error {
 	if err != nil {
 		return fmt.Errorf("fail to generate RemoveDeviceRequest for device %s with err: %v", device.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.RemoveDevice(dc.Ctx, &dmiv1alpha1.RemoveDeviceRequest{DeviceName: rdr.DeviceName})
+		return err
+	}
 	_, err = dc.Client.RemoveDevice(dc.Ctx, rdr)
 	if err != nil {
 		return err
@@ -261,6 +318,12 @@ func (dcs *DMIClients) UpdateDevice(device *v1beta1.Device) error {
 	if err != nil {
 		return fmt.Errorf("fail to generate UpdateDeviceRequest for device %s with err: %v", device.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.UpdateDevice(dc.Ctx, &dmiv1alpha1.UpdateDeviceRequest{
+			Device: dmicompat.ToV1alpha1Device(udr.Device),
+		})
+		return err
+	}
 	_, err = dc.Client.UpdateDevice(dc.Ctx, udr)
 	if err != nil {
 		return err
@@ -281,6 +344,12 @@ func (dcs *DMIClients) CreateDeviceModel(model *v1beta1.DeviceModel) error {
 	if err != nil {
 		return fmt.Errorf("fail to create RegisterDeviceModelRequest for device model %s with err: %v", model.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.CreateDeviceModel(dc.Ctx, &dmiv1alpha1.CreateDeviceModelRequest{
+			Model: dmicompat.ToV1alpha1DeviceModel(cdmr.Model, protocol),
+		})
+		return err
+	}
 	_, err = dc.Client.CreateDeviceModel(dc.Ctx, cdmr)
 	if err != nil {
 		return err
@@ -301,6 +370,10 @@ func (dcs *DMIClients) RemoveDeviceModel(model *v1beta1.DeviceModel) error {
 	if err != nil {
 		return fmt.Errorf("fail to create RemoveDeviceModelRequest for device model %s with err: %v", model.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.RemoveDeviceModel(dc.Ctx, &dmiv1alpha1.RemoveDeviceModelRequest{ModelName: rdmr.ModelName})
+		return err
+	}
 	_, err = dc.Client.RemoveDeviceModel(dc.Ctx, rdmr)
 	if err != nil {
 		return err
@@ -321,9 +394,40 @@ func (dcs *DMIClients) UpdateDeviceModel(model *v1beta1.DeviceModel) error {
 	if err != nil {
 		return fmt.Errorf("fail to create UpdateDeviceModelRequest for device model %s with err: %v", model.Name, err)
 	}
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		_, err = dc.V1alpha1Client.UpdateDeviceModel(dc.Ctx, &dmiv1alpha1.UpdateDeviceModelRequest{
+			Model: dmicompat.ToV1alpha1DeviceModel(udmr.Model, protocol),
+		})
+		return err
+	}
 	_, err = dc.Client.UpdateDeviceModel(dc.Ctx, udmr)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (dcs *DMIClients) CallDeviceMethod(device *v1beta1.Device, methodName string, inputs map[string]string) (map[string]string, error) {
+	protocol := device.Spec.Protocol.ProtocolName
+	dc, err := dcs.getDMIClientConn(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	defer dc.close()
+
+	if dmicompat.IsV1alpha1(dc.apiVersion) {
+		return nil, fmt.Errorf("device methods are not supported by the mapper of protocol %s speaking DMI %s",
+			protocol, dc.apiVersion)
+	}
+	resp, err := dc.Client.CallDeviceMethod(dc.Ctx, &dmiapi.CallDeviceMethodRequest{
+		DeviceName:      device.Name,
+		DeviceNamespace: device.Namespace,
+		MethodName:      methodName,
+		Inputs:          inputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOutputs(), nil
+}
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
+	dmiv1alpha1 "github.com/kubeedge/api/apis/dmi/v1alpha1"
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
 )
 
@@ -232,7 +233,7 @@ func TestGetDMIClientByProtocol_Found(t *testing.T) {
 
 func TestCreateDMIClient_NewWithoutConnect(t *testing.T) {
 	dcs := freshClients()
-	err := dcs.CreateDMIClient("modbus", "/tmp/fake.sock", false)
+	err := dcs.CreateDMIClient("modbus", "v1beta1", "/tmp/fake.sock", false)
 	assert.NoError(t, err)
 
 	dc, err := dcs.getDMIClientByProtocol("modbus")
@@ -244,7 +245,7 @@ func TestCreateDMIClient_ExistingClientUpdatesSocket(t *testing.T) {
 	dcs := freshClients()
 	dcs.clients["modbus"] = &DMIClient{protocol: "modbus", socket: "/tmp/old.sock"}
 
-	err := dcs.CreateDMIClient("modbus", "/tmp/new.sock", false)
+	err := dcs.CreateDMIClient("modbus", "v1beta1", "/tmp/new.sock", false)
 	assert.NoError(t, err)
 
 	dc, _ := dcs.getDMIClientByProtocol("modbus")
@@ -257,7 +258,7 @@ func TestCreateDMIClient_TryConnectSuccess(t *testing.T) {
 	defer cleanup()
 
 	dcs := freshClients()
-	err := dcs.CreateDMIClient("modbus", sock, true)
+	err := dcs.CreateDMIClient("modbus", "v1beta1", sock, true)
 	assert.NoError(t, err)
 }
 
@@ -268,7 +269,7 @@ func TestCreateDMIClient_TryConnectFail_NoSocket(t *testing.T) {
 	// the test verifies the function returns without panicking.
 	// The real failure path (DialContext with block) is exercised in
 	// TestCreateDMIClient_TryConnectBlockFail below.
-	err := dcs.CreateDMIClient("modbus", "/tmp/nonexistent-dmi.sock", true)
+	err := dcs.CreateDMIClient("modbus", "v1beta1", "/tmp/nonexistent-dmi.sock", true)
 	// May or may not error depending on grpc lazy-dial; just must not panic.
 	_ = err
 }
@@ -285,7 +286,7 @@ func TestCreateDMIClient_ReplacesExistingWithConnectedOne(t *testing.T) {
 	dcs.clients["modbus"] = existingDC
 
 	// CreateDMIClient when client already exists just updates the socket
-	err := dcs.CreateDMIClient("modbus", sock, false)
+	err := dcs.CreateDMIClient("modbus", "v1beta1", sock, false)
 	assert.NoError(t, err)
 }
 
@@ -309,6 +310,21 @@ func TestGetDMIClientConn_Success(t *testing.T) {
 	dc.close()
 }
 
+func TestProbeMapper(t *testing.T) {
+	fake := &fakeMapperServer{}
+	sock, cleanup := startUnixServer(t, fake)
+
+	dcs := freshClients()
+	assert.Error(t, dcs.ProbeMapper("modbus", time.Second))
+
+	dcs.clients["modbus"] = &DMIClient{protocol: "modbus", socket: sock}
+	// the fake mapper does not implement the health service but answers the call
+	assert.NoError(t, dcs.ProbeMapper("modbus", time.Second))
+
+	cleanup()
+	assert.Error(t, dcs.ProbeMapper("modbus", time.Second))
+}
+
 func TestRegisterDevice_Success(t *testing.T) {
 	fake := &fakeMapperServer{}
 	sock, cleanup := startUnixServer(t, fake)
@@ -503,7 +519,7 @@ func TestCreateDMIClient_Concurrent(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			_ = dcs.CreateDMIClient("modbus", sock, false)
+			_ = dcs.CreateDMIClient("modbus", "v1beta1", sock, false)
 		}()
 	}
 	wg.Wait()
@@ -551,3 +567,62 @@ func TestDMIClient_ConnectContextTimeout(t *testing.T) {
 
 	dc.close()
 }
+
+type fakeV1alpha1MapperServer struct {
+	dmiv1alpha1.UnimplementedDeviceMapperServiceServer
+	devices []*dmiv1alpha1.Device
+	models  []*dmiv1alpha1.DeviceModel
+	removed []string
+}
+
+func (f *fakeV1alpha1MapperServer) RegisterDevice(_ context.Context, in *dmiv1alpha1.RegisterDeviceRequest) (*dmiv1alpha1.RegisterDeviceResponse, error) {
+	f.devices = append(f.devices, in.Device)
+	return &dmiv1alpha1.RegisterDeviceResponse{DeviceName: in.Device.Name}, nil
+}
+func (f *fakeV1alpha1MapperServer) RemoveDevice(_ context.Context, in *dmiv1alpha1.RemoveDeviceRequest) (*dmiv1alpha1.RemoveDeviceResponse, error) {
+	f.removed = append(f.removed, in.DeviceName)
+	return &dmiv1alpha1.RemoveDeviceResponse{}, nil
+}
+func (f *fakeV1alpha1MapperServer) CreateDeviceModel(_ context.Context, in *dmiv1alpha1.CreateDeviceModelRequest) (*dmiv1alpha1.CreateDeviceModelResponse, error) {
+	f.models = append(f.models, in.Model)
+	return &dmiv1alpha1.CreateDeviceModelResponse{DeviceModelName: in.Model.Name}, nil
+}
+
+func TestV1alpha1Mapper(t *testing.T) {
+	f, err := os.CreateTemp("", "dmi-v1alpha1-test-*.sock")
+	assert.NoError(t, err)
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+	lis, err := net.Listen("unix", f.Name())
+	assert.NoError(t, err)
+	fake := &fakeV1alpha1MapperServer{}
+	srv := grpc.NewServer()
+	dmiv1alpha1.RegisterDeviceMapperServiceServer(srv, fake)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer func() {
+		srv.Stop()
+		_ = os.Remove(f.Name())
+	}()
+
+	dcs := freshClients()
+	assert.NoError(t, dcs.CreateDMIClient("modbus", "v1alpha1", f.Name(), false))
+
+	assert.NoError(t, dcs.CreateDeviceModel(newDeviceModel("model1", "default", "modbus")))
+	assert.Len(t, fake.models, 1)
+	assert.Equal(t, "model1", fake.models[0].Name)
+	assert.Equal(t, "modbus", fake.models[0].Spec.Protocol)
+
+	assert.NoError(t, dcs.RegisterDevice(newDevice("dev1", "default", "modbus")))
+	assert.Len(t, fake.devices, 1)
+	assert.Equal(t, "dev1", fake.devices[0].Name)
+	assert.Equal(t, "modbus", fake.devices[0].Spec.Protocol.CustomizedProtocol.ProtocolName)
+
+	assert.NoError(t, dcs.RemoveDevice(newDevice("dev1", "default", "modbus")))
+	assert.Equal(t, []string{"dev1"}, fake.removed)
+
+	// the methods of the devices are not a part of DMI v1alpha1
+	_, err = dcs.CallDeviceMethod(newDevice("dev1", "default", "modbus"), "reset", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dmicompat negotiates the DMI api version with the mappers and translates the DMI messages
+// between v1beta1 and v1alpha1, so that one edgecore serves the mappers built on both versions.
+package dmicompat
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	alpha "github.com/kubeedge/api/apis/dmi/v1alpha1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+)
+
+const (
+	// APIVersionV1alpha1 is the DMI api defined in dmi/v1alpha1/api.proto
+	APIVersionV1alpha1 = "v1alpha1"
+	// APIVersionV1beta1 is the DMI api defined in dmi/v1beta1/api.proto
+	APIVersionV1beta1 = "v1beta1"
+)
+
+// SupportedAPIVersions are the DMI api versions served by edgecore
+var SupportedAPIVersions = []string{APIVersionV1beta1, APIVersionV1alpha1}
+
+// dmiVersionRegexp matches the DMI api versions, mappers may declare their own version like v1.0.0 instead
+var dmiVersionRegexp = regexp.MustCompile(`^v[0-9]+(alpha|beta)[0-9]+$`)
+
+// NegotiateAPIVersion returns the DMI api version used to talk to a mapper, which is the version of
+// the service called by the mapper to register. A mapper which declares a DMI api version must declare
+// a supported one, and register with the service of that version.
+func NegotiateAPIVersion(declared, called string) (string, error) {
+	if !dmiVersionRegexp.MatchString(declared) {
+		return called, nil
+	}
+	supported := false
+	for _, version := range SupportedAPIVersions {
+		if declared == version {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", fmt.Errorf("unsupported DMI api version %s, supported versions are %s",
+			declared, strings.Join(SupportedAPIVersions, ", "))
+	}
+	if declared != called {
+		return "", fmt.Errorf("mapper declares DMI api version %s but registers with DMI api version %s", declared, called)
+	}
+	return declared, nil
+}
+
+// IsV1alpha1 returns true if the mapper speaks DMI v1alpha1, the mappers which registered before
+// the negotiation was introduced speak v1beta1
+func IsV1alpha1(apiVersion string) bool {
+	return apiVersion == APIVersionV1alpha1
+}
+
+// ToV1alpha1Device converts a v1beta1 device to v1alpha1, the desired values of the properties
+// are carried by the twins of the device status in v1alpha1
+func ToV1alpha1Device(device *pb.Device) *alpha.Device {
+	if device == nil {
+		return nil
+	}
+	out := &alpha.Device{Name: device.GetName()}
+	spec := device.GetSpec()
+	if spec == nil {
+		return out
+	}
+
+	out.Spec = &alpha.DeviceSpec{DeviceModelReference: spec.GetDeviceModelReference()}
+	if protocol := spec.GetProtocol(); protocol != nil {
+		out.Spec.Protocol = &alpha.ProtocolConfig{
+			CustomizedProtocol: &alpha.ProtocolConfigCustomized{
+				ProtocolName: protocol.GetProtocolName(),
+				ConfigData:   toV1alpha1CustomizedValue(protocol.GetConfigData()),
+			},
+		}
+	}
+	var twins []*alpha.Twin
+	for _, property := range spec.GetProperties() {
+		visitor := &alpha.DevicePropertyVisitor{
+			PropertyName: property.GetName(),
+			ReportCycle:  property.GetReportCycle(),
+			CollectCycle: property.GetCollectCycle(),
+		}
+		if visitors := property.GetVisitors(); visitors != nil {
+			visitor.CustomizedProtocol = &alpha.VisitorConfigCustomized{
+				ProtocolName: visitors.GetProtocolName(),
+				ConfigData:   toV1alpha1CustomizedValue(visitors.GetConfigData()),
+			}
+		}
+		out.Spec.PropertyVisitors = append(out.Spec.PropertyVisitors, visitor)
+
+		if desired := property.GetDesired(); desired != nil {
+			twins = append(twins, &alpha.Twin{
+				PropertyName: property.GetName(),
+				Desired:      &alpha.TwinProperty{Value: desired.GetValue(), Metadata: desired.GetMetadata()},
+			})
+		}
+	}
+	if len(twins) > 0 {
+		out.Status = &alpha.DeviceStatus{Twins: twins}
+	}
+	return out
+}
+
+func toV1alpha1CustomizedValue(value *pb.CustomizedValue) *alpha.CustomizedValue {
+	if value == nil {
+		return nil
+	}
+	return &alpha.CustomizedValue{Data: value.GetData()}
+}
+
+// ToV1alpha1DeviceModel converts a v1beta1 device model to v1alpha1, the protocol is not a part of
+// the v1beta1 device model so it's given by the caller
+func ToV1alpha1DeviceModel(model *pb.DeviceModel, protocol string) *alpha.DeviceModel {
+	if model == nil {
+		return nil
+	}
+	out := &alpha.DeviceModel{
+		Name: model.GetName(),
+		Spec: &alpha.DeviceModelSpec{Protocol: protocol},
+	}
+	for _, property := range model.GetSpec().GetProperties() {
+		out.Spec.Properties = append(out.Spec.Properties, &alpha.DeviceProperty{
+			Name:        property.GetName(),
+			Description: property.GetDescription(),
+			Type:        toV1alpha1PropertyType(property),
+		})
+	}
+	for _, command := range model.GetSpec().GetCommands() {
+		out.Spec.Commands = append(out.Spec.Commands, &alpha.DeviceCommand{
+			Name:       command.GetName(),
+			Url:        command.GetUrl(),
+			Method:     command.GetMethod(),
+			StatusCode: command.GetStatusCode(),
+			Parameters: command.GetParameters(),
+			Response:   command.GetResponse(),
+		})
+	}
+	return out
+}
+
+// toV1alpha1PropertyType converts the type of a property, v1alpha1 has no unbounded values so
+// a bound which is not set or invalid is converted to zero
+func toV1alpha1PropertyType(property *pb.ModelProperty) *alpha.PropertyType {
+	accessMode := property.GetAccessMode()
+	switch v1beta1.PropertyType(strings.ToUpper(property.GetType())) {
+	case v1beta1.INT:
+		minimum, _ := strconv.ParseInt(property.GetMinimum(), 10, 64)
+		maximum, _ := strconv.ParseInt(property.GetMaximum(), 10, 64)
+		return &alpha.PropertyType{Int: &alpha.PropertyTypeInt64{
+			AccessMode: accessMode, Minimum: minimum, Maximum: maximum, Unit: property.GetUnit(),
+		}}
+	case v1beta1.FLOAT:
+		minimum, _ := strconv.ParseFloat(property.GetMinimum(), 32)
+		maximum, _ := strconv.ParseFloat(property.GetMaximum(), 32)
+		return &alpha.PropertyType{Float: &alpha.PropertyTypeFloat{
+			AccessMode: accessMode, Minimum: float32(minimum), Maximum: float32(maximum), Unit: property.GetUnit(),
+		}}
+	case v1beta1.DOUBLE:
+		minimum, _ := strconv.ParseFloat(property.GetMinimum(), 64)
+		maximum, _ := strconv.ParseFloat(property.GetMaximum(), 64)
+		return &alpha.PropertyType{Double: &alpha.PropertyTypeDouble{
+			AccessMode: accessMode, Minimum: minimum, Maximum: maximum, Unit: property.GetUnit(),
+		}}
+	case v1beta1.BOOLEAN:
+		return &alpha.PropertyType{Boolean: &alpha.PropertyTypeBoolean{AccessMode: accessMode}}
+	case v1beta1.BYTES:
+		return &alpha.PropertyType{Bytes: &alpha.PropertyTypeBytes{AccessMode: accessMode}}
+	default:
+		// the values of the properties are strings in the twins
+		return &alpha.PropertyType{String_: &alpha.PropertyTypeString{AccessMode: accessMode}}
+	}
+}
+
+// FromV1alpha1MapperInfo converts the information of a v1alpha1 mapper to v1beta1
+func FromV1alpha1MapperInfo(mapper *alpha.MapperInfo) *pb.MapperInfo {
+	if mapper == nil {
+		return nil
+	}
+	return &pb.MapperInfo{
+		Name:       mapper.GetName(),
+		Version:    mapper.GetVersion(),
+		ApiVersion: mapper.GetApiVersion(),
+		Protocol:   mapper.GetProtocol(),
+		Address:    mapper.GetAddress(),
+		State:      mapper.GetState(),
+	}
+}
+
+// FromV1alpha1Twins converts the twins reported by a v1alpha1 mapper to v1beta1,
+// the desired value observed by the mapper is the desired value of the v1alpha1 twin
+func FromV1alpha1Twins(twins []*alpha.Twin) []*pb.Twin {
+	var out []*pb.Twin
+	for _, twin := range twins {
+		out = append(out, &pb.Twin{
+			PropertyName:    twin.GetPropertyName(),
+			ObservedDesired: fromV1alpha1TwinProperty(twin.GetDesired()),
+			Reported:        fromV1alpha1TwinProperty(twin.GetReported()),
+		})
+	}
+	return out
+}
+
+func fromV1alpha1TwinProperty(property *alpha.TwinProperty) *pb.TwinProperty {
+	if property == nil {
+		return &pb.TwinProperty{}
+	}
+	return &pb.TwinProperty{Value: property.GetValue(), Metadata: property.GetMetadata()}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmicompat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	alpha "github.com/kubeedge/api/apis/dmi/v1alpha1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+)
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	cases := []struct {
+		name     string
+		declared string
+		called   string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "case1 mapper version is not a DMI version",
+			declared: "v1.0.0",
+			called:   APIVersionV1alpha1,
+			expected: APIVersionV1alpha1,
+		},
+		{
+			name:     "case2 no declared version",
+			called:   APIVersionV1beta1,
+			expected: APIVersionV1beta1,
+		},
+		{
+			name:     "case3 declared version matches",
+			declared: APIVersionV1alpha1,
+			called:   APIVersionV1alpha1,
+			expected: APIVersionV1alpha1,
+		},
+		{
+			name:     "case4 unsupported version",
+			declared: "v1beta2",
+			called:   APIVersionV1beta1,
+			wantErr:  true,
+		},
+		{
+			name:     "case5 declared version does not match the service",
+			declared: APIVersionV1alpha1,
+			called:   APIVersionV1beta1,
+			wantErr:  true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			version, err := NegotiateAPIVersion(c.declared, c.called)
+			if c.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, version)
+		})
+	}
+}
+
+func TestToV1alpha1Device(t *testing.T) {
+	assert.Nil(t, ToV1alpha1Device(nil))
+
+	configData := &pb.CustomizedValue{Data: map[string]*anypb.Any{"slaveID": {}}}
+	device := &pb.Device{
+		Name:      "plc-1",
+		Namespace: "default",
+		Spec: &pb.DeviceSpec{
+			DeviceModelReference: "plc-model",
+			Protocol:             &pb.ProtocolConfig{ProtocolName: "modbus", ConfigData: configData},
+			Properties: []*pb.DeviceProperty{
+				{
+					Name:         "setpoint",
+					Desired:      &pb.TwinProperty{Value: "20", Metadata: map[string]string{"type": "int"}},
+					Visitors:     &pb.VisitorConfig{ProtocolName: "modbus", ConfigData: configData},
+					ReportCycle:  1000,
+					CollectCycle: 500,
+				},
+				{Name: "temperature"},
+			},
+		},
+	}
+
+	out := ToV1alpha1Device(device)
+	assert.Equal(t, "plc-1", out.Name)
+	assert.Equal(t, "plc-model", out.Spec.DeviceModelReference)
+	assert.Equal(t, "modbus", out.Spec.Protocol.CustomizedProtocol.ProtocolName)
+	assert.Equal(t, configData.Data, out.Spec.Protocol.CustomizedProtocol.ConfigData.Data)
+	assert.Len(t, out.Spec.PropertyVisitors, 2)
+	assert.Equal(t, "setpoint", out.Spec.PropertyVisitors[0].PropertyName)
+	assert.Equal(t, int64(1000), out.Spec.PropertyVisitors[0].ReportCycle)
+	assert.Equal(t, int64(500), out.Spec.PropertyVisitors[0].CollectCycle)
+	assert.Equal(t, "modbus", out.Spec.PropertyVisitors[0].CustomizedProtocol.ProtocolName)
+	assert.Nil(t, out.Spec.PropertyVisitors[1].CustomizedProtocol)
+	// only the properties with a desired value have a twin
+	assert.Len(t, out.Status.Twins, 1)
+	assert.Equal(t, "setpoint", out.Status.Twins[0].PropertyName)
+	assert.Equal(t, "20", out.Status.Twins[0].Desired.Value)
+	assert.Equal(t, "int", out.Status.Twins[0].Desired.Metadata["type"])
+}
+
+func TestToV1alpha1DeviceModel(t *testing.T) {
+	assert.Nil(t, ToV1alpha1DeviceModel(nil, "modbus"))
+
+	model := &pb.DeviceModel{
+		Name: "plc-model",
+		Spec: &pb.DeviceModelSpec{
+			Properties: []*pb.ModelProperty{
+				{Name: "setpoint", Type: "INT", AccessMode: "ReadWrite", Minimum: "0", Maximum: "100", Unit: "C"},
+				{Name: "ratio", Type: "float", AccessMode: "ReadOnly", Minimum: "0.5"},
+				{Name: "pressure", Type: "DOUBLE", Maximum: "1e3"},
+				{Name: "enabled", Type: "BOOLEAN", AccessMode: "ReadWrite"},
+				{Name: "raw", Type: "BYTES"},
+				{Name: "label", Type: "STRING", AccessMode: "ReadOnly"},
+			},
+			Commands: []*pb.DeviceCommand{{Name: "reset", Url: "/reset", Method: "POST"}},
+		},
+	}
+
+	out := ToV1alpha1DeviceModel(model, "modbus")
+	assert.Equal(t, "plc-model", out.Name)
+	assert.Equal(t, "modbus", out.Spec.Protocol)
+	assert.Len(t, out.Spec.Properties, 6)
+	assert.Equal(t, &alpha.PropertyTypeInt64{AccessMode: "ReadWrite", Minimum: 0, Maximum: 100, Unit: "C"},
+		out.Spec.Properties[0].Type.Int)
+	assert.Equal(t, float32(0.5), out.Spec.Properties[1].Type.Float.Minimum)
+	assert.Equal(t, float64(1000), out.Spec.Properties[2].Type.Double.Maximum)
+	assert.Equal(t, "ReadWrite", out.Spec.Properties[3].Type.Boolean.AccessMode)
+	assert.NotNil(t, out.Spec.Properties[4].Type.Bytes)
+	assert.Equal(t, "ReadOnly", out.Spec.Properties[5].Type.String_.AccessMode)
+	assert.Len(t, out.Spec.Commands, 1)
+	assert.Equal(t, "/reset", out.Spec.Commands[0].Url)
+}
+
+func TestFromV1alpha1(t *testing.T) {
+	mapper := FromV1alpha1MapperInfo(&alpha.MapperInfo{Name: "modbus-mapper", ApiVersion: "v1.0.0",
+		Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus.sock")})
+	assert.Equal(t, &pb.MapperInfo{Name: "modbus-mapper", ApiVersion: "v1.0.0",
+		Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus.sock")}, mapper)
+	assert.Nil(t, FromV1alpha1MapperInfo(nil))
+
+	twins := FromV1alpha1Twins([]*alpha.Twin{
+		{PropertyName: "setpoint", Desired: &alpha.TwinProperty{Value: "20"}, Reported: &alpha.TwinProperty{Value: "19"}},
+		{PropertyName: "temperature", Reported: &alpha.TwinProperty{Value: "25"}},
+	})
+	assert.Len(t, twins, 2)
+	assert.Equal(t, "20", twins[0].ObservedDesired.Value)
+	assert.Equal(t, "19", twins[0].Reported.Value)
+	// the twins without a desired value are still valid in v1beta1
+	assert.NotNil(t, twins[1].ObservedDesired)
+	assert.Equal(t, "25", twins[1].Reported.Value)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dmihistory keeps the recent values of the device properties reported by mappers
+// in memory, so that the applications on the node can query the device history without
+// an external database.
+package dmihistory
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+// Sample is a value of a device property reported at Timestamp, in milliseconds
+type Sample struct {
+	Timestamp int64  `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+// Bucket is the aggregation of the numeric samples in [Start, End), in milliseconds
+type Bucket struct {
+	Start int64   `json:"start"`
+	End   int64   `json:"end"`
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Avg   float64 `json:"avg"`
+}
+
+// minRingCapacity is the capacity of a new ring, which grows up to its size
+const minRingCapacity = 16
+
+// ring is a buffer of up to size samples which overwrites the oldest sample when it's full,
+// it grows with the samples so that the properties reported rarely take little memory
+type ring struct {
+	samples []Sample
+	size    int
+	// next is the index of the oldest sample after the ring is full
+	next int
+}
+
+func (r *ring) add(sample Sample) {
+	if len(r.samples) < r.size {
+		if len(r.samples) == cap(r.samples) {
+			grown := make([]Sample, len(r.samples), min(max(2*cap(r.samples), minRingCapacity), r.size))
+			copy(grown, r.samples)
+			r.samples = grown
+		}
+		r.samples = append(r.samples, sample)
+		return
+	}
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % r.size
+}
+
+// between returns the samples in [start, end] ordered by timestamp
+func (r *ring) between(start, end int64) []Sample {
+	var res []Sample
+	res = filter(res, r.samples[r.next:], start, end)
+	res = filter(res, r.samples[:r.next], start, end)
+	// mappers may report the values of a property out of order
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Timestamp < res[j].Timestamp
+	})
+	return res
+}
+
+func filter(dst, samples []Sample, start, end int64) []Sample {
+	for _, sample := range samples {
+		if sample.Timestamp >= start && sample.Timestamp <= end {
+			dst = append(dst, sample)
+		}
+	}
+	return dst
+}
+
+// History keeps the last size samples of each device property
+type History struct {
+	mu   sync.RWMutex
+	size int
+	// devices maps the device id to the rings of its properties
+	devices map[string]map[string]*ring
+}
+
+// NewHistory returns a History keeping size samples per property, it keeps nothing if size is not positive
+func NewHistory(size int) *History {
+	return &History{
+		size:    size,
+		devices: make(map[string]map[string]*ring),
+	}
+}
+
+// Enabled returns whether the history keeps any sample
+func (h *History) Enabled() bool {
+	return h.size > 0
+}
+
+// Add records the value of the device property
+func (h *History) Add(namespace, name, property, value string, timestamp time.Time) {
+	if !h.Enabled() {
+		return
+	}
+	deviceID := util.GetResourceID(namespace, name)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	properties, ok := h.devices[deviceID]
+	if !ok {
+		properties = make(map[string]*ring)
+		h.devices[deviceID] = properties
+	}
+	r, ok := properties[property]
+	if !ok {
+		r = &ring{size: h.size}
+		properties[property] = r
+	}
+	r.add(Sample{Timestamp: timestamp.UnixMilli(), Value: value})
+}
+
+// Query returns the samples of the device properties in [start, end], all the properties
+// are returned if property is empty
+func (h *History) Query(namespace, name, property string, start, end time.Time) map[string][]Sample {
+	deviceID := util.GetResourceID(namespace, name)
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	res := make(map[string][]Sample)
+	for propertyName, r := range h.devices[deviceID] {
+		if property != "" && propertyName != property {
+			continue
+		}
+		res[propertyName] = r.between(start.UnixMilli(), end.UnixMilli())
+	}
+	return res
+}
+
+// RemoveDevice drops the history of the device
+func (h *History) RemoveDevice(namespace, name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.devices, util.GetResourceID(namespace, name))
+}
+
+// Downsample aggregates the numeric samples into buckets of step starting at start,
+// the samples which are not finite numbers are skipped and the empty buckets are omitted
+func Downsample(samples []Sample, start time.Time, step time.Duration) []Bucket {
+	stepMilli := step.Milliseconds()
+	if stepMilli <= 0 || len(samples) == 0 {
+		return nil
+	}
+	startMilli := start.UnixMilli()
+	if start.IsZero() {
+		startMilli = samples[0].Timestamp
+	}
+
+	var buckets []Bucket
+	var sum float64
+	for _, sample := range samples {
+		value, err := strconv.ParseFloat(sample.Value, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) || sample.Timestamp < startMilli {
+			continue
+		}
+		bucketStart := startMilli + (sample.Timestamp-startMilli)/stepMilli*stepMilli
+		if len(buckets) == 0 || buckets[len(buckets)-1].Start != bucketStart {
+			if len(buckets) != 0 {
+				last := &buckets[len(buckets)-1]
+				last.Avg = sum / float64(last.Count)
+			}
+			buckets = append(buckets, Bucket{Start: bucketStart, End: bucketStart + stepMilli, Min: value, Max: value})
+			sum = 0
+		}
+		last := &buckets[len(buckets)-1]
+		last.Count++
+		last.Min = math.Min(last.Min, value)
+		last.Max = math.Max(last.Max, value)
+		sum += value
+	}
+	if len(buckets) != 0 {
+		last := &buckets[len(buckets)-1]
+		last.Avg = sum / float64(last.Count)
+	}
+	return buckets
+}
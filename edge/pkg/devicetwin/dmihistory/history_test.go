@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmihistory
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	base := time.UnixMilli(1700000000000)
+	at := func(sec int) time.Time {
+		return base.Add(time.Duration(sec) * time.Second)
+	}
+
+	h := NewHistory(3)
+	for i := 0; i < 5; i++ {
+		h.Add("default", "sensor", "temperature", string(rune('0'+i)), at(i))
+	}
+	h.Add("default", "sensor", "humidity", "40", at(1))
+	h.Add("default", "other", "temperature", "99", at(1))
+
+	cases := []struct {
+		name     string
+		property string
+		start    time.Time
+		end      time.Time
+		expected map[string][]Sample
+	}{
+		{
+			name:     "case1 the oldest samples are overwritten",
+			property: "temperature",
+			start:    at(0),
+			end:      at(10),
+			expected: map[string][]Sample{
+				"temperature": {
+					{Timestamp: at(2).UnixMilli(), Value: "2"},
+					{Timestamp: at(3).UnixMilli(), Value: "3"},
+					{Timestamp: at(4).UnixMilli(), Value: "4"},
+				},
+			},
+		},
+		{
+			name:     "case2 time range",
+			property: "temperature",
+			start:    at(3),
+			end:      at(3),
+			expected: map[string][]Sample{
+				"temperature": {{Timestamp: at(3).UnixMilli(), Value: "3"}},
+			},
+		},
+		{
+			name:  "case3 all properties",
+			start: at(1),
+			end:   at(2),
+			expected: map[string][]Sample{
+				"temperature": {{Timestamp: at(2).UnixMilli(), Value: "2"}},
+				"humidity":    {{Timestamp: at(1).UnixMilli(), Value: "40"}},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, h.Query("default", "sensor", c.property, c.start, c.end))
+		})
+	}
+
+	h.RemoveDevice("default", "sensor")
+	assert.Empty(t, h.Query("default", "sensor", "", at(0), at(10)))
+	assert.Len(t, h.Query("default", "other", "", at(0), at(10)), 1)
+}
+
+func TestHistoryDisabled(t *testing.T) {
+	h := NewHistory(0)
+	assert.False(t, h.Enabled())
+	h.Add("default", "sensor", "temperature", "1", time.Now())
+	assert.Empty(t, h.Query("default", "sensor", "", time.Time{}, time.Now()))
+}
+
+func TestRingGrowth(t *testing.T) {
+	r := &ring{size: 40}
+	r.add(Sample{Timestamp: 0, Value: "0"})
+	// a ring takes little memory until it is filled
+	assert.Equal(t, minRingCapacity, cap(r.samples))
+
+	for i := 1; i < 100; i++ {
+		r.add(Sample{Timestamp: int64(i), Value: strconv.Itoa(i)})
+	}
+	assert.Equal(t, 40, cap(r.samples))
+	samples := r.between(0, 100)
+	assert.Len(t, samples, 40)
+	assert.Equal(t, int64(60), samples[0].Timestamp)
+	assert.Equal(t, int64(99), samples[39].Timestamp)
+}
+
+func TestHistoryOutOfOrder(t *testing.T) {
+	h := NewHistory(4)
+	h.Add("default", "sensor", "temperature", "2", time.UnixMilli(2000))
+	h.Add("default", "sensor", "temperature", "1", time.UnixMilli(1000))
+	samples := h.Query("default", "sensor", "temperature", time.UnixMilli(0), time.UnixMilli(3000))["temperature"]
+	assert.Equal(t, []Sample{{Timestamp: 1000, Value: "1"}, {Timestamp: 2000, Value: "2"}}, samples)
+}
+
+func TestDownsample(t *testing.T) {
+	samples := []Sample{
+		{Timestamp: 1000, Value: "1"},
+		{Timestamp: 1500, Value: "3"},
+		{Timestamp: 1800, Value: "on"},
+		{Timestamp: 3200, Value: "-2"},
+		{Timestamp: 3900, Value: "4"},
+	}
+	cases := []struct {
+		name     string
+		start    time.Time
+		step     time.Duration
+		expected []Bucket
+	}{
+		{
+			name:  "case1 aligned to start",
+			start: time.UnixMilli(1000),
+			step:  time.Second,
+			expected: []Bucket{
+				{Start: 1000, End: 2000, Count: 2, Min: 1, Max: 3, Avg: 2},
+				{Start: 3000, End: 4000, Count: 2, Min: -2, Max: 4, Avg: 1},
+			},
+		},
+		{
+			name: "case2 zero start uses the first sample",
+			step: 5 * time.Second,
+			expected: []Bucket{
+				{Start: 1000, End: 6000, Count: 4, Min: -2, Max: 4, Avg: 1.5},
+			},
+		},
+		{
+			name:  "case3 samples before start are skipped",
+			start: time.UnixMilli(3000),
+			step:  time.Second,
+			expected: []Bucket{
+				{Start: 3000, End: 4000, Count: 2, Min: -2, Max: 4, Avg: 1},
+			},
+		},
+		{
+			name:     "case4 no step",
+			start:    time.UnixMilli(1000),
+			expected: nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, Downsample(samples, c.start, c.step))
+		})
+	}
+}
+
+func TestDownsampleNotFinite(t *testing.T) {
+	samples := []Sample{
+		{Timestamp: 1000, Value: "1"},
+		{Timestamp: 1200, Value: "NaN"},
+		{Timestamp: 1400, Value: "+Inf"},
+		{Timestamp: 1600, Value: "-Inf"},
+		{Timestamp: 1800, Value: "1e400"},
+		{Timestamp: 1900, Value: "3"},
+	}
+	expected := []Bucket{{Start: 1000, End: 2000, Count: 2, Min: 1, Max: 3, Avg: 2}}
+	assert.Equal(t, expected, Downsample(samples, time.UnixMilli(1000), time.Second))
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	klog "k8s.io/klog/v2"
+
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicache"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+const (
+	// MapperConditionTypePrefix is the prefix of the node condition which reports the health of a mapper,
+	// the condition type is the prefix followed by the mapper name
+	MapperConditionTypePrefix = "MapperReady/"
+
+	mapperHealthyReason   = "MapperHealthy"
+	mapperUnhealthyReason = "MapperUnhealthy"
+
+	mapperProbeTimeout = 3 * time.Second
+)
+
+var (
+	// mapperResyncInterval and mapperResyncTimeout bound the wait for a re-registered mapper
+	// to serve its DMI socket before its devices are resynced
+	mapperResyncInterval = time.Second
+	mapperResyncTimeout  = time.Minute
+)
+
+// mapperHealth is the health of a registered mapper
+type mapperHealth struct {
+	healthy            bool
+	failures           int
+	message            string
+	lastTransitionTime metav1.Time
+	// synced is true when the node condition of the mapper reports the current health
+	synced bool
+}
+
+// MapperMonitor probes the registered mappers over the DMI socket, marks the devices of a mapper
+// offline when it stops responding, and reports the health of the mappers as node conditions.
+type MapperMonitor struct {
+	dmiCache         *dmicache.DMICache
+	period           time.Duration
+	failureThreshold int
+
+	mu      sync.Mutex
+	mappers map[string]*mapperHealth
+}
+
+// NewMapperMonitor creates a MapperMonitor, a non-positive period disables the probing
+func NewMapperMonitor(cache *dmicache.DMICache, period time.Duration, failureThreshold int) *MapperMonitor {
+	if failureThreshold <= 0 {
+		failureThreshold = 1
+	}
+	return &MapperMonitor{
+		dmiCache:         cache,
+		period:           period,
+		failureThreshold: failureThreshold,
+		mappers:          make(map[string]*mapperHealth),
+	}
+}
+
+// Run probes the mappers periodically until stop is closed
+func (m *MapperMonitor) Run(stop <-chan struct{}) {
+	if m.period <= 0 {
+		klog.Infof("mapper health probing is disabled")
+		return
+	}
+	wait.Until(m.probeMappers, m.period, stop)
+}
+
+// Registered marks the mapper healthy because it has just registered
+func (m *MapperMonitor) Registered(mapper *pb.MapperInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.health(mapper.Name)
+	h.failures = 0
+	if !h.healthy {
+		h.transition(true, "mapper registered")
+		klog.Infof("mapper %s registered again", mapper.Name)
+	}
+}
+
+func (m *MapperMonitor) probeMappers() {
+	for _, mapper := range m.dmiCache.Mappers() {
+		err := dmiclient.DMIClientsImp.ProbeMapper(mapper.Protocol, mapperProbeTimeout)
+		if m.observe(mapper, err) {
+			markDevicesOffline(m.dmiCache, mapper.Protocol)
+		}
+	}
+	m.syncNodeConditions()
+}
+
+// observe records the result of a probe, it returns true if the mapper has just become unhealthy
+func (m *MapperMonitor) observe(mapper *pb.MapperInfo, err error) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.health(mapper.Name)
+	if err == nil {
+		h.failures = 0
+		if !h.healthy {
+			h.transition(true, "mapper is responding")
+			klog.Infof("mapper %s is healthy again", mapper.Name)
+		}
+		return false
+	}
+
+	h.failures++
+	klog.V(4).Infof("probe mapper %s failed %d times: %v", mapper.Name, h.failures, err)
+	if !h.healthy || h.failures < m.failureThreshold {
+		return false
+	}
+	h.transition(false, fmt.Sprintf("mapper stopped responding: %v", err))
+	klog.Warningf("mapper %s is unhealthy after %d failed probes: %v", mapper.Name, h.failures, err)
+	return true
+}
+
+// health returns the health of the mapper, a mapper which has not been probed yet is healthy
+func (m *MapperMonitor) health(name string) *mapperHealth {
+	h, ok := m.mappers[name]
+	if !ok {
+		h = &mapperHealth{healthy: true, message: "mapper registered", lastTransitionTime: metav1.Now()}
+		m.mappers[name] = h
+	}
+	return h
+}
+
+func (h *mapperHealth) transition(healthy bool, message string) {
+	h.healthy = healthy
+	h.message = message
+	h.lastTransitionTime = metav1.Now()
+	h.synced = false
+}
+
+// syncNodeConditions patches the node conditions of the mappers whose health is not reported yet,
+// the conditions are patched again on the next probe if it fails
+func (m *MapperMonitor) syncNodeConditions() {
+	m.mu.Lock()
+	var conditions []v1.NodeCondition
+	for name, h := range m.mappers {
+		if h.synced {
+			continue
+		}
+		condition := v1.NodeCondition{
+			Type:               v1.NodeConditionType(MapperConditionTypePrefix + name),
+			Status:             v1.ConditionTrue,
+			Reason:             mapperHealthyReason,
+			Message:            h.message,
+			LastHeartbeatTime:  metav1.Now(),
+			LastTransitionTime: h.lastTransitionTime,
+		}
+		if !h.healthy {
+			condition.Status = v1.ConditionFalse
+			condition.Reason = mapperUnhealthyReason
+		}
+		conditions = append(conditions, condition)
+	}
+	m.mu.Unlock()
+	if len(conditions) == 0 {
+		return
+	}
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].Type < conditions[j].Type
+	})
+
+	if err := patchNodeConditions(conditions); err != nil {
+		klog.Warningf("fail to report the health of the mappers in node conditions: %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, condition := range conditions {
+		h, ok := m.mappers[string(condition.Type)[len(MapperConditionTypePrefix):]]
+		// the health may change while patching the node
+		if ok && h.lastTransitionTime.Equal(&condition.LastTransitionTime) {
+			h.synced = true
+		}
+	}
+}
+
+func patchNodeConditions(conditions []v1.NodeCondition) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": conditions,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = metaclient.New().Nodes(models.NullNamespace).Patch(deviceconfig.Get().NodeName, patch)
+	return err
+}
+
+// markDevicesOffline reports the devices of the protocol offline as if the mapper had reported it
+func markDevicesOffline(cache *dmicache.DMICache, protocol string) {
+	for _, deviceID := range cache.DeviceIdsByProtocol(protocol) {
+		ns, name, err := util.GetNamespacedName(deviceID)
+		if err != nil {
+			klog.Errorf("fail to get namespaced name from deviceID %s: %v", deviceID, err)
+			continue
+		}
+		in := &pb.ReportDeviceStatesRequest{
+			DeviceName:      name,
+			DeviceNamespace: ns,
+			State:           dtcommon.DeviceStatusOffline,
+		}
+		msg, err := CreateMessageStateUpdate(in)
+		if err != nil {
+			klog.Errorf("fail to create state message data of device %s with err: %v", deviceID, err)
+			continue
+		}
+		handleDeviceState(in, msg)
+	}
+}
+
+// resyncMapper pushes all the devices and device models of the protocol to the mapper,
+// it's used when a known mapper registers again without asking for the data.
+// The mapper starts serving its DMI socket after the MapperRegister response is received,
+// so the devices are pushed once the mapper answers a probe.
+func resyncMapper(cache *dmicache.DMICache, protocol string) {
+	err := wait.PollUntilContextTimeout(context.Background(), mapperResyncInterval, mapperResyncTimeout, false,
+		func(context.Context) (bool, error) {
+			return dmiclient.DMIClientsImp.ProbeMapper(protocol, mapperProbeTimeout) == nil, nil
+		})
+	if err != nil {
+		klog.Errorf("fail to resync mapper of protocol %s because it is not serving: %v", protocol, err)
+		return
+	}
+
+	syncedModels := make(map[string]bool)
+	for _, deviceID := range cache.DeviceIdsByProtocol(protocol) {
+		ns, name, err := util.GetNamespacedName(deviceID)
+		if err != nil {
+			klog.Errorf("fail to get namespaced name from deviceID %s: %v", deviceID, err)
+			continue
+		}
+		dev, model, err := cache.GetOverriddenDevice(ns, name)
+		if err != nil {
+			klog.Errorf("fail to get overridden device %s from cache: %v", deviceID, err)
+			continue
+		}
+
+		modelID := util.GetResourceID(model.Namespace, model.Name)
+		if !syncedModels[modelID] {
+			if err = dmiclient.DMIClientsImp.CreateDeviceModel(model); err != nil {
+				klog.Errorf("fail to resync device model %s to mapper of protocol %s: %v", modelID, protocol, err)
+				continue
+			}
+			syncedModels[modelID] = true
+		}
+		if err = dmiclient.DMIClientsImp.RegisterDevice(dev); err != nil {
+			klog.Errorf("fail to resync device %s to mapper of protocol %s: %v", deviceID, protocol, err)
+		}
+	}
+}
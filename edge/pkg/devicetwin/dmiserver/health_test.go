@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicache"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
+)
+
+func TestMapperMonitorProbe(t *testing.T) {
+	cache := dmicache.NewDMICache()
+	mapper := &pb.MapperInfo{Name: "modbus-mapper", Protocol: "modbus"}
+	cache.PutMapper(mapper)
+	for _, name := range []string{"plc-1", "plc-2"} {
+		cache.PutDevice(&v1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       v1beta1.DeviceSpec{Protocol: v1beta1.ProtocolConfig{ProtocolName: "modbus"}},
+		})
+	}
+	cache.PutDevice(&v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: "sensor-1", Namespace: "default"},
+		Spec:       v1beta1.DeviceSpec{Protocol: v1beta1.ProtocolConfig{ProtocolName: "opcua"}},
+	})
+
+	var probeErr error
+	var offline []string
+	var patched [][]v1.NodeCondition
+	var patchErr error
+	patches := gomonkey.ApplyMethod(dmiclient.DMIClientsImp, "ProbeMapper",
+		func(_ *dmiclient.DMIClients, _ string, _ time.Duration) error {
+			return probeErr
+		})
+	defer patches.Reset()
+	patches.ApplyFunc(handleDeviceState, func(in *pb.ReportDeviceStatesRequest, _ []byte) {
+		assert.Equal(t, "offline", in.State)
+		offline = append(offline, in.DeviceNamespace+"/"+in.DeviceName)
+	})
+	patches.ApplyFunc(patchNodeConditions, func(conditions []v1.NodeCondition) error {
+		patched = append(patched, conditions)
+		return patchErr
+	})
+
+	monitor := NewMapperMonitor(cache, time.Second, 2)
+
+	// case1 a new mapper is reported healthy once
+	monitor.probeMappers()
+	monitor.probeMappers()
+	assert.Len(t, patched, 1)
+	assert.Equal(t, v1.NodeConditionType("MapperReady/modbus-mapper"), patched[0][0].Type)
+	assert.Equal(t, v1.ConditionTrue, patched[0][0].Status)
+
+	// case2 the devices are marked offline only when the threshold is reached
+	probeErr = errors.New("connection refused")
+	monitor.probeMappers()
+	assert.Empty(t, offline)
+	assert.Len(t, patched, 1)
+	monitor.probeMappers()
+	assert.Equal(t, []string{"default/plc-1", "default/plc-2"}, offline)
+	assert.Len(t, patched, 2)
+	assert.Equal(t, v1.ConditionFalse, patched[1][0].Status)
+	assert.Equal(t, mapperUnhealthyReason, patched[1][0].Reason)
+
+	// case3 the devices are not marked offline again while the mapper stays unhealthy
+	monitor.probeMappers()
+	assert.Len(t, offline, 2)
+	assert.Len(t, patched, 2)
+
+	// case4 the condition is patched again when the patch failed
+	patchErr = errors.New("connection lost")
+	monitor.Registered(mapper)
+	monitor.syncNodeConditions()
+	assert.Len(t, patched, 3)
+	patchErr = nil
+	probeErr = nil
+	monitor.probeMappers()
+	assert.Len(t, patched, 4)
+	assert.Equal(t, v1.ConditionTrue, patched[3][0].Status)
+	assert.Equal(t, "mapper registered", patched[3][0].Message)
+	monitor.probeMappers()
+	assert.Len(t, patched, 4)
+}
+
+func TestResyncMapper(t *testing.T) {
+	cache := dmicache.NewDMICache()
+	cache.PutDeviceModel(&v1beta1.DeviceModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "plc-model", Namespace: "default"},
+		Spec:       v1beta1.DeviceModelSpec{Protocol: "modbus"},
+	})
+	for _, name := range []string{"plc-1", "plc-2", "plc-3"} {
+		device := &v1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1beta1.DeviceSpec{
+				DeviceModelRef: &v1.LocalObjectReference{Name: "plc-model"},
+				Protocol:       v1beta1.ProtocolConfig{ProtocolName: "modbus"},
+			},
+		}
+		if name == "plc-3" {
+			device.Spec.DeviceModelRef.Name = "unknown"
+		}
+		cache.PutDevice(device)
+	}
+
+	var models, devices []string
+	patches := gomonkey.ApplyMethod(dmiclient.DMIClientsImp, "CreateDeviceModel",
+		func(_ *dmiclient.DMIClients, model *v1beta1.DeviceModel) error {
+			models = append(models, model.Name)
+			return nil
+		})
+	defer patches.Reset()
+	patches.ApplyMethod(dmiclient.DMIClientsImp, "RegisterDevice",
+		func(_ *dmiclient.DMIClients, device *v1beta1.Device) error {
+			devices = append(devices, device.Name)
+			return nil
+		})
+
+	// the mapper serves from the second probe
+	probes := 0
+	patches.ApplyMethod(dmiclient.DMIClientsImp, "ProbeMapper",
+		func(_ *dmiclient.DMIClients, _ string, _ time.Duration) error {
+			probes++
+			if probes < 2 {
+				return errors.New("connection refused")
+			}
+			return nil
+		})
+	interval, timeout := mapperResyncInterval, mapperResyncTimeout
+	defer func() {
+		mapperResyncInterval, mapperResyncTimeout = interval, timeout
+	}()
+	mapperResyncInterval, mapperResyncTimeout = 10*time.Millisecond, 100*time.Millisecond
+
+	resyncMapper(cache, "modbus")
+	assert.Equal(t, 2, probes)
+	assert.Equal(t, []string{"plc-model"}, models)
+	assert.Equal(t, []string{"plc-1", "plc-2"}, devices)
+
+	// nothing is pushed to the mapper which never serves
+	models, devices = nil, nil
+	probes = -100
+	resyncMapper(cache, "modbus")
+	assert.Empty(t, models)
+	assert.Empty(t, devices)
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,10 +34,10 @@ import (
 	klog "k8s.io/klog/v2"
 
 	apiconsts "github.com/kubeedge/api/apis/common/constants"
+	dmiv1alpha1 "github.com/kubeedge/api/apis/dmi/v1alpha1"
 	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	beehiveModel "github.com/kubeedge/beehive/pkg/core/model"
-	deviceconst "github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
 	"github.com/kubeedge/kubeedge/common/constants"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
@@ -45,9 +46,12 @@ import (
 	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicache"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicompat"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmihistory"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	deviceconst "github.com/kubeedge/kubeedge/pkg/device/constants"
 	"github.com/kubeedge/kubeedge/pkg/util"
 )
 
@@ -60,10 +64,17 @@ type server struct {
 	pb.UnimplementedDeviceManagerServiceServer
 	limiter  *rate.Limiter
 	dmiCache *dmicache.DMICache
+	history  *dmihistory.History
+	monitor  *MapperMonitor
 }
 
 func (s *server) MapperRegister(_ctx context.Context, in *pb.MapperRegisterRequest,
 ) (*pb.MapperRegisterResponse, error) {
+	return s.registerMapper(in, dmicompat.APIVersionV1beta1)
+}
+
+// registerMapper registers a mapper which called the MapperRegister of the DMI api version called
+func (s *server) registerMapper(in *pb.MapperRegisterRequest, called string) (*pb.MapperRegisterResponse, error) {
 	if !s.limiter.Allow() {
 		return nil, fmt.Errorf("fail to register mapper because of too many request: %s", in.Mapper.Name)
 	}
@@ -73,16 +84,37 @@ func (s *server) MapperRegister(_ctx context.Context, in *pb.MapperRegisterReque
 		return nil, fmt.Errorf("fail to register mapper %s because the protocol is nil", in.Mapper.Name)
 	}
 
+	apiVersion, err := dmicompat.NegotiateAPIVersion(in.Mapper.ApiVersion, called)
+	if err != nil {
+		klog.Errorf("fail to register mapper %s: %v", in.Mapper.Name, err)
+		return nil, err
+	}
+	// save the negotiated version so that edgecore keeps speaking it with the mapper after restart
+	in.Mapper.ApiVersion = apiVersion
+
 	klog.V(4).Infof("receive mapper register: %+v", in.Mapper)
-	err := saveMapper(in.Mapper)
+	err = saveMapper(in.Mapper)
 	if err != nil {
 		klog.Errorf("fail to save mapper %s to db with err: %v", in.Mapper.Name, err)
 		return nil, err
 	}
 
+	_, registered := s.dmiCache.GetMapper(in.Mapper.Name)
 	s.dmiCache.PutMapper(in.Mapper)
 
+	err = dmiclient.DMIClientsImp.CreateDMIClient(in.Mapper.Protocol, apiVersion, string(in.Mapper.Address), false)
+	if err != nil {
+		klog.Warningf("fail to create dmi client for device mapper %s: %v", in.Mapper.Name, err)
+		return nil, err
+	}
+	s.monitor.Registered(in.Mapper)
+
 	if !in.WithData {
+		// the mapper restarted without asking for its data, push the full device set to it
+		// once it serves after receiving the response
+		if registered {
+			go resyncMapper(s.dmiCache, in.Mapper.Protocol)
+		}
 		return &pb.MapperRegisterResponse{}, nil
 	}
 
@@ -121,12 +153,6 @@ func (s *server) MapperRegister(_ctx context.Context, in *pb.MapperRegisterReque
 		deviceModelList = append(deviceModelList, pbModel)
 	}
 
-	err = dmiclient.DMIClientsImp.CreateDMIClient(in.Mapper.Protocol, string(in.Mapper.Address), false)
-	if err != nil {
-		klog.Warningf("fail to create dmi client for device mapper %s: %v", in.Mapper.Name, err)
-		return nil, err
-	}
-
 	return &pb.MapperRegisterResponse{
 		DeviceList: deviceList,
 		ModelList:  deviceModelList,
@@ -147,6 +173,12 @@ func (s *server) ReportDeviceStatus(_ctx context.Context, in *pb.ReportDeviceSta
 				return nil, err
 			}
 			handleDeviceTwin(in, msg)
+			// the history is only kept for the known properties, so that a mapper can't
+			// take the memory by reporting the devices or the properties not on the node
+			if twin.Reported != nil && s.history.Enabled() &&
+				s.dmiCache.HasDeviceProperty(in.DeviceNamespace, in.DeviceName, twin.PropertyName) {
+				s.history.Add(in.DeviceNamespace, in.DeviceName, twin.PropertyName, twin.Reported.Value, reportedTime(twin.Reported))
+			}
 		}
 	} else {
 		return &pb.ReportDeviceStatusResponse{}, errors.New("ReportDeviceStatusRequest does not have twin data")
@@ -199,6 +231,15 @@ func handleDeviceState(in *pb.ReportDeviceStatesRequest, payload []byte) {
 	beehiveContext.SendToGroup(target, *message)
 }
 
+// reportedTime returns the time when the value was collected by the mapper,
+// which is the timestamp in milliseconds in the metadata, or now if it's not set
+func reportedTime(reported *pb.TwinProperty) time.Time {
+	if ts, err := strconv.ParseInt(reported.Metadata["timestamp"], 10, 64); err == nil && ts > 0 {
+		return time.UnixMilli(ts)
+	}
+	return time.Now()
+}
+
 // CreateMessageTwinUpdate create twin update message.
 func CreateMessageTwinUpdate(twin *pb.Twin) ([]byte, error) {
 	var updateMsg DeviceTwinUpdate
@@ -222,7 +263,7 @@ func CreateMessageStateUpdate(in *pb.ReportDeviceStatesRequest) ([]byte, error)
 	return msg, err
 }
 
-func StartDMIServer(cache *dmicache.DMICache) error {
+func StartDMIServer(cache *dmicache.DMICache, history *dmihistory.History) error {
 	socketPath := deviceconfig.Get().DeviceTwin.DMISockPath
 	// Compatibility with older configurations.
 	if strings.HasSuffix(socketPath, ".sock") {
@@ -244,11 +285,20 @@ func StartDMIServer(cache *dmicache.DMICache) error {
 
 	limiter := rate.NewLimiter(rate.Every(Limit*time.Millisecond), Burst)
 
+	monitor := NewMapperMonitor(cache, time.Duration(deviceconfig.Get().MapperProbePeriod)*time.Second,
+		int(deviceconfig.Get().MapperFailureThreshold))
+	go monitor.Run(beehiveContext.Done())
+
 	s := grpc.NewServer()
-	pb.RegisterDeviceManagerServiceServer(s, &server{
+	dmiServer := &server{
 		limiter:  limiter,
 		dmiCache: cache,
-	})
+		history:  history,
+		monitor:  monitor,
+	}
+	pb.RegisterDeviceManagerServiceServer(s, dmiServer)
+	// serve the mappers built on DMI v1alpha1 on the same socket
+	dmiv1alpha1.RegisterDeviceManagerServiceServer(s, &v1alpha1Server{server: dmiServer})
 	reflection.Register(s)
 
 	if err := s.Serve(lis); err != nil {
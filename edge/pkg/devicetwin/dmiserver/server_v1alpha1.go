@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	dmiv1alpha1 "github.com/kubeedge/api/apis/dmi/v1alpha1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicompat"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+// v1alpha1Server serves the mappers built on DMI v1alpha1, their requests are translated to v1beta1
+type v1alpha1Server struct {
+	dmiv1alpha1.UnimplementedDeviceManagerServiceServer
+	server *server
+}
+
+func (s *v1alpha1Server) MapperRegister(_ctx context.Context, in *dmiv1alpha1.MapperRegisterRequest,
+) (*dmiv1alpha1.MapperRegisterResponse, error) {
+	if in.GetMapper() == nil {
+		return nil, errors.New("fail to register mapper because the mapper info is nil")
+	}
+
+	resp, err := s.server.registerMapper(&pb.MapperRegisterRequest{
+		WithData: in.GetWithData(),
+		Mapper:   dmicompat.FromV1alpha1MapperInfo(in.GetMapper()),
+	}, dmicompat.APIVersionV1alpha1)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dmiv1alpha1.MapperRegisterResponse{}
+	for _, model := range resp.GetModelList() {
+		out.ModelList = append(out.ModelList, dmicompat.ToV1alpha1DeviceModel(model, in.GetMapper().GetProtocol()))
+	}
+	for _, device := range resp.GetDeviceList() {
+		out.DeviceList = append(out.DeviceList, dmicompat.ToV1alpha1Device(device))
+	}
+	return out, nil
+}
+
+// ReportDeviceStatus reports the twins and the state of a device, which are reported separately in v1beta1
+func (s *v1alpha1Server) ReportDeviceStatus(ctx context.Context, in *dmiv1alpha1.ReportDeviceStatusRequest,
+) (*dmiv1alpha1.ReportDeviceStatusResponse, error) {
+	if in.GetDeviceName() == "" || in.GetReportedDevice() == nil {
+		return &dmiv1alpha1.ReportDeviceStatusResponse{}, errors.New("ReportDeviceStatusRequest is invalid data")
+	}
+	namespace, err := s.deviceNamespace(in.GetDeviceName())
+	if err != nil {
+		return nil, err
+	}
+
+	if twins := in.GetReportedDevice().GetTwins(); len(twins) > 0 {
+		_, err = s.server.ReportDeviceStatus(ctx, &pb.ReportDeviceStatusRequest{
+			DeviceName:      in.GetDeviceName(),
+			DeviceNamespace: namespace,
+			ReportedDevice:  &pb.DeviceStatus{Twins: dmicompat.FromV1alpha1Twins(twins)},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if state := in.GetReportedDevice().GetState(); state != "" {
+		_, err = s.server.ReportDeviceStates(ctx, &pb.ReportDeviceStatesRequest{
+			DeviceName:      in.GetDeviceName(),
+			DeviceNamespace: namespace,
+			State:           state,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &dmiv1alpha1.ReportDeviceStatusResponse{}, nil
+}
+
+// deviceNamespace returns the namespace of a device reported by a v1alpha1 mapper,
+// which knows the devices by name only
+func (s *v1alpha1Server) deviceNamespace(name string) (string, error) {
+	var namespaces []string
+	for _, deviceID := range s.server.dmiCache.DeviceIds() {
+		ns, deviceName, err := util.GetNamespacedName(deviceID)
+		if err == nil && deviceName == name {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	switch len(namespaces) {
+	case 0:
+		return "", fmt.Errorf("device %s not found", name)
+	case 1:
+		return namespaces[0], nil
+	default:
+		sort.Strings(namespaces)
+		return "", fmt.Errorf("device %s exists in namespaces %s, it can't be reported by a v1alpha1 mapper",
+			name, strings.Join(namespaces, ", "))
+	}
+}
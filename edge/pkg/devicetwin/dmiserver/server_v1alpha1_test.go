@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	dmiv1alpha1 "github.com/kubeedge/api/apis/dmi/v1alpha1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicache"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmihistory"
+)
+
+func newTestServer() *server {
+	cache := dmicache.NewDMICache()
+	for _, ns := range []string{"default", "line-b"} {
+		cache.PutDeviceModel(&v1beta1.DeviceModel{
+			ObjectMeta: metav1.ObjectMeta{Name: "plc-model", Namespace: ns},
+			Spec:       v1beta1.DeviceModelSpec{Protocol: "modbus"},
+		})
+		cache.PutDevice(&v1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Name: "plc-1", Namespace: ns},
+			Spec: v1beta1.DeviceSpec{
+				DeviceModelRef: &v1.LocalObjectReference{Name: "plc-model"},
+				Protocol:       v1beta1.ProtocolConfig{ProtocolName: "modbus"},
+			},
+		})
+	}
+	cache.PutDevice(&v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: "plc-2", Namespace: "default"},
+		Spec: v1beta1.DeviceSpec{
+			DeviceModelRef: &v1.LocalObjectReference{Name: "plc-model"},
+			Protocol:       v1beta1.ProtocolConfig{ProtocolName: "modbus"},
+			Properties:     []v1beta1.DeviceProperty{{Name: "setpoint"}},
+		},
+	})
+	return &server{
+		limiter:  rate.NewLimiter(rate.Inf, Burst),
+		dmiCache: cache,
+		history:  dmihistory.NewHistory(10),
+		monitor:  NewMapperMonitor(cache, time.Second, 1),
+	}
+}
+
+func TestV1alpha1MapperRegister(t *testing.T) {
+	s := newTestServer()
+	var saved []*pb.MapperInfo
+	patches := gomonkey.ApplyFunc(saveMapper, func(mapper *pb.MapperInfo) error {
+		saved = append(saved, mapper)
+		return nil
+	})
+	defer patches.Reset()
+
+	alphaServer := &v1alpha1Server{server: s}
+	resp, err := alphaServer.MapperRegister(context.Background(), &dmiv1alpha1.MapperRegisterRequest{
+		WithData: true,
+		Mapper: &dmiv1alpha1.MapperInfo{Name: "modbus-mapper", ApiVersion: "v1.0.0", Protocol: "modbus",
+			Address: []byte("/etc/kubeedge/modbus.sock")},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.DeviceList, 3)
+	assert.Len(t, resp.ModelList, 3)
+	assert.Equal(t, "modbus", resp.ModelList[0].Spec.Protocol)
+	// the negotiated version is saved with the mapper
+	assert.Len(t, saved, 1)
+	assert.Equal(t, "v1alpha1", saved[0].ApiVersion)
+
+	_, err = alphaServer.MapperRegister(context.Background(), &dmiv1alpha1.MapperRegisterRequest{
+		Mapper: &dmiv1alpha1.MapperInfo{Name: "modbus-mapper", ApiVersion: "v1beta1", Protocol: "modbus"},
+	})
+	assert.Error(t, err)
+	assert.Len(t, saved, 1)
+}
+
+func TestV1alpha1ReportDeviceStatus(t *testing.T) {
+	s := newTestServer()
+	var twins []DeviceTwinUpdate
+	var states []string
+	patches := gomonkey.ApplyFunc(handleDeviceTwin, func(in *pb.ReportDeviceStatusRequest, payload []byte) {
+		assert.Equal(t, "default", in.DeviceNamespace)
+		var update DeviceTwinUpdate
+		assert.NoError(t, json.Unmarshal(payload, &update))
+		twins = append(twins, update)
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(handleDeviceState, func(in *pb.ReportDeviceStatesRequest, _ []byte) {
+		assert.Equal(t, "default", in.DeviceNamespace)
+		states = append(states, in.State)
+	})
+
+	alphaServer := &v1alpha1Server{server: s}
+	_, err := alphaServer.ReportDeviceStatus(context.Background(), &dmiv1alpha1.ReportDeviceStatusRequest{
+		DeviceName: "plc-2",
+		ReportedDevice: &dmiv1alpha1.DeviceStatus{
+			Twins: []*dmiv1alpha1.Twin{{
+				PropertyName: "setpoint",
+				Desired:      &dmiv1alpha1.TwinProperty{Value: "20"},
+				Reported:     &dmiv1alpha1.TwinProperty{Value: "19"},
+			}, {
+				PropertyName: "undeclared",
+				Reported:     &dmiv1alpha1.TwinProperty{Value: "1"},
+			}},
+			State: "online",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, twins, 2)
+	assert.Equal(t, "20", *twins[0].Twin["setpoint"].Expected.Value)
+	assert.Equal(t, "19", *twins[0].Twin["setpoint"].Actual.Value)
+	assert.Equal(t, []string{"online"}, states)
+	// only the properties of the device are kept in the history
+	history := s.history.Query("default", "plc-2", "", time.Time{}, time.Now().Add(time.Hour))
+	assert.Len(t, history, 1)
+	assert.Len(t, history["setpoint"], 1)
+
+	// plc-1 exists in two namespaces and can't be told apart by name
+	_, err = alphaServer.ReportDeviceStatus(context.Background(), &dmiv1alpha1.ReportDeviceStatusRequest{
+		DeviceName:     "plc-1",
+		ReportedDevice: &dmiv1alpha1.DeviceStatus{State: "online"},
+	})
+	assert.Error(t, err)
+
+	_, err = alphaServer.ReportDeviceStatus(context.Background(), &dmiv1alpha1.ReportDeviceStatusRequest{
+		DeviceName:     "unknown",
+		ReportedDevice: &dmiv1alpha1.DeviceStatus{State: "online"},
+	})
+	assert.Error(t, err)
+	assert.Len(t, states, 1)
+}
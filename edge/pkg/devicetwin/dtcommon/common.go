@@ -93,6 +93,8 @@ const (
 	Disconnected = "disconnected"
 	// MetaDeviceOperation event
 	MetaDeviceOperation = "MetaDeviceOperation"
+	// DeviceMethodCallOperation the operation to call a method of device
+	DeviceMethodCallOperation = "call"
 
 	// CommModule communicate module
 	CommModule = "CommModule"
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtcommon
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
+)
+
+// RecordDeviceEvent records an event on the device namespace/name through metaManager
+func RecordDeviceEvent(namespace, name string, source corev1.EventSource, eventType, reason, message string) error {
+	event := newDeviceEvent(namespace, name, source, eventType, reason, message)
+	if _, err := metaclient.New().Events(namespace).CreateWithEventNamespace(event); err != nil {
+		return fmt.Errorf("failed to create event %s of device %s/%s, err: %v", reason, namespace, name, err)
+	}
+	return nil
+}
+
+func newDeviceEvent(namespace, name string, source corev1.EventSource, eventType, reason, message string) *corev1.Event {
+	now := metav1.Now()
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", name, now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       constants.KindTypeDevice,
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+			Namespace:  namespace,
+			Name:       name,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         source,
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtcommon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
+)
+
+func TestNewDeviceEvent(t *testing.T) {
+	source := corev1.EventSource{Component: "twin", Host: "node1"}
+	event := newDeviceEvent("default", "dev1", source, corev1.EventTypeWarning, "Conflict", "both updated")
+
+	assert.True(t, strings.HasPrefix(event.Name, "dev1."))
+	assert.Equal(t, "default", event.Namespace)
+	assert.Equal(t, corev1.ObjectReference{
+		Kind:       constants.KindTypeDevice,
+		APIVersion: v1beta1.SchemeGroupVersion.String(),
+		Namespace:  "default",
+		Name:       "dev1",
+	}, event.InvolvedObject)
+	assert.Equal(t, corev1.EventTypeWarning, event.Type)
+	assert.Equal(t, "Conflict", event.Reason)
+	assert.Equal(t, "both updated", event.Message)
+	assert.Equal(t, source, event.Source)
+	assert.Equal(t, int32(1), event.Count)
+	assert.Equal(t, event.FirstTimestamp, event.LastTimestamp)
+}
@@ -1,6 +1,7 @@
 package dtcommon
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +16,7 @@ import (
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
 	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
-	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
 )
 
 // ValidateValue validate value type
@@ -259,3 +260,129 @@ func dataToAny(v interface{}) (*anypb.Any, error) {
 		return nil, fmt.Errorf("%v does not support converting to any", reflect.TypeOf(v))
 	}
 }
+
+// EncodeMethodInputs checks the inputs of a device method call against the parameters
+// declared in the device model and encodes them to the string form used by DMI
+func EncodeMethodInputs(method *v1beta1.ModelMethod, inputs map[string]interface{}) (map[string]string, error) {
+	params := make(map[string]v1beta1.MethodParameter, len(method.Inputs))
+	for _, param := range method.Inputs {
+		params[param.Name] = param
+		if _, ok := inputs[param.Name]; !ok && param.Required {
+			return nil, fmt.Errorf("required input %s of method %s is missing", param.Name, method.Name)
+		}
+	}
+	encoded := make(map[string]string, len(inputs))
+	for name, value := range inputs {
+		param, ok := params[name]
+		if !ok {
+			return nil, fmt.Errorf("input %s is not declared by method %s", name, method.Name)
+		}
+		str, err := encodeMethodValue(param.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %s of method %s: %v", name, method.Name, err)
+		}
+		encoded[name] = str
+	}
+	return encoded, nil
+}
+
+// DecodeMethodOutputs converts the outputs returned by the mapper to the types
+// declared in the device model
+func DecodeMethodOutputs(method *v1beta1.ModelMethod, outputs map[string]string) (map[string]interface{}, error) {
+	params := make(map[string]v1beta1.MethodParameter, len(method.Outputs))
+	for _, param := range method.Outputs {
+		params[param.Name] = param
+	}
+	decoded := make(map[string]interface{}, len(outputs))
+	for name, value := range outputs {
+		param, ok := params[name]
+		if !ok {
+			return nil, fmt.Errorf("output %s is not declared by method %s", name, method.Name)
+		}
+		v, err := decodeMethodValue(param.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output %s of method %s: %v", name, method.Name, err)
+		}
+		decoded[name] = v
+	}
+	return decoded, nil
+}
+
+func encodeMethodValue(paramType v1beta1.PropertyType, value interface{}) (string, error) {
+	switch paramType {
+	case v1beta1.INT:
+		switch v := value.(type) {
+		case json.Number:
+			i, err := v.Int64()
+			if err != nil {
+				return "", errors.New("the value is not int")
+			}
+			return strconv.FormatInt(i, 10), nil
+		case float64:
+			if v != float64(int64(v)) {
+				return "", errors.New("the value is not int")
+			}
+			return strconv.FormatInt(int64(v), 10), nil
+		}
+		return "", errors.New("the value is not int")
+	case v1beta1.FLOAT, v1beta1.DOUBLE:
+		switch v := value.(type) {
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return "", errors.New("the value is not float")
+			}
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+		return "", errors.New("the value is not float")
+	case v1beta1.BOOLEAN:
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v), nil
+		}
+		return "", errors.New("the value is not bool")
+	case v1beta1.STRING:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+		return "", errors.New("the value is not string")
+	case v1beta1.BYTES:
+		if v, ok := value.(string); ok {
+			if _, err := base64.StdEncoding.DecodeString(v); err != nil {
+				return "", errors.New("the value is not base64 encoded bytes")
+			}
+			return v, nil
+		}
+		return "", errors.New("the value is not base64 encoded bytes")
+	default:
+		return "", fmt.Errorf("unsupported parameter type %s", paramType)
+	}
+}
+
+func decodeMethodValue(paramType v1beta1.PropertyType, value string) (interface{}, error) {
+	switch paramType {
+	case v1beta1.INT:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.New("the value is not int")
+		}
+		return i, nil
+	case v1beta1.FLOAT, v1beta1.DOUBLE:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.New("the value is not float")
+		}
+		return f, nil
+	case v1beta1.BOOLEAN:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("the value is not bool")
+		}
+		return b, nil
+	case v1beta1.STRING, v1beta1.BYTES:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter type %s", paramType)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package dtcommon
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -643,3 +644,138 @@ func TestConvertDeviceProperty(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodeMethodInputs(t *testing.T) {
+	method := &v1beta1.ModelMethod{
+		Name: "calibrate",
+		Inputs: []v1beta1.MethodParameter{
+			{Name: "offset", Type: v1beta1.INT, Required: true},
+			{Name: "gain", Type: v1beta1.DOUBLE},
+			{Name: "force", Type: v1beta1.BOOLEAN},
+			{Name: "label", Type: v1beta1.STRING},
+			{Name: "blob", Type: v1beta1.BYTES},
+		},
+	}
+	cases := []struct {
+		name    string
+		inputs  map[string]interface{}
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "all types",
+			inputs: map[string]interface{}{
+				"offset": json.Number("-3"),
+				"gain":   json.Number("1.5"),
+				"force":  true,
+				"label":  "zero",
+				"blob":   "aGVsbG8=",
+			},
+			want: map[string]string{
+				"offset": "-3",
+				"gain":   "1.5",
+				"force":  "true",
+				"label":  "zero",
+				"blob":   "aGVsbG8=",
+			},
+		},
+		{
+			name:   "integral float as int",
+			inputs: map[string]interface{}{"offset": float64(7)},
+			want:   map[string]string{"offset": "7"},
+		},
+		{
+			name:    "missing required input",
+			inputs:  map[string]interface{}{"gain": json.Number("1")},
+			wantErr: true,
+		},
+		{
+			name:    "undeclared input",
+			inputs:  map[string]interface{}{"offset": json.Number("1"), "mode": "fast"},
+			wantErr: true,
+		},
+		{
+			name:    "fractional int",
+			inputs:  map[string]interface{}{"offset": json.Number("1.5")},
+			wantErr: true,
+		},
+		{
+			name:    "wrong bool type",
+			inputs:  map[string]interface{}{"offset": json.Number("1"), "force": "yes"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid bytes",
+			inputs:  map[string]interface{}{"offset": json.Number("1"), "blob": "not base64!"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeMethodInputs(method, tt.inputs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeMethodOutputs(t *testing.T) {
+	method := &v1beta1.ModelMethod{
+		Name: "selftest",
+		Outputs: []v1beta1.MethodParameter{
+			{Name: "passed", Type: v1beta1.BOOLEAN},
+			{Name: "errors", Type: v1beta1.INT},
+			{Name: "temperature", Type: v1beta1.FLOAT},
+			{Name: "report", Type: v1beta1.STRING},
+		},
+	}
+	cases := []struct {
+		name    string
+		outputs map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "all types",
+			outputs: map[string]string{
+				"passed":      "true",
+				"errors":      "2",
+				"temperature": "36.6",
+				"report":      "ok",
+			},
+			want: map[string]interface{}{
+				"passed":      true,
+				"errors":      int64(2),
+				"temperature": 36.6,
+				"report":      "ok",
+			},
+		},
+		{
+			name:    "undeclared output",
+			outputs: map[string]string{"extra": "1"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed int",
+			outputs: map[string]string{"errors": "two"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeMethodOutputs(method, tt.outputs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -17,24 +17,31 @@ limitations under the License.
 package dtmanager
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	klog "k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
 	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/beehive/pkg/core/model"
-	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmicache"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmihistory"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiserver"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcontext"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
 )
 
 // DMIWorker deal dmi event
@@ -42,16 +49,19 @@ type DMIWorker struct {
 	Worker
 	Group    string
 	dmiCache *dmicache.DMICache
+	// history keeps the recent values of the device properties reported by mappers
+	history *dmihistory.History
 	//dmiActionCallBack map for action to callback
 	dmiActionCallBack map[string]CallBack
 	// dmiClient is the client for dmi
-	DeviceService *dbclient.DeviceService
+	DeviceService dbclient.DeviceStore
 	// metaservice is the client for meta
-	MetaService *dbclient.MetaService
+	MetaService dbclient.MetaStore
 }
 
 func (dw *DMIWorker) init() {
 	dw.dmiCache = dmicache.NewDMICache()
+	dw.history = dmihistory.NewHistory(int(deviceconfig.Get().DeviceHistorySize))
 
 	dw.initDMIActionCallBack()
 	dw.initDeviceModelInfoFromDB()
@@ -65,7 +75,7 @@ func (dw DMIWorker) Start() {
 	dw.init()
 
 	go func() {
-		if err := dmiserver.StartDMIServer(dw.dmiCache); err != nil {
+		if err := dmiserver.StartDMIServer(dw.dmiCache, dw.history); err != nil {
 			klog.Errorf("failed to start DMI Server with err: %v", err)
 			return
 		}
@@ -142,21 +152,9 @@ func (dw *DMIWorker) dealMetaDeviceOperation(_ *dtcontext.DTContext, _ string, m
 				return err
 			}
 			dw.dmiCache.RemoveDevice(device.Namespace, device.Name)
+			dw.history.RemoveDevice(device.Namespace, device.Name)
 		case model.UpdateOperation:
-			if dw.dmiCache.CompareDeviceSpecHasChanged(&device) {
-				dw.dmiCache.PutDevice(&device)
-				// Override device instance config with model defaults before updating
-				devicePtr, _, err := dw.dmiCache.GetOverriddenDevice(device.Namespace, device.Name)
-				if err != nil {
-					return err
-				}
-
-				err = dmiclient.DMIClientsImp.UpdateDevice(devicePtr)
-				if err != nil {
-					klog.Errorf("update device %s failed with err: %v", devicePtr.Name, err)
-					return err
-				}
-			}
+			return dw.updateDevice(&device)
 		default:
 			klog.Warningf("unsupported operation %s", message.GetOperation())
 		}
@@ -191,6 +189,33 @@ func (dw *DMIWorker) dealMetaDeviceOperation(_ *dtcontext.DTContext, _ string, m
 			klog.Warningf("unsupported operation %s", message.GetOperation())
 		}
 
+	case constants.ResourceTypeDeviceGroup:
+		if message.GetOperation() == model.DeleteOperation {
+			// the group is removed from the metadata by metaManager, its devices are kept
+			return nil
+		}
+		if message.GetOperation() != model.UpdateOperation {
+			klog.Warningf("unsupported operation %s", message.GetOperation())
+			return nil
+		}
+		var deviceList v1beta1.DeviceList
+		err := json.Unmarshal(message.Content.([]byte), &deviceList)
+		if err != nil {
+			return fmt.Errorf("invalid message content with err: %+v", err)
+		}
+		dw.dealDeviceGroupUpdate(&deviceList)
+	case constants.ResourceTypeDeviceMethod:
+		if message.GetOperation() != dtcommon.DeviceMethodCallOperation {
+			klog.Warningf("unsupported operation %s", message.GetOperation())
+			return nil
+		}
+		dw.dealDeviceMethodCall(message, resources[0], resources[2])
+	case constants.ResourceTypeDeviceHistory:
+		if message.GetOperation() != model.QueryOperation {
+			klog.Warningf("unsupported operation %s", message.GetOperation())
+			return nil
+		}
+		dw.dealDeviceHistoryQuery(message, resources[0], resources[2])
 	default:
 		klog.Warningf("unsupported resource type %s", resources[3])
 	}
@@ -198,6 +223,111 @@ func (dw *DMIWorker) dealMetaDeviceOperation(_ *dtcontext.DTContext, _ string, m
 	return nil
 }
 
+// updateDevice updates the device in the mapper if its spec changed
+func (dw *DMIWorker) updateDevice(device *v1beta1.Device) error {
+	if !dw.dmiCache.CompareDeviceSpecHasChanged(device) {
+		return nil
+	}
+	dw.dmiCache.PutDevice(device)
+	// Override device instance config with model defaults before updating
+	devicePtr, _, err := dw.dmiCache.GetOverriddenDevice(device.Namespace, device.Name)
+	if err != nil {
+		return err
+	}
+
+	err = dmiclient.DMIClientsImp.UpdateDevice(devicePtr)
+	if err != nil {
+		klog.Errorf("update device %s failed with err: %v", devicePtr.Name, err)
+		return err
+	}
+	return nil
+}
+
+// dealDeviceGroupUpdate updates the devices sent in a batch by a device group,
+// the devices are saved as if they were sent one by one so that they are restored after restart
+func (dw *DMIWorker) dealDeviceGroupUpdate(deviceList *v1beta1.DeviceList) {
+	for i := range deviceList.Items {
+		device := &deviceList.Items[i]
+		content, err := json.Marshal(device)
+		if err != nil {
+			klog.Errorf("marshal device %s failed with err: %v", device.Name, err)
+			continue
+		}
+		meta := &models.Meta{
+			Key:   fmt.Sprintf("%s/%s/%s", device.Namespace, constants.ResourceTypeDevice, device.Name),
+			Type:  constants.ResourceTypeDevice,
+			Value: string(content),
+		}
+		if err = dw.MetaService.InsertOrUpdate(meta); err != nil {
+			klog.Errorf("save device %s failed with err: %v", device.Name, err)
+			continue
+		}
+		if err = dw.updateDevice(device); err != nil {
+			klog.Errorf("update device %s of device group failed with err: %v", device.Name, err)
+		}
+	}
+}
+
+// dealDeviceMethodCall invokes a method declared in the device model through the mapper
+// and replies the result to the sender
+func (dw *DMIWorker) dealDeviceMethodCall(message *model.Message, namespace, name string) {
+	result := dw.callDeviceMethod(message, namespace, name)
+	if result.Code != 0 {
+		klog.Errorf("call method of device %s/%s failed: %s", namespace, name, result.Reason)
+	}
+	content, err := json.Marshal(result)
+	if err != nil {
+		klog.Errorf("marshal method result of device %s/%s failed with err: %v", namespace, name, err)
+		return
+	}
+	beehiveContext.SendResp(*message.NewRespByMessage(message, content))
+}
+
+func (dw *DMIWorker) callDeviceMethod(message *model.Message, namespace, name string) *dttype.DeviceMethodResult {
+	data, err := message.GetContentData()
+	if err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.BadRequestCode, Reason: err.Error()}
+	}
+	var call dttype.DeviceMethodCall
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&call); err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.BadRequestCode, Reason: fmt.Sprintf("invalid method call: %v", err)}
+	}
+
+	device, deviceModel, err := dw.dmiCache.GetOverriddenDevice(namespace, name)
+	if err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.NotFoundCode, Reason: err.Error()}
+	}
+	var method *v1beta1.ModelMethod
+	for i := range deviceModel.Spec.Methods {
+		if deviceModel.Spec.Methods[i].Name == call.MethodName {
+			method = &deviceModel.Spec.Methods[i]
+			break
+		}
+	}
+	if method == nil {
+		return &dttype.DeviceMethodResult{
+			Code:   dtcommon.NotFoundCode,
+			Reason: fmt.Sprintf("method %s is not declared by device model %s", call.MethodName, deviceModel.Name),
+		}
+	}
+
+	inputs, err := dtcommon.EncodeMethodInputs(method, call.Inputs)
+	if err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.BadRequestCode, Reason: err.Error()}
+	}
+	outputs, err := dmiclient.DMIClientsImp.CallDeviceMethod(device, method.Name, inputs)
+	if err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.InternalErrorCode, Reason: err.Error()}
+	}
+	decoded, err := dtcommon.DecodeMethodOutputs(method, outputs)
+	if err != nil {
+		return &dttype.DeviceMethodResult{Code: dtcommon.InternalErrorCode, Reason: err.Error()}
+	}
+	return &dttype.DeviceMethodResult{Outputs: decoded}
+}
+
 func (dw *DMIWorker) initDeviceModelInfoFromDB() {
 	metas, err := dw.MetaService.QueryMeta("type", constants.ResourceTypeDeviceModel)
 	if err != nil {
@@ -248,7 +378,7 @@ func (dw *DMIWorker) initDeviceMapperInfoFromDB() {
 			return
 		}
 
-		err := dmiclient.DMIClientsImp.CreateDMIClient(deviceMapper.Protocol, string(deviceMapper.Address), true)
+		err := dmiclient.DMIClientsImp.CreateDMIClient(deviceMapper.Protocol, deviceMapper.ApiVersion, string(deviceMapper.Address), true)
 		if err != nil {
 			klog.Warningf("fail to init dmi client for device mapper %s: %v", deviceMapper.Name, err)
 			continue
@@ -258,3 +388,56 @@ func (dw *DMIWorker) initDeviceMapperInfoFromDB() {
 	}
 	klog.Infoln("success to init device mapper info from db")
 }
+
+// dealDeviceHistoryQuery replies the recent values of the device properties to the sender
+func (dw *DMIWorker) dealDeviceHistoryQuery(message *model.Message, namespace, name string) {
+	result := dw.queryDeviceHistory(message, namespace, name)
+	content, err := json.Marshal(result)
+	if err != nil {
+		klog.Errorf("marshal history of device %s/%s failed with err: %v", namespace, name, err)
+		return
+	}
+	beehiveContext.SendResp(*message.NewRespByMessage(message, content))
+}
+
+func (dw *DMIWorker) queryDeviceHistory(message *model.Message, namespace, name string) *dttype.DeviceHistoryResult {
+	if !dw.history.Enabled() {
+		return &dttype.DeviceHistoryResult{Code: dtcommon.NotFoundCode, Reason: "device history is disabled"}
+	}
+	data, err := message.GetContentData()
+	if err != nil {
+		return &dttype.DeviceHistoryResult{Code: dtcommon.BadRequestCode, Reason: err.Error()}
+	}
+	var query dttype.DeviceHistoryQuery
+	if err = json.Unmarshal(data, &query); err != nil {
+		return &dttype.DeviceHistoryResult{Code: dtcommon.BadRequestCode, Reason: fmt.Sprintf("invalid history query: %v", err)}
+	}
+	if _, _, err = dw.dmiCache.GetOverriddenDevice(namespace, name); err != nil {
+		return &dttype.DeviceHistoryResult{Code: dtcommon.NotFoundCode, Reason: err.Error()}
+	}
+
+	start := time.UnixMilli(query.Start)
+	end := time.Now()
+	if query.End != 0 {
+		end = time.UnixMilli(query.End)
+	}
+	histories := dw.history.Query(namespace, name, query.Property, start, end)
+	result := &dttype.DeviceHistoryResult{Properties: make([]dttype.PropertyHistory, 0, len(histories))}
+	for property, samples := range histories {
+		history := dttype.PropertyHistory{Name: property}
+		if query.Step > 0 {
+			bucketStart := start
+			if query.Start == 0 {
+				bucketStart = time.Time{}
+			}
+			history.Buckets = dmihistory.Downsample(samples, bucketStart, time.Duration(query.Step)*time.Millisecond)
+		} else {
+			history.Samples = samples
+		}
+		result.Properties = append(result.Properties, history)
+	}
+	sort.Slice(result.Properties, func(i, j int) bool {
+		return result.Properties[i].Name < result.Properties[j].Name
+	})
+	return result
+}
@@ -247,8 +247,13 @@ func DealDeviceTwin(context *dtcontext.DTContext, deviceID string, eventID strin
 				klog.Error(err)
 			}
 			klog.Errorf("Update device twin failed due to writing sql error: %v", err)
+		} else {
+			recordTwinHistory(deviceID, device.Twin, dealTwinResult, dealType, now)
 		}
 	}
+	for _, conflict := range dealTwinResult.Conflicts {
+		recordTwinConflictEvent(deviceID, conflict)
+	}
 
 	if dealType == RestDealType {
 		updateResult, _ := dttype.BuildDeviceTwinResult(dttype.BaseMessage{EventID: eventID, Timestamp: now}, dealTwinResult.Result, dealType)
@@ -1009,6 +1014,7 @@ func DealMsgTwin(context *dtcontext.DTContext, deviceID string, msgTwins map[str
 	}
 
 	var err error
+	policy := twinConflictPolicy()
 	for key, msgTwin := range msgTwins {
 		if twin, exist := twins[key]; exist {
 			if dealType >= 1 && msgTwin != nil && (msgTwin.Metadata == nil) {
@@ -1018,6 +1024,14 @@ func DealMsgTwin(context *dtcontext.DTContext, deviceID string, msgTwins map[str
 				dealTwinDelete(&returnResult, deviceID, key, twin, msgTwin, dealType)
 				continue
 			}
+			if dealType != RestDealType {
+				if conflicts := detectTwinConflicts(key, twin, msgTwin, policy); len(conflicts) > 0 {
+					returnResult.Conflicts = append(returnResult.Conflicts, conflicts...)
+					if !resolveTwinConflicts(twin, msgTwin, conflicts, policy) {
+						continue
+					}
+				}
+			}
 			err = dealTwinCompare(&returnResult, deviceID, key, twin, msgTwin, dealType)
 			if err != nil {
 				return returnResult
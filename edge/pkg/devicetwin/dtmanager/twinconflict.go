@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+const (
+	twinFieldExpected = "expected"
+	twinFieldActual   = "actual"
+
+	twinHistorySourceEdge  = "edge"
+	twinHistorySourceCloud = "cloud"
+
+	reasonTwinConflict = "TwinConflict"
+)
+
+// TwinHistoryServiceFactory is a function variable that can be mocked in tests
+var TwinHistoryServiceFactory = func() interface {
+	AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error
+} {
+	return dbclient.NewDeviceService()
+}
+
+func twinConflictPolicy() v1alpha2.TwinConflictPolicy {
+	if policy := deviceconfig.Get().TwinConflictPolicy; policy != "" {
+		return policy
+	}
+	return v1alpha2.TwinConflictPolicyEdgeWins
+}
+
+// isVersionConflict returns true if both the edge and the cloud updated the value since they last synced,
+// the cloud sends back the edge version it has seen with the value
+func isVersionConflict(version, reqVersion *dttype.TwinVersion) bool {
+	return version != nil && reqVersion != nil &&
+		version.EdgeVersion > reqVersion.EdgeVersion && reqVersion.CloudVersion > version.CloudVersion
+}
+
+// detectTwinConflicts returns the values of the twin synced from the cloud which conflict with the edge,
+// the values which are the same on both sides are not conflicts
+func detectTwinConflicts(key string, twin, msgTwin *dttype.MsgTwin, policy v1alpha2.TwinConflictPolicy) []dttype.TwinConflict {
+	var conflicts []dttype.TwinConflict
+	check := func(field string, value, msgValue *dttype.TwinValue, version, msgVersion *dttype.TwinVersion) {
+		if msgValue == nil || !isVersionConflict(version, msgVersion) {
+			return
+		}
+		conflict := dttype.TwinConflict{
+			Name:         key,
+			Field:        field,
+			CloudValue:   msgValue.Value,
+			EdgeVersion:  *version,
+			CloudVersion: *msgVersion,
+			Resolution:   string(policy),
+		}
+		if value != nil {
+			conflict.EdgeValue = value.Value
+		}
+		if stringValue(conflict.EdgeValue) == stringValue(conflict.CloudValue) {
+			return
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	check(twinFieldExpected, twin.Expected, msgTwin.Expected, twin.ExpectedVersion, msgTwin.ExpectedVersion)
+	check(twinFieldActual, twin.Actual, msgTwin.Actual, twin.ActualVersion, msgTwin.ActualVersion)
+	return conflicts
+}
+
+// resolveTwinConflicts prepares the twin for the sync of the conflicting values according to the policy,
+// it returns false if the sync of the twin is rejected.
+// The edge wins by default, the sync is rejected by dealVersion and the edge values are synced back to the cloud.
+func resolveTwinConflicts(twin *dttype.MsgTwin, msgTwin *dttype.MsgTwin, conflicts []dttype.TwinConflict, policy v1alpha2.TwinConflictPolicy) bool {
+	switch policy {
+	case v1alpha2.TwinConflictPolicyReject:
+		return false
+	case v1alpha2.TwinConflictPolicyCloudWins:
+		// the edge updates the cloud has not seen are discarded
+		for _, conflict := range conflicts {
+			if conflict.Field == twinFieldExpected {
+				twin.ExpectedVersion.EdgeVersion = msgTwin.ExpectedVersion.EdgeVersion
+			} else {
+				twin.ActualVersion.EdgeVersion = msgTwin.ActualVersion.EdgeVersion
+			}
+		}
+	}
+	return true
+}
+
+// recordTwinHistory saves the states of the changed twins, each of them costs
+// an insert and a trim of its older states in the twin update path. The
+// history is disabled if its size is 0, which is the default.
+func recordTwinHistory(deviceID string, twins map[string]*dttype.MsgTwin, result dttype.DealTwinResult, dealType int, timestamp int64) {
+	size := int(deviceconfig.Get().TwinHistorySize)
+	if size <= 0 || len(result.Document) == 0 {
+		return
+	}
+	source := twinHistorySourceCloud
+	if dealType == RestDealType {
+		source = twinHistorySourceEdge
+	}
+	conflicts := make(map[string]bool)
+	for _, conflict := range result.Conflicts {
+		conflicts[conflict.Name] = true
+	}
+
+	keys := make([]string, 0, len(result.Document))
+	for key := range result.Document {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var history []models.DeviceTwinHistory
+	for _, key := range keys {
+		twin, ok := twins[key]
+		if !ok || result.Document[key].CurrentState == nil {
+			continue
+		}
+		h := models.DeviceTwinHistory{
+			DeviceID:  deviceID,
+			Name:      key,
+			Source:    source,
+			Conflict:  conflicts[key],
+			Timestamp: timestamp,
+		}
+		if twin.Expected != nil {
+			h.Expected = stringValue(twin.Expected.Value)
+		}
+		if twin.Actual != nil {
+			h.Actual = stringValue(twin.Actual.Value)
+		}
+		if twin.ExpectedVersion != nil {
+			versionJSON, _ := json.Marshal(twin.ExpectedVersion)
+			h.ExpectedVersion = string(versionJSON)
+		}
+		if twin.ActualVersion != nil {
+			versionJSON, _ := json.Marshal(twin.ActualVersion)
+			h.ActualVersion = string(versionJSON)
+		}
+		history = append(history, h)
+	}
+	if len(history) == 0 {
+		return
+	}
+	if err := TwinHistoryServiceFactory().AddDeviceTwinHistory(history, size); err != nil {
+		klog.Errorf("failed to save the twin history of device %s: %v", deviceID, err)
+	}
+}
+
+// recordTwinConflictEvent records an event on the device with both values of the conflict
+func recordTwinConflictEvent(deviceID string, conflict dttype.TwinConflict) {
+	namespace, name, err := util.GetNamespacedName(deviceID)
+	if err != nil {
+		klog.Errorf("failed to record the twin conflict of device %s: %v", deviceID, err)
+		return
+	}
+	message := fmt.Sprintf("%s value of twin %s was updated on both the edge and the cloud: "+
+		"edge value %q (cloud version %d, edge version %d), cloud value %q (cloud version %d, edge version %d), resolved by %s",
+		conflict.Field, conflict.Name,
+		stringValue(conflict.EdgeValue), conflict.EdgeVersion.CloudVersion, conflict.EdgeVersion.EdgeVersion,
+		stringValue(conflict.CloudValue), conflict.CloudVersion.CloudVersion, conflict.CloudVersion.EdgeVersion,
+		conflict.Resolution)
+	klog.Warningf("device %s: %s", deviceID, message)
+
+	source := corev1.EventSource{
+		Component: modules.DeviceTwinModuleName,
+		Host:      deviceconfig.Get().NodeName,
+	}
+	if err := dtcommon.RecordDeviceEvent(namespace, name, source, corev1.EventTypeWarning, reasonTwinConflict, message); err != nil {
+		klog.Warningf("failed to record the twin conflict event of device %s: %v", deviceID, err)
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
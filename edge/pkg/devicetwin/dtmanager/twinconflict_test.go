@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtmanager
+
+import (
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcontext"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/mocks"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+const conflictDeviceID = "default/plc-1"
+
+// conflictContext returns a context with a twin which has been updated on the edge twice
+// since it was last synced with the cloud
+func conflictContext() *dtcontext.DTContext {
+	context := contextFunc(conflictDeviceID)
+	edgeValue := "20"
+	optional := true
+	device := &dttype.Device{Twin: map[string]*dttype.MsgTwin{
+		"setpoint": {
+			Expected:        &dttype.TwinValue{Value: &edgeValue},
+			ExpectedVersion: &dttype.TwinVersion{CloudVersion: 1, EdgeVersion: 3},
+			Optional:        &optional,
+			Metadata:        &dttype.TypeMetadata{Type: typeString},
+		},
+	}}
+	context.DeviceList.Store(conflictDeviceID, device)
+	return &context
+}
+
+// cloudTwin returns the twin synced from the cloud with the given versions
+func cloudTwin(value string, version dttype.TwinVersion) map[string]*dttype.MsgTwin {
+	return map[string]*dttype.MsgTwin{
+		"setpoint": {
+			Expected:        &dttype.TwinValue{Value: &value},
+			ExpectedVersion: &version,
+			Metadata:        &dttype.TypeMetadata{Type: typeString},
+		},
+	}
+}
+
+func TestDealMsgTwinConflict(t *testing.T) {
+	defer func() {
+		deviceconfig.Get().TwinConflictPolicy = ""
+	}()
+
+	cases := []struct {
+		name          string
+		policy        v1alpha2.TwinConflictPolicy
+		msgTwins      map[string]*dttype.MsgTwin
+		conflicts     int
+		expectedValue string
+		// synced is true if the edge value is synced back to the cloud
+		synced bool
+	}{
+		{
+			name:          "case1 the cloud has seen the edge updates",
+			policy:        v1alpha2.TwinConflictPolicyEdgeWins,
+			msgTwins:      cloudTwin("30", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 3}),
+			expectedValue: "30",
+		},
+		{
+			name:          "case2 edge wins by default",
+			msgTwins:      cloudTwin("30", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 1}),
+			conflicts:     1,
+			expectedValue: "20",
+			synced:        true,
+		},
+		{
+			name:          "case3 cloud wins",
+			policy:        v1alpha2.TwinConflictPolicyCloudWins,
+			msgTwins:      cloudTwin("30", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 1}),
+			conflicts:     1,
+			expectedValue: "30",
+		},
+		{
+			name:          "case4 reject",
+			policy:        v1alpha2.TwinConflictPolicyReject,
+			msgTwins:      cloudTwin("30", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 1}),
+			conflicts:     1,
+			expectedValue: "20",
+		},
+		{
+			name:          "case5 the same value on both sides is not a conflict",
+			policy:        v1alpha2.TwinConflictPolicyReject,
+			msgTwins:      cloudTwin("20", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 1}),
+			expectedValue: "20",
+			synced:        true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			deviceconfig.Get().TwinConflictPolicy = c.policy
+			context := conflictContext()
+
+			result := DealMsgTwin(context, conflictDeviceID, c.msgTwins, SyncDealType)
+			assert.NoError(t, result.Err)
+			assert.Len(t, result.Conflicts, c.conflicts)
+			device, _ := context.GetDevice(conflictDeviceID)
+			assert.Equal(t, c.expectedValue, *device.Twin["setpoint"].Expected.Value)
+			_, synced := result.SyncResult["setpoint"]
+			assert.Equal(t, c.synced, synced)
+			if c.conflicts > 0 {
+				conflict := result.Conflicts[0]
+				assert.Equal(t, "20", *conflict.EdgeValue)
+				assert.Equal(t, "30", *conflict.CloudValue)
+				assert.Equal(t, string(twinConflictPolicy()), conflict.Resolution)
+			}
+		})
+	}
+}
+
+func TestDealDeviceTwinHistory(t *testing.T) {
+	originalTwinHistoryServiceFactory := TwinHistoryServiceFactory
+	defer func() {
+		TwinServiceFactory = originalTwinServiceFactory
+		TwinHistoryServiceFactory = originalTwinHistoryServiceFactory
+		deviceconfig.Get().TwinHistorySize = 0
+		deviceconfig.Get().TwinConflictPolicy = ""
+	}()
+	deviceconfig.Get().TwinHistorySize = 5
+	deviceconfig.Get().TwinConflictPolicy = v1alpha2.TwinConflictPolicyCloudWins
+
+	twinService := mocks.NewMockDeviceService()
+	TwinServiceFactory = func() interface {
+		DeviceTwinTrans(adds []models.DeviceTwin, deletes []models.DeviceDelete, updates []models.DeviceTwinUpdate) error
+		QueryDevice(key string, condition string) ([]models.Device, error)
+		QueryDeviceAttr(key, condition string) (*[]models.DeviceAttr, error)
+		QueryDeviceTwin(key, condition string) (*[]models.DeviceTwin, error)
+	} {
+		return twinService
+	}
+	var history []models.DeviceTwinHistory
+	var limit int
+	twinService.AddDeviceTwinHistoryFunc = func(h []models.DeviceTwinHistory, l int) error {
+		history = append(history, h...)
+		limit = l
+		return nil
+	}
+	TwinHistoryServiceFactory = func() interface {
+		AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error
+	} {
+		return twinService
+	}
+	var events []dttype.TwinConflict
+	patches := gomonkey.ApplyFunc(recordTwinConflictEvent, func(deviceID string, conflict dttype.TwinConflict) {
+		assert.Equal(t, conflictDeviceID, deviceID)
+		events = append(events, conflict)
+	})
+	defer patches.Reset()
+
+	context := conflictContext()
+	err := DealDeviceTwin(context, conflictDeviceID, event1,
+		cloudTwin("30", dttype.TwinVersion{CloudVersion: 2, EdgeVersion: 1}), SyncDealType)
+	assert.NoError(t, err)
+
+	assert.Len(t, events, 1)
+	assert.Equal(t, 5, limit)
+	assert.Equal(t, []models.DeviceTwinHistory{{
+		DeviceID:        conflictDeviceID,
+		Name:            "setpoint",
+		Expected:        "30",
+		ExpectedVersion: `{"cloud":2,"edge":1}`,
+		Source:          twinHistorySourceCloud,
+		Conflict:        true,
+		Timestamp:       history[0].Timestamp,
+	}}, history)
+}
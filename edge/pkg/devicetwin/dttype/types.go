@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmihistory"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
 )
@@ -305,9 +306,23 @@ type DealTwinResult struct {
 	Result     map[string]*MsgTwin
 	SyncResult map[string]*MsgTwin
 	Document   map[string]*TwinDoc
+	Conflicts  []TwinConflict
 	Err        error
 }
 
+// TwinConflict is a twin value updated on both the edge and the cloud since they last synced
+type TwinConflict struct {
+	Name string
+	// Field is the conflicting value of the twin, expected or actual
+	Field        string
+	EdgeValue    *string
+	CloudValue   *string
+	EdgeVersion  TwinVersion
+	CloudVersion TwinVersion
+	// Resolution is the conflict policy used to resolve the conflict
+	Resolution string
+}
+
 // DealAttrResult the result of dealing attr
 type DealAttrResult struct {
 	Add    []models.DeviceAttr
@@ -316,3 +331,39 @@ type DealAttrResult struct {
 	Result map[string]*MsgAttr
 	Err    error
 }
+
+// DeviceMethodCall the struct of calling a method of device
+type DeviceMethodCall struct {
+	MethodName string                 `json:"methodName,omitempty"`
+	Inputs     map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// DeviceMethodResult the struct of the result of calling a method of device
+type DeviceMethodResult struct {
+	Outputs map[string]interface{} `json:"outputs,omitempty"`
+	Code    int                    `json:"code,omitempty"`
+	Reason  string                 `json:"reason,omitempty"`
+}
+
+// DeviceHistoryQuery the struct of querying the history of device properties,
+// the times are in milliseconds and Step enables the downsampling if it's positive
+type DeviceHistoryQuery struct {
+	Property string `json:"property,omitempty"`
+	Start    int64  `json:"start,omitempty"`
+	End      int64  `json:"end,omitempty"`
+	Step     int64  `json:"step,omitempty"`
+}
+
+// PropertyHistory the struct of the history of a device property
+type PropertyHistory struct {
+	Name    string              `json:"name"`
+	Samples []dmihistory.Sample `json:"samples,omitempty"`
+	Buckets []dmihistory.Bucket `json:"buckets,omitempty"`
+}
+
+// DeviceHistoryResult the struct of the result of querying the history of device properties
+type DeviceHistoryResult struct {
+	Properties []PropertyHistory `json:"properties,omitempty"`
+	Code       int               `json:"code,omitempty"`
+	Reason     string            `json:"reason,omitempty"`
+}
@@ -2,20 +2,79 @@ package clients
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/quicclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/reconnect"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/wsclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 )
 
+var (
+	reconnectOnce sync.Once
+	// servers and backoff are kept across clients, so that the server health
+	// and the backoff survive reconnects
+	servers *reconnect.ServerPool
+	backoff *reconnect.Backoff
+)
+
+func initReconnect() {
+	reconnectOnce.Do(func() {
+		config := config.Config
+		if b := config.ReconnectBackoff; b != nil {
+			backoff = reconnect.NewBackoff(time.Duration(b.InitialInterval)*time.Second,
+				time.Duration(b.MaxInterval)*time.Second, b.Factor, b.Jitter)
+		}
+		switch {
+		case config.WebSocket.Enable:
+			var urls []string
+			for _, server := range config.WebSocketServers() {
+				urls = append(urls, configWebSocketURL(server))
+			}
+			servers = reconnect.NewServerPool(urls, config.ServerSelection)
+		case config.Quic.Enable:
+			servers = reconnect.NewServerPool(config.QuicServers(), config.ServerSelection)
+		}
+	})
+}
+
+func configWebSocketURL(server string) string {
+	return config.WebSocketURL(server, config.Config.ProjectID, config.Config.NodeName)
+}
+
+// GetBackoff returns the backoff between attempts to connect cloudHub,
+// it is nil if the reconnect backoff is not configured. It is only advanced
+// by EdgeHub between client initializations, so it applies to both websocket
+// and quic, and the retries inside a client do not advance it.
+func GetBackoff() *reconnect.Backoff {
+	initReconnect()
+	return backoff
+}
+
+// compressionOptions returns the compression offered to cloudHub, nil if it is disabled
+func compressionOptions() *compress.Options {
+	c := config.Config.Compression
+	if c == nil || !c.Enable {
+		return nil
+	}
+	opts := &compress.Options{Threshold: int(c.Threshold)}
+	for _, a := range c.Algorithms {
+		opts.Algorithms = append(opts.Algorithms, compress.Algorithm(a))
+	}
+	return opts
+}
+
 // GetClient returns an Adapter object with new web socket
 func GetClient() (Adapter, error) {
+	initReconnect()
 	config := config.Config
 	switch {
 	case config.WebSocket.Enable:
 		websocketConf := wsclient.WebSocketConfig{
 			URL:              config.WebSocketURL,
+			Servers:          servers,
 			CertFilePath:     config.TLSCertFile,
 			KeyFilePath:      config.TLSPrivateKeyFile,
 			HandshakeTimeout: time.Duration(config.WebSocket.HandshakeTimeout) * time.Second,
@@ -23,11 +82,13 @@ func GetClient() (Adapter, error) {
 			WriteDeadline:    time.Duration(config.WebSocket.WriteDeadline) * time.Second,
 			ProjectID:        config.ProjectID,
 			NodeID:           config.NodeName,
+			Compression:      compressionOptions(),
 		}
 		return wsclient.NewWebSocketClient(&websocketConf), nil
 	case config.Quic.Enable:
 		quicConfig := quicclient.QuicConfig{
 			Addr:             config.Quic.Server,
+			Servers:          servers,
 			CaFilePath:       config.TLSCAFile,
 			CertFilePath:     config.TLSCertFile,
 			KeyFilePath:      config.TLSPrivateKeyFile,
@@ -36,6 +97,7 @@ func GetClient() (Adapter, error) {
 			WriteDeadline:    time.Duration(config.Quic.WriteDeadline) * time.Second,
 			ProjectID:        config.ProjectID,
 			NodeID:           config.NodeName,
+			Compression:      compressionOptions(),
 		}
 		return quicclient.NewQuicClient(&quicConfig), nil
 	}
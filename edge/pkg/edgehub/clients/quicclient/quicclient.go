@@ -11,8 +11,10 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/reconnect"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	qclient "github.com/kubeedge/kubeedge/pkg/viaduct/pkg/client"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 )
 
@@ -24,7 +26,9 @@ type QuicClient struct {
 
 // QuicConfig config for quic
 type QuicConfig struct {
-	Addr             string
+	Addr string
+	// Servers selects the address to connect to, Addr is used if it is nil
+	Servers          *reconnect.ServerPool
 	CaFilePath       string
 	CertFilePath     string
 	KeyFilePath      string
@@ -33,6 +37,8 @@ type QuicConfig struct {
 	WriteDeadline    time.Duration
 	NodeID           string
 	ProjectID        string
+	// Compression is the message compression offered to cloudHub, nil if it is disabled
+	Compression *compress.Options
 }
 
 // NewQuicClient initializes a new quic client instance
@@ -62,11 +68,16 @@ func (qcc *QuicClient) Init() error {
 		InsecureSkipVerify: true,
 	}
 
+	addr := qcc.config.Addr
+	if qcc.config.Servers != nil {
+		addr = qcc.config.Servers.Next()
+	}
 	option := qclient.Options{
 		HandshakeTimeout: qcc.config.HandshakeTimeout,
 		TLSConfig:        tlsConfig,
 		Type:             api.ProtocolTypeQuic,
-		Addr:             qcc.config.Addr,
+		Addr:             addr,
+		Compression:      qcc.config.Compression,
 	}
 	exOpts := api.QuicClientOption{Header: make(http.Header)}
 	exOpts.Header.Set("node_id", qcc.config.NodeID)
@@ -74,11 +85,17 @@ func (qcc *QuicClient) Init() error {
 	client := qclient.NewQuicClient(option, exOpts)
 	connection, err := client.Connect()
 	if err != nil {
-		klog.Errorf("Init quic connection failed %s", err.Error())
+		klog.Errorf("Init quic connection to %s failed %s", addr, err.Error())
+		if qcc.config.Servers != nil {
+			qcc.config.Servers.MarkFailure(addr)
+		}
 		return err
 	}
 	qcc.client = connection
-	klog.Infof("Quic connect to cloud access successful")
+	if qcc.config.Servers != nil {
+		qcc.config.Servers.MarkSuccess(addr)
+	}
+	klog.Infof("Quic connect to cloud access %s successful", addr)
 
 	return nil
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconnect
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Backoff computes the wait time between reconnect attempts. The wait time is
+// multiplied by Factor after every attempt up to Max, and a random part of it
+// given by Jitter is subtracted, so that nodes disconnected at the same time
+// do not reconnect at the same time.
+type Backoff struct {
+	mu      sync.Mutex
+	initial time.Duration
+	max     time.Duration
+	factor  float64
+	jitter  float64
+	current time.Duration
+	rand    *rand.Rand
+}
+
+// NewBackoff returns a Backoff. Factor is at least 1, and jitter is the max
+// fraction of the wait time removed at random, in the range of [0, 1].
+func NewBackoff(initial, max time.Duration, factor, jitter float64) *Backoff {
+	if max < initial {
+		max = initial
+	}
+	if factor < 1 {
+		factor = 1
+	}
+	if jitter < 0 {
+		jitter = 0
+	} else if jitter > 1 {
+		jitter = 1
+	}
+	return &Backoff{
+		initial: initial,
+		max:     max,
+		factor:  factor,
+		jitter:  jitter,
+		// #nosec G404 -- jitter does not need a cryptographically secure random number
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Next returns the time to wait before the next attempt
+func (b *Backoff) Next() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current == 0 {
+		b.current = b.initial
+	} else {
+		b.current = time.Duration(float64(b.current) * b.factor)
+		if b.current > b.max || b.current <= 0 {
+			b.current = b.max
+		}
+	}
+	return b.current - time.Duration(b.jitter*b.rand.Float64()*float64(b.current))
+}
+
+// Reset starts the backoff over, it is called when a connection is established
+func (b *Backoff) Reset() {
+	b.mu.Lock()
+	b.current = 0
+	b.mu.Unlock()
+}
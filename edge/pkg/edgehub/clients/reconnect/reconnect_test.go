@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconnect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+)
+
+func TestBackoffWithoutJitter(t *testing.T) {
+	b := NewBackoff(time.Second, 5*time.Second, 2, 0)
+	var waits []time.Duration
+	for i := 0; i < 5; i++ {
+		waits = append(waits, b.Next())
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, waits)
+
+	b.Reset()
+	assert.Equal(t, time.Second, b.Next())
+}
+
+func TestBackoffJitter(t *testing.T) {
+	b := NewBackoff(10*time.Second, 10*time.Second, 2, 0.5)
+	for i := 0; i < 100; i++ {
+		wait := b.Next()
+		assert.True(t, wait > 5*time.Second && wait <= 10*time.Second, "unexpected wait time %s", wait)
+	}
+}
+
+func TestBackoffNormalize(t *testing.T) {
+	b := NewBackoff(2*time.Second, time.Second, 0, 2)
+	assert.Equal(t, 2*time.Second, b.max)
+	assert.Equal(t, float64(1), b.factor)
+	assert.Equal(t, float64(1), b.jitter)
+}
+
+func TestServerPoolOrdered(t *testing.T) {
+	now := time.Now()
+	p := NewServerPool([]string{"a", "b", "c"}, v1alpha2.ServerSelectionOrdered)
+	p.now = func() time.Time { return now }
+
+	assert.Equal(t, "a", p.Next())
+	p.MarkFailure("a")
+	assert.Equal(t, "b", p.Next())
+	p.MarkSuccess("b")
+	assert.Equal(t, "b", p.Next())
+
+	// the server connected last is preferred over the servers before it
+	now = now.Add(unhealthyPeriod + time.Second)
+	assert.Equal(t, "b", p.Next())
+
+	p.MarkFailure("b")
+	assert.Equal(t, "a", p.Next())
+}
+
+func TestServerPoolAllFailed(t *testing.T) {
+	now := time.Now()
+	p := NewServerPool([]string{"a", "b", "c"}, v1alpha2.ServerSelectionOrdered)
+	p.now = func() time.Time { return now }
+
+	for _, s := range []string{"b", "a", "c"} {
+		p.MarkFailure(s)
+		now = now.Add(time.Second)
+	}
+	assert.Equal(t, "b", p.Next())
+}
+
+func TestServerPoolRandom(t *testing.T) {
+	p := NewServerPool([]string{"a", "b", "c"}, v1alpha2.ServerSelectionRandom)
+	p.MarkFailure("b")
+
+	selected := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		selected[p.Next()] = true
+	}
+	assert.Equal(t, map[string]bool{"a": true, "c": true}, selected)
+
+	p.MarkSuccess("c")
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, "c", p.Next())
+	}
+}
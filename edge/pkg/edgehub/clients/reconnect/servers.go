@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconnect
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+)
+
+// unhealthyPeriod is how long a server is skipped after a failed connection
+const unhealthyPeriod = 5 * time.Minute
+
+// ServerPool selects the CloudHub server to connect to. It remembers the
+// servers which failed recently and skips them while other servers are
+// healthy, and it sticks to the last server connected successfully.
+type ServerPool struct {
+	mu         sync.Mutex
+	servers    []string
+	selection  v1alpha2.ServerSelection
+	failedAt   map[string]time.Time
+	lastActive string
+	rand       *rand.Rand
+	now        func() time.Time
+}
+
+// NewServerPool returns a ServerPool of servers, selection defaults to ordered
+func NewServerPool(servers []string, selection v1alpha2.ServerSelection) *ServerPool {
+	return &ServerPool{
+		servers:   servers,
+		selection: selection,
+		failedAt:  make(map[string]time.Time),
+		// #nosec G404 -- server selection does not need a cryptographically secure random number
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:  time.Now,
+	}
+}
+
+// Len returns the number of servers
+func (p *ServerPool) Len() int {
+	return len(p.servers)
+}
+
+// Next returns the server to connect to. When all servers failed recently,
+// the server failed the earliest is returned.
+func (p *ServerPool) Next() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.servers) == 0 {
+		return ""
+	}
+	if p.lastActive != "" && p.healthy(p.lastActive) {
+		return p.lastActive
+	}
+
+	var healthy []string
+	for _, s := range p.servers {
+		if p.healthy(s) {
+			healthy = append(healthy, s)
+		}
+	}
+	if len(healthy) == 0 {
+		earliest := p.servers[0]
+		for _, s := range p.servers[1:] {
+			if p.failedAt[s].Before(p.failedAt[earliest]) {
+				earliest = s
+			}
+		}
+		return earliest
+	}
+	if p.selection == v1alpha2.ServerSelectionRandom {
+		return healthy[p.rand.Intn(len(healthy))]
+	}
+	return healthy[0]
+}
+
+func (p *ServerPool) healthy(server string) bool {
+	failedAt, ok := p.failedAt[server]
+	return !ok || p.now().Sub(failedAt) > unhealthyPeriod
+}
+
+// MarkFailure records a failed connection to server
+func (p *ServerPool) MarkFailure(server string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failedAt[server] = p.now()
+	if p.lastActive == server {
+		p.lastActive = ""
+	}
+}
+
+// MarkSuccess records a successful connection to server
+func (p *ServerPool) MarkSuccess(server string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.failedAt, server)
+	p.lastActive = server
+}
@@ -12,9 +12,11 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/reconnect"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	wsclient "github.com/kubeedge/kubeedge/pkg/viaduct/pkg/client"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 )
 
@@ -31,7 +33,9 @@ type WebSocketClient struct {
 
 // WebSocketConfig config for websocket
 type WebSocketConfig struct {
-	URL              string
+	URL string
+	// Servers selects the url to connect to, URL is used if it is nil
+	Servers          *reconnect.ServerPool
 	CertFilePath     string
 	KeyFilePath      string
 	HandshakeTimeout time.Duration
@@ -39,6 +43,8 @@ type WebSocketConfig struct {
 	WriteDeadline    time.Duration
 	NodeID           string
 	ProjectID        string
+	// Compression is the message compression offered to cloudHub, nil if it is disabled
+	Compression *compress.Options
 }
 
 // NewWebSocketClient initializes a new websocket client instance
@@ -71,26 +77,37 @@ func (wsc *WebSocketClient) Init() error {
 		InsecureSkipVerify: false,
 	}
 
-	option := wsclient.Options{
-		HandshakeTimeout: wsc.config.HandshakeTimeout,
-		TLSConfig:        tlsConfig,
-		Type:             api.ProtocolTypeWS,
-		Addr:             wsc.config.URL,
-		AutoRoute:        false,
-		ConnUse:          api.UseTypeMessage,
-	}
 	exOpts := api.WSClientOption{Header: make(http.Header)}
 	exOpts.Header.Set("node_id", wsc.config.NodeID)
 	exOpts.Header.Set("project_id", wsc.config.ProjectID)
-	client := &wsclient.Client{Options: option, ExOpts: exOpts}
 
 	for i := 0; i < retryCount; i++ {
+		url := wsc.config.URL
+		if wsc.config.Servers != nil {
+			url = wsc.config.Servers.Next()
+		}
+		option := wsclient.Options{
+			HandshakeTimeout: wsc.config.HandshakeTimeout,
+			TLSConfig:        tlsConfig,
+			Type:             api.ProtocolTypeWS,
+			Addr:             url,
+			AutoRoute:        false,
+			ConnUse:          api.UseTypeMessage,
+			Compression:      wsc.config.Compression,
+		}
+		client := &wsclient.Client{Options: option, ExOpts: exOpts}
 		connection, err := client.Connect()
 		if err != nil {
-			klog.Errorf("Init websocket connection failed %s", err.Error())
+			klog.Errorf("Init websocket connection to %s failed %s", url, err.Error())
+			if wsc.config.Servers != nil {
+				wsc.config.Servers.MarkFailure(url)
+			}
 		} else {
 			wsc.connection = connection
-			klog.Infof("Websocket connect to cloud access successful")
+			if wsc.config.Servers != nil {
+				wsc.config.Servers.MarkSuccess(url)
+			}
+			klog.Infof("Websocket connect to cloud access %s successful", url)
 			return nil
 		}
 		time.Sleep(cloudAccessSleep)
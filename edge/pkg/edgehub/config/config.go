@@ -20,8 +20,23 @@ func InitConfigure(eh *v1alpha2.EdgeHub, nodeName string) {
 	once.Do(func() {
 		Config = Configure{
 			EdgeHub:      *eh,
-			WebSocketURL: strings.Join([]string{"wss:/", eh.WebSocket.Server, eh.ProjectID, nodeName, "events"}, "/"),
+			WebSocketURL: WebSocketURL(eh.WebSocket.Server, eh.ProjectID, nodeName),
 			NodeName:     nodeName,
 		}
 	})
 }
+
+// WebSocketURL returns the url of the websocket server (ip:port) for the node
+func WebSocketURL(server, projectID, nodeName string) string {
+	return strings.Join([]string{"wss:/", server, projectID, nodeName, "events"}, "/")
+}
+
+// WebSocketServers returns the websocket server addresses, the primary server first
+func (c *Configure) WebSocketServers() []string {
+	return append([]string{c.WebSocket.Server}, c.WebSocket.Servers...)
+}
+
+// QuicServers returns the quic server addresses, the primary server first
+func (c *Configure) QuicServers() []string {
+	return append([]string{c.Quic.Server}, c.Quic.Servers...)
+}
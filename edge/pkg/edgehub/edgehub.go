@@ -15,6 +15,8 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
 	msghandler "github.com/kubeedge/kubeedge/edge/pkg/edgehub/messagehandler"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/queue"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
 	"github.com/kubeedge/kubeedge/pkg/features"
 )
 
@@ -26,6 +28,8 @@ type EdgeHub struct {
 	rateLimiter   flowcontrol.RateLimiter
 	keeperLock    sync.RWMutex
 	enable        bool
+	// outbox is nil if the outbound queue is disabled
+	outbox *outbox
 }
 
 var _ core.Module = (*EdgeHub)(nil)
@@ -46,7 +50,7 @@ func newEdgeHub(enable bool) *EdgeHub {
 	NewCertSyncChannel()
 	return &EdgeHub{
 		enable:        enable,
-		reconnectChan: make(chan struct{}),
+		reconnectChan: make(chan struct{}, 1),
 		rateLimiter: flowcontrol.NewTokenBucketRateLimiter(
 			float32(config.Config.EdgeHub.MessageQPS),
 			int(config.Config.EdgeHub.MessageBurst)),
@@ -99,6 +103,11 @@ func (eh *EdgeHub) Start() {
 
 	go eh.ifRotationDone()
 
+	if q := config.Config.OutboundQueue; q != nil && q.Enable {
+		eh.outbox = newOutbox(queue.New(dbclient.NewOutboxService(), q.MaxMessages, time.Duration(q.MaxAge)*time.Second))
+		go eh.routeToCloudQueued()
+	}
+
 	for {
 		select {
 		case <-beehiveContext.Done():
@@ -112,29 +121,39 @@ func (eh *EdgeHub) Start() {
 			return
 		}
 
-		waitTime := time.Duration(config.Config.Heartbeat) * time.Second * 2
-
 		err = eh.chClient.Init()
 		if err != nil {
+			waitTime := eh.reconnectWait()
 			klog.Errorf("connection failed: %v, will reconnect after %s", err, waitTime.String())
 			time.Sleep(waitTime)
 			continue
 		}
+		if backoff := clients.GetBackoff(); backoff != nil {
+			backoff.Reset()
+		}
 		// execute hook func after connect
 		eh.pubConnectInfo(true)
 		go eh.routeToEdge()
-		go eh.routeToCloud()
+		if eh.outbox != nil {
+			go eh.drainOutbox()
+		} else {
+			go eh.routeToCloud()
+		}
 		go eh.keepalive()
 
 		// wait the stop signal
 		// stop authinfo manager/websocket connection
 		<-eh.reconnectChan
+		if eh.outbox != nil {
+			eh.outbox.setOffline()
+		}
 		eh.chClient.UnInit()
 
 		// execute hook fun after disconnect
 		eh.pubConnectInfo(false)
 
-		// sleep one period of heartbeat, then try to connect cloud hub again
+		// wait a while, then try to connect cloud hub again
+		waitTime := eh.reconnectWait()
 		klog.Warningf("connection is broken, will reconnect after %s", waitTime.String())
 		time.Sleep(waitTime)
 
@@ -149,3 +168,21 @@ func (eh *EdgeHub) Start() {
 		}
 	}
 }
+
+// reconnect notifies Start to reconnect to cloud hub, it never blocks because
+// the goroutines of a broken connection may notify at the same time
+func (eh *EdgeHub) reconnect() {
+	select {
+	case eh.reconnectChan <- struct{}{}:
+	default:
+	}
+}
+
+// reconnectWait returns the time to wait before connecting cloud hub again,
+// it is two periods of heartbeat if the reconnect backoff is not configured
+func (eh *EdgeHub) reconnectWait() time.Duration {
+	if backoff := clients.GetBackoff(); backoff != nil {
+		return backoff.Next()
+	}
+	return time.Duration(config.Config.Heartbeat) * time.Second * 2
+}
@@ -18,8 +18,13 @@ package edgehub
 
 import (
 	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
 
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/reconnect"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
 )
 
@@ -117,3 +122,27 @@ func TestEnable(t *testing.T) {
 		})
 	}
 }
+
+func TestReconnectWait(t *testing.T) {
+	eh := &EdgeHub{}
+
+	t.Run("backoff is not configured", func(t *testing.T) {
+		patches := gomonkey.ApplyFuncReturn(clients.GetBackoff, (*reconnect.Backoff)(nil))
+		defer patches.Reset()
+		config.Config.Heartbeat = 15
+		if got := eh.reconnectWait(); got != 30*time.Second {
+			t.Errorf("reconnectWait() = %v, want %v", got, 30*time.Second)
+		}
+	})
+
+	t.Run("backoff is advanced once per reconnect", func(t *testing.T) {
+		backoff := reconnect.NewBackoff(time.Second, 4*time.Second, 2, 0)
+		patches := gomonkey.ApplyFuncReturn(clients.GetBackoff, backoff)
+		defer patches.Reset()
+		for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+			if got := eh.reconnectWait(); got != want {
+				t.Errorf("reconnectWait() = %v, want %v", got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgehub
+
+import (
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/queue"
+)
+
+// drainBatchSize is the number of queued messages read at a time while draining
+const drainBatchSize = 100
+
+// outbox sends upstream messages to the cloud. Messages are kept in the
+// outbound queue while the connection is broken, and until the messages
+// queued before them are sent, so that they reach the cloud in order.
+type outbox struct {
+	// mu guards online, it is held while a message is sent directly so that
+	// the drain can not switch to online in the middle of a send
+	mu     sync.Mutex
+	online bool
+	// drainLock makes sure only one drain runs at a time
+	drainLock sync.Mutex
+	queue     *queue.Queue
+}
+
+func newOutbox(q *queue.Queue) *outbox {
+	return &outbox{queue: q}
+}
+
+// send sends msg directly when the outbox is online, otherwise it is queued.
+// An error is returned only if the direct send failed, the outbox then goes
+// offline and msg is queued.
+func (o *outbox) send(msg model.Message, sendFunc func(model.Message) error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.online {
+		err := sendFunc(msg)
+		if err == nil {
+			return nil
+		}
+		o.online = false
+		o.push(msg)
+		return err
+	}
+	o.push(msg)
+	return nil
+}
+
+func (o *outbox) push(msg model.Message) {
+	// the sender of a sync message waits for the response with a timeout,
+	// a late response would be discarded anyway
+	if msg.IsSync() {
+		klog.Warningf("drop sync message %s, the connection to cloud is broken", msg.GetID())
+		return
+	}
+	if err := o.queue.Push(msg); err != nil {
+		klog.Errorf("failed to queue message %s: %v", msg.GetID(), err)
+	}
+}
+
+// setOffline makes following messages queued
+func (o *outbox) setOffline() {
+	o.mu.Lock()
+	o.online = false
+	o.mu.Unlock()
+}
+
+// drain sends the queued messages in order, and switches the outbox to
+// online when the queue is empty. A message is removed from the queue only
+// after it is sent, so it may be sent again if the connection breaks. The
+// returned error is the error of sending a message.
+func (o *outbox) drain(sendFunc func(model.Message) error) error {
+	o.drainLock.Lock()
+	defer o.drainLock.Unlock()
+	for {
+		select {
+		case <-beehiveContext.Done():
+			return nil
+		default:
+		}
+
+		o.mu.Lock()
+		items, err := o.queue.Peek(drainBatchSize)
+		if err != nil {
+			// do not block the upstream messages on a broken queue
+			klog.Errorf("failed to read the outbound queue, send messages directly: %v", err)
+		}
+		if err != nil || len(items) == 0 {
+			o.online = true
+			o.mu.Unlock()
+			return nil
+		}
+		o.mu.Unlock()
+
+		klog.V(4).Infof("send %d queued messages to cloud", len(items))
+		for _, item := range items {
+			if err := sendFunc(item.Message); err != nil {
+				return err
+			}
+			if err := o.queue.Remove(item.ID); err != nil {
+				klog.Errorf("failed to remove message %s from the outbound queue: %v", item.Message.GetID(), err)
+			}
+		}
+	}
+}
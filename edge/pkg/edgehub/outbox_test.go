@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgehub
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/queue"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+type fakeCloud struct {
+	broken bool
+	sent   []string
+}
+
+func (c *fakeCloud) send(msg model.Message) error {
+	if c.broken {
+		return errors.New("connection is broken")
+	}
+	c.sent = append(c.sent, msg.GetID())
+	return nil
+}
+
+func newTestOutbox(t *testing.T) *outbox {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&models.OutboundMessage{}))
+	patches := gomonkey.ApplyFuncReturn(dao.GetDB, db)
+	defer patches.Reset()
+	return newOutbox(queue.New(dbclient.NewOutboxService(), 0, 0))
+}
+
+func newUpstreamMessage() model.Message {
+	return *model.NewMessage("").BuildRouter(modules.EdgedModuleName, modules.MetaGroup,
+		"default/event/e1", model.InsertOperation).FillBody("event")
+}
+
+func TestOutbox(t *testing.T) {
+	o := newTestOutbox(t)
+	cloud := &fakeCloud{}
+
+	// messages are queued until the first drain
+	m1 := newUpstreamMessage()
+	require.NoError(t, o.send(m1, cloud.send))
+	assert.Empty(t, cloud.sent)
+
+	require.NoError(t, o.drain(cloud.send))
+	assert.Equal(t, []string{m1.GetID()}, cloud.sent)
+
+	// messages are sent directly when online
+	m2 := newUpstreamMessage()
+	require.NoError(t, o.send(m2, cloud.send))
+	assert.Equal(t, []string{m1.GetID(), m2.GetID()}, cloud.sent)
+
+	// a failed message is queued and following messages are queued after it
+	cloud.broken = true
+	m3 := newUpstreamMessage()
+	assert.Error(t, o.send(m3, cloud.send))
+	m4 := newUpstreamMessage()
+	require.NoError(t, o.send(m4, cloud.send))
+
+	// sync messages are not queued
+	m5 := newUpstreamMessage()
+	m5.Header.Sync = true
+	require.NoError(t, o.send(m5, cloud.send))
+
+	assert.Error(t, o.drain(cloud.send))
+	cloud.broken = false
+	require.NoError(t, o.drain(cloud.send))
+	assert.Equal(t, []string{m1.GetID(), m2.GetID(), m3.GetID(), m4.GetID()}, cloud.sent)
+
+	m6 := newUpstreamMessage()
+	require.NoError(t, o.send(m6, cloud.send))
+	assert.Equal(t, m6.GetID(), cloud.sent[len(cloud.sent)-1])
+
+	// messages are queued again once the connection is broken
+	o.setOffline()
+	require.NoError(t, o.send(newUpstreamMessage(), cloud.send))
+	assert.Len(t, cloud.sent, 5)
+}
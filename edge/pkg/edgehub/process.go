@@ -50,7 +50,7 @@ func (eh *EdgeHub) routeToEdge() {
 		message, err := eh.chClient.Receive()
 		if err != nil {
 			klog.Errorf("websocket read error: %v", err)
-			eh.reconnectChan <- struct{}{}
+			eh.reconnect()
 			return
 		}
 		klog.V(4).Infof("[edgehub/routeToEdge] receive msg from cloud, msg: %+v", message)
@@ -97,12 +97,52 @@ func (eh *EdgeHub) routeToCloud() {
 		err = eh.sendToCloud(message)
 		if err != nil {
 			klog.Errorf("failed to send message to cloud: %v", err)
-			eh.reconnectChan <- struct{}{}
+			eh.reconnect()
 			return
 		}
 	}
 }
 
+// routeToCloudQueued routes upstream messages through the outbox, it keeps
+// receiving messages while the connection is broken
+func (eh *EdgeHub) routeToCloudQueued() {
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Warning("EdgeHub RouteToCloud stop")
+			return
+		default:
+		}
+		message, err := beehiveContext.Receive(modules.EdgeHubModuleName)
+		if err != nil {
+			klog.Errorf("failed to receive message from edge: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		err = eh.outbox.send(message, eh.throttledSendToCloud)
+		if err != nil {
+			klog.Errorf("failed to send message to cloud: %v", err)
+			eh.reconnect()
+		}
+	}
+}
+
+// drainOutbox sends the messages queued while the connection was broken
+func (eh *EdgeHub) drainOutbox() {
+	if err := eh.outbox.drain(eh.throttledSendToCloud); err != nil {
+		klog.Errorf("failed to send queued message to cloud: %v", err)
+		eh.reconnect()
+	}
+}
+
+func (eh *EdgeHub) throttledSendToCloud(message model.Message) error {
+	if err := eh.tryThrottle(message.GetID()); err != nil {
+		klog.Errorf("msgID: %s, client rate limiter returned an error: %v ", message.GetID(), err)
+	}
+	return eh.sendToCloud(message)
+}
+
 func (eh *EdgeHub) keepalive() {
 	for {
 		select {
@@ -119,7 +159,7 @@ func (eh *EdgeHub) keepalive() {
 		err := eh.sendToCloud(*msg)
 		if err != nil {
 			klog.Errorf("websocket write error: %v", err)
-			eh.reconnectChan <- struct{}{}
+			eh.reconnect()
 			return
 		}
 
@@ -150,7 +190,7 @@ func (eh *EdgeHub) ifRotationDone() {
 	if eh.certManager.RotateCertificates {
 		for {
 			<-eh.certManager.Done
-			eh.reconnectChan <- struct{}{}
+			eh.reconnect()
 		}
 	}
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kubeedge/beehive/pkg/common"
@@ -242,6 +243,17 @@ func TestRouteToEdge(t *testing.T) {
 	}
 }
 
+// TestReconnect() tests that the reconnect notifications never block and are not queued up
+func TestReconnect(t *testing.T) {
+	hub := newEdgeHub(true)
+	hub.reconnect()
+	hub.reconnect()
+	assert.Len(t, hub.reconnectChan, 1)
+	<-hub.reconnectChan
+	hub.reconnect()
+	assert.Len(t, hub.reconnectChan, 1)
+}
+
 // TestSendToCloud() tests whether the send to cloud functionality works properly
 func TestSendToCloud(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
@@ -357,7 +369,7 @@ func TestKeepalive(t *testing.T) {
 			name: "Heartbeat failure Case",
 			hub: &EdgeHub{
 				chClient:      mockAdapter,
-				reconnectChan: make(chan struct{}),
+				reconnectChan: make(chan struct{}, 1),
 			},
 		},
 	}
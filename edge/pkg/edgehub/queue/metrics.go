@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	metricsSubsystem = "edgehub"
+
+	dropReasonCoalesced = "coalesced"
+	dropReasonExpired   = "expired"
+	dropReasonOverflow  = "overflow"
+)
+
+var (
+	queueDepth = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "outbound_queue_depth",
+			Help:           "Number of upstream messages queued while the connection to the cloud is broken",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	droppedMessages = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "outbound_queue_dropped_messages_total",
+			Help:           "Number of queued upstream messages dropped before they are sent, by reason",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+)
+
+var registerOnce sync.Once
+
+// registerMetrics registers the queue metrics, they are served by the edged metrics endpoint
+func registerMetrics() {
+	registerOnce.Do(func() {
+		legacyregistry.MustRegister(queueDepth)
+		legacyregistry.MustRegister(droppedMessages)
+	})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+// coalescedResourceTypes are the resource types of which a message carries the
+// full state of the resource, only the latest message of a resource is kept.
+var coalescedResourceTypes = map[string]bool{
+	model.ResourceTypeNodeStatus: true,
+	model.ResourceTypePodStatus:  true,
+}
+
+// Item is a message stored in the queue
+type Item struct {
+	ID      uint64
+	Message model.Message
+}
+
+// storedMessage is the persisted form of a message. The content is kept as
+// the raw bytes sent to the cloud, so it does not depend on the content type.
+type storedMessage struct {
+	Header  model.MessageHeader `json:"header"`
+	Router  model.MessageRoute  `json:"route"`
+	Content []byte              `json:"content,omitempty"`
+}
+
+// Queue is a disk-backed FIFO queue of upstream messages stored in the edgecore database
+type Queue struct {
+	// mu serializes the read-modify-write sequences on the store
+	mu          sync.Mutex
+	store       dbclient.OutboxStore
+	maxMessages int64
+	maxAge      time.Duration
+	now         func() time.Time
+}
+
+// New returns a queue kept in store. A zero maxMessages or maxAge disables the limit.
+func New(store dbclient.OutboxStore, maxMessages int32, maxAge time.Duration) *Queue {
+	q := &Queue{
+		store:       store,
+		maxMessages: int64(maxMessages),
+		maxAge:      maxAge,
+		now:         time.Now,
+	}
+	registerMetrics()
+	if n, err := q.Len(); err == nil {
+		queueDepth.Set(float64(n))
+	}
+	return q
+}
+
+// coalesceKey returns the key of messages which replace each other, it is
+// empty if the message must not be coalesced.
+func coalesceKey(msg *model.Message) string {
+	op := msg.GetOperation()
+	if op != model.UpdateOperation && op != model.PatchOperation {
+		return ""
+	}
+	// resource is in the form of namespace/resourceType/name
+	parts := strings.Split(msg.GetResource(), "/")
+	if len(parts) < 2 || !coalescedResourceTypes[parts[1]] {
+		return ""
+	}
+	return op + ":" + msg.GetResource()
+}
+
+// Push appends a message to the queue. A previously queued message with the
+// same coalesce key is replaced, and the oldest messages are dropped when
+// the queue is full.
+func (q *Queue) Push(msg model.Message) error {
+	content, err := msg.GetContentData()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(storedMessage{Header: msg.Header, Router: msg.Router, Content: content})
+	if err != nil {
+		return fmt.Errorf("failed to marshal message %s: %v", msg.GetID(), err)
+	}
+	key := coalesceKey(&msg)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	replaced, err := q.store.InsertOutboundMessage(&models.OutboundMessage{
+		CoalesceKey: key,
+		Message:     data,
+		CreatedAt:   q.now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue message %s: %v", msg.GetID(), err)
+	}
+	if replaced > 0 {
+		droppedMessages.WithLabelValues(dropReasonCoalesced).Add(float64(replaced))
+	}
+	return q.enforceLimits()
+}
+
+// Peek returns at most n messages from the head of the queue without removing them
+func (q *Queue) Peek(n int) ([]Item, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.enforceLimits(); err != nil {
+		return nil, err
+	}
+
+	entries, err := q.store.QueryOutboundMessages(n)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Item, 0, len(entries))
+	for _, e := range entries {
+		var stored storedMessage
+		if err := json.Unmarshal(e.Message, &stored); err != nil {
+			// a corrupted message can never be sent, drop it
+			klog.Errorf("failed to unmarshal queued message %d, drop it: %v", e.ID, err)
+			if err := q.remove(e.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		items = append(items, Item{
+			ID: e.ID,
+			Message: model.Message{
+				Header:  stored.Header,
+				Router:  stored.Router,
+				Content: stored.Content,
+			},
+		})
+	}
+	return items, nil
+}
+
+// Remove removes a message from the queue
+func (q *Queue) Remove(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.remove(id)
+}
+
+func (q *Queue) remove(id uint64) error {
+	if err := q.store.DeleteOutboundMessage(id); err != nil {
+		return err
+	}
+	queueDepth.Dec()
+	return nil
+}
+
+// Len returns the number of queued messages
+func (q *Queue) Len() (int64, error) {
+	return q.store.CountOutboundMessages()
+}
+
+// enforceLimits drops the expired messages and the oldest messages exceeding
+// the max number of messages, and refreshes the depth metric.
+func (q *Queue) enforceLimits() error {
+	if q.maxAge > 0 {
+		expired, err := q.store.DeleteOutboundMessagesBefore(q.now().Add(-q.maxAge).Unix())
+		if err != nil {
+			return err
+		}
+		if expired > 0 {
+			klog.Warningf("drop %d expired messages from the outbound queue", expired)
+			droppedMessages.WithLabelValues(dropReasonExpired).Add(float64(expired))
+		}
+	}
+
+	n, err := q.Len()
+	if err != nil {
+		return err
+	}
+	if q.maxMessages > 0 && n > q.maxMessages {
+		dropped, err := q.store.DeleteOldestOutboundMessages(n - q.maxMessages)
+		if err != nil {
+			return err
+		}
+		klog.Warningf("outbound queue is full, drop %d oldest messages", dropped)
+		droppedMessages.WithLabelValues(dropReasonOverflow).Add(float64(dropped))
+		n -= dropped
+	}
+	queueDepth.Set(float64(n))
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"k8s.io/component-base/metrics/testutil"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+func newTestQueue(t *testing.T, maxMessages int32, maxAge time.Duration) *Queue {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	// every connection opens a new in-memory database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&models.OutboundMessage{}))
+	return New(newTestStore(db), maxMessages, maxAge)
+}
+
+// newTestStore returns the OutboxStore on db
+func newTestStore(db *gorm.DB) dbclient.OutboxStore {
+	patches := gomonkey.ApplyFuncReturn(dao.GetDB, db)
+	defer patches.Reset()
+	return dbclient.NewOutboxService()
+}
+
+func newMessage(resource, operation string, content interface{}) model.Message {
+	return *model.NewMessage("").BuildRouter("edged", "meta", resource, operation).FillBody(content)
+}
+
+func messageIDs(items []Item) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Message.GetID())
+	}
+	return ids
+}
+
+func TestPushPeekRemove(t *testing.T) {
+	q := newTestQueue(t, 0, 0)
+
+	msg := newMessage("default/event/e1", model.InsertOperation, map[string]string{"reason": "Started"})
+	require.NoError(t, q.Push(msg))
+	require.NoError(t, q.Push(newMessage("default/event/e2", model.InsertOperation, "raw")))
+
+	items, err := q.Peek(10)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, msg.Header, items[0].Message.Header)
+	assert.Equal(t, msg.Router, items[0].Message.Router)
+	assert.Equal(t, []byte(`{"reason":"Started"}`), items[0].Message.Content)
+	assert.Equal(t, []byte("raw"), items[1].Message.Content)
+	depth, err := testutil.GetGaugeMetricValue(queueDepth)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), depth)
+
+	require.NoError(t, q.Remove(items[0].ID))
+	items, err = q.Peek(10)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	n, err := q.Len()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
+
+func TestPushCoalesce(t *testing.T) {
+	q := newTestQueue(t, 0, 0)
+
+	status1 := newMessage("default/nodestatus/node1", model.UpdateOperation, "1")
+	event := newMessage("default/event/e1", model.InsertOperation, "event")
+	status2 := newMessage("default/nodestatus/node1", model.UpdateOperation, "2")
+	podStatus := newMessage("default/podstatus/pod1", model.UpdateOperation, "pod")
+	for _, msg := range []model.Message{status1, event, status2, podStatus} {
+		require.NoError(t, q.Push(msg))
+	}
+
+	items, err := q.Peek(10)
+	require.NoError(t, err)
+	// the latest node status takes the position of the newest message
+	assert.Equal(t, []string{event.GetID(), status2.GetID(), podStatus.GetID()}, messageIDs(items))
+}
+
+func TestPushMaxMessages(t *testing.T) {
+	q := newTestQueue(t, 2, 0)
+
+	var msgs []model.Message
+	for i := 0; i < 3; i++ {
+		msg := newMessage("default/event/e", model.InsertOperation, "event")
+		msgs = append(msgs, msg)
+		require.NoError(t, q.Push(msg))
+	}
+
+	items, err := q.Peek(10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{msgs[1].GetID(), msgs[2].GetID()}, messageIDs(items))
+}
+
+func TestPeekMaxAge(t *testing.T) {
+	q := newTestQueue(t, 0, time.Minute)
+	now := time.Now()
+	q.now = func() time.Time { return now }
+
+	old := newMessage("default/event/old", model.InsertOperation, "old")
+	require.NoError(t, q.Push(old))
+	now = now.Add(45 * time.Second)
+	recent := newMessage("default/event/recent", model.InsertOperation, "recent")
+	require.NoError(t, q.Push(recent))
+
+	now = now.Add(30 * time.Second)
+	items, err := q.Peek(10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{recent.GetID()}, messageIDs(items))
+}
+
+func TestCoalesceKey(t *testing.T) {
+	cases := []struct {
+		name     string
+		msg      model.Message
+		expected string
+	}{
+		{"node status update", newMessage("default/nodestatus/node1", model.UpdateOperation, nil), "update:default/nodestatus/node1"},
+		{"pod status patch", newMessage("default/podstatus/pod1", model.PatchOperation, nil), "patch:default/podstatus/pod1"},
+		{"event insert", newMessage("default/event/e1", model.InsertOperation, nil), ""},
+		{"node patch", newMessage("default/nodepatch/node1", model.PatchOperation, nil), ""},
+		{"short resource", newMessage("node1", model.UpdateOperation, nil), ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, coalesceKey(&c.msg))
+		})
+	}
+}
@@ -24,7 +24,7 @@ var mqttServer *mqttBus.Server
 // eventbus struct
 type eventbus struct {
 	enable bool
-	ebs    *dbclient.EventBusService
+	ebs    dbclient.EventBusStore
 }
 
 var _ core.Module = (*eventbus)(nil)
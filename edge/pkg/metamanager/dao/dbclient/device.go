@@ -28,7 +28,11 @@ type DeviceService struct {
 	db *gorm.DB
 }
 
-func NewDeviceService() *DeviceService {
+// NewDeviceService returns the DeviceStore of the database driver
+func NewDeviceService() DeviceStore {
+	if store := dao.GetKV(); store != nil {
+		return &kvDeviceService{store: store}
+	}
 	return &DeviceService{db: dao.GetDB()}
 }
 
@@ -152,6 +156,12 @@ func (s *DeviceService) DeleteDeviceTrans(deletes []string) error {
 			klog.Errorf("Failed to delete DeviceTwin by deviceID: %v", err)
 			return err
 		}
+
+		if err := tx.Where("deviceid = ?", id).Delete(&models.DeviceTwinHistory{}).Error; err != nil {
+			tx.Rollback()
+			klog.Errorf("Failed to delete DeviceTwinHistory by deviceID: %v", err)
+			return err
+		}
 	}
 
 	return tx.Commit().Error
@@ -291,6 +301,9 @@ func (s *DeviceService) DeviceTwinTrans(adds []models.DeviceTwin, deletes []mode
 			if err := tx.Where("deviceid = ? AND name = ?", del.DeviceID, del.Name).Delete(&models.DeviceTwin{}).Error; err != nil {
 				return err
 			}
+			if err := tx.Where("deviceid = ? AND name = ?", del.DeviceID, del.Name).Delete(&models.DeviceTwinHistory{}).Error; err != nil {
+				return err
+			}
 		}
 		for _, upd := range updates {
 			if err := tx.Model(&models.DeviceTwin{}).
@@ -302,3 +315,34 @@ func (s *DeviceService) DeviceTwinTrans(adds []models.DeviceTwin, deletes []mode
 		return nil
 	})
 }
+
+// AddDeviceTwinHistory adds the states of the twins, only the newest limit states of each twin are kept
+func (s *DeviceService) AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for i := range history {
+			if err := tx.Create(&history[i]).Error; err != nil {
+				klog.Errorf("Failed to insert DeviceTwinHistory: %v", err)
+				return err
+			}
+		}
+		for _, h := range history {
+			newest := tx.Model(&models.DeviceTwinHistory{}).Select("id").
+				Where("deviceid = ? AND name = ?", h.DeviceID, h.Name).Order("id desc").Limit(limit)
+			if err := tx.Where("deviceid = ? AND name = ? AND id NOT IN (?)", h.DeviceID, h.Name, newest).
+				Delete(&models.DeviceTwinHistory{}).Error; err != nil {
+				klog.Errorf("Failed to trim DeviceTwinHistory: %v", err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// QueryDeviceTwinHistory returns the states of a twin, the oldest first
+func (s *DeviceService) QueryDeviceTwinHistory(deviceID, name string) ([]models.DeviceTwinHistory, error) {
+	var history []models.DeviceTwinHistory
+	if err := s.db.Where("deviceid = ? AND name = ?", deviceID, name).Order("id").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -30,7 +30,11 @@ type EventBusService struct {
 	db *gorm.DB
 }
 
-func NewEventBusService() *EventBusService {
+// NewEventBusService returns the EventBusStore of the database driver
+func NewEventBusService() EventBusStore {
+	if store := dao.GetKV(); store != nil {
+		return &kvEventBusService{store: store}
+	}
 	return &EventBusService{db: dao.GetDB()}
 }
 
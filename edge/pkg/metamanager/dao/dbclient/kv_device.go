@@ -0,0 +1,386 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+var (
+	deviceTable = kvTable[models.Device]{
+		bucket: models.DeviceTableName,
+		key:    func(d *models.Device) string { return d.ID },
+	}
+
+	// the attributes and twins are keyed by device and name, an attribute or
+	// twin saved twice replaces the previous one
+	deviceAttrTable = kvTable[models.DeviceAttr]{
+		bucket: models.DeviceAttrTableName,
+		key:    func(a *models.DeviceAttr) string { return a.DeviceID + "/" + a.Name },
+	}
+	deviceTwinTable = kvTable[models.DeviceTwin]{
+		bucket: models.DeviceTwinTableName,
+		key:    func(t *models.DeviceTwin) string { return t.DeviceID + "/" + t.Name },
+	}
+
+	// the ids are padded so that the states of a twin are stored in order
+	deviceTwinHistoryTable = kvTable[models.DeviceTwinHistory]{
+		bucket: models.DeviceTwinHistoryTableName,
+		key: func(h *models.DeviceTwinHistory) string {
+			return fmt.Sprintf("%s/%s/%020d", h.DeviceID, h.Name, h.ID)
+		},
+	}
+)
+
+// kvDeviceService is the DeviceStore of the kv driver
+type kvDeviceService struct {
+	store kv.Store
+}
+
+var _ DeviceStore = &kvDeviceService{}
+
+func (s *kvDeviceService) SaveDevice(doc *models.Device) error {
+	err := s.store.Update(func(tx kv.Tx) error {
+		return deviceTable.create(tx, doc)
+	})
+	if err != nil {
+		klog.Errorf("Failed to insert Device data: %v", err)
+	}
+	return err
+}
+
+func (s *kvDeviceService) DeleteDeviceByID(id string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return deviceTable.delete(tx, id)
+	})
+}
+
+func (s *kvDeviceService) UpdateDeviceField(deviceID string, col string, value interface{}) error {
+	return s.UpdateDeviceFields(deviceID, map[string]interface{}{col: value})
+}
+
+func (s *kvDeviceService) UpdateDeviceFields(deviceID string, cols map[string]interface{}) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return updateDevice(tx, deviceID, cols)
+	})
+}
+
+func updateDevice(tx kv.Tx, deviceID string, cols map[string]interface{}) error {
+	_, err := deviceTable.update(tx, func(d *models.Device) bool { return d.ID == deviceID }, cols)
+	return err
+}
+
+func (s *kvDeviceService) QueryDevice(key string, condition string) ([]models.Device, error) {
+	var devices []models.Device
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		devices, err = deviceTable.where(tx, key, condition)
+		return err
+	})
+	if err != nil {
+		klog.Errorf("Failed to query device: %v", err)
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (s *kvDeviceService) QueryDeviceAll() ([]models.Device, error) {
+	var devices []models.Device
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		devices, err = deviceTable.list(tx, nil)
+		return err
+	})
+	if err != nil {
+		klog.Errorf("Failed to query all devices: %v", err)
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (s *kvDeviceService) UpdateDeviceMulti(updates []models.DeviceUpdate) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for _, update := range updates {
+			if err := updateDevice(tx, update.DeviceID, update.Cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) AddDeviceTrans(adds []models.Device, addAttrs []models.DeviceAttr, addTwins []models.DeviceTwin) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for i := range adds {
+			if err := deviceTable.create(tx, &adds[i]); err != nil {
+				klog.Errorf("Failed to save device: %v", err)
+				return err
+			}
+		}
+		for i := range addAttrs {
+			if err := deviceAttrTable.put(tx, &addAttrs[i]); err != nil {
+				klog.Errorf("Failed to save device attr: %v", err)
+				return err
+			}
+		}
+		for i := range addTwins {
+			if err := deviceTwinTable.put(tx, &addTwins[i]); err != nil {
+				klog.Errorf("Failed to save device twin: %v", err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) DeleteDeviceTrans(deletes []string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for _, id := range deletes {
+			if err := deviceTable.delete(tx, id); err != nil {
+				return err
+			}
+			if _, err := deviceAttrTable.deleteWhere(tx, id+"/", func(a *models.DeviceAttr) bool { return a.DeviceID == id }); err != nil {
+				klog.Errorf("Failed to delete DeviceAttr by deviceID: %v", err)
+				return err
+			}
+			if _, err := deviceTwinTable.deleteWhere(tx, id+"/", func(t *models.DeviceTwin) bool { return t.DeviceID == id }); err != nil {
+				klog.Errorf("Failed to delete DeviceTwin by deviceID: %v", err)
+				return err
+			}
+			if _, err := deviceTwinHistoryTable.deleteWhere(tx, id+"/", func(h *models.DeviceTwinHistory) bool { return h.DeviceID == id }); err != nil {
+				klog.Errorf("Failed to delete DeviceTwinHistory by deviceID: %v", err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) SaveDeviceAttr(doc *models.DeviceAttr) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return deviceAttrTable.put(tx, doc)
+	})
+}
+
+func (s *kvDeviceService) DeleteDeviceAttr(deviceID string, name string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return deviceAttrTable.delete(tx, deviceID+"/"+name)
+	})
+}
+
+func (s *kvDeviceService) UpdateDeviceAttrField(deviceID, name, col string, value interface{}) error {
+	return s.UpdateDeviceAttrFields(deviceID, name, map[string]interface{}{col: value})
+}
+
+func (s *kvDeviceService) UpdateDeviceAttrFields(deviceID, name string, cols map[string]interface{}) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return updateDeviceAttr(tx, deviceID, name, cols)
+	})
+}
+
+func updateDeviceAttr(tx kv.Tx, deviceID, name string, cols map[string]interface{}) error {
+	_, err := deviceAttrTable.update(tx, func(a *models.DeviceAttr) bool {
+		return a.DeviceID == deviceID && a.Name == name
+	}, cols)
+	return err
+}
+
+func (s *kvDeviceService) QueryDeviceAttr(key, condition string) (*[]models.DeviceAttr, error) {
+	var attrs []models.DeviceAttr
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		attrs, err = deviceAttrTable.where(tx, key, condition)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &attrs, nil
+}
+
+func (s *kvDeviceService) UpdateDeviceAttrMulti(updates []models.DeviceAttrUpdate) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for _, update := range updates {
+			if err := updateDeviceAttr(tx, update.DeviceID, update.Name, update.Cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) DeviceAttrTrans(adds []models.DeviceAttr, deletes []models.DeviceDelete, updates []models.DeviceAttrUpdate) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for i := range adds {
+			if err := deviceAttrTable.put(tx, &adds[i]); err != nil {
+				return err
+			}
+		}
+		for _, del := range deletes {
+			if err := deviceAttrTable.delete(tx, del.DeviceID+"/"+del.Name); err != nil {
+				return err
+			}
+		}
+		for _, upd := range updates {
+			if err := updateDeviceAttr(tx, upd.DeviceID, upd.Name, upd.Cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) SaveDeviceTwin(doc *models.DeviceTwin) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return deviceTwinTable.put(tx, doc)
+	})
+}
+
+func (s *kvDeviceService) DeleteDeviceTwin(deviceID, name string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return deviceTwinTable.delete(tx, deviceID+"/"+name)
+	})
+}
+
+func (s *kvDeviceService) UpdateDeviceTwinField(deviceID, name, col string, value interface{}) error {
+	return s.UpdateDeviceTwinFields(deviceID, name, map[string]interface{}{col: value})
+}
+
+func (s *kvDeviceService) UpdateDeviceTwinFields(deviceID, name string, cols map[string]interface{}) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return updateDeviceTwin(tx, deviceID, name, cols)
+	})
+}
+
+func updateDeviceTwin(tx kv.Tx, deviceID, name string, cols map[string]interface{}) error {
+	_, err := deviceTwinTable.update(tx, func(t *models.DeviceTwin) bool {
+		return t.DeviceID == deviceID && t.Name == name
+	}, cols)
+	return err
+}
+
+func (s *kvDeviceService) QueryDeviceTwin(key, condition string) (*[]models.DeviceTwin, error) {
+	var twins []models.DeviceTwin
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		twins, err = deviceTwinTable.where(tx, key, condition)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &twins, nil
+}
+
+func (s *kvDeviceService) UpdateDeviceTwinMulti(updates []models.DeviceTwinUpdate) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for _, update := range updates {
+			if err := updateDeviceTwin(tx, update.DeviceID, update.Name, update.Cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) DeviceTwinTrans(adds []models.DeviceTwin, deletes []models.DeviceDelete, updates []models.DeviceTwinUpdate) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for i := range adds {
+			if err := deviceTwinTable.put(tx, &adds[i]); err != nil {
+				return err
+			}
+		}
+		for _, del := range deletes {
+			if err := deviceTwinTable.delete(tx, del.DeviceID+"/"+del.Name); err != nil {
+				return err
+			}
+			prefix := twinHistoryPrefix(del.DeviceID, del.Name)
+			if _, err := deviceTwinHistoryTable.deleteWhere(tx, prefix, matchTwinHistory(del.DeviceID, del.Name)); err != nil {
+				return err
+			}
+		}
+		for _, upd := range updates {
+			if err := updateDeviceTwin(tx, upd.DeviceID, upd.Name, upd.Cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		for i := range history {
+			h := &history[i]
+			rows, err := twinHistory(tx, h.DeviceID, h.Name)
+			if err != nil {
+				return err
+			}
+			// the ids grow like the autoincrement id of sqlite
+			h.ID = 1
+			if len(rows) > 0 {
+				h.ID = rows[len(rows)-1].ID + 1
+			}
+			if err := deviceTwinHistoryTable.put(tx, h); err != nil {
+				klog.Errorf("Failed to insert DeviceTwinHistory: %v", err)
+				return err
+			}
+			rows = append(rows, *h)
+			for j := 0; j < len(rows)-limit; j++ {
+				if err := deviceTwinHistoryTable.delete(tx, deviceTwinHistoryTable.key(&rows[j])); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *kvDeviceService) QueryDeviceTwinHistory(deviceID, name string) ([]models.DeviceTwinHistory, error) {
+	var history []models.DeviceTwinHistory
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		history, err = twinHistory(tx, deviceID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// twinHistory returns the states of a twin, the oldest first
+func twinHistory(tx kv.Tx, deviceID, name string) ([]models.DeviceTwinHistory, error) {
+	return deviceTwinHistoryTable.scan(tx, twinHistoryPrefix(deviceID, name), matchTwinHistory(deviceID, name))
+}
+
+// twinHistoryPrefix returns the key prefix of the states of a twin, the
+// states of the twins whose names extend name after a "/" share the prefix
+func twinHistoryPrefix(deviceID, name string) string {
+	return deviceID + "/" + name + "/"
+}
+
+func matchTwinHistory(deviceID, name string) func(h *models.DeviceTwinHistory) bool {
+	return func(h *models.DeviceTwinHistory) bool {
+		return h.DeviceID == deviceID && h.Name == name
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"errors"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+var subTopicsTable = kvTable[models.SubTopics]{
+	bucket: models.SubTopicsName,
+	key:    func(t *models.SubTopics) string { return t.Topic },
+}
+
+// kvEventBusService is the EventBusStore of the kv driver
+type kvEventBusService struct {
+	store kv.Store
+}
+
+var _ EventBusStore = &kvEventBusService{}
+
+func (s *kvEventBusService) InsertTopics(topic string) error {
+	err := s.store.Update(func(tx kv.Tx) error {
+		return subTopicsTable.put(tx, &models.SubTopics{Topic: topic})
+	})
+	if err != nil {
+		klog.Errorf("Failed to insert or replace topic: %v", err)
+	}
+	return err
+}
+
+func (s *kvEventBusService) DeleteTopicsByKey(key string) error {
+	err := s.store.Update(func(tx kv.Tx) error {
+		return subTopicsTable.delete(tx, key)
+	})
+	if err != nil {
+		klog.Errorf("Failed to delete topic: %v", err)
+	}
+	return err
+}
+
+func (s *kvEventBusService) QueryAllTopics() (*[]string, error) {
+	var entries []models.SubTopics
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		entries, err = subTopicsTable.list(tx, nil)
+		return err
+	})
+	if err != nil {
+		klog.Errorf("Failed to query topics: %v", err)
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no topics found")
+	}
+	var result []string
+	for _, entry := range entries {
+		result = append(result, entry.Topic)
+	}
+	return &result, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"strings"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+var metaTable = kvTable[models.Meta]{
+	bucket: models.MetaTableName,
+	key:    func(m *models.Meta) string { return m.Key },
+}
+
+// kvMetaService is the MetaStore of the kv driver
+type kvMetaService struct {
+	store kv.Store
+}
+
+var _ MetaStore = &kvMetaService{}
+
+func (s *kvMetaService) SaveMeta(meta *models.Meta) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		// an existing key is ignored like the unique constraint error of sqlite
+		if tx.Get(metaTable.bucket, meta.Key) != nil {
+			return nil
+		}
+		return metaTable.put(tx, meta)
+	})
+}
+
+func (s *kvMetaService) DeleteMetaByKey(key string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return metaTable.delete(tx, key)
+	})
+}
+
+func (s *kvMetaService) DeleteMetaByKeyAndPodUID(key, podUID string) (int64, error) {
+	var deleted int64
+	err := s.store.Update(func(tx kv.Tx) error {
+		meta, err := metaTable.get(tx, key)
+		if err != nil || meta == nil || !strings.Contains(meta.Value, podUID) {
+			return err
+		}
+		deleted = 1
+		return metaTable.delete(tx, key)
+	})
+	return deleted, err
+}
+
+func (s *kvMetaService) UpdateMeta(meta *models.Meta) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return metaTable.put(tx, meta)
+	})
+}
+
+func (s *kvMetaService) InsertOrUpdate(meta *models.Meta) error {
+	return s.UpdateMeta(meta)
+}
+
+func (s *kvMetaService) UpdateMetaField(key string, col string, value interface{}) error {
+	return s.UpdateMetaFields(key, map[string]interface{}{col: value})
+}
+
+func (s *kvMetaService) UpdateMetaFields(key string, cols map[string]interface{}) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		_, err := metaTable.update(tx, func(m *models.Meta) bool { return m.Key == key }, cols)
+		return err
+	})
+}
+
+func (s *kvMetaService) QueryMeta(key string, condition string) (*[]string, error) {
+	metas, err := s.QueryAllMeta(key, condition)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, v := range *metas {
+		result = append(result, v.Value)
+	}
+	return &result, nil
+}
+
+func (s *kvMetaService) QueryAllMeta(key string, condition string) (*[]models.Meta, error) {
+	var metas []models.Meta
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		if key == models.KEY {
+			// a lookup by the primary key does not scan the bucket
+			var meta *models.Meta
+			if meta, err = metaTable.get(tx, condition); meta != nil {
+				metas = append(metas, *meta)
+			}
+			return err
+		}
+		metas, err = metaTable.where(tx, key, condition)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &metas, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	commontypes "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+var metaV2Table = kvTable[models.MetaV2]{
+	bucket: models.NewMetaTableName,
+	key:    func(m *models.MetaV2) string { return m.Key },
+}
+
+// kvMetaV2Service is the MetaV2Store of the kv driver. Its lookups of a
+// missing key return gorm.ErrRecordNotFound like MetaV2Service, so that the
+// callers handle both drivers the same.
+type kvMetaV2Service struct {
+	store kv.Store
+}
+
+var _ MetaV2Store = &kvMetaV2Service{}
+
+func (s *kvMetaV2Service) RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]models.MetaV2, error) {
+	var objs []models.MetaV2
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		objs, err = metaV2Table.list(tx, func(m *models.MetaV2) bool {
+			if !gvr.Empty() && m.GroupVersionResource != gvr.String() {
+				return false
+			}
+			if namespace != models.NullNamespace && namespace != "" && m.Namespace != namespace {
+				return false
+			}
+			if name != models.NullName && name != "" && m.Name != name {
+				return false
+			}
+			return true
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &objs, nil
+}
+
+func (s *kvMetaV2Service) GetLatestMetaV2() (models.MetaV2, error) {
+	var latest models.MetaV2
+	err := s.store.View(func(tx kv.Tx) error {
+		objs, err := metaV2Table.list(tx, nil)
+		for _, obj := range objs {
+			if obj.ResourceVersion > latest.ResourceVersion || latest.Key == "" {
+				latest = obj
+			}
+		}
+		return err
+	})
+	return latest, err
+}
+
+func (s *kvMetaV2Service) InsertOrReplaceMetaV2(m *models.MetaV2) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return metaV2Table.put(tx, m)
+	})
+}
+
+func (s *kvMetaV2Service) RetryInsertOrReplaceMetaV2(m *models.MetaV2, maxRetries int) error {
+	err := s.InsertOrReplaceMetaV2(m)
+	if err == nil {
+		return nil
+	}
+	for i := 1; i < maxRetries; i++ {
+		klog.Errorf("retry %d: failed to insert or replace meta_v2: %v", i, err)
+		err = s.InsertOrReplaceMetaV2(m)
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to insert or replace meta_v2 after %d retries: %v", maxRetries, err)
+}
+
+func (s *kvMetaV2Service) GetByKey(key string) (*models.MetaV2, error) {
+	var result *models.MetaV2
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		result, err = metaV2Table.get(tx, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return result, nil
+}
+
+func (s *kvMetaV2Service) DeleteByKey(key string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		return metaV2Table.delete(tx, key)
+	})
+}
+
+// saveNamed replaces the rows named name by meta, the columns not set by
+// meta are kept from the replaced row like the update of MetaV2Service
+func (s *kvMetaV2Service) saveNamed(name string, meta models.MetaV2) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		rows, err := metaV2Table.list(tx, func(m *models.MetaV2) bool { return m.Name == name })
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			klog.Infof("%s exists, updating...", name)
+			old := rows[0]
+			if meta.GroupVersionResource == "" {
+				meta.GroupVersionResource = old.GroupVersionResource
+			}
+			if meta.Namespace == "" {
+				meta.Namespace = old.Namespace
+			}
+			if meta.ResourceVersion == 0 {
+				meta.ResourceVersion = old.ResourceVersion
+			}
+			for i := range rows {
+				if err := metaV2Table.delete(tx, rows[i].Key); err != nil {
+					return err
+				}
+			}
+		} else {
+			klog.Infof("%s not found, inserting...", name)
+		}
+		return metaV2Table.put(tx, &meta)
+	})
+}
+
+func (s *kvMetaV2Service) deleteNamed(name string) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		_, err := metaV2Table.deleteWhere(tx, "", func(m *models.MetaV2) bool { return m.Name == name })
+		return err
+	})
+}
+
+// queryNamed unmarshals the value of the first row named name into obj
+func (s *kvMetaV2Service) queryNamed(name string, obj interface{}) error {
+	var rows []models.MetaV2
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		rows, err = metaV2Table.list(tx, func(m *models.MetaV2) bool { return m.Name == name })
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	if err := json.Unmarshal([]byte(rows[0].Value), obj); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %v", name, err)
+	}
+	return nil
+}
+
+func (s *kvMetaV2Service) SaveNodeUpgradeJobRequestToMetaV2(nodeUpgradeJobReq commontypes.NodeUpgradeJobRequest) error {
+	nodeUpgradeJobReqJSON, err := json.Marshal(nodeUpgradeJobReq)
+	if err != nil {
+		return errors.New("failed to marshal NodeUpgradeJobRequest")
+	}
+	return s.saveNamed(models.NodeUpgradeJobRequestName, models.MetaV2{
+		Key:   nodeUpgradeJobReq.UpgradeID,
+		Name:  models.NodeUpgradeJobRequestName,
+		Value: string(nodeUpgradeJobReqJSON),
+	})
+}
+
+func (s *kvMetaV2Service) DeleteNodeUpgradeJobRequestFromMetaV2() error {
+	return s.deleteNamed(models.NodeUpgradeJobRequestName)
+}
+
+func (s *kvMetaV2Service) QueryNodeUpgradeJobRequestFromMetaV2() (commontypes.NodeUpgradeJobRequest, error) {
+	var nodeUpgradeReq commontypes.NodeUpgradeJobRequest
+	err := s.queryNamed(models.NodeUpgradeJobRequestName, &nodeUpgradeReq)
+	return nodeUpgradeReq, err
+}
+
+func (s *kvMetaV2Service) SaveNodeTaskRequestToMetaV2(nodeTaskReq commontypes.NodeTaskRequest) error {
+	nodeTaskReqJSON, err := json.Marshal(nodeTaskReq)
+	if err != nil {
+		return errors.New("failed to marshal NodeTaskRequest")
+	}
+	return s.saveNamed(models.NodeTaskRequestName, models.MetaV2{
+		Key:   nodeTaskReq.TaskID,
+		Name:  models.NodeTaskRequestName,
+		Value: string(nodeTaskReqJSON),
+	})
+}
+
+func (s *kvMetaV2Service) DeleteNodeTaskRequestFromMetaV2() error {
+	return s.deleteNamed(models.NodeTaskRequestName)
+}
+
+func (s *kvMetaV2Service) QueryNodeTaskRequestFromMetaV2() (commontypes.NodeTaskRequest, error) {
+	var nodeTaskReq commontypes.NodeTaskRequest
+	err := s.queryNamed(models.NodeTaskRequestName, &nodeTaskReq)
+	return nodeTaskReq, err
+}
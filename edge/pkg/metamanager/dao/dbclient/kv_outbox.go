@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+const (
+	// outboundCoalesceBucket maps the coalesce keys to the keys of the messages
+	outboundCoalesceBucket = models.OutboundMessageTableName + "_coalesce"
+	// outboundSequenceBucket keeps the last id like the autoincrement id of sqlite
+	outboundSequenceBucket = models.OutboundMessageTableName + "_sequence"
+	outboundSequenceKey    = "id"
+)
+
+// errStopIteration stops a ForEach of the kv store early
+var errStopIteration = errors.New("stop iteration")
+
+// the ids are padded so that the messages are stored in order
+var outboundMessageTable = kvTable[models.OutboundMessage]{
+	bucket: models.OutboundMessageTableName,
+	key:    func(m *models.OutboundMessage) string { return fmt.Sprintf("%020d", m.ID) },
+}
+
+// kvOutboxService is the OutboxStore of the kv driver
+type kvOutboxService struct {
+	store kv.Store
+}
+
+var _ OutboxStore = &kvOutboxService{}
+
+func (s *kvOutboxService) InsertOutboundMessage(msg *models.OutboundMessage) (int64, error) {
+	var replaced int64
+	err := s.store.Update(func(tx kv.Tx) error {
+		if msg.CoalesceKey != "" {
+			if key := tx.Get(outboundCoalesceBucket, msg.CoalesceKey); key != nil {
+				if err := outboundMessageTable.delete(tx, string(key)); err != nil {
+					return err
+				}
+				replaced = 1
+			}
+		}
+		var last uint64
+		if data := tx.Get(outboundSequenceBucket, outboundSequenceKey); data != nil {
+			var err error
+			if last, err = strconv.ParseUint(string(data), 10, 64); err != nil {
+				return fmt.Errorf("failed to parse the last id of %s: %v", outboundMessageTable.bucket, err)
+			}
+		}
+		msg.ID = last + 1
+		if err := tx.Put(outboundSequenceBucket, outboundSequenceKey, []byte(strconv.FormatUint(msg.ID, 10))); err != nil {
+			return err
+		}
+		if msg.CoalesceKey != "" {
+			if err := tx.Put(outboundCoalesceBucket, msg.CoalesceKey, []byte(outboundMessageTable.key(msg))); err != nil {
+				return err
+			}
+		}
+		return outboundMessageTable.put(tx, msg)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return replaced, nil
+}
+
+func (s *kvOutboxService) QueryOutboundMessages(limit int) ([]models.OutboundMessage, error) {
+	var msgs []models.OutboundMessage
+	err := s.store.View(func(tx kv.Tx) error {
+		var err error
+		msgs, err = oldestOutboundMessages(tx, func(_ *models.OutboundMessage, n int) bool { return n < limit })
+		return err
+	})
+	return msgs, err
+}
+
+func (s *kvOutboxService) DeleteOutboundMessage(id uint64) error {
+	return s.store.Update(func(tx kv.Tx) error {
+		msg, err := outboundMessageTable.get(tx, outboundMessageTable.key(&models.OutboundMessage{ID: id}))
+		if err != nil || msg == nil {
+			return err
+		}
+		return deleteOutboundMessage(tx, msg)
+	})
+}
+
+// DeleteOutboundMessagesBefore deletes the oldest messages until a message queued
+// at or after createdAt, the messages are queued in the order of their ids
+func (s *kvOutboxService) DeleteOutboundMessagesBefore(createdAt int64) (int64, error) {
+	return s.deleteOldest(func(msg *models.OutboundMessage, _ int) bool { return msg.CreatedAt < createdAt })
+}
+
+func (s *kvOutboxService) DeleteOldestOutboundMessages(n int64) (int64, error) {
+	return s.deleteOldest(func(_ *models.OutboundMessage, i int) bool { return int64(i) < n })
+}
+
+func (s *kvOutboxService) deleteOldest(next func(msg *models.OutboundMessage, n int) bool) (int64, error) {
+	var deleted int64
+	err := s.store.Update(func(tx kv.Tx) error {
+		msgs, err := oldestOutboundMessages(tx, next)
+		if err != nil {
+			return err
+		}
+		for i := range msgs {
+			if err := deleteOutboundMessage(tx, &msgs[i]); err != nil {
+				return err
+			}
+		}
+		deleted = int64(len(msgs))
+		return nil
+	})
+	return deleted, err
+}
+
+func (s *kvOutboxService) CountOutboundMessages() (int64, error) {
+	var n int64
+	err := s.store.View(func(tx kv.Tx) error {
+		return tx.ForEach(outboundMessageTable.bucket, func(string, []byte) error {
+			n++
+			return nil
+		})
+	})
+	return n, err
+}
+
+// oldestOutboundMessages returns the oldest messages while next accepts the
+// message and the number of the messages returned before it
+func oldestOutboundMessages(tx kv.Tx, next func(msg *models.OutboundMessage, n int) bool) ([]models.OutboundMessage, error) {
+	var msgs []models.OutboundMessage
+	err := tx.ForEach(outboundMessageTable.bucket, func(key string, data []byte) error {
+		var msg models.OutboundMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return fmt.Errorf("failed to unmarshal %s %s: %v", outboundMessageTable.bucket, key, err)
+		}
+		if !next(&msg, len(msgs)) {
+			return errStopIteration
+		}
+		msgs = append(msgs, msg)
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// restoreOutboundIndexes puts the coalesce keys and the last id of the messages
+// copied from sqlite, so that the kv driver goes on with the queued messages
+func restoreOutboundIndexes(tx kv.Tx) error {
+	msgs, err := outboundMessageTable.list(tx, nil)
+	if err != nil || len(msgs) == 0 {
+		return err
+	}
+	for i := range msgs {
+		if msgs[i].CoalesceKey == "" {
+			continue
+		}
+		if err := tx.Put(outboundCoalesceBucket, msgs[i].CoalesceKey, []byte(outboundMessageTable.key(&msgs[i]))); err != nil {
+			return err
+		}
+	}
+	// the messages are listed in the order of their ids
+	last := msgs[len(msgs)-1].ID
+	return tx.Put(outboundSequenceBucket, outboundSequenceKey, []byte(strconv.FormatUint(last, 10)))
+}
+
+// deleteOutboundMessage deletes msg and its coalesce key
+func deleteOutboundMessage(tx kv.Tx, msg *models.OutboundMessage) error {
+	key := outboundMessageTable.key(msg)
+	if msg.CoalesceKey != "" && string(tx.Get(outboundCoalesceBucket, msg.CoalesceKey)) == key {
+		if err := tx.Delete(outboundCoalesceBucket, msg.CoalesceKey); err != nil {
+			return err
+		}
+	}
+	return outboundMessageTable.delete(tx, key)
+}
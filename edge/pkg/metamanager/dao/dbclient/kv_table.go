@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+)
+
+// namingStrategy maps the struct fields to columns the same as gorm,
+// so that the kv driver accepts the column names used with sqlite
+var namingStrategy = schema.NamingStrategy{}
+
+// kvTable stores the rows of a table as JSON in a bucket of the kv store,
+// key returns the key of a row, which is the primary key of the table
+type kvTable[T any] struct {
+	bucket string
+	key    func(row *T) string
+}
+
+func (t kvTable[T]) get(tx kv.Tx, key string) (*T, error) {
+	data := tx.Get(t.bucket, key)
+	if data == nil {
+		return nil, nil
+	}
+	var row T
+	if err := json.Unmarshal(data, &row); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s %s: %v", t.bucket, key, err)
+	}
+	return &row, nil
+}
+
+func (t kvTable[T]) put(tx kv.Tx, row *T) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %v", t.bucket, t.key(row), err)
+	}
+	return tx.Put(t.bucket, t.key(row), data)
+}
+
+// create puts row, it fails if the key exists like a primary key conflict of sqlite
+func (t kvTable[T]) create(tx kv.Tx, row *T) error {
+	if tx.Get(t.bucket, t.key(row)) != nil {
+		return fmt.Errorf("UNIQUE constraint failed: %s key %s", t.bucket, t.key(row))
+	}
+	return t.put(tx, row)
+}
+
+func (t kvTable[T]) delete(tx kv.Tx, key string) error {
+	return tx.Delete(t.bucket, key)
+}
+
+// list returns the rows accepted by match, all rows if match is nil
+func (t kvTable[T]) list(tx kv.Tx, match func(row *T) bool) ([]T, error) {
+	return t.scan(tx, "", match)
+}
+
+// scan returns the rows accepted by match among the rows whose key begins with prefix
+func (t kvTable[T]) scan(tx kv.Tx, prefix string, match func(row *T) bool) ([]T, error) {
+	var rows []T
+	err := tx.ForEachPrefix(t.bucket, prefix, func(key string, data []byte) error {
+		var row T
+		if err := json.Unmarshal(data, &row); err != nil {
+			return fmt.Errorf("failed to unmarshal %s %s: %v", t.bucket, key, err)
+		}
+		if match == nil || match(&row) {
+			rows = append(rows, row)
+		}
+		return nil
+	})
+	return rows, err
+}
+
+// where returns the rows of which the column col equals value
+func (t kvTable[T]) where(tx kv.Tx, col string, value string) ([]T, error) {
+	var zero T
+	if _, ok := columnField(reflect.ValueOf(&zero).Elem(), col); !ok {
+		return nil, fmt.Errorf("no such column: %s", col)
+	}
+	return t.list(tx, func(row *T) bool {
+		field, _ := columnField(reflect.ValueOf(row).Elem(), col)
+		return fmt.Sprint(field.Interface()) == value
+	})
+}
+
+// update sets the columns of the rows accepted by match, it returns the
+// number of updated rows
+func (t kvTable[T]) update(tx kv.Tx, match func(row *T) bool, cols map[string]interface{}) (int, error) {
+	rows, err := t.list(tx, match)
+	if err != nil {
+		return 0, err
+	}
+	for i := range rows {
+		row := &rows[i]
+		oldKey := t.key(row)
+		if err := setColumns(row, cols); err != nil {
+			return 0, err
+		}
+		if newKey := t.key(row); newKey != oldKey {
+			if err := t.delete(tx, oldKey); err != nil {
+				return 0, err
+			}
+		}
+		if err := t.put(tx, row); err != nil {
+			return 0, err
+		}
+	}
+	return len(rows), nil
+}
+
+// deleteWhere deletes the rows accepted by match among the rows whose key begins with prefix
+func (t kvTable[T]) deleteWhere(tx kv.Tx, prefix string, match func(row *T) bool) (int, error) {
+	rows, err := t.scan(tx, prefix, match)
+	if err != nil {
+		return 0, err
+	}
+	for i := range rows {
+		if err := t.delete(tx, t.key(&rows[i])); err != nil {
+			return 0, err
+		}
+	}
+	return len(rows), nil
+}
+
+// columnField returns the field of the struct v mapped to the column col
+func columnField(v reflect.Value, col string) (reflect.Value, bool) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		if columnName(typ.Field(i)) == col {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func columnName(f reflect.StructField) string {
+	for _, setting := range strings.Split(f.Tag.Get("gorm"), ";") {
+		if name, ok := strings.CutPrefix(setting, "column:"); ok {
+			return name
+		}
+	}
+	return namingStrategy.ColumnName("", f.Name)
+}
+
+// setColumns sets the fields of row mapped to the columns of cols
+func setColumns(row interface{}, cols map[string]interface{}) error {
+	v := reflect.ValueOf(row).Elem()
+	for col, value := range cols {
+		field, ok := columnField(v, col)
+		if !ok {
+			return fmt.Errorf("no such column: %s", col)
+		}
+		val := reflect.ValueOf(value)
+		switch {
+		case !val.IsValid():
+			field.Set(reflect.Zero(field.Type()))
+		case val.Type().AssignableTo(field.Type()):
+			field.Set(val)
+		case val.Kind() == field.Kind() || isNumber(val.Kind()) && isNumber(field.Kind()):
+			field.Set(val.Convert(field.Type()))
+		default:
+			return fmt.Errorf("cannot set column %s of type %s to %T", col, field.Type(), value)
+		}
+	}
+	return nil
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
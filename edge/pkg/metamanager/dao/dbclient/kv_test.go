@@ -0,0 +1,411 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	commontypes "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+func newKVStore(t *testing.T) kv.Store {
+	store, err := kv.Open(filepath.Join(t.TempDir(), "edgecore.kv"))
+	if err != nil {
+		t.Fatalf("failed to open kv store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestKVMetaService(t *testing.T) {
+	s := &kvMetaService{store: newKVStore(t)}
+
+	if err := s.SaveMeta(&models.Meta{Key: "default/pod/p1", Type: "pod", Value: "uid-1"}); err != nil {
+		t.Fatalf("save meta err: %v", err)
+	}
+	// saving an existing key is ignored
+	if err := s.SaveMeta(&models.Meta{Key: "default/pod/p1", Type: "pod", Value: "uid-2"}); err != nil {
+		t.Fatalf("save existing meta err: %v", err)
+	}
+	if err := s.InsertOrUpdate(&models.Meta{Key: "default/configmap/c1", Type: "configmap", Value: "v1"}); err != nil {
+		t.Fatalf("insert meta err: %v", err)
+	}
+
+	values, err := s.QueryMeta(models.KEY, "default/pod/p1")
+	if err != nil || !reflect.DeepEqual(*values, []string{"uid-1"}) {
+		t.Errorf("expected [uid-1], but got %v, err %v", values, err)
+	}
+	metas, err := s.QueryAllMeta("type", "configmap")
+	if err != nil || len(*metas) != 1 || (*metas)[0].Value != "v1" {
+		t.Errorf("expected configmap c1, but got %v, err %v", metas, err)
+	}
+	if _, err := s.QueryMeta("unknown", "x"); err == nil {
+		t.Errorf("expected err of unknown column")
+	}
+
+	if err := s.UpdateMetaField("default/configmap/c1", "value", "v2"); err != nil {
+		t.Fatalf("update meta err: %v", err)
+	}
+	values, _ = s.QueryMeta(models.KEY, "default/configmap/c1")
+	if !reflect.DeepEqual(*values, []string{"v2"}) {
+		t.Errorf("expected [v2], but got %v", *values)
+	}
+
+	if n, err := s.DeleteMetaByKeyAndPodUID("default/pod/p1", "uid-other"); err != nil || n != 0 {
+		t.Errorf("expected no delete of other pod uid, but got %d, err %v", n, err)
+	}
+	if n, err := s.DeleteMetaByKeyAndPodUID("default/pod/p1", "uid-1"); err != nil || n != 1 {
+		t.Errorf("expected 1 delete, but got %d, err %v", n, err)
+	}
+	values, _ = s.QueryMeta(models.KEY, "default/pod/p1")
+	if len(*values) != 0 {
+		t.Errorf("expected pod to be deleted, but got %v", *values)
+	}
+}
+
+func TestKVMetaV2Service(t *testing.T) {
+	s := &kvMetaV2Service{store: newKVStore(t)}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	for i, name := range []string{"p1", "p2"} {
+		m := &models.MetaV2{
+			Key:                  "/core/v1/pods/default/" + name,
+			GroupVersionResource: gvr.String(),
+			Namespace:            "default",
+			Name:                 name,
+			ResourceVersion:      uint64(10 + i),
+		}
+		if err := s.InsertOrReplaceMetaV2(m); err != nil {
+			t.Fatalf("insert meta_v2 err: %v", err)
+		}
+	}
+
+	objs, err := s.RawMetaByGVRNN(gvr, "default", models.NullName)
+	if err != nil || len(*objs) != 2 {
+		t.Errorf("expected 2 pods, but got %v, err %v", objs, err)
+	}
+	objs, _ = s.RawMetaByGVRNN(gvr, "default", "p2")
+	if len(*objs) != 1 || (*objs)[0].Name != "p2" {
+		t.Errorf("expected pod p2, but got %v", *objs)
+	}
+	latest, err := s.GetLatestMetaV2()
+	if err != nil || latest.ResourceVersion != 11 {
+		t.Errorf("expected latest resource version 11, but got %d, err %v", latest.ResourceVersion, err)
+	}
+	if _, err := s.GetByKey("/core/v1/pods/default/p3"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, but got %v", err)
+	}
+
+	req := commontypes.NodeTaskRequest{TaskID: "task1"}
+	if err := s.SaveNodeTaskRequestToMetaV2(req); err != nil {
+		t.Fatalf("save node task request err: %v", err)
+	}
+	req.TaskID = "task2"
+	if err := s.SaveNodeTaskRequestToMetaV2(req); err != nil {
+		t.Fatalf("update node task request err: %v", err)
+	}
+	got, err := s.QueryNodeTaskRequestFromMetaV2()
+	if err != nil || got.TaskID != "task2" {
+		t.Errorf("expected task2, but got %v, err %v", got.TaskID, err)
+	}
+	if err := s.DeleteNodeTaskRequestFromMetaV2(); err != nil {
+		t.Fatalf("delete node task request err: %v", err)
+	}
+	if _, err := s.QueryNodeTaskRequestFromMetaV2(); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, but got %v", err)
+	}
+}
+
+func TestKVDeviceService(t *testing.T) {
+	s := &kvDeviceService{store: newKVStore(t)}
+
+	err := s.AddDeviceTrans(
+		[]models.Device{{ID: "d1", Name: "d1"}, {ID: "d2", Name: "d2"}},
+		[]models.DeviceAttr{{DeviceID: "d1", Name: "a1", Value: "1"}},
+		[]models.DeviceTwin{{DeviceID: "d1", Name: "t1", Expected: "on"}, {DeviceID: "d2", Name: "t1"}},
+	)
+	if err != nil {
+		t.Fatalf("add devices err: %v", err)
+	}
+	if err := s.SaveDevice(&models.Device{ID: "d1"}); err == nil || !IsNonUniqueNameError(err) {
+		t.Errorf("expected unique constraint err, but got %v", err)
+	}
+
+	if err := s.UpdateDeviceFields("d1", map[string]interface{}{"state": "online", "last_online": "now"}); err != nil {
+		t.Fatalf("update device err: %v", err)
+	}
+	devices, err := s.QueryDevice("state", "online")
+	if err != nil || len(devices) != 1 || devices[0].ID != "d1" || devices[0].LastOnline != "now" {
+		t.Errorf("expected online device d1, but got %v, err %v", devices, err)
+	}
+
+	err = s.DeviceTwinTrans(nil,
+		[]models.DeviceDelete{{DeviceID: "d2", Name: "t1"}},
+		[]models.DeviceTwinUpdate{{DeviceID: "d1", Name: "t1", Cols: map[string]interface{}{"actual": "on", "optional": true}}})
+	if err != nil {
+		t.Fatalf("device twin trans err: %v", err)
+	}
+	twins, err := s.QueryDeviceTwin("deviceid", "d1")
+	if err != nil || len(*twins) != 1 || (*twins)[0].Actual != "on" || !(*twins)[0].Optional {
+		t.Errorf("expected updated twin t1 of d1, but got %v, err %v", twins, err)
+	}
+	twins, _ = s.QueryDeviceTwin("deviceid", "d2")
+	if len(*twins) != 0 {
+		t.Errorf("expected twin of d2 to be deleted, but got %v", *twins)
+	}
+
+	// a failed transaction is not applied
+	err = s.DeviceAttrTrans([]models.DeviceAttr{{DeviceID: "d1", Name: "a2"}}, nil,
+		[]models.DeviceAttrUpdate{{DeviceID: "d1", Name: "a1", Cols: map[string]interface{}{"unknown": "x"}}})
+	if err == nil {
+		t.Errorf("expected err of unknown column")
+	}
+	attrs, _ := s.QueryDeviceAttr("deviceid", "d1")
+	if len(*attrs) != 1 {
+		t.Errorf("expected the failed transaction to be discarded, but got %v", *attrs)
+	}
+
+	if err := s.DeleteDeviceTrans([]string{"d1"}); err != nil {
+		t.Fatalf("delete devices err: %v", err)
+	}
+	devices, _ = s.QueryDeviceAll()
+	if len(devices) != 1 || devices[0].ID != "d2" {
+		t.Errorf("expected device d2, but got %v", devices)
+	}
+	attrs, _ = s.QueryDeviceAttr("deviceid", "d1")
+	twins, _ = s.QueryDeviceTwin("deviceid", "d1")
+	if len(*attrs) != 0 || len(*twins) != 0 {
+		t.Errorf("expected attrs and twins of d1 to be deleted, but got %v %v", *attrs, *twins)
+	}
+}
+
+func TestKVEventBusService(t *testing.T) {
+	s := &kvEventBusService{store: newKVStore(t)}
+	if _, err := s.QueryAllTopics(); err == nil {
+		t.Errorf("expected err of no topics")
+	}
+	for _, topic := range []string{"b", "a", "a"} {
+		if err := s.InsertTopics(topic); err != nil {
+			t.Fatalf("insert topic err: %v", err)
+		}
+	}
+	if err := s.DeleteTopicsByKey("b"); err != nil {
+		t.Fatalf("delete topic err: %v", err)
+	}
+	topics, err := s.QueryAllTopics()
+	if err != nil || !reflect.DeepEqual(*topics, []string{"a"}) {
+		t.Errorf("expected [a], but got %v, err %v", topics, err)
+	}
+}
+
+func TestMigrateToKV(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	// the EventBus tables do not exist, like when the module is disabled
+	if err := db.AutoMigrate(&models.Meta{}, &models.MetaV2{}, &models.Device{},
+		&models.DeviceAttr{}, &models.DeviceTwin{}, &models.OutboundMessage{}); err != nil {
+		t.Fatalf("failed to migrate db: %v", err)
+	}
+	sqliteMeta := &MetaService{db: db}
+	if err := sqliteMeta.SaveMeta(&models.Meta{Key: "default/pod/p1", Type: "pod", Value: "v"}); err != nil {
+		t.Fatal(err)
+	}
+	sqliteDevice := &DeviceService{db: db}
+	if err := sqliteDevice.AddDeviceTrans([]models.Device{{ID: "d1"}},
+		[]models.DeviceAttr{{DeviceID: "d1", Name: "a1"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	sqliteOutbox := &OutboxService{db: db}
+	for _, msg := range []*models.OutboundMessage{
+		{Message: []byte("m1"), CoalesceKey: "status", CreatedAt: 1},
+		{Message: []byte("m2"), CreatedAt: 2},
+	} {
+		if _, err := sqliteOutbox.InsertOutboundMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := newKVStore(t)
+	result, err := MigrateToKV(db, store)
+	if err != nil {
+		t.Fatalf("migrate err: %v", err)
+	}
+	expected := MigrationResult{
+		models.MetaTableName:            1,
+		models.NewMetaTableName:         0,
+		models.DeviceTableName:          1,
+		models.DeviceAttrTableName:      1,
+		models.DeviceTwinTableName:      0,
+		models.OutboundMessageTableName: 2,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected result %v, but got %v", expected, result)
+	}
+
+	metas, err := (&kvMetaService{store: store}).QueryAllMeta(models.KEY, "default/pod/p1")
+	if err != nil || len(*metas) != 1 || (*metas)[0].Value != "v" {
+		t.Errorf("expected migrated meta, but got %v, err %v", metas, err)
+	}
+	attrs, err := (&kvDeviceService{store: store}).QueryDeviceAttr("deviceid", "d1")
+	if err != nil || len(*attrs) != 1 || (*attrs)[0].ID == 0 {
+		t.Errorf("expected migrated attr with its id, but got %v, err %v", attrs, err)
+	}
+	// the queued messages go on with their coalesce keys and ids
+	kvOutbox := &kvOutboxService{store: store}
+	m3 := &models.OutboundMessage{Message: []byte("m3"), CoalesceKey: "status", CreatedAt: 3}
+	if n, err := kvOutbox.InsertOutboundMessage(m3); err != nil || n != 1 || m3.ID != 3 {
+		t.Errorf("expected m1 to be replaced by m3 with id 3, but got %d replaced, id %d, err %v", n, m3.ID, err)
+	}
+	msgs, err := kvOutbox.QueryOutboundMessages(10)
+	if err != nil || len(msgs) != 2 || string(msgs[0].Message) != "m2" || string(msgs[1].Message) != "m3" {
+		t.Errorf("expected migrated messages [m2 m3], but got %v, err %v", msgs, err)
+	}
+}
+
+func TestDeviceTwinHistory(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	if err := db.AutoMigrate(&models.Device{}, &models.DeviceAttr{}, &models.DeviceTwin{},
+		&models.DeviceTwinHistory{}); err != nil {
+		t.Fatalf("failed to migrate db: %v", err)
+	}
+	stores := map[string]DeviceStore{
+		"sqlite": &DeviceService{db: db},
+		"kv":     &kvDeviceService{store: newKVStore(t)},
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			for _, value := range []string{"1", "2", "3"} {
+				err := s.AddDeviceTwinHistory([]models.DeviceTwinHistory{
+					{DeviceID: "default/d1", Name: "t1", Expected: value},
+					{DeviceID: "default/d1", Name: "t2", Expected: value},
+					{DeviceID: "default/d1", Name: "t1/x", Expected: value},
+				}, 2)
+				if err != nil {
+					t.Fatalf("add twin history err: %v", err)
+				}
+			}
+			history, err := s.QueryDeviceTwinHistory("default/d1", "t1")
+			if err != nil || len(history) != 2 || history[0].Expected != "2" || history[1].Expected != "3" {
+				t.Errorf("expected the newest 2 states of t1, but got %v, err %v", history, err)
+			}
+			// the states of t1/x share the key prefix of t1
+			if history, _ = s.QueryDeviceTwinHistory("default/d1", "t1/x"); len(history) != 2 {
+				t.Errorf("expected the newest 2 states of t1/x, but got %v", history)
+			}
+
+			if err := s.DeviceTwinTrans(nil, []models.DeviceDelete{{DeviceID: "default/d1", Name: "t2"}}, nil); err != nil {
+				t.Fatalf("device twin trans err: %v", err)
+			}
+			if history, _ = s.QueryDeviceTwinHistory("default/d1", "t2"); len(history) != 0 {
+				t.Errorf("expected the history of the deleted twin to be deleted, but got %v", history)
+			}
+			if err := s.DeleteDeviceTrans([]string{"default/d1"}); err != nil {
+				t.Fatalf("delete devices err: %v", err)
+			}
+			if history, _ = s.QueryDeviceTwinHistory("default/d1", "t1"); len(history) != 0 {
+				t.Errorf("expected the history of the deleted device to be deleted, but got %v", history)
+			}
+		})
+	}
+}
+
+func TestOutboxStore(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	if err := db.AutoMigrate(&models.OutboundMessage{}); err != nil {
+		t.Fatalf("failed to migrate db: %v", err)
+	}
+	stores := map[string]OutboxStore{
+		"sqlite": &OutboxService{db: db},
+		"kv":     &kvOutboxService{store: newKVStore(t)},
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			messages := []models.OutboundMessage{
+				{Message: []byte("m1"), CreatedAt: 1},
+				{Message: []byte("m2"), CoalesceKey: "status", CreatedAt: 2},
+				{Message: []byte("m3"), CreatedAt: 3},
+				{Message: []byte("m4"), CoalesceKey: "status", CreatedAt: 4},
+				{Message: []byte("m5"), CreatedAt: 5},
+			}
+			var replaced int64
+			for i := range messages {
+				n, err := s.InsertOutboundMessage(&messages[i])
+				if err != nil {
+					t.Fatalf("insert outbound message err: %v", err)
+				}
+				replaced += n
+			}
+			if replaced != 1 || messages[4].ID <= messages[3].ID {
+				t.Errorf("expected m2 to be replaced and the ids to grow, but got %d replaced, messages %v", replaced, messages)
+			}
+			contents := func(limit int) []string {
+				msgs, err := s.QueryOutboundMessages(limit)
+				if err != nil {
+					t.Fatalf("query outbound messages err: %v", err)
+				}
+				var result []string
+				for _, m := range msgs {
+					result = append(result, string(m.Message))
+				}
+				return result
+			}
+			if got := contents(10); !reflect.DeepEqual(got, []string{"m1", "m3", "m4", "m5"}) {
+				t.Errorf("expected [m1 m3 m4 m5], but got %v", got)
+			}
+			if got := contents(2); !reflect.DeepEqual(got, []string{"m1", "m3"}) {
+				t.Errorf("expected [m1 m3], but got %v", got)
+			}
+
+			if n, err := s.DeleteOutboundMessagesBefore(3); err != nil || n != 1 {
+				t.Errorf("expected m1 to be expired, but got %d, err %v", n, err)
+			}
+			if n, err := s.DeleteOldestOutboundMessages(1); err != nil || n != 1 {
+				t.Errorf("expected m3 to be dropped, but got %d, err %v", n, err)
+			}
+			if err := s.DeleteOutboundMessage(messages[4].ID); err != nil {
+				t.Fatalf("delete outbound message err: %v", err)
+			}
+			if n, err := s.CountOutboundMessages(); err != nil || n != 1 {
+				t.Errorf("expected 1 message, but got %d, err %v", n, err)
+			}
+			// the coalesce key of the remaining message is still replaced
+			if n, err := s.InsertOutboundMessage(&models.OutboundMessage{Message: []byte("m6"), CoalesceKey: "status", CreatedAt: 6}); err != nil || n != 1 {
+				t.Errorf("expected m4 to be replaced, but got %d, err %v", n, err)
+			}
+			if got := contents(10); !reflect.DeepEqual(got, []string{"m6"}) {
+				t.Errorf("expected [m6], but got %v", got)
+			}
+		})
+	}
+}
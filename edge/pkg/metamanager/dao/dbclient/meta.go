@@ -31,7 +31,11 @@ type MetaService struct {
 	db *gorm.DB
 }
 
-func NewMetaService() *MetaService {
+// NewMetaService returns the MetaStore of the database driver
+func NewMetaService() MetaStore {
+	if store := dao.GetKV(); store != nil {
+		return &kvMetaService{store: store}
+	}
 	return &MetaService{db: dao.GetDB()}
 }
 
@@ -35,7 +35,11 @@ type MetaV2Service struct {
 	db *gorm.DB
 }
 
-func NewMetaV2Service() *MetaV2Service {
+// NewMetaV2Service returns the MetaV2Store of the database driver
+func NewMetaV2Service() MetaV2Store {
+	if store := dao.GetKV(); store != nil {
+		return &kvMetaV2Service{store: store}
+	}
 	return &MetaV2Service{db: dao.GetDB()}
 }
 
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+)
+
+// MigrationResult is the number of rows migrated by table name
+type MigrationResult map[string]int
+
+// MigrateToKV copies the tables stored by the kv driver from the sqlite
+// database to the kv store. All rows are written in one transaction, so the
+// kv store is left unchanged if the migration fails.
+// The tables of the disabled modules do not exist and are skipped.
+func MigrateToKV(db *gorm.DB, store kv.Store) (MigrationResult, error) {
+	result := make(MigrationResult)
+	err := store.Update(func(tx kv.Tx) error {
+		if err := migrateTable(db, tx, metaTable, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, metaV2Table, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, deviceTable, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, deviceAttrTable, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, deviceTwinTable, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, deviceTwinHistoryTable, result); err != nil {
+			return err
+		}
+		if err := migrateTable(db, tx, outboundMessageTable, result); err != nil {
+			return err
+		}
+		if err := restoreOutboundIndexes(tx); err != nil {
+			return err
+		}
+		return migrateTable(db, tx, subTopicsTable, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func migrateTable[T any](db *gorm.DB, tx kv.Tx, table kvTable[T], result MigrationResult) error {
+	if !db.Migrator().HasTable(table.bucket) {
+		return nil
+	}
+	var rows []T
+	if err := db.Table(table.bucket).Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to read table %s: %v", table.bucket, err)
+	}
+	for i := range rows {
+		if err := table.put(tx, &rows[i]); err != nil {
+			return err
+		}
+	}
+	result[table.bucket] = len(rows)
+	return nil
+}
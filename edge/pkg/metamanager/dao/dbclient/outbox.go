@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+// OutboxService is the OutboxStore on sqlite
+type OutboxService struct {
+	db *gorm.DB
+}
+
+// NewOutboxService returns the OutboxStore of the database driver
+func NewOutboxService() OutboxStore {
+	if store := dao.GetKV(); store != nil {
+		return &kvOutboxService{store: store}
+	}
+	return &OutboxService{db: dao.GetDB()}
+}
+
+func (s *OutboxService) InsertOutboundMessage(msg *models.OutboundMessage) (int64, error) {
+	var replaced int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if msg.CoalesceKey != "" {
+			result := tx.Where("coalesce_key = ?", msg.CoalesceKey).Delete(&models.OutboundMessage{})
+			if result.Error != nil {
+				return result.Error
+			}
+			replaced = result.RowsAffected
+		}
+		return tx.Create(msg).Error
+	})
+	return replaced, err
+}
+
+func (s *OutboxService) QueryOutboundMessages(limit int) ([]models.OutboundMessage, error) {
+	var msgs []models.OutboundMessage
+	if err := s.db.Order("id").Limit(limit).Find(&msgs).Error; err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+func (s *OutboxService) DeleteOutboundMessage(id uint64) error {
+	return s.db.Delete(&models.OutboundMessage{}, id).Error
+}
+
+func (s *OutboxService) DeleteOutboundMessagesBefore(createdAt int64) (int64, error) {
+	result := s.db.Where("created_at < ?", createdAt).Delete(&models.OutboundMessage{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *OutboxService) DeleteOldestOutboundMessages(n int64) (int64, error) {
+	oldest := s.db.Model(&models.OutboundMessage{}).Select("id").Order("id").Limit(int(n))
+	result := s.db.Where("id IN (?)", oldest).Delete(&models.OutboundMessage{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *OutboxService) CountOutboundMessages() (int64, error) {
+	var n int64
+	err := s.db.Model(&models.OutboundMessage{}).Count(&n).Error
+	return n, err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclient
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	commontypes "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+// MetaStore is the storage of the meta table, it is implemented by
+// MetaService on sqlite and by the kv driver
+type MetaStore interface {
+	SaveMeta(meta *models.Meta) error
+	DeleteMetaByKey(key string) error
+	DeleteMetaByKeyAndPodUID(key, podUID string) (int64, error)
+	UpdateMeta(meta *models.Meta) error
+	InsertOrUpdate(meta *models.Meta) error
+	UpdateMetaField(key string, col string, value interface{}) error
+	UpdateMetaFields(key string, cols map[string]interface{}) error
+	QueryMeta(key string, condition string) (*[]string, error)
+	QueryAllMeta(key string, condition string) (*[]models.Meta, error)
+}
+
+// MetaV2Store is the storage of the meta_v2 table, it is implemented by
+// MetaV2Service on sqlite and by the kv driver
+type MetaV2Store interface {
+	RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]models.MetaV2, error)
+	GetLatestMetaV2() (models.MetaV2, error)
+	InsertOrReplaceMetaV2(m *models.MetaV2) error
+	RetryInsertOrReplaceMetaV2(m *models.MetaV2, maxRetries int) error
+	GetByKey(key string) (*models.MetaV2, error)
+	DeleteByKey(key string) error
+	SaveNodeUpgradeJobRequestToMetaV2(nodeUpgradeJobReq commontypes.NodeUpgradeJobRequest) error
+	DeleteNodeUpgradeJobRequestFromMetaV2() error
+	QueryNodeUpgradeJobRequestFromMetaV2() (commontypes.NodeUpgradeJobRequest, error)
+	SaveNodeTaskRequestToMetaV2(nodeTaskReq commontypes.NodeTaskRequest) error
+	DeleteNodeTaskRequestFromMetaV2() error
+	QueryNodeTaskRequestFromMetaV2() (commontypes.NodeTaskRequest, error)
+}
+
+// DeviceStore is the storage of the device, device_attr, device_twin and
+// device_twin_history tables, it is implemented by DeviceService on sqlite and by the kv driver
+type DeviceStore interface {
+	SaveDevice(doc *models.Device) error
+	DeleteDeviceByID(id string) error
+	UpdateDeviceField(deviceID string, col string, value interface{}) error
+	UpdateDeviceFields(deviceID string, cols map[string]interface{}) error
+	QueryDevice(key string, condition string) ([]models.Device, error)
+	QueryDeviceAll() ([]models.Device, error)
+	UpdateDeviceMulti(updates []models.DeviceUpdate) error
+	AddDeviceTrans(adds []models.Device, addAttrs []models.DeviceAttr, addTwins []models.DeviceTwin) error
+	DeleteDeviceTrans(deletes []string) error
+
+	SaveDeviceAttr(doc *models.DeviceAttr) error
+	DeleteDeviceAttr(deviceID string, name string) error
+	UpdateDeviceAttrField(deviceID, name, col string, value interface{}) error
+	UpdateDeviceAttrFields(deviceID, name string, cols map[string]interface{}) error
+	QueryDeviceAttr(key, condition string) (*[]models.DeviceAttr, error)
+	UpdateDeviceAttrMulti(updates []models.DeviceAttrUpdate) error
+	DeviceAttrTrans(adds []models.DeviceAttr, deletes []models.DeviceDelete, updates []models.DeviceAttrUpdate) error
+
+	SaveDeviceTwin(doc *models.DeviceTwin) error
+	DeleteDeviceTwin(deviceID, name string) error
+	UpdateDeviceTwinField(deviceID, name, col string, value interface{}) error
+	UpdateDeviceTwinFields(deviceID, name string, cols map[string]interface{}) error
+	QueryDeviceTwin(key, condition string) (*[]models.DeviceTwin, error)
+	UpdateDeviceTwinMulti(updates []models.DeviceTwinUpdate) error
+	DeviceTwinTrans(adds []models.DeviceTwin, deletes []models.DeviceDelete, updates []models.DeviceTwinUpdate) error
+
+	AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error
+	QueryDeviceTwinHistory(deviceID, name string) ([]models.DeviceTwinHistory, error)
+}
+
+// EventBusStore is the storage of the sub_topics table, it is implemented by
+// EventBusService on sqlite and by the kv driver
+type EventBusStore interface {
+	InsertTopics(topic string) error
+	DeleteTopicsByKey(key string) error
+	QueryAllTopics() (*[]string, error)
+}
+
+// OutboxStore is the storage of the EdgeHub outbound queue, the messages are
+// ordered by their ids. It is implemented by OutboxService on sqlite and by the kv driver
+type OutboxStore interface {
+	// InsertOutboundMessage sets the id of msg and inserts it, the messages with the
+	// coalesce key of msg are deleted if it is set, it returns the number of deleted messages
+	InsertOutboundMessage(msg *models.OutboundMessage) (int64, error)
+	// QueryOutboundMessages returns at most limit messages, the oldest first
+	QueryOutboundMessages(limit int) ([]models.OutboundMessage, error)
+	DeleteOutboundMessage(id uint64) error
+	// DeleteOutboundMessagesBefore deletes the messages queued before the unix time createdAt
+	DeleteOutboundMessagesBefore(createdAt int64) (int64, error)
+	// DeleteOldestOutboundMessages deletes the n oldest messages
+	DeleteOldestOutboundMessages(n int64) (int64, error)
+	CountOutboundMessages() (int64, error)
+}
+
+var (
+	_ MetaStore     = &MetaService{}
+	_ MetaV2Store   = &MetaV2Service{}
+	_ DeviceStore   = &DeviceService{}
+	_ EventBusStore = &EventBusService{}
+	_ OutboxStore   = &OutboxService{}
+)
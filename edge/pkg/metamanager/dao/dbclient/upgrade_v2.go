@@ -18,17 +18,14 @@ package dbclient
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strings"
 
-	"gorm.io/gorm"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	operationv1alpha1 "github.com/kubeedge/api/apis/operations/v1alpha1"
 	operationv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
 	"github.com/kubeedge/kubeedge/common/types"
-	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
 )
 
@@ -51,11 +48,11 @@ var (
 // It's used to store information needed to continue upgrading after the upgrade is confirmed.
 // Whether complete information about node jobs needs to be stored is another matter.
 type Upgrade struct {
-	db *gorm.DB
+	metaV2 MetaV2Store
 }
 
 func NewUpgrade() *Upgrade {
-	return &Upgrade{db: dao.GetDB()}
+	return &Upgrade{metaV2: NewMetaV2Service()}
 }
 
 // Generates the key for meta_v2 table. The format is:
@@ -77,7 +74,7 @@ func (dao *Upgrade) key(jobname, nodename string) string {
 // The NodeUpgradeJobSpec is parsed from 'meta_v2.value'.
 // Usually, only 1 or 0 rows of data should be queried by GVR.
 func (dao *Upgrade) Get() (string, string, *operationv1alpha2.NodeUpgradeJobSpec, error) {
-	row, err := onlyOneUpgradeRowByGVR(dao.db, MetaGVRUpgrade.String())
+	row, err := onlyOneUpgradeRowByGVR(dao.metaV2, MetaGVRUpgrade)
 	if err != nil {
 		return "", "", nil, err
 	}
@@ -115,12 +112,12 @@ func (dao *Upgrade) Save(jobname, nodename string, spec *operationv1alpha2.NodeU
 		GroupVersionResource: MetaGVRUpgrade.String(),
 		Value:                string(buff),
 	}
-	return NewMetaV2Service().InsertOrReplaceMetaV2(&meta)
+	return dao.metaV2.InsertOrReplaceMetaV2(&meta)
 }
 
 // Delete deletes nodeupgradejobspecs resources from metav2 table.
 func (dao *Upgrade) Delete() error {
-	row, err := onlyOneUpgradeRowByGVR(dao.db, MetaGVRUpgrade.String())
+	row, err := onlyOneUpgradeRowByGVR(dao.metaV2, MetaGVRUpgrade)
 	if err != nil {
 		return err
 	}
@@ -128,35 +125,31 @@ func (dao *Upgrade) Delete() error {
 		return nil
 	}
 
-	return NewMetaV2Service().DeleteByKey(row.Key)
+	return dao.metaV2.DeleteByKey(row.Key)
 }
 
 // onlyOneUpgradeRowByGVR returns the first row of data that query by GVR.
-func onlyOneUpgradeRowByGVR(db *gorm.DB, gvr string) (*models.MetaV2, error) {
-	var row models.MetaV2
-	err := db.Where(models.GVR+" = ?", gvr).Limit(1).Find(&row).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, nil
-	}
+func onlyOneUpgradeRowByGVR(metaV2 MetaV2Store, gvr schema.GroupVersionResource) (*models.MetaV2, error) {
+	rows, err := metaV2.RawMetaByGVRNN(gvr, "", "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to query metav2 by %s GVR, err: %v", gvr, err)
+		return nil, fmt.Errorf("failed to query metav2 by %s GVR, err: %v", gvr.String(), err)
 	}
-	if row.Key == "" {
+	if rows == nil || len(*rows) == 0 || (*rows)[0].Key == "" {
 		return nil, nil
 	}
-	return &row, nil
+	return &(*rows)[0], nil
 }
 
 // UpgradeV1alpha1 is a dao wrapper for v1alpha1 node upgrade job record.
 // It's used to store information needed to continue upgrading after the upgrade is confirmed.
 // Deprecated: For compatibility with v1alpha1 version, It will be removed in v1.23
 type UpgradeV1alpha1 struct {
-	db *gorm.DB
+	metaV2 MetaV2Store
 }
 
 func NewUpgradeV1alpha1() *UpgradeV1alpha1 {
 	return &UpgradeV1alpha1{
-		db: dao.GetDB(),
+		metaV2: NewMetaV2Service(),
 	}
 }
 
@@ -175,7 +168,7 @@ func (dao *UpgradeV1alpha1) key(taskID string) string {
 // Get returns the NodeTaskRequest that query by GVR and parsed from 'meta_v2.value'.
 // Usually, only 1 or 0 rows of data should be queried by GVR.
 func (dao *UpgradeV1alpha1) Get() (*types.NodeTaskRequest, error) {
-	row, err := onlyOneUpgradeRowByGVR(dao.db, MetaGVRUpgradeV1alpha1.String())
+	row, err := onlyOneUpgradeRowByGVR(dao.metaV2, MetaGVRUpgradeV1alpha1)
 	if err != nil {
 		return nil, err
 	}
@@ -208,17 +201,17 @@ func (dao *UpgradeV1alpha1) Save(request *types.NodeTaskRequest) error {
 		GroupVersionResource: MetaGVRUpgradeV1alpha1.String(),
 		Value:                string(buff),
 	}
-	return NewMetaV2Service().InsertOrReplaceMetaV2(&meta)
+	return dao.metaV2.InsertOrReplaceMetaV2(&meta)
 }
 
 // Delete deletes nodeupgradejobtaskrequests resources from metav2 table.
 func (dao *UpgradeV1alpha1) Delete() error {
-	row, err := onlyOneUpgradeRowByGVR(dao.db, MetaGVRUpgradeV1alpha1.String())
+	row, err := onlyOneUpgradeRowByGVR(dao.metaV2, MetaGVRUpgradeV1alpha1)
 	if err != nil {
 		return err
 	}
 	if row == nil {
 		return nil
 	}
-	return NewMetaV2Service().DeleteByKey(row.Key)
+	return dao.metaV2.DeleteByKey(row.Key)
 }
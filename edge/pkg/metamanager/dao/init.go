@@ -25,12 +25,29 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
 )
 
 var dbInstance *gorm.DB
 var once sync.Once
 
+var kvInstance kv.Store
+var kvOnce sync.Once
+
+// InitKV opens the key-value store of the kv driver, which stores the tables
+// of DeviceTwin, EventBus and MetaManager instead of sqlite.
+// It must be called before Init.
+func InitKV(dataSource string) {
+	kvOnce.Do(func() {
+		var err error
+		kvInstance, err = kv.Open(dataSource)
+		if err != nil {
+			log.Fatalf("Failed to open KV store: %v", err)
+		}
+	})
+}
+
 func Init(dataSource string, modules ...interface{}) {
 	once.Do(func() {
 		var err error
@@ -53,20 +70,45 @@ func migrateTables(modules ...interface{}) {
 				klog.Info("DeviceTwin module is disabled, skipping DB migration")
 				continue
 			}
+			if kvInstance != nil {
+				klog.Info("DeviceTwin tables are stored in the KV store, skipping DB migration")
+				continue
+			}
 			klog.Info("Migrating DB tables for DeviceTwin module")
 			if err := dbInstance.AutoMigrate(
 				&models.Device{},
 				&models.DeviceAttr{},
 				&models.DeviceTwin{},
+				&models.DeviceTwinHistory{},
 			); err != nil {
 				klog.Fatalf("Failed to migrate DeviceTwin tables: %v", err)
 			}
 
+		case *v1alpha2.EdgeHub:
+			if !module.Enable || module.OutboundQueue == nil || !module.OutboundQueue.Enable {
+				klog.Info("EdgeHub outbound queue is disabled, skipping DB migration")
+				continue
+			}
+			if kvInstance != nil {
+				klog.Info("EdgeHub tables are stored in the KV store, skipping DB migration")
+				continue
+			}
+			klog.Info("Migrating DB tables for EdgeHub module")
+			if err := dbInstance.AutoMigrate(
+				&models.OutboundMessage{},
+			); err != nil {
+				klog.Fatalf("Failed to migrate EdgeHub tables: %v", err)
+			}
+
 		case *v1alpha2.EventBus:
 			if !module.Enable {
 				klog.Info("EventBus module is disabled, skipping DB migration")
 				continue
 			}
+			if kvInstance != nil {
+				klog.Info("EventBus tables are stored in the KV store, skipping DB migration")
+				continue
+			}
 			klog.Info("Migrating DB tables for EventBus module")
 			if err := dbInstance.AutoMigrate(
 				&models.SubTopics{},
@@ -79,6 +121,10 @@ func migrateTables(modules ...interface{}) {
 				klog.Info("MetaManager module is disabled, skipping DB migration")
 				continue
 			}
+			if kvInstance != nil {
+				klog.Info("MetaManager tables are stored in the KV store, skipping DB migration")
+				continue
+			}
 			klog.Info("Migrating DB tables for MetaManager module")
 			if err := dbInstance.AutoMigrate(
 				&models.Meta{},
@@ -108,3 +154,8 @@ func migrateTables(modules ...interface{}) {
 func GetDB() *gorm.DB {
 	return dbInstance
 }
+
+// GetKV returns the key-value store, it is nil if the kv driver is not used
+func GetKV() kv.Store {
+	return kvInstance
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// openTimeout is how long Open waits for the file lock, which is held by
+// another process that opened the store, e.g. edgecore and keadm
+const openTimeout = 5 * time.Second
+
+// errReadOnly is returned by the writes of a read-only transaction
+var errReadOnly = errors.New("kv transaction is read-only")
+
+// boltStore is the Store backed by a bbolt database
+type boltStore struct {
+	path string
+	db   *bolt.DB
+}
+
+var _ Store = &boltStore{}
+
+// Open opens the store at path, it is created if it does not exist
+func Open(path string) (Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create dir of kv store %s: %v", path, err)
+	}
+	db, err := bolt.Open(path, 0640, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open kv store %s: %v", path, err)
+	}
+	return &boltStore{path: path, db: db}, nil
+}
+
+func (s *boltStore) View(fn func(tx Tx) error) error {
+	err := s.db.View(func(btx *bolt.Tx) error {
+		return fn(&tx{btx: btx})
+	})
+	if errors.Is(err, bolt.ErrDatabaseNotOpen) {
+		return ErrClosed
+	}
+	return err
+}
+
+func (s *boltStore) Update(fn func(tx Tx) error) error {
+	err := s.db.Update(func(btx *bolt.Tx) error {
+		return fn(&tx{btx: btx})
+	})
+	if errors.Is(err, bolt.ErrDatabaseNotOpen) {
+		return ErrClosed
+	}
+	return err
+}
+
+func (s *boltStore) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close kv store %s: %v", s.path, err)
+	}
+	return nil
+}
+
+// tx is a Tx of a bbolt transaction, the buckets are created by the first write
+type tx struct {
+	btx *bolt.Tx
+}
+
+func (t *tx) Get(bucket, key string) []byte {
+	b := t.btx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	value := b.Get([]byte(key))
+	if value == nil {
+		return nil
+	}
+	// the value of bbolt points to the mmap, which is unmapped after the
+	// transaction or remapped by a write of it
+	return append([]byte{}, value...)
+}
+
+func (t *tx) Put(bucket, key string, value []byte) error {
+	if !t.btx.Writable() {
+		return errReadOnly
+	}
+	b, err := t.btx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return fmt.Errorf("failed to create bucket %s: %v", bucket, err)
+	}
+	// bbolt treats a nil value as a bucket
+	if value == nil {
+		value = []byte{}
+	}
+	return b.Put([]byte(key), value)
+}
+
+func (t *tx) Delete(bucket, key string) error {
+	if !t.btx.Writable() {
+		return errReadOnly
+	}
+	b := t.btx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
+
+func (t *tx) ForEach(bucket string, fn func(key string, value []byte) error) error {
+	return t.ForEachPrefix(bucket, "", fn)
+}
+
+func (t *tx) ForEachPrefix(bucket, prefix string, fn func(key string, value []byte) error) error {
+	b := t.btx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	c := b.Cursor()
+	p := []byte(prefix)
+	for k, v := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, v = c.Next() {
+		// a nil value is a nested bucket, which is not created by the Tx
+		if v == nil {
+			continue
+		}
+		if err := fn(string(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func openStore(t *testing.T, path string) Store {
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	return s
+}
+
+func keysOf(t *testing.T, s Store, bucket string) []string {
+	var keys []string
+	err := s.View(func(tx Tx) error {
+		return tx.ForEach(bucket, func(key string, _ []byte) error {
+			keys = append(keys, key)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("failed to iterate bucket %s: %v", bucket, err)
+	}
+	return keys
+}
+
+func TestUpdateAndReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "edgecore.kv")
+	s := openStore(t, path)
+
+	err := s.Update(func(tx Tx) error {
+		if err := tx.Put("meta", "b", []byte("2")); err != nil {
+			return err
+		}
+		if err := tx.Put("meta", "a", []byte("1")); err != nil {
+			return err
+		}
+		if err := tx.Put("meta", "c", []byte("3")); err != nil {
+			return err
+		}
+		// the pending writes are visible in the transaction
+		if v := tx.Get("meta", "a"); string(v) != "1" {
+			return fmt.Errorf("expected pending value 1, but got %q", v)
+		}
+		return tx.Delete("meta", "c")
+	})
+	if err != nil {
+		t.Fatalf("update err: %v", err)
+	}
+
+	// a failed transaction is discarded
+	expectedErr := errors.New("rollback")
+	err = s.Update(func(tx Tx) error {
+		if err := tx.Put("meta", "d", []byte("4")); err != nil {
+			return err
+		}
+		return expectedErr
+	})
+	if err != expectedErr {
+		t.Fatalf("expected err %v, but got %v", expectedErr, err)
+	}
+
+	if keys := keysOf(t, s, "meta"); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("expected keys [a b], but got %v", keys)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close err: %v", err)
+	}
+	if err := s.View(func(Tx) error { return nil }); err != ErrClosed {
+		t.Errorf("expected ErrClosed, but got %v", err)
+	}
+
+	s = openStore(t, path)
+	defer s.Close()
+	if keys := keysOf(t, s, "meta"); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("expected keys [a b] after reopen, but got %v", keys)
+	}
+	err = s.View(func(tx Tx) error {
+		if v := tx.Get("meta", "b"); string(v) != "2" {
+			t.Errorf("expected value 2, but got %q", v)
+		}
+		if err := tx.Put("meta", "e", nil); err != errReadOnly {
+			t.Errorf("expected errReadOnly, but got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("view err: %v", err)
+	}
+}
+func TestForEachPrefix(t *testing.T) {
+	s := openStore(t, filepath.Join(t.TempDir(), "edgecore.kv"))
+	defer s.Close()
+	err := s.Update(func(tx Tx) error {
+		for _, key := range []string{"dev1/b/2", "dev2/a/1", "dev1/a/1", "dev1/a/2", "dev10/a/1"} {
+			if err := tx.Put("history", key, []byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("update err: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{name: "case1 all keys", prefix: "", expected: []string{"dev1/a/1", "dev1/a/2", "dev1/b/2", "dev10/a/1", "dev2/a/1"}},
+		{name: "case2 keys of a device", prefix: "dev1/", expected: []string{"dev1/a/1", "dev1/a/2", "dev1/b/2"}},
+		{name: "case3 keys of a twin", prefix: "dev1/a/", expected: []string{"dev1/a/1", "dev1/a/2"}},
+		{name: "case4 no key", prefix: "dev3/", expected: nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var keys []string
+			err := s.View(func(tx Tx) error {
+				return tx.ForEachPrefix("history", c.prefix, func(key string, value []byte) error {
+					if string(value) != key {
+						t.Errorf("expected value %s, but got %q", key, value)
+					}
+					keys = append(keys, key)
+					return nil
+				})
+			})
+			if err != nil {
+				t.Fatalf("view err: %v", err)
+			}
+			if !reflect.DeepEqual(keys, c.expected) {
+				t.Errorf("expected keys %v, but got %v", c.expected, keys)
+			}
+		})
+	}
+
+	// the iteration of a missing bucket is empty
+	if keys := keysOf(t, s, "missing"); keys != nil {
+		t.Errorf("expected no keys, but got %v", keys)
+	}
+}
+
+func TestGetReturnsCopy(t *testing.T) {
+	s := openStore(t, filepath.Join(t.TempDir(), "edgecore.kv"))
+	defer s.Close()
+	if err := s.Update(func(tx Tx) error {
+		if err := tx.Put("meta", "empty", nil); err != nil {
+			return err
+		}
+		return tx.Put("meta", "a", []byte("1"))
+	}); err != nil {
+		t.Fatalf("update err: %v", err)
+	}
+
+	var value, empty []byte
+	if err := s.View(func(tx Tx) error {
+		value = tx.Get("meta", "a")
+		empty = tx.Get("meta", "empty")
+		return nil
+	}); err != nil {
+		t.Fatalf("view err: %v", err)
+	}
+	// the value is still readable after the transaction and the later writes
+	if err := s.Update(func(tx Tx) error {
+		return tx.Put("meta", "a", []byte("2"))
+	}); err != nil {
+		t.Fatalf("update err: %v", err)
+	}
+	if string(value) != "1" {
+		t.Errorf("expected value 1, but got %q", value)
+	}
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("expected an empty value, but got %#v", empty)
+	}
+	if keys := keysOf(t, s, "meta"); !reflect.DeepEqual(keys, []string{"a", "empty"}) {
+		t.Errorf("expected keys [a empty], but got %v", keys)
+	}
+}
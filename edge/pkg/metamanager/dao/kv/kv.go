@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kv is an embedded key-value store for the edge metadata.
+//
+// It is backed by bbolt, the keys are grouped in buckets and all reads and
+// writes happen in transactions. A committed write transaction is synced to
+// the disk before Update returns, and an interrupted one is rolled back when
+// the store is opened again.
+package kv
+
+import (
+	"errors"
+)
+
+// ErrClosed is returned by the transactions of a closed store
+var ErrClosed = errors.New("kv store is closed")
+
+// Store is an embedded key-value store
+type Store interface {
+	// View runs fn in a read-only transaction
+	View(fn func(tx Tx) error) error
+	// Update runs fn in a read-write transaction, the writes of fn are
+	// committed atomically if fn returns nil and discarded otherwise
+	Update(fn func(tx Tx) error) error
+	// Close flushes and closes the store
+	Close() error
+}
+
+// Tx is a transaction of a Store. A Tx must not be used after its function returns.
+type Tx interface {
+	// Get returns a copy of the value of key in bucket, or nil if it does not exist
+	Get(bucket, key string) []byte
+	// Put sets the value of key in bucket, it fails in a read-only transaction
+	Put(bucket, key string, value []byte) error
+	// Delete removes key from bucket, it fails in a read-only transaction
+	Delete(bucket, key string) error
+	// ForEach calls fn for each key of bucket in key order, the iteration
+	// stops at the first error of fn, which is returned.
+	// value is only valid until fn returns and must not be modified.
+	ForEach(bucket string, fn func(key string, value []byte) error) error
+	// ForEachPrefix is like ForEach, but only for the keys beginning with prefix
+	ForEachPrefix(bucket, prefix string, fn func(key string, value []byte) error) error
+}
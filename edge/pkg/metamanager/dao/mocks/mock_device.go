@@ -52,6 +52,9 @@ type MockDeviceService struct {
 
 	// DeleteDeviceTransFunc can be overridden for testing
 	DeleteDeviceTransFunc func(deletes []string) error
+
+	// AddDeviceTwinHistoryFunc can be overridden for testing
+	AddDeviceTwinHistoryFunc func(history []models.DeviceTwinHistory, limit int) error
 }
 
 // NewMockDeviceService creates a new mock device service with default implementations
@@ -84,6 +87,9 @@ func NewMockDeviceService() *MockDeviceService {
 		DeleteDeviceTransFunc: func(deletes []string) error {
 			return nil
 		},
+		AddDeviceTwinHistoryFunc: func(history []models.DeviceTwinHistory, limit int) error {
+			return nil
+		},
 	}
 }
 
@@ -136,6 +142,13 @@ func (m *MockDeviceService) DeviceTwinTrans(adds []models.DeviceTwin, deletes []
 	return m.DeviceTwinTransFunc(adds, deletes, updates)
 }
 
+// AddDeviceTwinHistory mocks the AddDeviceTwinHistory method
+func (m *MockDeviceService) AddDeviceTwinHistory(history []models.DeviceTwinHistory, limit int) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.AddDeviceTwinHistoryFunc(history, limit)
+}
+
 func (m *MockDeviceService) SaveDevice(doc *models.Device) error {
 	return nil
 }
@@ -199,3 +212,7 @@ func (m *MockDeviceService) UpdateDeviceTwinFields(deviceID, name string, cols m
 func (m *MockDeviceService) UpdateDeviceTwinMulti(updates []models.DeviceTwinUpdate) error {
 	return nil
 }
+
+func (m *MockDeviceService) QueryDeviceTwinHistory(deviceID, name string) ([]models.DeviceTwinHistory, error) {
+	return nil, nil
+}
@@ -21,12 +21,16 @@ const (
 	DeviceAttrTableName = "device_attr"
 	DeviceTwinTableName = "device_twin"
 
+	DeviceTwinHistoryTableName = "device_twin_history"
+
 	SubTopicsName = "sub_topics"
 
 	TargetUrlsName = "target_urls"
 
 	MetaTableName    = "meta"
 	NewMetaTableName = "meta_v2"
+
+	OutboundMessageTableName = "edgehub_outbound_message"
 )
 
 const (
@@ -48,6 +48,27 @@ func (DeviceTwin) TableName() string {
 	return DeviceTwinTableName
 }
 
+// DeviceTwinHistory is the state of a device twin after a change, the history of a twin
+// is bounded and the oldest states are removed first
+type DeviceTwinHistory struct {
+	ID              int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	DeviceID        string `gorm:"column:deviceid;index:idx_device_twin_history"`
+	Name            string `gorm:"column:name;index:idx_device_twin_history"`
+	Expected        string `gorm:"column:expected"`
+	Actual          string `gorm:"column:actual"`
+	ExpectedVersion string `gorm:"column:expected_version"`
+	ActualVersion   string `gorm:"column:actual_version"`
+	// Source is where the change comes from, edge or cloud
+	Source string `gorm:"column:source"`
+	// Conflict is true if the change resolved a conflict between the edge and the cloud
+	Conflict  bool  `gorm:"column:conflict"`
+	Timestamp int64 `gorm:"column:timestamp"`
+}
+
+func (DeviceTwinHistory) TableName() string {
+	return DeviceTwinHistoryTableName
+}
+
 type DeviceAttr struct {
 	ID          int64  `gorm:"column:id;primaryKey;autoIncrement"`
 	DeviceID    string `gorm:"column:deviceid"`
@@ -0,0 +1,33 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+// OutboundMessage is an upstream message queued by EdgeHub while the
+// connection to the cloud is broken
+type OutboundMessage struct {
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// CoalesceKey is set for messages of which only the latest one is kept
+	CoalesceKey string `gorm:"column:coalesce_key;type:text;index"`
+	Message     []byte `gorm:"column:message;type:blob"`
+	// CreatedAt is the unix time (second) the message is queued
+	CreatedAt int64 `gorm:"column:created_at;index"`
+}
+
+// TableName returns the name of the table in the DB
+func (OutboundMessage) TableName() string {
+	return OutboundMessageTableName
+}
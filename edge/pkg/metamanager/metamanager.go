@@ -16,7 +16,7 @@ import (
 
 type metaManager struct {
 	enable      bool
-	metaService *dbclient.MetaService
+	metaService dbclient.MetaStore
 }
 
 var _ core.Module = (*metaManager)(nil)
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlerfactory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+	metaserverconfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/config"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
+)
+
+const (
+	reasonDeviceMethodCalled     = "DeviceMethodCalled"
+	reasonDeviceMethodCallFailed = "DeviceMethodCallFailed"
+)
+
+// CallDeviceMethod invokes a method declared in the device model,
+// the request path is .../namespaces/{namespace}/devices/{name}/methods/{method}
+func (f *Factory) CallDeviceMethod(reqInfo *request.RequestInfo) http.Handler {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if len(reqInfo.Parts) != 4 || reqInfo.Parts[3] == "" {
+			http.Error(w, "method name is required", http.StatusBadRequest)
+			return
+		}
+		methodName := reqInfo.Parts[3]
+
+		body, err := limitedReadBody(req, int64(3*1024*1024))
+		if err != nil {
+			http.Error(w, err.Error(), readBodyErrorStatus(err))
+			return
+		}
+		call := dttype.DeviceMethodCall{MethodName: methodName}
+		if len(body) != 0 {
+			decoder := json.NewDecoder(bytes.NewReader(body))
+			decoder.UseNumber()
+			if err = decoder.Decode(&call); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			call.MethodName = methodName
+		}
+		content, err := json.Marshal(call)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid inputs of method %s: %v", methodName, err), http.StatusBadRequest)
+			return
+		}
+
+		source := modules.MetaManagerModuleName
+		target := modules.DeviceTwinModuleName
+		resource := fmt.Sprintf("%s/%s/%s", reqInfo.Namespace, constants.ResourceTypeDeviceMethod, reqInfo.Name)
+		modelMsg := model.NewMessage("").FillBody(content)
+		modelMsg.BuildRouter(source, target, resource, dtcommon.DeviceMethodCallOperation)
+		resp, err := beehiveContext.SendSync(source, *modelMsg, 1*time.Minute)
+		if err != nil {
+			recordDeviceMethodEvent(req, reqInfo, methodName, err.Error())
+			http.Error(w, err.Error(), http.StatusGatewayTimeout)
+			return
+		}
+		respData, err := resp.GetContentData()
+		if err != nil {
+			recordDeviceMethodEvent(req, reqInfo, methodName, err.Error())
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		var result dttype.DeviceMethodResult
+		if err = json.Unmarshal(respData, &result); err != nil {
+			recordDeviceMethodEvent(req, reqInfo, methodName, err.Error())
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		recordDeviceMethodEvent(req, reqInfo, methodName, result.Reason)
+		if result.Code != 0 {
+			http.Error(w, result.Reason, result.Code)
+			return
+		}
+
+		outputs, err := json.Marshal(dttype.DeviceMethodResult{Outputs: result.Outputs})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid outputs of method %s: %v", methodName, err), http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(outputs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+	return h
+}
+
+// recordDeviceMethodEvent records an event on the device for auditing the method call,
+// failure is empty if the call succeeded
+func recordDeviceMethodEvent(req *http.Request, reqInfo *request.RequestInfo, methodName, failure string) {
+	caller := "unknown"
+	if u, ok := request.UserFrom(req.Context()); ok {
+		caller = u.GetName()
+	}
+	eventType, reason := corev1.EventTypeNormal, reasonDeviceMethodCalled
+	message := fmt.Sprintf("method %s called by %s on node %s", methodName, caller, metaserverconfig.Config.NodeName)
+	if failure != "" {
+		eventType, reason = corev1.EventTypeWarning, reasonDeviceMethodCallFailed
+		message = fmt.Sprintf("%s failed: %s", message, failure)
+	}
+
+	source := corev1.EventSource{
+		Component: modules.MetaManagerModuleName,
+		Host:      metaserverconfig.Config.NodeName,
+	}
+	if err := dtcommon.RecordDeviceEvent(reqInfo.Namespace, reqInfo.Name, source, eventType, reason, message); err != nil {
+		klog.Warningf("failed to record event for method %s: %v", methodName, err)
+	}
+}
+
+// readBodyErrorStatus returns the status code of the error reading the request body,
+// the body over the limit is rejected with 413 and the others are bad requests
+func readBodyErrorStatus(err error) int {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		return int(status.Status().Code)
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlerfactory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+)
+
+func TestCallDeviceMethod(t *testing.T) {
+	reqInfo := &request.RequestInfo{
+		Namespace: "default",
+		Resource:  "devices",
+		Name:      "thermometer",
+		Parts:     []string{"devices", "thermometer", "methods", "calibrate"},
+	}
+	body := `{"inputs":{"offset":2}}`
+
+	t.Run("missing method name", func(t *testing.T) {
+		info := *reqInfo
+		info.Parts = []string{"devices", "thermometer", "methods"}
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(&info).ServeHTTP(w, httptest.NewRequest("POST", "/", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("send to devicetwin failed", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+		patches.ApplyFunc(beehiveContext.SendSync, func(_ string, _ model.Message, _ time.Duration) (model.Message, error) {
+			return model.Message{}, errors.New("timeout")
+		})
+		var failure string
+		patches.ApplyFunc(recordDeviceMethodEvent, func(_ *http.Request, _ *request.RequestInfo, _, f string) {
+			failure = f
+		})
+
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+		assert.Equal(t, "timeout", failure)
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+		patches.ApplyFunc(limitedReadBody, func(_ *http.Request, limit int64) ([]byte, error) {
+			return nil, apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("limit is %d", limit))
+		})
+
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	rejected := []struct {
+		name         string
+		result       dttype.DeviceMethodResult
+		expectedCode int
+	}{
+		{
+			name:         "unknown device",
+			result:       dttype.DeviceMethodResult{Code: dtcommon.NotFoundCode, Reason: "device thermometer not found"},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "unknown method",
+			result:       dttype.DeviceMethodResult{Code: dtcommon.NotFoundCode, Reason: "method calibrate is not declared"},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid inputs",
+			result:       dttype.DeviceMethodResult{Code: dtcommon.BadRequestCode, Reason: "input offset must be a number"},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+	for _, c := range rejected {
+		t.Run(c.name, func(t *testing.T) {
+			patches := gomonkey.NewPatches()
+			defer patches.Reset()
+			patches.ApplyFunc(beehiveContext.SendSync, func(_ string, msg model.Message, _ time.Duration) (model.Message, error) {
+				content, _ := json.Marshal(c.result)
+				return *model.NewMessage("").FillBody(content), nil
+			})
+			var failure string
+			patches.ApplyFunc(recordDeviceMethodEvent, func(_ *http.Request, _ *request.RequestInfo, _, f string) {
+				failure = f
+			})
+
+			w := httptest.NewRecorder()
+			NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+			assert.Equal(t, c.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), c.result.Reason)
+			assert.Equal(t, c.result.Reason, failure)
+		})
+	}
+
+	t.Run("invalid response of devicetwin", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+		patches.ApplyFunc(beehiveContext.SendSync, func(_ string, msg model.Message, _ time.Duration) (model.Message, error) {
+			return *model.NewMessage("").FillBody([]byte("{")), nil
+		})
+		patches.ApplyFunc(recordDeviceMethodEvent, func(_ *http.Request, _ *request.RequestInfo, _, _ string) {})
+
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+	})
+
+	t.Run("method call succeeded", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+		var sent model.Message
+		patches.ApplyFunc(beehiveContext.SendSync, func(_ string, msg model.Message, _ time.Duration) (model.Message, error) {
+			sent = msg
+			content, _ := json.Marshal(dttype.DeviceMethodResult{Outputs: map[string]interface{}{"applied": true}})
+			return *model.NewMessage("").FillBody(content), nil
+		})
+		called := false
+		patches.ApplyFunc(recordDeviceMethodEvent, func(_ *http.Request, _ *request.RequestInfo, method, failure string) {
+			called = true
+			assert.Equal(t, "calibrate", method)
+			assert.Empty(t, failure)
+		})
+
+		w := httptest.NewRecorder()
+		NewFactory().CallDeviceMethod(reqInfo).ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"outputs":{"applied":true}}`, w.Body.String())
+		assert.True(t, called)
+		assert.Equal(t, "default/devicemethod/thermometer", sent.GetResource())
+		assert.Equal(t, dtcommon.DeviceMethodCallOperation, sent.GetOperation())
+
+		data, err := sent.GetContentData()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"methodName":"calibrate","inputs":{"offset":2}}`, string(data))
+	})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlerfactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+	"github.com/kubeedge/kubeedge/pkg/device/constants"
+)
+
+// DeviceHistory returns the recent values of the device properties kept on the node,
+// the request path is .../namespaces/{namespace}/devices/{name}/history and the query
+// parameters are property, start and end in RFC3339, and step in duration like 1m
+func (f *Factory) DeviceHistory(reqInfo *request.RequestInfo) http.Handler {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query, err := parseDeviceHistoryQuery(req.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, err := json.Marshal(query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		source := modules.MetaManagerModuleName
+		target := modules.DeviceTwinModuleName
+		resource := fmt.Sprintf("%s/%s/%s", reqInfo.Namespace, constants.ResourceTypeDeviceHistory, reqInfo.Name)
+		modelMsg := model.NewMessage("").FillBody(content)
+		modelMsg.BuildRouter(source, target, resource, model.QueryOperation)
+		resp, err := beehiveContext.SendSync(source, *modelMsg, 1*time.Minute)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusGatewayTimeout)
+			return
+		}
+		respData, err := resp.GetContentData()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		var result dttype.DeviceHistoryResult
+		if err = json.Unmarshal(respData, &result); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		if result.Code != 0 {
+			http.Error(w, result.Reason, result.Code)
+			return
+		}
+
+		properties := result.Properties
+		if properties == nil {
+			properties = []dttype.PropertyHistory{}
+		}
+		history, err := json.Marshal(map[string][]dttype.PropertyHistory{"properties": properties})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(history)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+	return h
+}
+
+func parseDeviceHistoryQuery(values url.Values) (*dttype.DeviceHistoryQuery, error) {
+	query := &dttype.DeviceHistoryQuery{Property: values.Get("property")}
+	if start := values.Get("start"); start != "" {
+		t, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start %s: %v", start, err)
+		}
+		query.Start = t.UnixMilli()
+	}
+	if end := values.Get("end"); end != "" {
+		t, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end %s: %v", end, err)
+		}
+		query.End = t.UnixMilli()
+	}
+	if query.End != 0 && query.End < query.Start {
+		return nil, fmt.Errorf("end must not be before start")
+	}
+	if step := values.Get("step"); step != "" {
+		d, err := time.ParseDuration(step)
+		if err != nil {
+			return nil, fmt.Errorf("invalid step %s: %v", step, err)
+		}
+		if d.Milliseconds() <= 0 {
+			return nil, fmt.Errorf("step must be at least 1ms")
+		}
+		query.Step = d.Milliseconds()
+	}
+	return query, nil
+}
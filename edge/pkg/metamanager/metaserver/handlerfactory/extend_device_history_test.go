@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlerfactory
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmihistory"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+)
+
+func TestDeviceHistory(t *testing.T) {
+	reqInfo := &request.RequestInfo{
+		Namespace: "default",
+		Resource:  "devices",
+		Name:      "thermometer",
+		Parts:     []string{"devices", "thermometer", "history"},
+	}
+
+	cases := []struct {
+		name         string
+		target       string
+		result       *dttype.DeviceHistoryResult
+		sendErr      error
+		expectedCode int
+		expectedBody string
+		expectedSent string
+	}{
+		{
+			name:         "case1 invalid start",
+			target:       "/?start=yesterday",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "case2 invalid step",
+			target:       "/?step=0s",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "case3 end before start",
+			target:       "/?start=2025-01-01T00:01:00Z&end=2025-01-01T00:00:00Z",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "case4 send to devicetwin failed",
+			target:       "/",
+			sendErr:      errors.New("timeout"),
+			expectedCode: http.StatusGatewayTimeout,
+		},
+		{
+			name:         "case5 history disabled",
+			target:       "/",
+			result:       &dttype.DeviceHistoryResult{Code: dtcommon.NotFoundCode, Reason: "device history is disabled"},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "case6 downsampled history",
+			target: "/?property=temperature&start=2025-01-01T00:00:00Z&end=2025-01-01T00:01:00Z&step=30s",
+			result: &dttype.DeviceHistoryResult{Properties: []dttype.PropertyHistory{{
+				Name:    "temperature",
+				Buckets: []dmihistory.Bucket{{Start: 1735689600000, End: 1735689630000, Count: 2, Min: 1, Max: 3, Avg: 2}},
+			}}},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"properties":[{"name":"temperature","buckets":[{"start":1735689600000,"end":1735689630000,"count":2,"min":1,"max":3,"avg":2}]}]}`,
+			expectedSent: `{"property":"temperature","start":1735689600000,"end":1735689660000,"step":30000}`,
+		},
+		{
+			name:         "case7 no history",
+			target:       "/",
+			result:       &dttype.DeviceHistoryResult{},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"properties":[]}`,
+			expectedSent: `{}`,
+		},
+		{
+			name:         "case8 unknown device",
+			target:       "/",
+			result:       &dttype.DeviceHistoryResult{Code: dtcommon.NotFoundCode, Reason: "device thermometer not found"},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			patches := gomonkey.NewPatches()
+			defer patches.Reset()
+			var sent model.Message
+			patches.ApplyFunc(beehiveContext.SendSync, func(_ string, msg model.Message, _ time.Duration) (model.Message, error) {
+				sent = msg
+				if c.sendErr != nil {
+					return model.Message{}, c.sendErr
+				}
+				content, _ := json.Marshal(c.result)
+				return *model.NewMessage("").FillBody(content), nil
+			})
+
+			w := httptest.NewRecorder()
+			NewFactory().DeviceHistory(reqInfo).ServeHTTP(w, httptest.NewRequest("GET", c.target, nil))
+			assert.Equal(t, c.expectedCode, w.Code)
+			if c.expectedBody != "" {
+				assert.JSONEq(t, c.expectedBody, w.Body.String())
+			}
+			if c.expectedSent != "" {
+				assert.Equal(t, "default/devicehistory/thermometer", sent.GetResource())
+				assert.Equal(t, model.QueryOperation, sent.GetOperation())
+				data, err := sent.GetContentData()
+				assert.NoError(t, err)
+				assert.JSONEq(t, c.expectedSent, string(data))
+			}
+		})
+	}
+}
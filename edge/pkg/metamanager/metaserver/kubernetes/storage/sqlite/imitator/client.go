@@ -6,6 +6,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/apiserver/pkg/storage"
@@ -14,6 +15,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	metaserverconfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/config"
 )
 
 // DefaultV2Client is the only one client. Because of v2Client
@@ -35,9 +37,11 @@ type Client interface {
 	SetRevision(version interface{})
 
 	// This set of functions for upper storage
-	List(ctx context.Context, key string) (Resp, error)
+	// List lists the objects at ResourceVersion, 0 means the latest ones
+	List(ctx context.Context, key string, ResourceVersion uint64) (Resp, error)
 	Get(ctx context.Context, key string) (Resp, error)
-	Watch(ctx context.Context, key string, ResourceVersion uint64) <-chan watch.Event
+	// Watch watches the events after ResourceVersion
+	Watch(ctx context.Context, key string, ResourceVersion uint64) (<-chan watch.Event, error)
 }
 
 type Resp struct {
@@ -52,6 +56,7 @@ func newV2Client() Client {
 		lock:      sync.RWMutex{},
 		versioner: Versioner,
 		codec:     unstructured.UnstructuredJSONScheme,
+		cache:     newWatchCache(0),
 	}
 }
 
@@ -63,4 +68,15 @@ func StorageInit() {
 
 	DefaultV2Client.SetRevision(meta.ResourceVersion)
 	klog.Infof("StorageInit set revision to: %d", meta.ResourceVersion)
+
+	if s, ok := DefaultV2Client.(*imitator); ok {
+		objs, err := dbclient.NewMetaV2Service().RawMetaByGVRNN(schema.GroupVersionResource{}, "", "")
+		utilruntime.Must(err)
+
+		s.lock.Lock()
+		s.cache = newWatchCache(int(metaserverconfig.Config.WatchCacheSize))
+		s.cache.replace(*objs, meta.ResourceVersion)
+		s.lock.Unlock()
+		klog.Infof("StorageInit load %d objs to the watch cache", len(*objs))
+	}
 }
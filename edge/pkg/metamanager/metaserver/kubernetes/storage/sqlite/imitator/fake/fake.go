@@ -19,9 +19,9 @@ type Client struct {
 	GetPassThroughObjF            func(ctx context.Context, key string) ([]byte, error)
 	GetRevisionF                  func() uint64
 	SetRevisionF                  func(version interface{})
-	ListF                         func(ctx context.Context, key string) (imitator.Resp, error)
+	ListF                         func(ctx context.Context, key string, ResourceVersion uint64) (imitator.Resp, error)
 	GetF                          func(ctx context.Context, key string) (imitator.Resp, error)
-	WatchF                        func(ctx context.Context, key string, ResourceVersion uint64) <-chan watch.Event
+	WatchF                        func(ctx context.Context, key string, ResourceVersion uint64) (<-chan watch.Event, error)
 }
 
 // Inject fake
@@ -60,8 +60,8 @@ func (c Client) SetRevision(version interface{}) {
 }
 
 // List fake
-func (c Client) List(ctx context.Context, key string) (imitator.Resp, error) {
-	return c.ListF(ctx, key)
+func (c Client) List(ctx context.Context, key string, ResourceVersion uint64) (imitator.Resp, error) {
+	return c.ListF(ctx, key, ResourceVersion)
 }
 
 // Get fake
@@ -70,6 +70,6 @@ func (c Client) Get(ctx context.Context, key string) (imitator.Resp, error) {
 }
 
 // Watch fake
-func (c Client) Watch(ctx context.Context, key string, ResourceVersion uint64) <-chan watch.Event {
+func (c Client) Watch(ctx context.Context, key string, ResourceVersion uint64) (<-chan watch.Event, error) {
 	return c.WatchF(ctx, key, ResourceVersion)
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +35,8 @@ type imitator struct {
 	versioner storage.Versioner
 	// to co/decoder obj
 	codec runtime.Codec
+	// cache serves list and watch in memory once it is initialized by StorageInit
+	cache *watchCache
 }
 
 // Inject transform the message to watch.event, save internal obj/objs to table meta_v2
@@ -60,30 +63,38 @@ func (s *imitator) Inject(msg model.Message) {
 
 // TODO: filter out insert or update req that the obj's rev is smaller than the stored
 func (s *imitator) InsertOrUpdateObj(_ context.Context, obj runtime.Object) error {
-	key, err := metaserver.KeyFuncObj(obj)
+	m, err := s.metaV2(obj)
 	if err != nil {
 		return err
 	}
+	return s.insertOrReplaceMetaV2(m, m.ResourceVersion)
+}
+
+// metaV2 converts obj to the row of meta_v2
+func (s *imitator) metaV2(obj runtime.Object) (models.MetaV2, error) {
+	key, err := metaserver.KeyFuncObj(obj)
+	if err != nil {
+		return models.MetaV2{}, err
+	}
 	gvr, ns, name := metaserver.ParseKey(key)
 	unstr, isUnstr := obj.(*unstructured.Unstructured)
 	if !isUnstr {
-		return fmt.Errorf("obj is not unstructured type")
+		return models.MetaV2{}, fmt.Errorf("obj is not unstructured type")
 	}
 	buf := bytes.NewBuffer(nil)
 	err = s.codec.Encode(unstr, buf)
 	if err != nil {
-		return err
+		return models.MetaV2{}, err
 	}
-	objRv, err := s.versioner.ObjectResourceVersion(obj)
-	m := models.MetaV2{
+	objRv, _ := s.versioner.ObjectResourceVersion(obj)
+	return models.MetaV2{
 		Key:                  key,
 		GroupVersionResource: gvr.String(),
 		Namespace:            ns,
 		Name:                 name,
 		ResourceVersion:      objRv,
 		Value:                buf.String(),
-	}
-	return s.insertOrReplaceMetaV2(m, objRv)
+	}, nil
 }
 
 func (s *imitator) insertOrReplaceMetaV2(m models.MetaV2, objRv uint64) error {
@@ -99,6 +110,7 @@ func (s *imitator) insertOrReplaceMetaV2(m models.MetaV2, objRv uint64) error {
 	if objRv > s.GetRevision() {
 		s.SetRevision(objRv)
 	}
+	s.cache.update(m)
 	klog.V(4).Infof("[metaserver]successfully insert or update obj:%v", m.Key)
 	return nil
 }
@@ -116,14 +128,24 @@ func (s *imitator) GetPassThroughObj(_ context.Context, key string) ([]byte, err
 }
 
 func (s *imitator) Delete(_ context.Context, key string) error {
+	return s.delete(key, nil)
+}
+
+// delete deletes key from meta_v2, m is the deleted object if it's known
+func (s *imitator) delete(key string, m *models.MetaV2) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	err := dbclient.NewMetaV2Service().DeleteByKey(key)
 	if err != nil {
 		klog.Errorf("[imitator] delete error: %v", err)
+		return err
+	}
+	if m != nil && m.ResourceVersion > s.GetRevision() {
+		s.SetRevision(m.ResourceVersion)
 	}
-	return err
+	s.cache.delete(key, m)
+	return nil
 }
 
 func (s *imitator) InsertOrUpdatePassThroughObj(_ context.Context, obj []byte, key string) error {
@@ -135,12 +157,11 @@ func (s *imitator) InsertOrUpdatePassThroughObj(_ context.Context, obj []byte, k
 }
 
 func (s *imitator) DeleteObj(_ context.Context, obj runtime.Object) error {
-	key, err := metaserver.KeyFuncObj(obj)
+	m, err := s.metaV2(obj)
 	if err != nil {
 		return err
 	}
-	err = s.Delete(context.TODO(), key)
-	return err
+	return s.delete(m.Key, &m)
 }
 
 func (s *imitator) Get(_ context.Context, key string) (Resp, error) {
@@ -160,7 +181,7 @@ func (s *imitator) Get(_ context.Context, key string) (Resp, error) {
 		return Resp{}, fmt.Errorf("the server could not find the requested resource")
 	}
 }
-func (s *imitator) List(_ context.Context, key string) (Resp, error) {
+func (s *imitator) List(_ context.Context, key string, rev uint64) (Resp, error) {
 	gvr, ns, name := metaserver.ParseKey(key)
 	//if name != NullName {
 	//	return Resp{}, fmt.Errorf("dao client list must not have resource name")
@@ -168,6 +189,20 @@ func (s *imitator) List(_ context.Context, key string) (Resp, error) {
 	klog.Infof("%v,%v,%v", gvr, ns, name)
 	var resp Resp
 	s.lock.RLock()
+	if s.cache.isInitialized() {
+		objs, listRev, err := s.cache.list(gvr, ns, name, rev)
+		s.lock.RUnlock()
+		if err != nil {
+			return Resp{}, err
+		}
+		resp.Kvs = &objs
+		resp.Revision = listRev
+		return resp, nil
+	}
+	if rev > s.revision {
+		s.lock.RUnlock()
+		return Resp{}, storage.NewTooLargeResourceVersionError(rev, s.revision, TooLargeResourceVersionRetrySeconds)
+	}
 	results, err := dbclient.NewMetaV2Service().RawMetaByGVRNN(gvr, ns, name)
 	resp.Revision = s.revision
 
@@ -175,6 +210,7 @@ func (s *imitator) List(_ context.Context, key string) (Resp, error) {
 	if err != nil {
 		return Resp{}, err
 	}
+	sort.Slice(*results, func(i, j int) bool { return (*results)[i].Key < (*results)[j].Key })
 	resp.Kvs = results
 	return resp, nil
 }
@@ -201,13 +237,37 @@ func (s *imitator) SetRevision(version interface{}) {
 	}
 }
 
-func (s *imitator) Watch(ctx context.Context, key string, rev uint64) <-chan watch.Event {
-	wch := make(chan watch.Event)
-	receiver := watchhook.NewChanReceiver(wch)
-	wh, err := watchhook.NewWatchHook(key, rev, receiver)
+func (s *imitator) Watch(ctx context.Context, key string, rev uint64) (<-chan watch.Event, error) {
+	gvr, ns, name := metaserver.ParseKey(key)
+	var wch chan watch.Event
+	var wh *watchhook.WatchHook
+	// The events recorded before the hook is added are replayed, if such an
+	// event is triggered after that, the hook drops it because its resource
+	// version is not bigger than the replayed ones.
+	ok, err := s.cache.since(gvr, ns, name, rev, func(events []watchCacheEvent) error {
+		wch = make(chan watch.Event, len(events))
+		hookRev := rev
+		for _, e := range events {
+			obj, err := runtime.Decode(s.codec, []byte(e.Object.Value))
+			if err != nil {
+				klog.Errorf("failed to decode cached obj %s, %v", e.Object.Key, err)
+				continue
+			}
+			wch <- watch.Event{Type: e.Type, Object: obj}
+			if e.Object.ResourceVersion > hookRev {
+				hookRev = e.Object.ResourceVersion
+			}
+		}
+		var err error
+		wh, err = watchhook.NewWatchHook(key, hookRev, watchhook.NewChanReceiver(wch))
+		return err
+	})
+	if !ok {
+		return nil, tooOldResourceVersionError(rev, s.cache.oldestResourceVersion())
+	}
 	if err != nil {
 		klog.Errorf("add hook for %s failed, %v", key, err)
-		return nil
+		return nil, err
 	}
 
 	go func() {
@@ -215,7 +275,7 @@ func (s *imitator) Watch(ctx context.Context, key string, rev uint64) <-chan wat
 		wh.Stop()
 		close(wch)
 	}()
-	return wch
+	return wch, nil
 }
 
 // Event transform the message to watch.event
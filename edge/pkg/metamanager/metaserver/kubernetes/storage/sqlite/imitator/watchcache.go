@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imitator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+// TooLargeResourceVersionRetrySeconds is the retry delay suggested to the
+// clients that list at a resource version newer than the cache
+const TooLargeResourceVersionRetrySeconds = 1
+
+// watchCacheEvent is a change of a meta_v2 object kept in the history of the watch cache
+type watchCacheEvent struct {
+	Type watch.EventType
+	// Object is the object of the event, it's the deleted object for watch.Deleted
+	Object *models.MetaV2
+	// PrevObject is the object before the event, nil if it did not exist
+	PrevObject *models.MetaV2
+}
+
+// watchCache keeps the meta_v2 objects and a bounded history of their events
+// in memory. Like the watch cache of kube-apiserver, it serves lists at the
+// latest or a recent resource version and replays the events after a resource
+// version to resumed watches.
+type watchCache struct {
+	lock sync.RWMutex
+	// initialized is false until replace is called, the cache is bypassed before it
+	initialized bool
+	items       map[string]*models.MetaV2
+	// history is a ring buffer of the latest events, the oldest one is at start
+	history []watchCacheEvent
+	start   int
+	count   int
+	// all events after oldestRV are kept in history, the events at or before it are evicted
+	oldestRV uint64
+	// resourceVersion is the biggest resource version in the cache
+	resourceVersion uint64
+}
+
+func newWatchCache(capacity int) *watchCache {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &watchCache{
+		items:   make(map[string]*models.MetaV2),
+		history: make([]watchCacheEvent, capacity),
+	}
+}
+
+// replace resets the cache to objs, the history starts from resourceVersion
+func (c *watchCache) replace(objs []models.MetaV2, resourceVersion uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.items = make(map[string]*models.MetaV2, len(objs))
+	for i := range objs {
+		if objs[i].GroupVersionResource == "" {
+			continue
+		}
+		c.items[objs[i].Key] = &objs[i]
+	}
+	c.start, c.count = 0, 0
+	c.oldestRV = resourceVersion
+	c.resourceVersion = resourceVersion
+	c.initialized = true
+}
+
+func (c *watchCache) isInitialized() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.initialized
+}
+
+// update records that m is inserted or updated
+func (c *watchCache) update(m models.MetaV2) {
+	// pass through objects are not resources and never listed or watched
+	if m.GroupVersionResource == "" {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.initialized {
+		return
+	}
+
+	prev := c.items[m.Key]
+	eventType := watch.Modified
+	if prev == nil {
+		eventType = watch.Added
+	}
+	c.items[m.Key] = &m
+	c.record(watchCacheEvent{Type: eventType, Object: &m, PrevObject: prev})
+}
+
+// delete records that the object of key is deleted, m is the deleted object
+// and defaults to the cached one if nil
+func (c *watchCache) delete(key string, m *models.MetaV2) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.initialized {
+		return
+	}
+
+	prev := c.items[key]
+	if prev == nil {
+		return
+	}
+	if m == nil {
+		m = prev
+	}
+	delete(c.items, key)
+	c.record(watchCacheEvent{Type: watch.Deleted, Object: m, PrevObject: prev})
+}
+
+func (c *watchCache) record(e watchCacheEvent) {
+	rv := e.Object.ResourceVersion
+	if rv > c.resourceVersion {
+		c.resourceVersion = rv
+	}
+	capacity := len(c.history)
+	if capacity == 0 {
+		c.evict(rv)
+		return
+	}
+	if c.count == capacity {
+		c.evict(c.history[c.start].Object.ResourceVersion)
+		c.history[c.start] = e
+		c.start = (c.start + 1) % capacity
+		return
+	}
+	c.history[(c.start+c.count)%capacity] = e
+	c.count++
+}
+
+func (c *watchCache) evict(rv uint64) {
+	if rv > c.oldestRV {
+		c.oldestRV = rv
+	}
+}
+
+// since calls fn with the events after resourceVersion that match gvr,
+// namespace and name in the order they happened. No event is recorded until
+// fn returns. ok is false if some of these events are evicted from the history.
+func (c *watchCache) since(gvr schema.GroupVersionResource, namespace, name string, resourceVersion uint64,
+	fn func(events []watchCacheEvent) error) (ok bool, err error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if !c.initialized {
+		return true, fn(nil)
+	}
+	if resourceVersion < c.oldestRV {
+		return false, nil
+	}
+	var events []watchCacheEvent
+	for i := 0; i < c.count; i++ {
+		e := c.history[(c.start+i)%len(c.history)]
+		if e.Object.ResourceVersion > resourceVersion && matches(e.Object, gvr, namespace, name) {
+			events = append(events, e)
+		}
+	}
+	return true, fn(events)
+}
+
+// list returns the objects that match gvr, namespace and name sorted by key.
+// If resourceVersion is 0 or the latest one, the latest objects are returned,
+// otherwise the objects are rewound to resourceVersion by the history. It
+// fails with 410 Gone if some of the needed events are evicted, and with the
+// too large resource version error if resourceVersion is newer than the cache.
+func (c *watchCache) list(gvr schema.GroupVersionResource, namespace, name string, resourceVersion uint64) (objs []models.MetaV2, rv uint64, err error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if resourceVersion > c.resourceVersion {
+		return nil, 0, storage.NewTooLargeResourceVersionError(resourceVersion, c.resourceVersion, TooLargeResourceVersionRetrySeconds)
+	}
+	items := make(map[string]*models.MetaV2)
+	for key, m := range c.items {
+		if matches(m, gvr, namespace, name) {
+			items[key] = m
+		}
+	}
+	rv = c.resourceVersion
+	if resourceVersion != 0 && resourceVersion < c.resourceVersion {
+		if resourceVersion < c.oldestRV {
+			return nil, 0, tooOldResourceVersionError(resourceVersion, c.oldestRV)
+		}
+		// undo the events after resourceVersion from the newest one
+		for i := c.count - 1; i >= 0; i-- {
+			e := c.history[(c.start+i)%len(c.history)]
+			if e.Object.ResourceVersion <= resourceVersion || !matches(e.Object, gvr, namespace, name) {
+				continue
+			}
+			if e.PrevObject == nil {
+				delete(items, e.Object.Key)
+			} else {
+				items[e.Object.Key] = e.PrevObject
+			}
+		}
+		rv = resourceVersion
+	}
+
+	objs = make([]models.MetaV2, 0, len(items))
+	for _, m := range items {
+		objs = append(objs, *m)
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Key < objs[j].Key })
+	return objs, rv, nil
+}
+
+// oldestResourceVersion returns the oldest resource version that can be resumed from
+func (c *watchCache) oldestResourceVersion() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.oldestRV
+}
+
+// tooOldResourceVersionError is the 410 Gone error of a resource version whose events are evicted from the cache
+func tooOldResourceVersionError(rev, oldestRV uint64) error {
+	return apierrors.NewResourceExpired(fmt.Sprintf("too old resource version: %d (%d)", rev, oldestRV))
+}
+
+// matches has the same filter semantics as RawMetaByGVRNN
+func matches(m *models.MetaV2, gvr schema.GroupVersionResource, namespace, name string) bool {
+	if !gvr.Empty() && m.GroupVersionResource != gvr.String() {
+		return false
+	}
+	if namespace != models.NullNamespace && namespace != "" && m.Namespace != namespace {
+		return false
+	}
+	if name != models.NullName && name != "" && m.Name != name {
+		return false
+	}
+	return true
+}
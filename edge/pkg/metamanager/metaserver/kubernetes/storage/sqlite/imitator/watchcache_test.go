@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imitator
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+)
+
+var podsGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+func pod(name, value string, rv uint64) models.MetaV2 {
+	return models.MetaV2{
+		Key:                  "/core/v1/pods/default/" + name,
+		GroupVersionResource: podsGVR.String(),
+		Namespace:            "default",
+		Name:                 name,
+		ResourceVersion:      rv,
+		Value:                value,
+	}
+}
+
+func listValues(c *watchCache, rv uint64) ([]string, uint64, error) {
+	objs, listRV, err := c.list(podsGVR, "default", "", rv)
+	var values []string
+	for _, obj := range objs {
+		values = append(values, obj.Name+"="+obj.Value)
+	}
+	return values, listRV, err
+}
+
+func sinceTypes(t *testing.T, c *watchCache, name string, rv uint64) ([]watch.EventType, bool) {
+	var types []watch.EventType
+	ok, err := c.since(podsGVR, "default", name, rv, func(events []watchCacheEvent) error {
+		for _, e := range events {
+			types = append(types, e.Type)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("since err: %v", err)
+	}
+	return types, ok
+}
+
+func TestWatchCacheNotInitialized(t *testing.T) {
+	c := newWatchCache(10)
+	c.update(pod("p1", "a", 10))
+	if c.isInitialized() || len(c.items) != 0 || c.count != 0 {
+		t.Errorf("expected the update to be ignored before the cache is initialized")
+	}
+	if types, ok := sinceTypes(t, c, "", 5); !ok || len(types) != 0 {
+		t.Errorf("expected no events, but got %v, %v", types, ok)
+	}
+}
+
+func TestWatchCacheList(t *testing.T) {
+	c := newWatchCache(10)
+	c.replace([]models.MetaV2{pod("p2", "a", 8), {Key: "/version", Value: "v1.30"}}, 8)
+	c.update(pod("p1", "a", 10))
+	c.update(pod("p2", "b", 11))
+	deleted := pod("p1", "a", 12)
+	c.delete(deleted.Key, &deleted)
+	c.update(pod("p3", "a", 13))
+
+	cases := []struct {
+		name     string
+		rv       uint64
+		expected []string
+		listRV   uint64
+	}{
+		{name: "case1 latest", rv: 0, expected: []string{"p2=b", "p3=a"}, listRV: 13},
+		{name: "case2 the latest resource version", rv: 13, expected: []string{"p2=b", "p3=a"}, listRV: 13},
+		{name: "case3 after the update of p2", rv: 11, expected: []string{"p1=a", "p2=b"}, listRV: 11},
+		{name: "case4 after the add of p1", rv: 10, expected: []string{"p1=a", "p2=a"}, listRV: 10},
+		{name: "case5 start of the history", rv: 8, expected: []string{"p2=a"}, listRV: 8},
+	}
+	for _, tc := range cases {
+		values, listRV, err := listValues(c, tc.rv)
+		if err != nil || !reflect.DeepEqual(values, tc.expected) || listRV != tc.listRV {
+			t.Errorf("%s: expected %v at %d, but got %v at %d, err %v", tc.name, tc.expected, tc.listRV, values, listRV, err)
+		}
+	}
+	if _, _, err := listValues(c, 7); !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected the list before the history to be expired, but got %v", err)
+	}
+	if _, _, err := listValues(c, 20); !storage.IsTooLargeResourceVersion(err) {
+		t.Errorf("expected the list newer than the cache to fail with too large resource version, but got %v", err)
+	}
+}
+
+func TestWatchCacheSince(t *testing.T) {
+	c := newWatchCache(3)
+	c.replace(nil, 5)
+	c.update(pod("p1", "a", 6))
+	c.update(pod("p2", "a", 7))
+	c.update(pod("p1", "b", 8))
+
+	types, ok := sinceTypes(t, c, "", 5)
+	if !ok || !reflect.DeepEqual(types, []watch.EventType{watch.Added, watch.Added, watch.Modified}) {
+		t.Errorf("expected all events, but got %v, ok %v", types, ok)
+	}
+	types, _ = sinceTypes(t, c, "p1", 6)
+	if !reflect.DeepEqual(types, []watch.EventType{watch.Modified}) {
+		t.Errorf("expected the update of p1, but got %v", types)
+	}
+
+	// the add of p1 is evicted
+	deleted := pod("p2", "a", 9)
+	c.delete(deleted.Key, &deleted)
+	if _, ok := sinceTypes(t, c, "", 5); ok {
+		t.Errorf("expected the evicted resource version to be too old")
+	}
+	types, ok = sinceTypes(t, c, "", 6)
+	if !ok || !reflect.DeepEqual(types, []watch.EventType{watch.Added, watch.Modified, watch.Deleted}) {
+		t.Errorf("expected the kept events, but got %v, ok %v", types, ok)
+	}
+	if types, ok := sinceTypes(t, c, "", 9); !ok || len(types) != 0 {
+		t.Errorf("expected no events after the latest one, but got %v, ok %v", types, ok)
+	}
+
+	// without history, only the latest resource version can be resumed
+	c = newWatchCache(0)
+	c.replace(nil, 5)
+	c.update(pod("p1", "a", 6))
+	if _, ok := sinceTypes(t, c, "", 5); ok {
+		t.Errorf("expected the resource version to be too old without history")
+	}
+	if _, ok := sinceTypes(t, c, "", 6); !ok {
+		t.Errorf("expected the latest resource version to be resumed")
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"reflect"
 	"strconv"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/fields"
@@ -28,6 +30,10 @@ import (
 /*
 This file is designed to encapsulate the Imitator as Store.Interface,
 */
+
+// continueKeyPrefix is the prefix of the meta_v2 keys in continue tokens
+const continueKeyPrefix = "/"
+
 type store struct {
 	client    imitator.Client
 	versioner storage.Versioner
@@ -90,14 +96,52 @@ func (s *store) GetList(ctx context.Context, key string, opts storage.ListOption
 		return fmt.Errorf("need ptr to slice: %v", err)
 	}
 
-	resp, err := s.client.List(context.TODO(), key)
+	// A continued list is served at the resource version of the first page,
+	// which is consistent as long as the watch cache keeps the events after it.
+	var startKey string
+	var rev, minRev uint64
+	if opts.Predicate.Continue != "" {
+		var continueRV int64
+		startKey, continueRV, err = storage.DecodeContinue(opts.Predicate.Continue, continueKeyPrefix)
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		rev = uint64(continueRV)
+	} else if opts.ResourceVersionMatch == metav1.ResourceVersionMatchExact {
+		if rev, err = s.versioner.ParseResourceVersion(opts.ResourceVersion); err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid resource version: %v", err))
+		}
+	} else if minRev, err = s.versioner.ParseResourceVersion(opts.ResourceVersion); err != nil {
+		// the latest objects are listed, which must not be older than the resource version
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid resource version: %v", err))
+	}
+
+	resp, err := s.client.List(context.TODO(), key, rev)
+	if opts.Predicate.Continue != "" && apierrors.IsResourceExpired(err) {
+		return apierrors.NewResourceExpired("the provided continue parameter is too old to display a consistent list result, " +
+			"start a new list without the continue parameter")
+	}
 
+	if err == nil && minRev > resp.Revision {
+		err = storage.NewTooLargeResourceVersionError(minRev, resp.Revision, imitator.TooLargeResourceVersionRetrySeconds)
+	}
 	if err != nil || len(*resp.Kvs) == 0 {
 		klog.Error(err)
 		return err
 	}
 	unstrList := listObj.(*unstructured.UnstructuredList)
+	// the kvs are sorted by key, so the next page starts after the last key of this one
+	var lastKey string
+	var remaining int64
 	for _, v := range *resp.Kvs {
+		if v.Key < startKey {
+			continue
+		}
+		if opts.Predicate.Limit > 0 && int64(len(unstrList.Items)) == opts.Predicate.Limit {
+			// like etcd, the remaining kvs are not filtered, so the last page may be empty
+			remaining++
+			continue
+		}
 		var unstrObj unstructured.Unstructured
 		err := runtime.DecodeInto(s.codec, []byte(v.Value), &unstrObj)
 		if err != nil {
@@ -125,6 +169,17 @@ func (s *store) GetList(ctx context.Context, key string, opts storage.ListOption
 		}
 
 		unstrList.Items = append(unstrList.Items, unstrObj)
+		lastKey = v.Key
+	}
+	if remaining > 0 {
+		token, err := storage.EncodeContinue(lastKey+"\x00", continueKeyPrefix, int64(resp.Revision))
+		if err != nil {
+			return err
+		}
+		unstrList.SetContinue(token)
+		if opts.Predicate.Empty() {
+			unstrList.SetRemainingItemCount(&remaining)
+		}
 	}
 	rv := strconv.FormatUint(resp.Revision, 10)
 	unstrList.SetResourceVersion(rv)
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/storage"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator/fake"
+)
+
+const configMapsKey = "/core/v1/configmaps/default"
+
+func configMap(name string) models.MetaV2 {
+	return models.MetaV2{
+		Key:   configMapsKey + "/" + name,
+		Value: fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":%q,"namespace":"default"}}`, name),
+	}
+}
+
+func newFakeStore(client imitator.Client) *store {
+	codec := unstructured.UnstructuredJSONScheme
+	return &store{
+		client:    client,
+		versioner: imitator.Versioner,
+		watcher:   newWatcher(client, codec),
+		codec:     codec,
+	}
+}
+
+// listStore returns a store of 3 config maps at revision 10 and records the
+// revisions it's listed at
+func listStore(revs *[]uint64, err error) *store {
+	return newFakeStore(fake.Client{
+		ListF: func(_ context.Context, _ string, rev uint64) (imitator.Resp, error) {
+			*revs = append(*revs, rev)
+			if err != nil {
+				return imitator.Resp{}, err
+			}
+			kvs := []models.MetaV2{configMap("c1"), configMap("c2"), configMap("c3")}
+			return imitator.Resp{Kvs: &kvs, Revision: 10}, nil
+		},
+	})
+}
+
+func listOptions(rv string, match metav1.ResourceVersionMatch, limit int64, continueToken string) storage.ListOptions {
+	return storage.ListOptions{
+		ResourceVersion:      rv,
+		ResourceVersionMatch: match,
+		Predicate: storage.SelectionPredicate{
+			Label:    labels.Everything(),
+			Field:    fields.Everything(),
+			Limit:    limit,
+			Continue: continueToken,
+		},
+	}
+}
+
+func itemNames(list *unstructured.UnstructuredList) []string {
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestGetListContinue(t *testing.T) {
+	var revs []uint64
+	s := listStore(&revs, nil)
+
+	first := &unstructured.UnstructuredList{}
+	if err := s.GetList(context.TODO(), configMapsKey, listOptions("", "", 2, ""), first); err != nil {
+		t.Fatalf("failed to list the first page: %v", err)
+	}
+	if names := itemNames(first); !reflect.DeepEqual(names, []string{"c1", "c2"}) {
+		t.Errorf("expected c1 and c2 in the first page, but got %v", names)
+	}
+	if first.GetContinue() == "" || first.GetResourceVersion() != "10" {
+		t.Fatalf("expected a continue token at revision 10, but got %q at %s", first.GetContinue(), first.GetResourceVersion())
+	}
+	if remaining := first.GetRemainingItemCount(); remaining == nil || *remaining != 1 {
+		t.Errorf("expected 1 remaining item, but got %v", remaining)
+	}
+
+	second := &unstructured.UnstructuredList{}
+	if err := s.GetList(context.TODO(), configMapsKey, listOptions("", "", 2, first.GetContinue()), second); err != nil {
+		t.Fatalf("failed to list the second page: %v", err)
+	}
+	if names := itemNames(second); !reflect.DeepEqual(names, []string{"c3"}) {
+		t.Errorf("expected c3 in the second page, but got %v", names)
+	}
+	if second.GetContinue() != "" {
+		t.Errorf("expected no continue token in the last page, but got %q", second.GetContinue())
+	}
+	// the first page is listed at the latest revision and the next one at the revision of the token
+	if !reflect.DeepEqual(revs, []uint64{0, 10}) {
+		t.Errorf("expected the pages to be listed at 0 and 10, but got %v", revs)
+	}
+}
+
+func TestGetListErrors(t *testing.T) {
+	token, err := storage.EncodeContinue(configMapsKey+"/c2\x00", continueKeyPrefix, 5)
+	if err != nil {
+		t.Fatalf("failed to encode the continue token: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		opts      storage.ListOptions
+		listErr   error
+		expectErr func(error) bool
+		listedAt  []uint64
+	}{
+		{
+			name:      "case1 invalid continue token",
+			opts:      listOptions("", "", 2, "invalid"),
+			expectErr: apierrors.IsBadRequest,
+		},
+		{
+			name:    "case2 continue token evicted from the watch cache",
+			opts:    listOptions("", "", 2, token),
+			listErr: apierrors.NewResourceExpired("too old resource version: 5 (8)"),
+			expectErr: func(err error) bool {
+				return apierrors.IsResourceExpired(err) && strings.Contains(err.Error(), "continue parameter is too old")
+			},
+			listedAt: []uint64{5},
+		},
+		{
+			name:      "case3 exact resource version newer than the storage",
+			opts:      listOptions("20", metav1.ResourceVersionMatchExact, 0, ""),
+			listErr:   storage.NewTooLargeResourceVersionError(20, 10, imitator.TooLargeResourceVersionRetrySeconds),
+			expectErr: storage.IsTooLargeResourceVersion,
+			listedAt:  []uint64{20},
+		},
+		{
+			name:      "case4 not older than a resource version newer than the storage",
+			opts:      listOptions("20", metav1.ResourceVersionMatchNotOlderThan, 0, ""),
+			expectErr: storage.IsTooLargeResourceVersion,
+			listedAt:  []uint64{0},
+		},
+		{
+			name:      "case5 invalid resource version",
+			opts:      listOptions("abc", "", 0, ""),
+			expectErr: apierrors.IsBadRequest,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var revs []uint64
+			s := listStore(&revs, tc.listErr)
+			err := s.GetList(context.TODO(), configMapsKey, tc.opts, &unstructured.UnstructuredList{})
+			if !tc.expectErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(revs, tc.listedAt) {
+				t.Errorf("expected to be listed at %v, but got %v", tc.listedAt, revs)
+			}
+		})
+	}
+}
@@ -61,6 +61,8 @@ type watchChan struct {
 	ctx               context.Context
 	cancel            context.CancelFunc
 	incomingEventChan chan *watch.Event
+	// wch is the watch of the client opened when the watch resumes from a resource version
+	wch <-chan watch.Event
 	// added is map show an obj whether it has been added to watch chan before
 	added      map[string]bool
 	resultChan chan watch.Event
@@ -88,12 +90,17 @@ func (wc *watchChan) Receive(e watch.Event) error {
 // If recursive is true, it watches any children and directories under the key, excluding the root key itself.
 // pred must be non-nil. Only if pred matches the change, it will be returned.
 func (w *watcher) Watch(ctx context.Context, key string, rev int64, recursive bool, pred storage.SelectionPredicate) (watch.Interface, error) {
-	// TODO: support rev != 0
+	wc := w.createWatchChan(ctx, key, rev, recursive, pred)
 	if rev != 0 {
-		klog.Warningf("base storage now only support rev == 0, but get rev == %v, force set to 0!", rev)
-		rev = 0
+		// The events after rev are replayed from the watch cache, open the watch
+		// here to fail the request with 410 Gone if they are evicted.
+		wch, err := w.client.Watch(wc.ctx, key, uint64(rev))
+		if err != nil {
+			wc.cancel()
+			return nil, err
+		}
+		wc.wch = wch
 	}
-	wc := w.createWatchChan(ctx, key, rev, recursive, pred)
 	go wc.run()
 	return wc, nil
 }
@@ -166,7 +173,7 @@ func (wc *watchChan) ResultChan() <-chan watch.Event {
 func (wc *watchChan) sync() error {
 	switch wc.recursive {
 	case true: /*list*/
-		resp, err := wc.watcher.client.List(context.TODO(), wc.key)
+		resp, err := wc.watcher.client.List(context.TODO(), wc.key, 0)
 		if err != nil {
 			return err
 		}
@@ -180,7 +187,7 @@ func (wc *watchChan) sync() error {
 		}
 		wc.initialRev = int64(resp.Revision)
 	case false: /*get*/
-		resp, err := wc.watcher.client.List(context.TODO(), wc.key)
+		resp, err := wc.watcher.client.List(context.TODO(), wc.key, 0)
 		if err != nil {
 			return err
 		}
@@ -228,14 +235,22 @@ func logWatchChannelErr(err error) {
 // - watch on given key and send events to process.
 func (wc *watchChan) startWatching(watchClosedCh chan struct{}) {
 	klog.Infof("start watching, rev:%v", wc.initialRev)
-	if wc.initialRev == 0 {
-		if err := wc.sync(); err != nil {
-			klog.Errorf("failed to sync with latest state: %v", err)
+	wch := wc.wch
+	if wch == nil {
+		if wc.initialRev == 0 {
+			if err := wc.sync(); err != nil {
+				klog.Errorf("failed to sync with latest state: %v", err)
+				wc.sendError(err)
+				return
+			}
+		}
+		var err error
+		wch, err = wc.watcher.client.Watch(wc.ctx, wc.key, uint64(wc.initialRev))
+		if err != nil {
 			wc.sendError(err)
 			return
 		}
 	}
-	wch := wc.watcher.client.Watch(wc.ctx, wc.key, uint64(wc.initialRev+1))
 	for wres := range wch {
 		wc.sendEvent(&wres)
 	}
@@ -314,6 +329,9 @@ func (wc *watchChan) acceptAll() bool {
 
 func transformErrorToEvent(err error) *watch.Event {
 	status := apierrors.NewInternalError(err).Status()
+	if statusErr, ok := err.(apierrors.APIStatus); ok {
+		status = statusErr.Status()
+	}
 	return &watch.Event{
 		Type:   watch.Error,
 		Object: &status,
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator/fake"
+)
+
+func TestWatchResumeExpired(t *testing.T) {
+	var watchedAt uint64
+	s := newFakeStore(fake.Client{
+		WatchF: func(_ context.Context, _ string, rev uint64) (<-chan watch.Event, error) {
+			watchedAt = rev
+			return nil, apierrors.NewResourceExpired("too old resource version: 5 (8)")
+		},
+	})
+	w, err := s.Watch(context.TODO(), configMapsKey, listOptions("5", "", 0, ""))
+	if !apierrors.IsResourceExpired(err) || w != nil {
+		t.Fatalf("expected the watch to fail with 410 Gone, but got %v", err)
+	}
+	if watchedAt != 5 {
+		t.Errorf("expected to watch from 5, but got %d", watchedAt)
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	cm := configMap("c1")
+	obj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, []byte(cm.Value))
+	if err != nil {
+		t.Fatalf("failed to decode the config map: %v", err)
+	}
+	var watchedAt uint64
+	s := newFakeStore(fake.Client{
+		// the resumed watch replays the events from the client without listing
+		WatchF: func(_ context.Context, _ string, rev uint64) (<-chan watch.Event, error) {
+			watchedAt = rev
+			wch := make(chan watch.Event, 1)
+			wch <- watch.Event{Type: watch.Modified, Object: obj}
+			return wch, nil
+		},
+	})
+	w, err := s.Watch(context.TODO(), configMapsKey, listOptions("5", "", 0, ""))
+	if err != nil {
+		t.Fatalf("failed to resume the watch: %v", err)
+	}
+	defer w.Stop()
+	if watchedAt != 5 {
+		t.Errorf("expected to watch from 5, but got %d", watchedAt)
+	}
+
+	select {
+	case e := <-w.ResultChan():
+		// the object is new to the watcher, so the modification is sent as an add
+		if e.Type != watch.Added {
+			t.Errorf("expected an Added event, but got %v", e.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for the replayed event")
+	}
+}
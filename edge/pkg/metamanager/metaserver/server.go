@@ -211,6 +211,8 @@ func (ls *MetaServer) BuildBasicHandler() http.Handler {
 			case reqInfo.Verb == "get":
 				if reqInfo.Subresource == "log" {
 					ls.Factory.Logs(reqInfo).ServeHTTP(w, req)
+				} else if reqInfo.Resource == "devices" && reqInfo.Subresource == "history" {
+					ls.Factory.DeviceHistory(reqInfo).ServeHTTP(w, req)
 				} else {
 					ls.Factory.Get().ServeHTTP(w, req)
 				}
@@ -231,6 +233,8 @@ func (ls *MetaServer) BuildBasicHandler() http.Handler {
 					ls.Factory.ConfirmUpgrade().ServeHTTP(w, req)
 				} else if reqInfo.Subresource == "exec" {
 					ls.Factory.Exec(reqInfo).ServeHTTP(w, req)
+				} else if reqInfo.Resource == "devices" && reqInfo.Subresource == "methods" {
+					ls.Factory.CallDeviceMethod(reqInfo).ServeHTTP(w, req)
 				} else {
 					ls.Factory.Create(reqInfo).ServeHTTP(w, req)
 				}
@@ -161,7 +161,7 @@ func (m *metaManager) handleMessage(message *model.Message) error {
 	return nil
 }
 
-func processDeletePodDB(message model.Message, ms *dbclient.MetaService) error {
+func processDeletePodDB(message model.Message, ms dbclient.MetaStore) error {
 	var msgPod corev1.Pod
 	msgContent, err := message.GetContentData()
 	if err != nil {
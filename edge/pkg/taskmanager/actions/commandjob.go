@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	klog "k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/common/constants"
+	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/message"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/commandbundle"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
+	"github.com/kubeedge/kubeedge/pkg/util/execs"
+)
+
+const (
+	// defaultCommandExecTimeout is the default duration of running the commands of each action.
+	defaultCommandExecTimeout = 60 * time.Second
+	// commandWaitDelay is the delay of waiting for the I/O of the killed commands to be closed.
+	commandWaitDelay = time.Second
+)
+
+func newCommandJobRunner() *ActionRunner {
+	logger := klog.Background().WithName("command-job-runner")
+	handler := commandJobActionHandler{
+		logger: logger,
+	}
+	runner := &ActionRunner{
+		Flow:                 actionflow.FlowCommandJob,
+		ReportActionStatus:   handler.reportActionStatus,
+		GetSpecSerializer:    handler.getSpecSerializer,
+		GetMaintenanceWindow: handler.getMaintenanceWindow,
+		Logger:               logger,
+	}
+	runner.addAction(string(operationsv1alpha2.CommandJobActionCheck), handler.checkBundle)
+	runner.addAction(string(operationsv1alpha2.CommandJobActionRun), handler.runCommands)
+	runner.addAction(string(operationsv1alpha2.CommandJobActionVerify), handler.verifyCommands)
+	return runner
+}
+
+type commandJobActionResponse struct {
+	output *operationsv1alpha2.CommandOutput
+	baseActionResponse
+}
+
+// Check that commandJobActionResponse implements ActionResponse interface.
+var _ ActionResponse = (*commandJobActionResponse)(nil)
+
+// commandJobActionHandler defines action-related functions
+type commandJobActionHandler struct {
+	logger logr.Logger
+}
+
+// checkBundle checks whether the command bundle is allowed on the edge node and bound
+// to the job and the node, and verifies its signature by the trusted public keys.
+func (commandJobActionHandler) checkBundle(
+	_ctx context.Context,
+	jobname, nodename string,
+	specser SpecSerializer,
+) ActionResponse {
+	resp := new(commandJobActionResponse)
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.CommandJobSpec)
+	if !ok {
+		resp.err = fmt.Errorf("failed to conv spec to CommandJobSpec, actual type %T", specser.GetSpec())
+		return resp
+	}
+	resp.err = verifyBundle(spec.Bundle, jobname, nodename)
+	return resp
+}
+
+// verifyBundle returns an error if the command bundle is not allowed on the edge node,
+// it is not signed for the job and the node or has expired, or its signature is not
+// verified by the trusted public keys. The actions that run the commands verify the
+// bundle again, because the action flow can start at any action.
+func verifyBundle(bundle operationsv1alpha2.CommandBundle, jobname, nodename string) error {
+	cfg := getCommandJobConfig()
+	if !slices.Contains(cfg.AllowedBundles, bundle.Name) {
+		return fmt.Errorf("the command bundle %s is not allowed on the edge node", bundle.Name)
+	}
+	if err := commandbundle.CheckBinding(bundle, jobname, nodename, time.Now()); err != nil {
+		return err
+	}
+	keys, err := commandbundle.LoadPublicKeys(cfg.PublicKeyFiles)
+	if err != nil {
+		return err
+	}
+	return commandbundle.Verify(bundle, keys)
+}
+
+func (h *commandJobActionHandler) runCommands(
+	ctx context.Context,
+	jobname, nodename string,
+	specser SpecSerializer,
+) ActionResponse {
+	resp := new(commandJobActionResponse)
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.CommandJobSpec)
+	if !ok {
+		resp.err = fmt.Errorf("failed to conv spec to CommandJobSpec, actual type %T", specser.GetSpec())
+		return resp
+	}
+	if err := verifyBundle(spec.Bundle, jobname, nodename); err != nil {
+		resp.err = err
+		return resp
+	}
+	resp.output, resp.err = runBundleCommands(ctx, spec.Bundle.Commands,
+		getCommandExecTimeout(spec.Bundle), int(getCommandJobConfig().MaxOutputBytes))
+	resp.output.Action = operationsv1alpha2.CommandJobActionRun
+	return resp
+}
+
+func (h *commandJobActionHandler) verifyCommands(
+	ctx context.Context,
+	jobname, nodename string,
+	specser SpecSerializer,
+) ActionResponse {
+	resp := new(commandJobActionResponse)
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.CommandJobSpec)
+	if !ok {
+		resp.err = fmt.Errorf("failed to conv spec to CommandJobSpec, actual type %T", specser.GetSpec())
+		return resp
+	}
+	if err := verifyBundle(spec.Bundle, jobname, nodename); err != nil {
+		resp.err = err
+		return resp
+	}
+	if len(spec.Bundle.VerifyCommands) == 0 {
+		return resp
+	}
+	resp.output, resp.err = runBundleCommands(ctx, spec.Bundle.VerifyCommands,
+		getCommandExecTimeout(spec.Bundle), int(getCommandJobConfig().MaxOutputBytes))
+	resp.output.Action = operationsv1alpha2.CommandJobActionVerify
+	return resp
+}
+
+// runBundleCommands runs the commands in order by the shell of the edge node, and stops at
+// the first failed command. All the commands must be finished within the timeout.
+// The returned output is never nil.
+func runBundleCommands(
+	ctx context.Context,
+	commands []string,
+	timeout time.Duration,
+	maxOutputBytes int,
+) (*operationsv1alpha2.CommandOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout, stderr := newTailBuffer(maxOutputBytes), newTailBuffer(maxOutputBytes)
+	output := &operationsv1alpha2.CommandOutput{}
+	var runErr error
+	for _, command := range commands {
+		// Use the same shell as the other commands run by the edge node.
+		args := execs.NewCommand(command).Cmd.Args
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+		cmd.WaitDelay = commandWaitDelay
+		setProcessGroup(cmd)
+		err := cmd.Run()
+		if err == nil {
+			continue
+		}
+		output.ExitCode = -1
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			output.ExitCode = int32(exitErr.ExitCode())
+		}
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			runErr = fmt.Errorf("the commands timed out after %s, command: %s", timeout, command)
+		case output.ExitCode == -1:
+			runErr = fmt.Errorf("failed to run command: %s, err: %v", command, err)
+		default:
+			runErr = fmt.Errorf("the command exited with code %d, command: %s", output.ExitCode, command)
+		}
+		break
+	}
+	output.Stdout, output.Stderr = stdout.String(), stderr.String()
+	output.Truncated = stdout.truncated || stderr.truncated
+	return output, runErr
+}
+
+func (commandJobActionHandler) getSpecSerializer(specData []byte) (SpecSerializer, error) {
+	return NewSpecSerializer(specData, func(d []byte) (any, error) {
+		var spec operationsv1alpha2.CommandJobSpec
+		if err := json.Unmarshal(d, &spec); err != nil {
+			return nil, err
+		}
+		return &spec, nil
+	})
+}
+
+func (commandJobActionHandler) getMaintenanceWindow(specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow {
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.CommandJobSpec)
+	if !ok {
+		return nil
+	}
+	return spec.MaintenanceWindow
+}
+
+func (h *commandJobActionHandler) reportActionStatus(jobname, nodename, action string, resp ActionResponse) {
+	res := taskmsg.Resource{
+		APIVersion:   operationsv1alpha2.SchemeGroupVersion.String(),
+		ResourceType: operationsv1alpha2.ResourceCommandJob,
+		JobName:      jobname,
+		NodeName:     nodename,
+	}
+	var extend string
+	if resp, ok := resp.(*commandJobActionResponse); ok && resp.output != nil {
+		var err error
+		extend, err = taskmsg.FormatCommandJobExtend(*resp.output)
+		if err != nil {
+			h.logger.Error(err, "failed to marshal command output")
+		}
+	}
+	body := taskmsg.UpstreamMessage{
+		Action:     action,
+		FinishTime: time.Now().UTC().Format(time.RFC3339),
+		Extend:     extend,
+	}
+	if err := resp.Error(); err != nil {
+		body.Succ = false
+		body.Reason = err.Error()
+	} else {
+		body.Succ = true
+	}
+	message.ReportNodeTaskStatus(res, body)
+}
+
+// getCommandJobConfig returns the command job config of the edge node, never returns nil.
+func getCommandJobConfig() *edgecoreconfig.CommandJobConfig {
+	var cfg edgecoreconfig.CommandJobConfig
+	if edgecoreCfg := options.GetEdgeCoreConfig(); edgecoreCfg != nil &&
+		edgecoreCfg.Modules != nil &&
+		edgecoreCfg.Modules.TaskManager != nil &&
+		edgecoreCfg.Modules.TaskManager.CommandJob != nil {
+		cfg = *edgecoreCfg.Modules.TaskManager.CommandJob
+	}
+	if cfg.MaxOutputBytes <= 0 {
+		cfg.MaxOutputBytes = constants.DefaultCommandJobMaxOutputBytes
+	}
+	return &cfg
+}
+
+// getCommandExecTimeout returns the duration of running the commands of each action.
+func getCommandExecTimeout(bundle operationsv1alpha2.CommandBundle) time.Duration {
+	if ts := bundle.ExecTimeoutSeconds; ts != nil && *ts > 0 {
+		return time.Duration(*ts) * time.Second
+	}
+	return defaultCommandExecTimeout
+}
+
+// tailBuffer is a writer that keeps the last limit bytes written to it.
+type tailBuffer struct {
+	limit     int
+	buf       []byte
+	truncated bool
+}
+
+func newTailBuffer(limit int) *tailBuffer {
+	return &tailBuffer{limit: limit}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.limit; over > 0 {
+		b.buf = append(b.buf[:0], b.buf[over:]...)
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+// String returns the kept bytes, the incomplete UTF-8 sequences at the cut point are dropped.
+func (b *tailBuffer) String() string {
+	return strings.ToValidUTF8(string(b.buf), "")
+}
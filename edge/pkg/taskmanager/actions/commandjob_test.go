@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/commandbundle"
+)
+
+func TestCommandJobCheckBundle(t *testing.T) {
+	ctx := context.TODO()
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "bundle.pub")
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	bundle := operationsv1alpha2.CommandBundle{
+		Name:           "restart-app",
+		Commands:       []string{"systemctl restart app"},
+		JobName:        "restart-app-job",
+		NodeNames:      []string{"edge-node1"},
+		ExpirationTime: metav1.NewTime(time.Now().Add(time.Hour)),
+	}
+	bundle.Signature, err = commandbundle.Sign(bundle, key)
+	require.NoError(t, err)
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(options.GetEdgeCoreConfig, func() *edgecoreconfig.EdgeCoreConfig {
+		cfg := edgecoreconfig.NewDefaultEdgeCoreConfig()
+		cfg.Modules.TaskManager.CommandJob.AllowedBundles = []string{"restart-app"}
+		cfg.Modules.TaskManager.CommandJob.PublicKeyFiles = []string{keyFile}
+		return cfg
+	})
+
+	cases := []struct {
+		name     string
+		bundle   func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle
+		jobName  string
+		nodeName string
+		wantErr  string
+	}{
+		{
+			name: "case1 the command bundle is not allowed",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.Name = "unknown"
+				return b
+			},
+			wantErr: "the command bundle unknown is not allowed on the edge node",
+		},
+		{
+			name: "case2 the commands are changed after signing",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.Commands = []string{"rm -rf /"}
+				return b
+			},
+			wantErr: "is not verified by any trusted public key",
+		},
+		{
+			name: "case3 the command bundle is verified",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				return b
+			},
+		},
+		{
+			name: "case4 the command bundle is replayed in another job",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				return b
+			},
+			jobName: "another-job",
+			wantErr: `is signed for job "restart-app-job", not for job "another-job"`,
+		},
+		{
+			name: "case5 the command bundle is not signed for the node",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				return b
+			},
+			nodeName: "edge-node2",
+			wantErr:  "is not signed for node edge-node2",
+		},
+		{
+			name: "case6 the expiration time is changed after signing",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.ExpirationTime = metav1.NewTime(b.ExpirationTime.Add(24 * time.Hour))
+				return b
+			},
+			wantErr: "is not verified by any trusted public key",
+		},
+	}
+	h := commandJobActionHandler{
+		logger: klog.Background(),
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			specser := &cachedSpecSerializer{
+				spec: &operationsv1alpha2.CommandJobSpec{Bundle: c.bundle(bundle)},
+			}
+			jobName, nodeName := "restart-app-job", "edge-node1"
+			if c.jobName != "" {
+				jobName = c.jobName
+			}
+			if c.nodeName != "" {
+				nodeName = c.nodeName
+			}
+			resp := h.checkBundle(ctx, jobName, nodeName, specser)
+			if c.wantErr != "" {
+				assert.ErrorContains(t, resp.Error(), c.wantErr)
+			} else {
+				assert.NoError(t, resp.Error())
+			}
+		})
+	}
+}
+
+func TestCommandJobRunUnsignedBundle(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "bundle.pub")
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(options.GetEdgeCoreConfig, func() *edgecoreconfig.EdgeCoreConfig {
+		cfg := edgecoreconfig.NewDefaultEdgeCoreConfig()
+		cfg.Modules.TaskManager.CommandJob.AllowedBundles = []string{"touch-file"}
+		cfg.Modules.TaskManager.CommandJob.PublicKeyFiles = []string{keyFile}
+		return cfg
+	})
+
+	file := filepath.Join(t.TempDir(), "touched")
+	spec := operationsv1alpha2.CommandJobSpec{
+		Bundle: operationsv1alpha2.CommandBundle{
+			Name:           "touch-file",
+			Commands:       []string{"touch " + file},
+			VerifyCommands: []string{"touch " + file},
+			JobName:        "test-job",
+			ExpirationTime: metav1.NewTime(time.Now().Add(time.Hour)),
+		},
+	}
+	data, err := json.Marshal(spec)
+	require.NoError(t, err)
+
+	for _, action := range []operationsv1alpha2.CommandJobAction{
+		operationsv1alpha2.CommandJobActionRun,
+		operationsv1alpha2.CommandJobActionVerify,
+	} {
+		t.Run(string(action), func(t *testing.T) {
+			var reported []string
+			runner := newCommandJobRunner()
+			runner.ReportActionStatus = func(_jobname, _nodename, action string, resp ActionResponse) {
+				reported = append(reported, action)
+				assert.ErrorContains(t, resp.Error(), "the command bundle is not signed")
+			}
+			// The action flow starts at the action of the message, instead of the Check action.
+			runner.RunAction(context.TODO(), "test-job", "test-node", string(action), data)
+			assert.Equal(t, []string{string(action)}, reported)
+			assert.NoFileExists(t, file)
+		})
+	}
+}
+
+func TestRunBundleCommands(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("case1 all commands succeed", func(t *testing.T) {
+		output, err := runBundleCommands(ctx, []string{"echo hello", "echo world"}, 10*time.Second, 1024)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), output.ExitCode)
+		assert.Contains(t, output.Stdout, "hello")
+		assert.Contains(t, output.Stdout, "world")
+		assert.False(t, output.Truncated)
+	})
+
+	t.Run("case2 stop at the first failed command", func(t *testing.T) {
+		output, err := runBundleCommands(ctx, []string{"exit 3", "echo skipped"}, 10*time.Second, 1024)
+		require.ErrorContains(t, err, "the command exited with code 3")
+		assert.Equal(t, int32(3), output.ExitCode)
+		assert.NotContains(t, output.Stdout, "skipped")
+	})
+
+	t.Run("case3 the commands time out", func(t *testing.T) {
+		output, err := runBundleCommands(ctx, []string{"sleep 10"}, 100*time.Millisecond, 1024)
+		require.ErrorContains(t, err, "the commands timed out")
+		assert.Equal(t, int32(-1), output.ExitCode)
+	})
+
+	t.Run("case4 the processes started by the commands are killed on timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := runBundleCommands(ctx, []string{"sleep 10 & wait"}, 100*time.Millisecond, 1024)
+		require.ErrorContains(t, err, "the commands timed out")
+		// the background sleep would hold the output pipe until the wait delay if it was alive
+		assert.Less(t, time.Since(start), commandWaitDelay)
+	})
+
+	t.Run("case5 only the tail of the output is kept", func(t *testing.T) {
+		output, err := runBundleCommands(ctx, []string{"echo 0123456789"}, 10*time.Second, 4)
+		require.NoError(t, err)
+		assert.Equal(t, "789\n", output.Stdout)
+		assert.True(t, output.Truncated)
+	})
+}
+
+func TestTailBuffer(t *testing.T) {
+	b := newTailBuffer(8)
+	n, err := b.Write([]byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, "hello", b.String())
+	assert.False(t, b.truncated)
+
+	_, err = b.Write([]byte(" world"))
+	require.NoError(t, err)
+	assert.Equal(t, "lo world", b.String())
+	assert.True(t, b.truncated)
+
+	// The incomplete UTF-8 sequence at the cut point is dropped.
+	b = newTailBuffer(4)
+	_, err = b.Write([]byte("a" + strings.Repeat("世", 2)))
+	require.NoError(t, err)
+	assert.Equal(t, "世", b.String())
+}
@@ -40,10 +40,11 @@ func newConfigUpdateJobRunner() *ActionRunner {
 		logger: logger,
 	}
 	runner := &ActionRunner{
-		Flow:               actionflow.FlowConfigUpdateJob,
-		ReportActionStatus: handler.reportActionStatus,
-		GetSpecSerializer:  handler.getSpecSerializer,
-		Logger:             logger,
+		Flow:                 actionflow.FlowConfigUpdateJob,
+		ReportActionStatus:   handler.reportActionStatus,
+		GetSpecSerializer:    handler.getSpecSerializer,
+		GetMaintenanceWindow: handler.getMaintenanceWindow,
+		Logger:               logger,
 	}
 	runner.addAction(string(operationsv1alpha2.ConfigUpdateJobActionCheck), handler.checkItems)
 	runner.addAction(string(operationsv1alpha2.ConfigUpdateJobActionBackUp), handler.backup)
@@ -131,6 +132,14 @@ func (h *configUpdateJobActionHandler) getSpecSerializer(specData []byte) (SpecS
 	})
 }
 
+func (h *configUpdateJobActionHandler) getMaintenanceWindow(specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow {
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.ConfigUpdateJobSpec)
+	if !ok {
+		return nil
+	}
+	return spec.MaintenanceWindow
+}
+
 func (h *configUpdateJobActionHandler) reportActionStatus(jobname, nodename, action string, resp ActionResponse) {
 	res := taskmsg.Resource{
 		APIVersion:   operationsv1alpha2.SchemeGroupVersion.String(),
@@ -36,6 +36,7 @@ import (
 	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/edge/pkg/taskmanager/imagemirror"
 	"github.com/kubeedge/kubeedge/pkg/image"
 	"github.com/kubeedge/kubeedge/pkg/nodetask/actionflow"
 	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
@@ -47,10 +48,11 @@ func newImagePrePullJobRunner() *ActionRunner {
 		logger: logger,
 	}
 	runner := &ActionRunner{
-		Flow:               actionflow.FlowImagePrePullJob,
-		ReportActionStatus: handler.reportActionStatus,
-		GetSpecSerializer:  handler.getSpecSerializer,
-		Logger:             logger,
+		Flow:                 actionflow.FlowImagePrePullJob,
+		ReportActionStatus:   handler.reportActionStatus,
+		GetSpecSerializer:    handler.getSpecSerializer,
+		GetMaintenanceWindow: handler.getMaintenanceWindow,
+		Logger:               logger,
 	}
 	runner.addAction(string(operationsv1alpha2.ImagePrePullJobActionCheck), handler.checkItems)
 	runner.addAction(string(operationsv1alpha2.ImagePrePullJobActionPull), handler.pullImages)
@@ -59,6 +61,10 @@ func newImagePrePullJobRunner() *ActionRunner {
 
 const (
 	pullImageFailureMessage = "there were some failures when pulling images"
+
+	// defaultPeerServeDuration is the duration that the elected node serves the images for its peers
+	// after pulling them, if the node job has no timeout.
+	defaultPeerServeDuration = time.Hour
 )
 
 type imagePrePullJobActionResponse struct {
@@ -80,9 +86,9 @@ func (imagePrePullJobActionHandler) checkItems(
 	specser SpecSerializer,
 ) ActionResponse {
 	resp := new(imagePrePullJobActionResponse)
-	spec, ok := specser.GetSpec().(*operationsv1alpha2.ImagePrePullJobSpec)
+	spec, ok := specser.GetSpec().(*taskmsg.ImagePrePullJobTaskSpec)
 	if !ok {
-		resp.err = fmt.Errorf("failed to conv spec to ImagePrePullJobSpec, actual type %T", specser.GetSpec())
+		resp.err = fmt.Errorf("failed to conv spec to ImagePrePullJobTaskSpec, actual type %T", specser.GetSpec())
 		return resp
 	}
 	if len(spec.ImagePrePullTemplate.CheckItems) > 0 {
@@ -96,21 +102,21 @@ func (imagePrePullJobActionHandler) checkItems(
 
 func (h *imagePrePullJobActionHandler) pullImages(
 	ctx context.Context,
-	_jobname, _nodename string,
+	jobname, _nodename string,
 	specser SpecSerializer,
 ) ActionResponse {
 	const retryDelay = 500 * time.Millisecond
 	resp := new(imagePrePullJobActionResponse)
-	spec, ok := specser.GetSpec().(*operationsv1alpha2.ImagePrePullJobSpec)
+	spec, ok := specser.GetSpec().(*taskmsg.ImagePrePullJobTaskSpec)
 	if !ok {
-		resp.err = fmt.Errorf("failed to conv spec to ImagePrePullJobSpec, actual type %T", specser.GetSpec())
+		resp.err = fmt.Errorf("failed to conv spec to ImagePrePullJobTaskSpec, actual type %T", specser.GetSpec())
 		return resp
 	}
 	var imageStatus []operationsv1alpha2.ImageStatus
 	err := retry.Do(
 		func() error {
 			var err error
-			imageStatus, err = h.tryPullImage(ctx, spec)
+			imageStatus, err = h.tryPullImage(ctx, jobname, spec)
 			return err
 		},
 		retry.Delay(retryDelay),
@@ -121,13 +127,33 @@ func (h *imagePrePullJobActionHandler) pullImages(
 	if err != nil {
 		resp.err = err
 	}
+	if mirror := imagemirror.Get(); spec.ServePeers && mirror != nil {
+		servePeers(mirror, jobname, spec, err == nil)
+	}
 	resp.imageStatus = imageStatus
 	return resp
 }
 
+// servePeers keeps the grant of the node job in the image mirror for the peers after the images
+// are pulled. The peers are released when the elected node finishes, and their node tasks time out
+// after the timeout of the node job, so the grant expires then. If the elected node fails, the peers
+// pull the images from the upstream registries, so the grant is revoked immediately.
+func servePeers(mirror *imagemirror.Mirror, jobname string, spec *taskmsg.ImagePrePullJobTaskSpec, succ bool) {
+	if !succ {
+		mirror.Revoke(jobname)
+		return
+	}
+	duration := defaultPeerServeDuration
+	if ts := spec.ImagePrePullTemplate.TimeoutSeconds; ts != nil && *ts > 0 {
+		duration = time.Duration(*ts) * time.Second
+	}
+	mirror.Expire(jobname, duration)
+}
+
 func (imagePrePullJobActionHandler) tryPullImage(
 	ctx context.Context,
-	spec *operationsv1alpha2.ImagePrePullJobSpec,
+	jobname string,
+	spec *taskmsg.ImagePrePullJobTaskSpec,
 ) ([]operationsv1alpha2.ImageStatus, error) {
 	edgecoreCfg := options.GetEdgeCoreConfig()
 	imgrt, err := image.NewImageRuntime(
@@ -152,17 +178,39 @@ func (imagePrePullJobActionHandler) tryPullImage(
 				named[0], named[1], err)
 		}
 	}
+	// In the Peer pull mode, the images are pulled through the image mirror.
+	switch {
+	case spec.ServePeers:
+		mirror := imagemirror.Get()
+		if mirror == nil {
+			return nil, errors.New("the image mirror is not enabled")
+		}
+		if err := mirror.Allow(jobname, spec.PeerToken, spec.ImagePrePullTemplate.Images, &authcfg); err != nil {
+			return nil, err
+		}
+		imgrt = imgrt.WithMirror(mirror.Endpoint(), spec.PeerToken)
+	case spec.PeerEndpoint != "":
+		imgrt = imgrt.WithMirror(spec.PeerEndpoint, spec.PeerToken)
+	}
 	imageStatus := make([]operationsv1alpha2.ImageStatus, 0, len(spec.ImagePrePullTemplate.Images))
 	var hasError bool
 	for _, image := range spec.ImagePrePullTemplate.Images {
 		st := operationsv1alpha2.ImageStatus{Image: image}
-		if err := imgrt.PullImage(ctx, image, &authcfg, nil); err != nil {
+		fellBack, err := imgrt.PullImageThroughMirror(ctx, image, &authcfg, nil)
+		if err != nil {
 			hasError = true
 			st.Status = metav1.ConditionFalse
 			st.Reason = err.Error()
 		} else {
 			st.Status = metav1.ConditionTrue
 		}
+		// The images that cannot be pulled from the elected node are pulled from the upstream registries.
+		if spec.PeerEndpoint != "" && err == nil {
+			st.PullSource = operationsv1alpha2.ImagePullSourcePeer
+			if fellBack {
+				st.PullSource = operationsv1alpha2.ImagePullSourceRegistry
+			}
+		}
 		imageStatus = append(imageStatus, st)
 	}
 	if hasError {
@@ -173,7 +221,7 @@ func (imagePrePullJobActionHandler) tryPullImage(
 
 func (imagePrePullJobActionHandler) getSpecSerializer(specData []byte) (SpecSerializer, error) {
 	return NewSpecSerializer(specData, func(d []byte) (any, error) {
-		var spec operationsv1alpha2.ImagePrePullJobSpec
+		var spec taskmsg.ImagePrePullJobTaskSpec
 		if err := json.Unmarshal(d, &spec); err != nil {
 			return nil, err
 		}
@@ -181,6 +229,14 @@ func (imagePrePullJobActionHandler) getSpecSerializer(specData []byte) (SpecSeri
 	})
 }
 
+func (imagePrePullJobActionHandler) getMaintenanceWindow(specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow {
+	spec, ok := specser.GetSpec().(*taskmsg.ImagePrePullJobTaskSpec)
+	if !ok {
+		return nil
+	}
+	return spec.ImagePrePullTemplate.MaintenanceWindow
+}
+
 func (h *imagePrePullJobActionHandler) reportActionStatus(jobname, nodename, action string, resp ActionResponse) {
 	res := taskmsg.Resource{
 		APIVersion:   operationsv1alpha2.SchemeGroupVersion.String(),
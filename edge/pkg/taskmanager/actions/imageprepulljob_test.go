@@ -29,19 +29,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
 	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
 	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
+	"github.com/kubeedge/kubeedge/edge/pkg/taskmanager/imagemirror"
 	"github.com/kubeedge/kubeedge/pkg/image"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
 )
 
 func TestImagePrePullJobCheckItems(t *testing.T) {
 	ctx := context.TODO()
 	specser := &cachedSpecSerializer{
-		spec: &operationsv1alpha2.ImagePrePullJobSpec{
-			ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
-				CheckItems: []string{"cpu", "mem", "disk"},
+		spec: &taskmsg.ImagePrePullJobTaskSpec{
+			ImagePrePullJobSpec: operationsv1alpha2.ImagePrePullJobSpec{
+				ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+					CheckItems: []string{"cpu", "mem", "disk"},
+				},
 			},
 		},
 	}
@@ -77,9 +82,11 @@ func TestImagePrePullJobCheckItems(t *testing.T) {
 func TestImagePrePullJobPullImages(t *testing.T) {
 	ctx := context.TODO()
 	specser := &cachedSpecSerializer{
-		spec: &operationsv1alpha2.ImagePrePullJobSpec{
-			ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
-				Images: []string{"image1", "image2"},
+		spec: &taskmsg.ImagePrePullJobTaskSpec{
+			ImagePrePullJobSpec: operationsv1alpha2.ImagePrePullJobSpec{
+				ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+					Images: []string{"image1", "image2"},
+				},
 			},
 		},
 	}
@@ -98,16 +105,16 @@ func TestImagePrePullJobPullImages(t *testing.T) {
 	) (*image.RuntimeImpl, error) {
 		return imagert, nil
 	})
-	patches.ApplyMethodFunc(reflect.TypeOf(imagert), "PullImage",
+	patches.ApplyMethodFunc(reflect.TypeOf(imagert), "PullImageThroughMirror",
 		func(_ctx context.Context,
 			image string,
 			_authConfig *runtimeapi.AuthConfig,
 			_sandboxConfig *runtimeapi.PodSandboxConfig,
-		) error {
+		) (bool, error) {
 			if image == "image2" {
-				return errors.New("test error")
+				return false, errors.New("test error")
 			}
-			return nil
+			return false, nil
 		})
 
 	resp := h.pullImages(ctx, "", "", specser)
@@ -118,3 +125,112 @@ func TestImagePrePullJobPullImages(t *testing.T) {
 	assert.Equal(t, metav1.ConditionFalse, imagePrePullResp.imageStatus[1].Status)
 	assert.Equal(t, "test error", imagePrePullResp.imageStatus[1].Reason)
 }
+
+func TestImagePrePullJobPullImagesFromPeer(t *testing.T) {
+	ctx := context.TODO()
+	h := imagePrePullJobActionHandler{
+		logger: klog.Background(),
+	}
+	newSpecSerializer := func(peerEndpoint string, servePeers bool) *cachedSpecSerializer {
+		return &cachedSpecSerializer{
+			spec: &taskmsg.ImagePrePullJobTaskSpec{
+				ImagePrePullJobSpec: operationsv1alpha2.ImagePrePullJobSpec{
+					ImagePrePullTemplate: operationsv1alpha2.ImagePrePullTemplate{
+						Images: []string{"image1"},
+					},
+				},
+				PeerEndpoint: peerEndpoint,
+				ServePeers:   servePeers,
+				PeerToken:    "test-token",
+			},
+		}
+	}
+
+	var mirrorEndpoint, mirrorToken string
+	imagert := &image.RuntimeImpl{}
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	patches.ApplyFunc(options.GetEdgeCoreConfig, func() *edgecoreconfig.EdgeCoreConfig {
+		return edgecoreconfig.NewDefaultEdgeCoreConfig()
+	})
+	patches.ApplyFunc(image.NewImageRuntime, func(_endpoint string, _timeout time.Duration,
+	) (*image.RuntimeImpl, error) {
+		return imagert, nil
+	})
+	patches.ApplyMethodFunc(reflect.TypeOf(imagert), "WithMirror", func(endpoint, token string) *image.RuntimeImpl {
+		mirrorEndpoint, mirrorToken = endpoint, token
+		return imagert
+	})
+	var (
+		pullErr  error
+		fellBack bool
+	)
+	patches.ApplyMethodFunc(reflect.TypeOf(imagert), "PullImageThroughMirror",
+		func(_ctx context.Context,
+			_image string,
+			_authConfig *runtimeapi.AuthConfig,
+			_sandboxConfig *runtimeapi.PodSandboxConfig,
+		) (bool, error) {
+			return fellBack, pullErr
+		})
+
+	t.Run("pull images from the peer node", func(t *testing.T) {
+		mirrorEndpoint = ""
+		resp := h.pullImages(ctx, "", "", newSpecSerializer("10.0.0.5:10552", false))
+		require.NoError(t, resp.Error())
+		assert.Equal(t, "10.0.0.5:10552", mirrorEndpoint)
+		assert.Equal(t, "test-token", mirrorToken)
+		imagePrePullResp, ok := resp.(*imagePrePullJobActionResponse)
+		require.True(t, ok)
+		assert.Equal(t, operationsv1alpha2.ImagePullSourcePeer, imagePrePullResp.imageStatus[0].PullSource)
+	})
+
+	t.Run("fall back to the upstream registries when the peer node fails", func(t *testing.T) {
+		fellBack = true
+		defer func() { fellBack = false }()
+		resp := h.pullImages(ctx, "", "", newSpecSerializer("10.0.0.5:10552", false))
+		require.NoError(t, resp.Error())
+		imagePrePullResp, ok := resp.(*imagePrePullJobActionResponse)
+		require.True(t, ok)
+		assert.Equal(t, operationsv1alpha2.ImagePullSourceRegistry, imagePrePullResp.imageStatus[0].PullSource)
+	})
+
+	t.Run("the image mirror is not enabled", func(t *testing.T) {
+		resp := h.pullImages(ctx, "", "", newSpecSerializer("", true))
+		require.ErrorContains(t, resp.Error(), "the image mirror is not enabled")
+	})
+
+	t.Run("pull images through the local image mirror", func(t *testing.T) {
+		mirrorEndpoint = ""
+		mirror := imagemirror.New("192.168.1.1", 10552, t.TempDir(), 0)
+		patches := gomonkey.ApplyFunc(imagemirror.Get, func() *imagemirror.Mirror {
+			return mirror
+		})
+		defer patches.Reset()
+
+		var expireAfter time.Duration
+		patches.ApplyMethodFunc(reflect.TypeOf(mirror), "Expire", func(job string, after time.Duration) {
+			expireAfter = after
+		})
+		var revoked string
+		patches.ApplyMethodFunc(reflect.TypeOf(mirror), "Revoke", func(job string) {
+			revoked = job
+		})
+
+		specser := newSpecSerializer("", true)
+		specser.spec.(*taskmsg.ImagePrePullJobTaskSpec).ImagePrePullTemplate.TimeoutSeconds = ptr.To[uint32](600)
+		resp := h.pullImages(ctx, "test-job", "", specser)
+		require.NoError(t, resp.Error())
+		assert.Equal(t, "192.168.1.1:10552", mirrorEndpoint)
+		assert.Equal(t, "test-token", mirrorToken)
+		// The grant expires after the timeout of the node job, when the peers cannot pull the images.
+		assert.Equal(t, 600*time.Second, expireAfter)
+
+		pullErr = errors.New("test error")
+		defer func() { pullErr = nil }()
+		resp = h.pullImages(ctx, "test-job", "", newSpecSerializer("", true))
+		require.Error(t, resp.Error())
+		assert.Equal(t, "test-job", revoked)
+	})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/maintenancewindow"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
+)
+
+// waitMaintenanceWindow blocks until the maintenance window of the node opens.
+// While waiting, the node task status is reported to the cloud with the WaitingWindow action.
+func (r *ActionRunner) waitMaintenanceWindow(
+	ctx context.Context,
+	jobname, nodename string,
+	specser SpecSerializer,
+) error {
+	if r.GetMaintenanceWindow == nil {
+		return nil
+	}
+	spec := r.GetMaintenanceWindow(specser)
+	if spec == nil {
+		return nil
+	}
+	annotations, err := getNodeAnnotations(nodename)
+	if err != nil {
+		return err
+	}
+	window, err := maintenancewindow.ForNode(spec, annotations)
+	if err != nil {
+		return err
+	}
+	for {
+		now := time.Now()
+		if _, open := window.Open(now); open {
+			return nil
+		}
+		next, ok := window.NextOpen(now)
+		if !ok {
+			return errors.New("the maintenance window of the node never opens")
+		}
+		reason := window.WaitingReason(now)
+		r.Logger.Info("the maintenance window is closed, wait for it", "job", jobname, "opens", next)
+		r.ReportActionStatus(jobname, nodename, taskmsg.ActionWaitingWindow,
+			&baseActionResponse{err: errors.New(reason)})
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// getNodeAnnotations returns the annotations of the edge node from the local metadata.
+func getNodeAnnotations(nodename string) (map[string]string, error) {
+	node, err := metaclient.New().Nodes(models.NullNamespace).Get(nodename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s, err: %v", nodename, err)
+	}
+	return node.Annotations, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/klog/v2"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
+)
+
+func TestWaitMaintenanceWindow(t *testing.T) {
+	// The window opens at the beginning of the next hour, so it is closed now.
+	closed := &operationsv1alpha2.MaintenanceWindow{
+		Schedules:       []string{"0 " + time.Now().UTC().Add(time.Hour).Format("15") + " * * *"},
+		DurationSeconds: 60,
+	}
+
+	cases := []struct {
+		name         string
+		window       *operationsv1alpha2.MaintenanceWindow
+		annotations  map[string]string
+		cancelled    bool
+		wantErr      bool
+		wantReported bool
+	}{
+		{
+			name: "case1 no maintenance window",
+		},
+		{
+			name:   "case2 the window of the node annotation is open",
+			window: closed,
+			annotations: map[string]string{
+				operationsv1alpha2.AnnotationMaintenanceWindow: `{"schedules":["* * * * *"],"durationSeconds":60}`,
+			},
+		},
+		{
+			name:         "case3 wait for the window until the context is cancelled",
+			window:       closed,
+			cancelled:    true,
+			wantErr:      true,
+			wantReported: true,
+		},
+		{
+			name:   "case4 invalid annotation of the node",
+			window: closed,
+			annotations: map[string]string{
+				operationsv1alpha2.AnnotationMaintenanceWindow: "invalid",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			patches := gomonkey.NewPatches()
+			defer patches.Reset()
+			patches.ApplyFunc(getNodeAnnotations, func(_nodename string) (map[string]string, error) {
+				return c.annotations, nil
+			})
+
+			var reported bool
+			runner := &ActionRunner{
+				GetMaintenanceWindow: func(_specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow {
+					return c.window
+				},
+				ReportActionStatus: func(_jobname, _nodename, action string, resp ActionResponse) {
+					assert.Equal(t, taskmsg.ActionWaitingWindow, action)
+					assert.Error(t, resp.Error())
+					reported = true
+				},
+				Logger: klog.Background(),
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if c.cancelled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+			err := runner.waitMaintenanceWindow(ctx, "test-job", "node1", nil)
+			assert.Equal(t, c.wantErr, err != nil, err)
+			assert.Equal(t, c.wantReported, reported)
+		})
+	}
+}
@@ -54,12 +54,13 @@ func newNodeUpgradeJobRunner() *ActionRunner {
 		},
 	}
 	runner := &ActionRunner{
-		Flow:               actionflow.FlowNodeUpgradeJob,
-		ReportActionStatus: handler.reportActionStatus,
-		GetSpecSerializer:  handler.getSpecSerializer,
-		PreRun:             handler.preRun,
-		PostRun:            handler.postRun,
-		Logger:             logger,
+		Flow:                 actionflow.FlowNodeUpgradeJob,
+		ReportActionStatus:   handler.reportActionStatus,
+		GetSpecSerializer:    handler.getSpecSerializer,
+		GetMaintenanceWindow: handler.getMaintenanceWindow,
+		PreRun:               handler.preRun,
+		PostRun:              handler.postRun,
+		Logger:               logger,
 	}
 	runner.addAction(string(operationsv1alpha2.NodeUpgradeJobActionCheck), handler.checkItems)
 	runner.addAction(string(operationsv1alpha2.NodeUpgradeJobActionWaitingConfirmation), handler.waitingConfirmation)
@@ -313,6 +314,14 @@ func (nodeUpgradeJobActionHandler) getSpecSerializer(specData []byte) (SpecSeria
 	})
 }
 
+func (nodeUpgradeJobActionHandler) getMaintenanceWindow(specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow {
+	spec, ok := specser.GetSpec().(*operationsv1alpha2.NodeUpgradeJobSpec)
+	if !ok {
+		return nil
+	}
+	return spec.MaintenanceWindow
+}
+
 func (nodeUpgradeJobActionHandler) reportActionStatus(jobname, nodename, action string, resp ActionResponse) {
 	res := taskmsg.Resource{
 		APIVersion:   operationsv1alpha2.SchemeGroupVersion.String(),
@@ -36,6 +36,7 @@ func Init() {
 		operationsv1alpha2.ResourceImagePrePullJob: newImagePrePullJobRunner,
 		operationsv1alpha2.ResourceConfigUpdateJob: newConfigUpdateJobRunner,
 		operationsv1alpha2.ResourceNodeUpgradeJob:  newNodeUpgradeJobRunner,
+		operationsv1alpha2.ResourceCommandJob:      newCommandJobRunner,
 	}
 	for name, factory := range runnersToRegister {
 		RegisterRunner(name, factory())
@@ -106,6 +107,9 @@ type ActionRunner struct {
 	ReportActionStatus func(jobname, nodename, action string, resp ActionResponse)
 	// GetSpecSerializer returns serializer for parse the spec data.
 	GetSpecSerializer func(specData []byte) (SpecSerializer, error)
+	// GetMaintenanceWindow returns the maintenance window of the node job. If it is nil or
+	// returns nil, the actions can be run at any time.
+	GetMaintenanceWindow func(specser SpecSerializer) *operationsv1alpha2.MaintenanceWindow
 	// Logger define a logger in the specified format to print information.
 	Logger logr.Logger
 }
@@ -137,6 +141,12 @@ func (r *ActionRunner) RunAction(ctx context.Context, jobname, nodename, action
 		return
 	}
 
+	if err := r.waitMaintenanceWindow(ctx, jobname, nodename, ser); err != nil {
+		logger.Error(err, "failed to wait for the maintenance window, report to cloud")
+		r.ReportActionStatus(jobname, nodename, action, &baseActionResponse{err: err})
+		return
+	}
+
 	act := r.Flow.Find(action)
 	if r.PreRun != nil {
 		if err := r.PreRun(ctx, jobname, nodename, action, ser); err != nil {
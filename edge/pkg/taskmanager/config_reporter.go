@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/models"
+	"github.com/kubeedge/kubeedge/edge/pkg/taskmanager/imagemirror"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/configprofile"
+)
+
+// ReportEdgeCoreConfig reports the effective EdgeCore configuration and its hash to the cloud
+// by the annotations of the edge node, so that the cloud can detect the configuration drift.
+// The port of the image mirror is also reported if it is enabled, which is used to elect the
+// edge nodes serving the images for their peers.
+// The configuration only changes when EdgeCore restarts, so it is reported when the edge node
+// connects to the cloud.
+func ReportEdgeCoreConfig(ctx context.Context) error {
+	logger := klog.FromContext(ctx).WithName("report-edgecore-config")
+	cfg := options.GetEdgeCoreConfig()
+	if cfg == nil || cfg.Modules == nil || cfg.Modules.Edged == nil {
+		return errors.New("the edgecore config is not loaded")
+	}
+	nodeName := cfg.Modules.Edged.HostnameOverride
+	snapshot, hash, err := configprofile.Snapshot(cfg)
+	if err != nil {
+		return err
+	}
+	annotations := map[string]any{
+		operationsv1alpha2.AnnotationEdgeCoreConfigHash: hash,
+		operationsv1alpha2.AnnotationEdgeCoreConfig:     string(snapshot),
+		// Remove the annotation if the image mirror is not enabled.
+		operationsv1alpha2.AnnotationImageMirrorPort: nil,
+	}
+	if mirror := imagemirror.Get(); mirror != nil {
+		annotations[operationsv1alpha2.AnnotationImageMirrorPort] = strconv.Itoa(int(mirror.Port()))
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the patch of node %s, err: %v", nodeName, err)
+	}
+	if _, err := metaclient.New().Nodes(models.NullNamespace).Patch(nodeName, patch); err != nil {
+		return fmt.Errorf("failed to patch the annotations of node %s, err: %v", nodeName, err)
+	}
+	logger.V(2).Info("reported the edgecore config", "hash", hash)
+	return nil
+}
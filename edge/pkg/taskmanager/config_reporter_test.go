@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskmanager
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+	"github.com/kubeedge/kubeedge/edge/pkg/taskmanager/imagemirror"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/configprofile"
+)
+
+type fakeMetaClient struct {
+	metaclient.CoreInterface
+	nodes *fakeNodes
+}
+
+func (c *fakeMetaClient) Nodes(_namespace string) metaclient.NodesInterface {
+	return c.nodes
+}
+
+type fakeNodes struct {
+	metaclient.NodesInterface
+	name  string
+	patch []byte
+}
+
+func (n *fakeNodes) Patch(name string, patchBytes []byte) (*corev1.Node, error) {
+	n.name, n.patch = name, patchBytes
+	return &corev1.Node{}, nil
+}
+
+func TestReportEdgeCoreConfig(t *testing.T) {
+	ctx := context.TODO()
+	cfg := edgecoreconfig.NewDefaultEdgeCoreConfig()
+	cfg.Modules.Edged.HostnameOverride = "test-node"
+	cfg.Modules.EdgeHub.Token = "secret-token"
+	nodes := &fakeNodes{}
+
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFunc(options.GetEdgeCoreConfig, func() *edgecoreconfig.EdgeCoreConfig {
+		return cfg
+	})
+	patches.ApplyFunc(metaclient.New, func() metaclient.CoreInterface {
+		return &fakeMetaClient{nodes: nodes}
+	})
+
+	require.NoError(t, ReportEdgeCoreConfig(ctx))
+	assert.Equal(t, "test-node", nodes.name)
+
+	var patch struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(nodes.patch, &patch))
+	snapshot := patch.Metadata.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfig]
+	assert.NotContains(t, snapshot, "secret-token")
+	assert.Equal(t, configprofile.Hash([]byte(snapshot)),
+		patch.Metadata.Annotations[operationsv1alpha2.AnnotationEdgeCoreConfigHash])
+	// The image mirror is not enabled, so the annotation of its port is removed.
+	port, ok := patch.Metadata.Annotations[operationsv1alpha2.AnnotationImageMirrorPort]
+	assert.True(t, ok)
+	assert.Empty(t, port)
+
+	mirror := imagemirror.New("192.168.1.1", 10552, t.TempDir(), 0)
+	patches.ApplyFunc(imagemirror.Get, func() *imagemirror.Mirror {
+		return mirror
+	})
+	require.NoError(t, ReportEdgeCoreConfig(ctx))
+	require.NoError(t, json.Unmarshal(nodes.patch, &patch))
+	assert.Equal(t, "10552", patch.Metadata.Annotations[operationsv1alpha2.AnnotationImageMirrorPort])
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"k8s.io/klog/v2"
+)
+
+// blobCache caches the blobs on the disk. The blobs are stored in the files named by their digests,
+// and the modification time of the files is used to remove the least recently used blobs.
+type blobCache struct {
+	dir   string
+	limit int64
+	// lock serializes the pruning of the blobs.
+	lock sync.Mutex
+}
+
+func newBlobCache(dir string, limit int64) *blobCache {
+	return &blobCache{
+		dir:   dir,
+		limit: limit,
+	}
+}
+
+func (c *blobCache) blobPath(dgst digest.Digest) string {
+	return filepath.Join(c.dir, "blobs", dgst.Algorithm().String(), dgst.Encoded())
+}
+
+// open opens the cached blob, it returns fs.ErrNotExist if the blob is not cached.
+func (c *blobCache) open(dgst digest.Digest) (*os.File, int64, error) {
+	path := c.blobPath(dgst)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	// Mark the blob as recently used.
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		klog.V(4).Infof("failed to update the modification time of blob %s, err: %v", dgst, err)
+	}
+	return f, info.Size(), nil
+}
+
+// blobWriter writes a blob to a temporary file, which is moved to the cache when the digest is verified.
+type blobWriter struct {
+	cache    *blobCache
+	dgst     digest.Digest
+	file     *os.File
+	verifier digest.Verifier
+}
+
+func (c *blobCache) writer(dgst digest.Digest) (*blobWriter, error) {
+	tmpDir := filepath.Join(c.dir, "tmp")
+	if err := os.MkdirAll(tmpDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create dir %s, err: %v", tmpDir, err)
+	}
+	f, err := os.CreateTemp(tmpDir, dgst.Encoded())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file of blob %s, err: %v", dgst, err)
+	}
+	return &blobWriter{
+		cache:    c,
+		dgst:     dgst,
+		file:     f,
+		verifier: dgst.Verifier(),
+	}, nil
+}
+
+func (w *blobWriter) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return w.verifier.Write(p[:n])
+}
+
+// commit moves the blob to the cache if its digest is verified, and removes the least recently
+// used blobs if the size of the cache exceeds the limit.
+func (w *blobWriter) commit() error {
+	defer os.Remove(w.file.Name())
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	if !w.verifier.Verified() {
+		return fmt.Errorf("the digest of blob %s is not verified", w.dgst)
+	}
+	path := w.cache.blobPath(w.dgst)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	if err := os.Rename(w.file.Name(), path); err != nil {
+		return err
+	}
+	w.cache.prune()
+	return nil
+}
+
+// abort discards the blob.
+func (w *blobWriter) abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// prune removes the least recently used blobs until the size of the cache does not exceed the limit.
+func (c *blobCache) prune() {
+	if c.limit <= 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	type blobFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var (
+		blobs []blobFile
+		total int64
+	)
+	err := filepath.WalkDir(filepath.Join(c.dir, "blobs"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, blobFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		klog.Warningf("failed to walk the cached blobs, err: %v", err)
+		return
+	}
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobs[i].modTime.Before(blobs[j].modTime)
+	})
+	for i := 0; i < len(blobs) && total > c.limit; i++ {
+		if err := os.Remove(blobs[i].path); err != nil {
+			klog.Warningf("failed to remove the cached blob %s, err: %v", blobs[i].path, err)
+			continue
+		}
+		total -= blobs[i].size
+	}
+}
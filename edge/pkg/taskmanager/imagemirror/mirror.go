@@ -0,0 +1,318 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
+	"k8s.io/apimachinery/pkg/util/wait"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+
+	v1alpha2cfg "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+)
+
+const (
+	// pruneInterval is the interval to drop the expired grants and their cached manifests.
+	pruneInterval = time.Minute
+
+	// maxManifestsPerRepository is the maximum number of the cached manifests of a repository,
+	// the manifests are cached by both the tag and the digest.
+	maxManifestsPerRepository = 64
+)
+
+var defaultMirror *Mirror
+
+// Init creates the image mirror if it is enabled in the config. The image mirror listens on
+// the nodeIP only, it is not enabled if the nodeIP is empty.
+func Init(cfg *v1alpha2cfg.ImageMirrorConfig, nodeIP string) {
+	if cfg == nil || !cfg.Enable {
+		return
+	}
+	if nodeIP == "" {
+		klog.Error("the image mirror is not enabled, because the IP address of the node is unknown")
+		return
+	}
+	defaultMirror = New(nodeIP, cfg.Port, cfg.CacheDir, int64(cfg.CacheSizeLimitMB)<<20)
+}
+
+// Get returns the image mirror, it returns nil if the image mirror is not enabled.
+func Get() *Mirror {
+	return defaultMirror
+}
+
+// Mirror is a pull-through image mirror that implements the pull API of the OCI distribution spec.
+// It pulls the images of the allowed repositories from the upstream registries, and caches the blobs
+// on the disk, so that the peer edge nodes can pull the images without accessing the upstream registries.
+// The repositories of the image mirror are returned by image.MirrorImage.
+//
+// The repositories are allowed by the grants of the node jobs. The peers authenticate with the token
+// of the grant by the basic authentication, and can only pull the repositories allowed by the grant.
+type Mirror struct {
+	address  string
+	port     int32
+	cache    *blobCache
+	upstream *upstreamClient
+	logger   logr.Logger
+
+	lock sync.RWMutex
+	// grants contains the grants of the node jobs, the key is the name of the node job.
+	grants map[string]*grant
+	// manifests caches the manifests pulled from the upstream registries, the key is the repository,
+	// e.g., "docker.io/library/nginx", and then the tag or the digest. The manifests of a repository
+	// are removed when no grant allows the repository, and at most maxManifestsPerRepository manifests
+	// are cached for each repository.
+	manifests map[string]map[string]manifest
+}
+
+// grant allows the peers with the token to pull the repositories through the image mirror.
+type grant struct {
+	token string
+	// credentials contains the allowed repositories and their credentials of the upstream registries,
+	// the key is the repository in the upstream registry, e.g., "docker.io/library/nginx".
+	credentials map[string]credential
+	// expireAt is the time when the grant expires, it is zero if the grant does not expire.
+	expireAt time.Time
+}
+
+type credential struct {
+	username string
+	password string
+	token    string
+}
+
+type manifest struct {
+	mediaType string
+	digest    digest.Digest
+	data      []byte
+	// blobs contains the digests of the config and the layers referenced by the manifest,
+	// the peers can only pull the blobs referenced by the cached manifests of the repository.
+	blobs []digest.Digest
+}
+
+// New creates an image mirror that listens on the address and port, and caches the blobs in the cacheDir.
+func New(address string, port int32, cacheDir string, cacheSizeLimit int64) *Mirror {
+	return &Mirror{
+		address:   address,
+		port:      port,
+		cache:     newBlobCache(cacheDir, cacheSizeLimit),
+		upstream:  newUpstreamClient(),
+		logger:    klog.Background().WithName("image-mirror"),
+		grants:    make(map[string]*grant),
+		manifests: make(map[string]map[string]manifest),
+	}
+}
+
+// Port returns the port that the image mirror listens on.
+func (m *Mirror) Port() int32 {
+	return m.port
+}
+
+// Endpoint returns the endpoint of the image mirror in host:port format.
+func (m *Mirror) Endpoint() string {
+	return net.JoinHostPort(m.address, strconv.Itoa(int(m.port)))
+}
+
+// Allow grants the peers with the token to pull the images of the node job through the image mirror,
+// it replaces the previous grant of the node job. The authConfig is used to pull the images from
+// the upstream registries, it can be nil.
+func (m *Mirror) Allow(job, token string, images []string, authConfig *runtimeapi.AuthConfig) error {
+	if token == "" {
+		return errors.New("the token of the image mirror cannot be empty")
+	}
+	cred := credential{}
+	if authConfig != nil {
+		cred.username, cred.password, cred.token =
+			authConfig.Username, authConfig.Password, authConfig.RegistryToken
+		if authConfig.Auth != "" && cred.username == "" {
+			decoded, err := base64.StdEncoding.DecodeString(authConfig.Auth)
+			if err != nil {
+				return fmt.Errorf("failed to decode the auth of the auth config, err: %v", err)
+			}
+			cred.username, cred.password, _ = strings.Cut(string(decoded), ":")
+		}
+	}
+	g := &grant{
+		token:       token,
+		credentials: make(map[string]credential, len(images)),
+	}
+	for _, image := range images {
+		named, err := reference.ParseNormalizedNamed(image)
+		if err != nil {
+			return fmt.Errorf("failed to parse image %s, err: %v", image, err)
+		}
+		g.credentials[strings.ToLower(reference.Domain(named))+"/"+reference.Path(named)] = cred
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.grants[job] = g
+	m.pruneLocked(time.Now())
+	return nil
+}
+
+// Expire makes the grant of the node job expire after the duration. The credentials of the grant
+// and the cached manifests of its repositories are dropped when it expires.
+func (m *Mirror) Expire(job string, after time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if g, ok := m.grants[job]; ok {
+		g.expireAt = time.Now().Add(after)
+	}
+	m.pruneLocked(time.Now())
+}
+
+// Revoke drops the grant of the node job immediately.
+func (m *Mirror) Revoke(job string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.grants, job)
+	m.pruneLocked(time.Now())
+}
+
+// pruneLocked removes the expired grants, and the cached manifests of the repositories that are
+// not allowed by any grant. It must be called with the lock held.
+func (m *Mirror) pruneLocked(now time.Time) {
+	for job, g := range m.grants {
+		if !g.expireAt.IsZero() && now.After(g.expireAt) {
+			delete(m.grants, job)
+		}
+	}
+	for repo := range m.manifests {
+		if !m.allowedLocked(repo) {
+			delete(m.manifests, repo)
+		}
+	}
+}
+
+func (m *Mirror) allowedLocked(repo string) bool {
+	for _, g := range m.grants {
+		if _, ok := g.credentials[repo]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Start starts the image mirror server, it blocks until the ctx is done.
+func (m *Mirror) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              m.Endpoint(),
+		Handler:           m,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	go wait.Until(func() {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.pruneLocked(time.Now())
+	}, pruneInterval, ctx.Done())
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			m.logger.Error(err, "failed to shutdown the image mirror server")
+		}
+	}()
+	m.logger.Info("start the image mirror server", "endpoint", m.Endpoint())
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve the image mirror, err: %v", err)
+	}
+	return nil
+}
+
+// authenticate returns whether the token is the token of any grant that has not expired.
+func (m *Mirror) authenticate(token string) bool {
+	if token == "" {
+		return false
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked(time.Now())
+	for _, g := range m.grants {
+		if subtle.ConstantTimeCompare([]byte(g.token), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// getCredential returns the credential of the repository, if the grant of the token allows it.
+func (m *Mirror) getCredential(token, repo string) (credential, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.pruneLocked(time.Now())
+	for _, g := range m.grants {
+		if subtle.ConstantTimeCompare([]byte(g.token), []byte(token)) != 1 {
+			continue
+		}
+		if cred, ok := g.credentials[repo]; ok {
+			return cred, true
+		}
+	}
+	return credential{}, false
+}
+
+func (m *Mirror) getManifest(repo, ref string) (manifest, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	mf, ok := m.manifests[repo][ref]
+	return mf, ok
+}
+
+// referencesBlob returns whether any cached manifest of the repository references the blob.
+func (m *Mirror) referencesBlob(repo string, dgst digest.Digest) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	for _, mf := range m.manifests[repo] {
+		for _, blob := range mf.blobs {
+			if blob == dgst {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *Mirror) putManifest(repo, ref string, mf manifest) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	// The grant may have been dropped while the manifest was being pulled.
+	if !m.allowedLocked(repo) {
+		return
+	}
+	// The peers can pull any tag of the allowed repositories, so the cached manifests
+	// of the repository are dropped when there are too many.
+	if len(m.manifests[repo]) >= maxManifestsPerRepository {
+		delete(m.manifests, repo)
+	}
+	if m.manifests[repo] == nil {
+		m.manifests[repo] = make(map[string]manifest)
+	}
+	m.manifests[repo][ref] = mf
+	m.manifests[repo][mf.digest.String()] = mf
+}
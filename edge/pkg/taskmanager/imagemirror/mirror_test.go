@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const testManifestType = "application/vnd.oci.image.manifest.v1+json"
+
+// fakeRegistry is an upstream registry that requires the bearer token authentication.
+type fakeRegistry struct {
+	server   *httptest.Server
+	manifest []byte
+	blob     []byte
+	// requests is the number of the requests of the manifests and blobs.
+	requests atomic.Int32
+	// down makes the registry unavailable.
+	down atomic.Bool
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	reg := &fakeRegistry{blob: []byte("test layer")}
+	reg.manifest = []byte(fmt.Sprintf(`{"schemaVersion":2,"config":{"digest":%q},"layers":[{"digest":%q}]}`,
+		digest.FromString("other"), digest.FromBytes(reg.blob)))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"test-token"}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if reg.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.Header().Set("WWW-Authenticate",
+				`Bearer realm="`+reg.server.URL+`/token",service="test",scope="repository:library/app:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		reg.requests.Add(1)
+		switch r.URL.Path {
+		case "/v2/library/app/manifests/v1":
+			w.Header().Set("Content-Type", testManifestType)
+			_, _ = w.Write(reg.manifest)
+		case "/v2/library/app/blobs/" + digest.FromBytes(reg.blob).String():
+			_, _ = w.Write(reg.blob)
+		case "/v2/library/app/blobs/" + digest.FromString("other").String():
+			_, _ = w.Write([]byte("corrupted"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	reg.server = httptest.NewTLSServer(mux)
+	t.Cleanup(reg.server.Close)
+	return reg
+}
+
+const testToken = "test-peer-token"
+
+func newTestMirror(t *testing.T, reg *fakeRegistry) (*Mirror, string) {
+	m := New("127.0.0.1", 0, t.TempDir(), 0)
+	m.upstream.client = reg.server.Client()
+	require.NoError(t, m.Allow("test-job", testToken, []string{reg.server.Listener.Addr().String() + "/library/app:v1"},
+		&runtimeapi.AuthConfig{Username: "user", Password: "pass"}))
+	// The repository in the image mirror separates the port of the domain by "_".
+	repo := strings.ReplaceAll(reg.server.Listener.Addr().String(), ":", "_") + "/library/app"
+	return m, repo
+}
+
+func doRequest(m *Mirror, method, path string) *httptest.ResponseRecorder {
+	return doRequestWithToken(m, method, path, testToken)
+}
+
+func doRequestWithToken(m *Mirror, method, path, token string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.SetBasicAuth("kubeedge", token)
+	}
+	m.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMirrorServeHTTP(t *testing.T) {
+	reg := newFakeRegistry(t)
+	m, repo := newTestMirror(t, reg)
+	blobDigest := digest.FromBytes(reg.blob)
+
+	t.Run("case1 ping", func(t *testing.T) {
+		rec := doRequest(m, http.MethodGet, "/v2/")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "registry/2.0", rec.Header().Get("Docker-Distribution-API-Version"))
+	})
+
+	t.Run("case2 the repository is not allowed", func(t *testing.T) {
+		rec := doRequest(m, http.MethodGet, "/v2/docker.io/library/nginx/manifests/latest")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), errCodeDenied)
+	})
+
+	t.Run("case3 pull the manifest and blob from the upstream registry", func(t *testing.T) {
+		rec := doRequest(m, http.MethodGet, "/v2/"+repo+"/manifests/v1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, reg.manifest, rec.Body.Bytes())
+		assert.Equal(t, testManifestType, rec.Header().Get("Content-Type"))
+		assert.Equal(t, digest.FromBytes(reg.manifest).String(), rec.Header().Get(headerContentDigest))
+
+		rec = doRequest(m, http.MethodGet, "/v2/"+repo+"/blobs/"+blobDigest.String())
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, reg.blob, rec.Body.Bytes())
+		assert.FileExists(t, m.cache.blobPath(blobDigest))
+		assert.Equal(t, int32(2), reg.requests.Load())
+	})
+
+	t.Run("case4 serve the cached content when the upstream registry is down", func(t *testing.T) {
+		reg.down.Store(true)
+		defer reg.down.Store(false)
+		requests := reg.requests.Load()
+
+		rec := doRequest(m, http.MethodHead, "/v2/"+repo+"/manifests/v1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+
+		rec = doRequest(m, http.MethodGet, "/v2/"+repo+"/manifests/"+digest.FromBytes(reg.manifest).String())
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, reg.manifest, rec.Body.Bytes())
+
+		rec = doRequest(m, http.MethodGet, "/v2/"+repo+"/blobs/"+blobDigest.String())
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, reg.blob, rec.Body.Bytes())
+		assert.Equal(t, requests, reg.requests.Load())
+	})
+
+	t.Run("case5 the corrupted blob is not cached", func(t *testing.T) {
+		dgst := digest.FromString("other")
+		doRequest(m, http.MethodGet, "/v2/"+repo+"/blobs/"+dgst.String())
+		assert.NoFileExists(t, m.cache.blobPath(dgst))
+	})
+
+	t.Run("case6 unknown manifest", func(t *testing.T) {
+		rec := doRequest(m, http.MethodGet, "/v2/"+repo+"/manifests/v2")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), errCodeManifestUnknown)
+	})
+
+	t.Run("case7 the image mirror is read-only", func(t *testing.T) {
+		rec := doRequest(m, http.MethodPut, "/v2/"+repo+"/manifests/v1")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("case8 the requests without the valid token are rejected", func(t *testing.T) {
+		for _, token := range []string{"", "invalid-token"} {
+			rec := doRequestWithToken(m, http.MethodGet, "/v2/", token)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic")
+
+			rec = doRequestWithToken(m, http.MethodGet, "/v2/"+repo+"/blobs/"+blobDigest.String(), token)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("case9 the grant of another job does not allow the repository", func(t *testing.T) {
+		require.NoError(t, m.Allow("other-job", "other-token", []string{"nginx:1.25"}, nil))
+		defer m.Revoke("other-job")
+		rec := doRequestWithToken(m, http.MethodGet, "/v2/"+repo+"/manifests/v1", "other-token")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("case10 the blobs not referenced by the manifests of the repository are unknown", func(t *testing.T) {
+		rec := doRequest(m, http.MethodGet, "/v2/"+repo+"/blobs/"+digest.FromString("unknown").String())
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), errCodeBlobUnknown)
+
+		// The blob is cached for the test job, but the grant of another job cannot pull it
+		// through its own repository.
+		otherRepo := strings.TrimSuffix(repo, "/library/app") + "/library/other"
+		require.NoError(t, m.Allow("other-job", "other-token",
+			[]string{reg.server.Listener.Addr().String() + "/library/other:v1"}, nil))
+		defer m.Revoke("other-job")
+		requests := reg.requests.Load()
+		rec = doRequestWithToken(m, http.MethodGet, "/v2/"+otherRepo+"/blobs/"+blobDigest.String(), "other-token")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), errCodeBlobUnknown)
+		assert.Equal(t, requests, reg.requests.Load())
+	})
+}
+
+func TestMirrorGrantExpire(t *testing.T) {
+	reg := newFakeRegistry(t)
+	m, repo := newTestMirror(t, reg)
+	rec := doRequest(m, http.MethodGet, "/v2/"+repo+"/manifests/v1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, m.manifests, 1)
+
+	m.Expire("test-job", time.Hour)
+	rec = doRequest(m, http.MethodGet, "/v2/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The credentials and the cached manifests are dropped when the grant expires.
+	m.Expire("test-job", -time.Second)
+	assert.Empty(t, m.grants)
+	assert.Empty(t, m.manifests)
+	rec = doRequest(m, http.MethodGet, "/v2/"+repo+"/manifests/v1")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestMirrorManifestLimit(t *testing.T) {
+	m := New("127.0.0.1", 0, t.TempDir(), 0)
+	require.NoError(t, m.Allow("test-job", testToken, []string{"nginx"}, nil))
+	const repo = "docker.io/library/nginx"
+	for i := 0; i < 2*maxManifestsPerRepository; i++ {
+		tag := fmt.Sprintf("v%d", i)
+		m.putManifest(repo, tag, manifest{digest: digest.FromString(tag)})
+		assert.LessOrEqual(t, len(m.manifests[repo]), maxManifestsPerRepository)
+	}
+	_, ok := m.getManifest(repo, fmt.Sprintf("v%d", 2*maxManifestsPerRepository-1))
+	assert.True(t, ok)
+
+	// The manifests of the repositories that are not allowed are not cached.
+	m.putManifest("docker.io/library/redis", "latest", manifest{digest: digest.FromString("redis")})
+	_, ok = m.getManifest("docker.io/library/redis", "latest")
+	assert.False(t, ok)
+}
+
+func TestBlobCachePrune(t *testing.T) {
+	cache := newBlobCache(t.TempDir(), 10)
+	write := func(content string) digest.Digest {
+		dgst := digest.FromString(content)
+		w, err := cache.writer(dgst)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, content)
+		require.NoError(t, err)
+		require.NoError(t, w.commit())
+		return dgst
+	}
+
+	old := write("123456")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(cache.blobPath(old), past, past))
+	recent := write("abcdef")
+
+	// The size of the cache exceeds the limit, so the least recently used blob is removed.
+	assert.NoFileExists(t, cache.blobPath(old))
+	f, size, err := cache.open(recent)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.Equal(t, int64(6), size)
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/kubeedge/kubeedge/pkg/image"
+)
+
+const (
+	headerContentDigest = "Docker-Content-Digest"
+
+	// maxManifestSize is the max size of the manifests pulled from the upstream registries.
+	maxManifestSize = 4 << 20
+
+	errCodeUnauthorized    = "UNAUTHORIZED"
+	errCodeNameInvalid     = "NAME_INVALID"
+	errCodeDenied          = "DENIED"
+	errCodeManifestUnknown = "MANIFEST_UNKNOWN"
+	errCodeBlobUnknown     = "BLOB_UNKNOWN"
+	errCodeDigestInvalid   = "DIGEST_INVALID"
+	errCodeUnsupported     = "UNSUPPORTED"
+	errCodeUnavailable     = "UNAVAILABLE"
+)
+
+// upstreamStatusError is the unexpected status code returned by the upstream registry.
+type upstreamStatusError struct {
+	statusCode int
+}
+
+func (e *upstreamStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d of the upstream registry", e.statusCode)
+}
+
+// ServeHTTP serves the pull API of the OCI distribution spec. The requests must be authenticated
+// by the basic authentication, the password is the token of the grant.
+func (m *Mirror) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, errCodeUnsupported, "the image mirror is read-only")
+		return
+	}
+	_, token, _ := r.BasicAuth()
+	if !m.authenticate(token) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="kubeedge-image-mirror"`)
+		writeError(w, http.StatusUnauthorized, errCodeUnauthorized, "authentication required")
+		return
+	}
+	if r.URL.Path == "/v2/" || r.URL.Path == "/v2" {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+		return
+	}
+	rest, ok := strings.CutPrefix(r.URL.Path, "/v2/")
+	if !ok {
+		writeError(w, http.StatusNotFound, errCodeUnsupported, "unsupported path "+r.URL.Path)
+		return
+	}
+	if i := strings.LastIndex(rest, "/manifests/"); i > 0 {
+		m.serveManifest(w, r, token, rest[:i], rest[i+len("/manifests/"):])
+		return
+	}
+	if i := strings.LastIndex(rest, "/blobs/"); i > 0 {
+		m.serveBlob(w, r, token, rest[:i], rest[i+len("/blobs/"):])
+		return
+	}
+	writeError(w, http.StatusNotFound, errCodeUnsupported, "unsupported path "+r.URL.Path)
+}
+
+// resolveRepository returns the domain and the repository in the upstream registry of the name.
+// It writes the error response and returns false if the repository is not allowed by the grant of the token.
+func (m *Mirror) resolveRepository(w http.ResponseWriter, token, name string) (string, string, credential, bool) {
+	domain, path, err := image.ParseMirrorRepository(name)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errCodeNameInvalid, err.Error())
+		return "", "", credential{}, false
+	}
+	repo := domain + "/" + path
+	cred, ok := m.getCredential(token, repo)
+	if !ok {
+		writeError(w, http.StatusForbidden, errCodeDenied,
+			fmt.Sprintf("the repository %s is not allowed by the image mirror", repo))
+		return "", "", credential{}, false
+	}
+	return domain, path, cred, true
+}
+
+func (m *Mirror) serveManifest(w http.ResponseWriter, r *http.Request, token, name, ref string) {
+	domain, path, cred, ok := m.resolveRepository(w, token, name)
+	if !ok {
+		return
+	}
+	repo := domain + "/" + path
+	// The manifests referenced by digests never change, and the tags are resolved by the upstream
+	// registry so that the changes of the tags are not missed.
+	_, digestErr := digest.Parse(ref)
+	if digestErr == nil {
+		if mf, ok := m.getManifest(repo, ref); ok {
+			writeManifest(w, r, mf)
+			return
+		}
+	}
+	mf, err := m.fetchManifest(r.Context(), domain, path, ref, r.Header.Get("Accept"), cred)
+	if err != nil {
+		// Serve the cached manifest if the upstream registry is unavailable.
+		if cached, ok := m.getManifest(repo, ref); ok {
+			m.logger.V(2).Info("serve the cached manifest", "repository", repo, "reference", ref, "err", err.Error())
+			writeManifest(w, r, cached)
+			return
+		}
+		m.logger.Error(err, "failed to fetch manifest", "repository", repo, "reference", ref)
+		writeUpstreamError(w, err, errCodeManifestUnknown)
+		return
+	}
+	m.putManifest(repo, ref, mf)
+	writeManifest(w, r, mf)
+}
+
+func (m *Mirror) fetchManifest(
+	ctx context.Context,
+	domain, path, ref, accept string,
+	cred credential,
+) (manifest, error) {
+	header := make(http.Header)
+	if accept != "" {
+		header.Set("Accept", accept)
+	}
+	resp, err := m.upstream.do(ctx, http.MethodGet, domain, path, path+"/manifests/"+ref, header, cred)
+	if err != nil {
+		return manifest{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return manifest{}, &upstreamStatusError{statusCode: resp.StatusCode}
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return manifest{}, fmt.Errorf("failed to read manifest, err: %v", err)
+	}
+	if len(data) > maxManifestSize {
+		return manifest{}, fmt.Errorf("the size of manifest exceeds %d bytes", maxManifestSize)
+	}
+	mf := manifest{
+		mediaType: resp.Header.Get("Content-Type"),
+		digest:    digest.FromBytes(data),
+		data:      data,
+	}
+	if dgst, err := digest.Parse(ref); err == nil && dgst != mf.digest {
+		return manifest{}, fmt.Errorf("the digest of manifest %s does not match, actual %s", ref, mf.digest)
+	}
+	if mf.blobs, err = referencedBlobs(data); err != nil {
+		return manifest{}, err
+	}
+	return mf, nil
+}
+
+// referencedBlobs returns the digests of the blobs referenced by the manifest. The image indexes
+// reference the manifests only, which are pulled through the manifests API.
+func referencedBlobs(data []byte) ([]digest.Digest, error) {
+	type descriptor struct {
+		Digest digest.Digest `json:"digest"`
+	}
+	var content struct {
+		Config *descriptor  `json:"config"`
+		Layers []descriptor `json:"layers"`
+		// FSLayers are the layers of the docker schema1 manifests.
+		FSLayers []struct {
+			BlobSum digest.Digest `json:"blobSum"`
+		} `json:"fsLayers"`
+	}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest, err: %v", err)
+	}
+	var blobs []digest.Digest
+	if content.Config != nil && content.Config.Digest != "" {
+		blobs = append(blobs, content.Config.Digest)
+	}
+	for _, layer := range content.Layers {
+		blobs = append(blobs, layer.Digest)
+	}
+	for _, layer := range content.FSLayers {
+		blobs = append(blobs, layer.BlobSum)
+	}
+	return blobs, nil
+}
+
+func writeManifest(w http.ResponseWriter, r *http.Request, mf manifest) {
+	w.Header().Set("Content-Type", mf.mediaType)
+	w.Header().Set(headerContentDigest, mf.digest.String())
+	w.Header().Set("Content-Length", strconv.Itoa(len(mf.data)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(mf.data)
+	}
+}
+
+func (m *Mirror) serveBlob(w http.ResponseWriter, r *http.Request, token, name, ref string) {
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errCodeDigestInvalid, err.Error())
+		return
+	}
+	domain, path, cred, ok := m.resolveRepository(w, token, name)
+	if !ok {
+		return
+	}
+	// The blobs are cached by the digests only, so the peers can only pull the blobs referenced
+	// by the manifests of the allowed repository, rather than the blobs cached for other grants.
+	if !m.referencesBlob(domain+"/"+path, dgst) {
+		writeError(w, http.StatusNotFound, errCodeBlobUnknown,
+			fmt.Sprintf("the blob %s is not referenced by any manifest of the repository %s", dgst, domain+"/"+path))
+		return
+	}
+
+	f, size, err := m.cache.open(dgst)
+	if err == nil {
+		defer f.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set(headerContentDigest, dgst.String())
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = io.Copy(w, f)
+		}
+		return
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		m.logger.Error(err, "failed to open the cached blob", "digest", dgst)
+	}
+
+	// The blob is still cached if the client cancels the request, the peer nodes may need it later.
+	ctx := context.WithoutCancel(r.Context())
+	resp, err := m.upstream.do(ctx, r.Method, domain, path, path+"/blobs/"+dgst.String(), nil, cred)
+	if err != nil {
+		m.logger.Error(err, "failed to fetch blob", "repository", domain+"/"+path, "digest", dgst)
+		writeUpstreamError(w, err, errCodeBlobUnknown)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		writeUpstreamError(w, &upstreamStatusError{statusCode: resp.StatusCode}, errCodeBlobUnknown)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set(headerContentDigest, dgst.String())
+	if resp.ContentLength >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(resp.ContentLength, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodGet {
+		return
+	}
+
+	bw, err := m.cache.writer(dgst)
+	if err != nil {
+		m.logger.Error(err, "failed to cache blob", "digest", dgst)
+		_, _ = io.Copy(w, resp.Body)
+		return
+	}
+	if _, err := io.Copy(io.MultiWriter(bw, &tolerantWriter{w: w}), resp.Body); err != nil {
+		bw.abort()
+		m.logger.Error(err, "failed to fetch blob", "repository", domain+"/"+path, "digest", dgst)
+		return
+	}
+	if err := bw.commit(); err != nil {
+		m.logger.Error(err, "failed to cache blob", "digest", dgst)
+	}
+}
+
+// tolerantWriter ignores the errors of writing to the client, so that the blob is still cached
+// after the client fails.
+type tolerantWriter struct {
+	w      io.Writer
+	failed bool
+}
+
+func (t *tolerantWriter) Write(p []byte) (int, error) {
+	if !t.failed {
+		if _, err := t.w.Write(p); err != nil {
+			t.failed = true
+		}
+	}
+	return len(p), nil
+}
+
+func writeUpstreamError(w http.ResponseWriter, err error, notFoundCode string) {
+	var statusErr *upstreamStatusError
+	if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+		writeError(w, http.StatusNotFound, notFoundCode, err.Error())
+		return
+	}
+	writeError(w, http.StatusServiceUnavailable, errCodeUnavailable, err.Error())
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	body, _ := json.Marshal(map[string]any{
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
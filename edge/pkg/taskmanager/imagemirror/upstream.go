@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// dockerHubDomain is the domain of the images in Docker Hub.
+	dockerHubDomain = "docker.io"
+	// dockerHubRegistry is the host of the registry API of Docker Hub.
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+var authParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// upstreamClient pulls the manifests and blobs from the upstream registries over HTTPS.
+// It supports the anonymous, basic and bearer token authentication of the registries.
+type upstreamClient struct {
+	client *http.Client
+	// scheme is the scheme of the upstream registries, it is always https except in tests.
+	scheme string
+
+	lock sync.Mutex
+	// tokens caches the bearer tokens, the key is the registry host and the scope of the token.
+	tokens map[string]string
+}
+
+func newUpstreamClient() *upstreamClient {
+	return &upstreamClient{
+		client: &http.Client{Timeout: 30 * time.Minute},
+		scheme: "https",
+		tokens: make(map[string]string),
+	}
+}
+
+func registryHost(domain string) string {
+	if domain == dockerHubDomain {
+		return dockerHubRegistry
+	}
+	return domain
+}
+
+// do sends the request of the path to the upstream registry of the domain. The path is relative
+// to "/v2/". If the registry requires authentication, the request is sent again with the credential.
+func (c *upstreamClient) do(
+	ctx context.Context,
+	method, domain, repo, path string,
+	header http.Header,
+	cred credential,
+) (*http.Response, error) {
+	host := registryHost(domain)
+	u := c.scheme + "://" + host + "/v2/" + path
+	scopeKey := host + "|repository:" + repo + ":pull"
+
+	send := func(auth string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, method, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header = header.Clone()
+		if req.Header == nil {
+			req.Header = make(http.Header)
+		}
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		return c.client.Do(req)
+	}
+
+	c.lock.Lock()
+	token := c.tokens[scopeKey]
+	c.lock.Unlock()
+	var auth string
+	if token != "" {
+		auth = "Bearer " + token
+	}
+	resp, err := send(auth)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Authenticate with the challenge of the registry and send the request again.
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(cred.username+":"+cred.password))
+	case "bearer":
+		token, err := c.fetchToken(ctx, params, repo, cred)
+		if err != nil {
+			return nil, err
+		}
+		c.lock.Lock()
+		c.tokens[scopeKey] = token
+		c.lock.Unlock()
+		auth = "Bearer " + token
+	default:
+		return nil, fmt.Errorf("unsupported authentication challenge %q of registry %s", challenge, host)
+	}
+	return send(auth)
+}
+
+// fetchToken fetches the bearer token from the realm of the challenge.
+func (c *upstreamClient) fetchToken(
+	ctx context.Context,
+	params map[string]string,
+	repo string,
+	cred credential,
+) (string, error) {
+	if cred.token != "" {
+		return cred.token, nil
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme == "" {
+		return "", fmt.Errorf("invalid realm %q of the authentication challenge", params["realm"])
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + repo + ":pull"
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if cred.username != "" {
+		req.SetBasicAuth(cred.username, cred.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch token from %s, err: %v", realm.Host, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch token from %s, status code: %d", realm.Host, resp.StatusCode)
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token response, err: %v", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("no token in the response of %s", realm.Host)
+}
+
+// parseChallenge parses the WWW-Authenticate header, e.g.,
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+	for _, match := range authParamRegexp.FindAllStringSubmatch(rest, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+	return scheme, params
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/taskmanager/imagemirror"
 	taskmgrv1alpha1 "github.com/kubeedge/kubeedge/edge/pkg/taskmanager/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/features"
 	nodetaskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
@@ -42,9 +43,12 @@ type TaskManager struct {
 var _ core.Module = (*TaskManager)(nil)
 
 // Register registers the taskmanager module
-func Register(cfg *v1alpha2cfg.TaskManager) {
+func Register(cfg *v1alpha2cfg.TaskManager, nodeIP string) {
 	taskmgrv1alpha1.Init()
 	InitRunner()
+	if cfg != nil {
+		imagemirror.Init(cfg.ImageMirror, nodeIP)
+	}
 
 	core.Register(&TaskManager{
 		cfg:    cfg,
@@ -76,6 +80,13 @@ func (t *TaskManager) RestartPolicy() *core.ModuleRestartPolicy {
 
 func (t TaskManager) Start() {
 	ctx := beehiveContext.GetContext()
+	if mirror := imagemirror.Get(); mirror != nil {
+		go func() {
+			if err := mirror.Start(ctx); err != nil {
+				t.logger.Error(err, "failed to start the image mirror")
+			}
+		}()
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -96,6 +107,11 @@ func (t TaskManager) Start() {
 			if err = ReportUpgradeStatus(ctx); err != nil {
 				t.logger.Error(err, "failed to report upgrade status and run next action")
 			}
+			go func() {
+				if err := ReportEdgeCoreConfig(ctx); err != nil {
+					t.logger.Error(err, "failed to report edgecore config")
+				}
+			}()
 			continue
 		}
 
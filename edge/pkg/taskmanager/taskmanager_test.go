@@ -43,6 +43,9 @@ func TestStart(t *testing.T) {
 		postHubConnectedCalled = true
 		return nil
 	})
+	globpatches.ApplyFunc(ReportEdgeCoreConfig, func(_ctx context.Context) error {
+		return nil
+	})
 	globpatches.ApplyFunc(taskmgrv1alpha1.RunTask, func(_msg *model.Message) error {
 		v1alpha1RunTaskCalled = true
 		return nil
@@ -114,7 +114,7 @@ function :copy:to:destination {
   for entry in `ls /tmp/crds/*.yaml`; do
       CRD_NAME=$(echo ${entry} | cut -d'.' -f3 | cut -d'_' -f2)
 
-      if [ "$CRD_NAME" == "devices" ] || [ "$CRD_NAME" == "devicemodels" ] || [ "$CRD_NAME" == "devicestatuses" ]; then
+      if [ "$CRD_NAME" == "devices" ] || [ "$CRD_NAME" == "devicemodels" ] || [ "$CRD_NAME" == "devicestatuses" ] || [ "$CRD_NAME" == "devicegroups" ]; then
           if [ "$CRD_NAME" == "devicestatuses" ]; then
               CRD_NAME=$(remove_suffix_es "$CRD_NAME")
           else
@@ -136,7 +136,7 @@ function :copy:to:destination {
       elif [ "$CRD_NAME" == "objectsyncs" ]; then
           cp -v ${entry} ${CRD_OUTPUTS}/reliablesyncs/objectsync_${RELIABLESYNCS_VERSION}.yaml
           cp -v ${entry} ${HELM_CRDS_DIR}/objectsync_${RELIABLESYNCS_VERSION}.yaml
-      elif [ "$CRD_NAME" == "nodeupgradejobs" ] || [ "$CRD_NAME" == "imageprepulljobs" ] || [ "$CRD_NAME" == "configupdatejobs" ]; then
+      elif [ "$CRD_NAME" == "nodeupgradejobs" ] || [ "$CRD_NAME" == "imageprepulljobs" ] || [ "$CRD_NAME" == "configupdatejobs" ] || [ "$CRD_NAME" == "commandjobs" ] || [ "$CRD_NAME" == "edgecoreconfigprofiles" ]; then
           CRD_NAME=$(remove_suffix_s "$CRD_NAME")
           cp -v ${entry} ${CRD_OUTPUTS}/operations/operations_${OPERATIONS_VERSION}_${CRD_NAME}.yaml
           cp -v ${entry} ${HELM_CRDS_DIR}/operations_${OPERATIONS_VERSION}_${CRD_NAME}.yaml
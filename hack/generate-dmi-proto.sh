@@ -47,8 +47,14 @@ func NewDeviceManageClient(cc grpc.ClientConnInterface) DeviceManagerServiceClie
 }
 '
 
+# v1alpha1 was first released in 2022
+COPY_RIGHT_YEAR=2023
+if [ "${DMI_VERSION}" == "v1alpha1" ]; then
+  COPY_RIGHT_YEAR=2022
+fi
+
 # shellcheck disable=SC1004
-COPY_RIGHT_INFO_LINE_1='/*\nCopyright 2023 The KubeEdge Authors.\n'
+COPY_RIGHT_INFO_LINE_1="/*\nCopyright ${COPY_RIGHT_YEAR} The KubeEdge Authors.\n"
 COPY_RIGHT_INFO_LINE_2='Licensed under the Apache License, Version 2.0 (the "License");'
 COPY_RIGHT_INFO_LINE_3='you may not use this file except in compliance with the License.'
 COPY_RIGHT_INFO_LINE_4='You may obtain a copy of the License at\n'
@@ -60,9 +66,17 @@ COPY_RIGHT_INFO_LINE_9='See the License for the specific language governing perm
 COPY_RIGHT_INFO_LINE_10='limitations under the License.\n*/\n'
 
 
-cd ${DMI_DIR}
-#protoc -I "${DMI_VERSION}"/ --go_out=plugins=grpc:"${DMI_VERSION}" "${DMI_VERSION}/${DMI_API_FILE}"
-protoc -I "${DMI_VERSION}/" --go_out="${DMI_VERSION}" --go-grpc_out="${DMI_VERSION}" "${DMI_VERSION}/${DMI_API_FILE}"
+# run protoc from the parent of DMI_DIR, so that each version of the api.proto is registered
+# with a unique path (dmi/<version>/api.proto) and the versions can be linked into one binary.
+cd ${DMI_DIR}/..
+DMI_PROTO_FILE="dmi/${DMI_VERSION}/${DMI_API_FILE}"
+if [ "${DMI_VERSION}" == "v1alpha1" ]; then
+  # v1alpha1 keeps the grpc code in api.pb.go, which is generated by the github.com/golang/protobuf plugin
+  protoc -I . --go_out=plugins=grpc:"dmi/${DMI_VERSION}" "${DMI_PROTO_FILE}"
+else
+  protoc -I . --go_out="dmi/${DMI_VERSION}" --go-grpc_out="dmi/${DMI_VERSION}" "${DMI_PROTO_FILE}"
+fi
+cd dmi
 
 sed -i '2,15d' "${DMI_VERSION}/${DMI_API_GO_FILE}"
 sed -i "1i\ $COPY_RIGHT_INFO_LINE_10"  "${DMI_VERSION}/${DMI_API_GO_FILE}"
@@ -77,6 +91,10 @@ sed -i "1i\ $COPY_RIGHT_INFO_LINE_2"  "${DMI_VERSION}/${DMI_API_GO_FILE}"
 sed -i "1i\ $COPY_RIGHT_INFO_LINE_1"  "${DMI_VERSION}/${DMI_API_GO_FILE}"
 
 echo "${DMI_CLIENT_CODE}" >> "${DMI_VERSION}/${DMI_API_GO_FILE}"
+if [ "${DMI_VERSION}" != "v1alpha1" ]; then
+  # the client code above refers to the grpc package, which is imported by api_grpc.pb.go only
+  sed -i '0,/^import (/s//import (\n\t"google.golang.org\/grpc"/' "${DMI_VERSION}/${DMI_API_GO_FILE}"
+fi
 
 gofmt -w "${DMI_VERSION}/${DMI_API_GO_FILE}"
 
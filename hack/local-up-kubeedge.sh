@@ -169,6 +169,7 @@ function create_device_crd {
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/devices/devices_v1beta1_device.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/devices/devices_v1beta1_devicemodel.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/devices/devices_v1beta1_devicestatus.yaml
+  kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/devices/devices_v1beta1_devicegroup.yaml
 }
 
 function create_objectsync_crd {
@@ -188,6 +189,8 @@ function create_operation_crd {
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha2_nodeupgradejob.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha2_imageprepulljob.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha2_configupdatejob.yaml
+  kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha2_commandjob.yaml
+  kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha2_edgecoreconfigprofile.yaml
 }
 
 function create_serviceaccountaccess_crd {
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/commandbundle"
+)
+
+const defaultBundleExpiresIn = 24 * time.Hour
+
+var (
+	signLongDescription = `
+"keadm sign" command signs the resources which the edge nodes only accept with a trusted signature.
+`
+
+	signCommandJobLongDescription = `
+"keadm sign commandjob" command signs the command bundle of a CommandJob manifest, and prints the signed manifest.
+The bundle is bound to the name of the CommandJob, the edge nodes it is signed for and an expiration time before
+signing, so the edge nodes refuse it in another CommandJob, on another node or after it expires.
+The edge nodes verify the signature by the public keys in modules.taskManager.commandJob.publicKeyFiles of EdgeCore.
+`
+
+	signCommandJobExample = `
+keadm sign commandjob -f restart-app-job.yaml --key bundle-key.pem > restart-app-job.signed.yaml
+- key is the PEM encoded private key in PKCS #8, PKCS #1 (RSA) or SEC 1 (EC) form
+- the command bundle is signed for the spec.nodeNames of the CommandJob, or for all the selected edge nodes if it is empty
+
+keadm sign commandjob -f restart-app-job.yaml --key bundle-key.pem --node edge-node1 --node edge-node2 --expires-in 2h
+- the command bundle is signed for edge-node1 and edge-node2, and expires in 2 hours
+`
+)
+
+// SignCommandJobOptions has the options of "keadm sign commandjob"
+type SignCommandJobOptions struct {
+	File      string
+	KeyFile   string
+	NodeNames []string
+	ExpiresIn time.Duration
+}
+
+// NewSign signs the resources which the edge nodes only accept with a trusted signature
+func NewSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Sign the resources which the edge nodes only accept with a trusted signature",
+		Long:  signLongDescription,
+	}
+	cmd.AddCommand(newSignCommandJob())
+	return cmd
+}
+
+func newSignCommandJob() *cobra.Command {
+	opts := &SignCommandJobOptions{ExpiresIn: defaultBundleExpiresIn}
+
+	cmd := &cobra.Command{
+		Use:     "commandjob",
+		Short:   "Sign the command bundle of a CommandJob manifest",
+		Long:    signCommandJobLongDescription,
+		Example: signCommandJobExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return signCommandJob(opts, cmd.OutOrStdout(), time.Now())
+		},
+	}
+	addSignCommandJobFlags(cmd, opts)
+	return cmd
+}
+
+func addSignCommandJobFlags(cmd *cobra.Command, opts *SignCommandJobOptions) {
+	cmd.Flags().StringVarP(&opts.File, "file", "f", opts.File,
+		"The CommandJob manifest to sign")
+	cmd.Flags().StringVar(&opts.KeyFile, "key", opts.KeyFile,
+		"The PEM encoded private key to sign the command bundle")
+	cmd.Flags().StringSliceVar(&opts.NodeNames, "node", opts.NodeNames,
+		"The edge nodes the command bundle is signed for, default to the spec.nodeNames of the CommandJob")
+	cmd.Flags().DurationVar(&opts.ExpiresIn, "expires-in", opts.ExpiresIn,
+		"The duration after which the edge nodes refuse the command bundle")
+	_ = cmd.MarkFlagRequired("file")
+	_ = cmd.MarkFlagRequired("key")
+}
+
+// signCommandJob binds the command bundle of the CommandJob manifest to the job, the nodes
+// and the expiration time, signs it and writes the manifest with the signed bundle to out.
+// The other fields of the manifest are written as they are.
+func signCommandJob(opts *SignCommandJobOptions, out io.Writer, now time.Time) error {
+	if opts.ExpiresIn <= 0 {
+		return errors.New("the expiration duration of command bundle must be positive")
+	}
+	data, err := os.ReadFile(opts.File)
+	if err != nil {
+		return fmt.Errorf("failed to read the CommandJob manifest, err: %v", err)
+	}
+	var job operationsv1alpha2.CommandJob
+	if err := yaml.Unmarshal(data, &job); err != nil {
+		return fmt.Errorf("failed to decode the CommandJob manifest, err: %v", err)
+	}
+	if job.Name == "" {
+		return errors.New("the CommandJob manifest must have a name")
+	}
+	keyData, err := os.ReadFile(opts.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the private key file, err: %v", err)
+	}
+	key, err := commandbundle.ParsePrivateKey(keyData)
+	if err != nil {
+		return fmt.Errorf("failed to parse the private key file, err: %v", err)
+	}
+
+	bundle := job.Spec.Bundle
+	bundle.JobName = job.Name
+	bundle.NodeNames = job.Spec.NodeNames
+	if len(opts.NodeNames) > 0 {
+		bundle.NodeNames = opts.NodeNames
+	}
+	// The expiration time is encoded in seconds in the manifest.
+	bundle.ExpirationTime = metav1.NewTime(now.Add(opts.ExpiresIn).Truncate(time.Second))
+	bundle.Signature, err = commandbundle.Sign(bundle, key)
+	if err != nil {
+		return err
+	}
+
+	var manifest map[string]interface{}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("failed to decode the CommandJob manifest, err: %v", err)
+	}
+	spec, ok := manifest["spec"].(map[string]interface{})
+	if !ok {
+		return errors.New("the CommandJob manifest must have a spec")
+	}
+	spec["bundle"] = bundle
+	signed, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to encode the signed CommandJob manifest, err: %v", err)
+	}
+	_, err = out.Write(signed)
+	return err
+}
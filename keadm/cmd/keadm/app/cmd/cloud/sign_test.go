@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/nodetask/commandbundle"
+)
+
+const testCommandJobManifest = `apiVersion: operations.kubeedge.io/v1alpha2
+kind: CommandJob
+metadata:
+  name: restart-app-job
+  labels:
+    app: demo
+spec:
+  nodeNames:
+  - edge-node1
+  bundle:
+    name: restart-app
+    commands:
+    - systemctl restart app
+`
+
+func TestNewSign(t *testing.T) {
+	cmd := NewSign()
+	assert.Equal(t, "sign", cmd.Use)
+
+	sub, _, err := cmd.Find([]string{"commandjob"})
+	require.NoError(t, err)
+	assert.Equal(t, "commandjob", sub.Use)
+	for _, name := range []string{"file", "key", "node", "expires-in"} {
+		assert.NotNil(t, sub.Flags().Lookup(name), name)
+	}
+	assert.Equal(t, defaultBundleExpiresIn.String(), sub.Flags().Lookup("expires-in").DefValue)
+}
+
+func TestSignCommandJob(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "bundle-key.pem")
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	jobFile := filepath.Join(dir, "job.yaml")
+	require.NoError(t, os.WriteFile(jobFile, []byte(testCommandJobManifest), 0600))
+	now := time.Date(2025, 1, 2, 10, 4, 5, 600, time.UTC)
+
+	decode := func(t *testing.T, data []byte) operationsv1alpha2.CommandJob {
+		var job operationsv1alpha2.CommandJob
+		require.NoError(t, yaml.Unmarshal(data, &job))
+		return job
+	}
+
+	t.Run("case1 sign for the nodes of the job", func(t *testing.T) {
+		var out bytes.Buffer
+		opts := &SignCommandJobOptions{File: jobFile, KeyFile: keyFile, ExpiresIn: time.Hour}
+		require.NoError(t, signCommandJob(opts, &out, now))
+
+		job := decode(t, out.Bytes())
+		assert.Equal(t, "demo", job.Labels["app"])
+		bundle := job.Spec.Bundle
+		assert.Equal(t, "restart-app-job", bundle.JobName)
+		assert.Equal(t, []string{"edge-node1"}, bundle.NodeNames)
+		assert.Equal(t, now.Add(time.Hour).Truncate(time.Second), bundle.ExpirationTime.UTC())
+		assert.NoError(t, commandbundle.Verify(bundle, []crypto.PublicKey{pub}))
+		assert.NoError(t, commandbundle.CheckBinding(bundle, "restart-app-job", "edge-node1", now))
+	})
+
+	t.Run("case2 sign for the nodes of the flags", func(t *testing.T) {
+		var out bytes.Buffer
+		opts := &SignCommandJobOptions{File: jobFile, KeyFile: keyFile, ExpiresIn: time.Hour,
+			NodeNames: []string{"edge-node2"}}
+		require.NoError(t, signCommandJob(opts, &out, now))
+
+		bundle := decode(t, out.Bytes()).Spec.Bundle
+		assert.Equal(t, []string{"edge-node2"}, bundle.NodeNames)
+		assert.NoError(t, commandbundle.Verify(bundle, []crypto.PublicKey{pub}))
+	})
+
+	t.Run("case3 invalid private key", func(t *testing.T) {
+		opts := &SignCommandJobOptions{File: jobFile, KeyFile: jobFile, ExpiresIn: time.Hour}
+		err := signCommandJob(opts, &bytes.Buffer{}, now)
+		assert.ErrorContains(t, err, "no PEM encoded private key found")
+	})
+
+	t.Run("case4 the manifest has no name", func(t *testing.T) {
+		file := filepath.Join(dir, "noname.yaml")
+		require.NoError(t, os.WriteFile(file, []byte("kind: CommandJob\nspec: {}\n"), 0600))
+		opts := &SignCommandJobOptions{File: file, KeyFile: keyFile, ExpiresIn: time.Hour}
+		err := signCommandJob(opts, &bytes.Buffer{}, now)
+		assert.ErrorContains(t, err, "the CommandJob manifest must have a name")
+	})
+
+	t.Run("case5 the expiration duration is not positive", func(t *testing.T) {
+		opts := &SignCommandJobOptions{File: jobFile, KeyFile: keyFile}
+		err := signCommandJob(opts, &bytes.Buffer{}, now)
+		assert.ErrorContains(t, err, "must be positive")
+	})
+}
@@ -77,6 +77,7 @@ func NewKubeedgeCommand() *cobra.Command {
 
 	cmds.AddCommand(NewCmdVersion())
 	cmds.AddCommand(cloud.NewGettoken())
+	cmds.AddCommand(cloud.NewSign())
 	cmds.AddCommand(debug.NewEdgeDebug())
 
 	// recommended cmds
@@ -87,6 +88,7 @@ func NewKubeedgeCommand() *cobra.Command {
 	cmds.AddCommand(newCmdConfig())
 	cmds.AddCommand(NewKubeEdgeReset())
 	cmds.AddCommand(edge.NewEdgeConfigUpdate())
+	cmds.AddCommand(edge.NewMigrateDBCommand())
 
 	// beta cmds
 	cmds.AddCommand(beta.NewBeta())
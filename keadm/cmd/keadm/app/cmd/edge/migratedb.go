@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/dbclient"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/kv"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+)
+
+const migrateDBLongDescription = `Migrate the metadata, devices, topics and queued upstream messages of EdgeCore from the sqlite
+database to the KV store, which is used by the "kv" database driver.
+EdgeCore must be stopped during the migration. After the migration, set
+database.driverName to "kv" in the EdgeCore configuration and start EdgeCore.
+The sqlite database is not modified, it still stores the other tables.`
+
+func NewMigrateDBCommand() *cobra.Command {
+	var opts MigrateDBOptions
+
+	cmd := &cobra.Command{
+		Use:   "migrate-db",
+		Short: "Migrate the EdgeCore database from sqlite to the KV store.",
+		Long:  migrateDBLongDescription,
+		RunE: func(_cmd *cobra.Command, _args []string) error {
+			return migrateDB(opts)
+		},
+	}
+	AddMigrateDBFlags(cmd, &opts)
+	return cmd
+}
+
+func migrateDB(opts MigrateDBOptions) error {
+	cfg, err := util.ParseEdgecoreConfig(opts.Config)
+	if err != nil {
+		return fmt.Errorf("failed to parse edgecore config %s, err: %v", opts.Config, err)
+	}
+	if cfg.DataBase.DriverName == v1alpha2.DataBaseDriverKV {
+		return fmt.Errorf("edgecore already uses the %s database driver", v1alpha2.DataBaseDriverKV)
+	}
+	source, target := cfg.DataBase.DataSource, cfg.DataBase.KVDataSource
+	if target == "" {
+		target = v1alpha2.DataBaseKVDataSource
+	}
+
+	if _, err := os.Stat(source); err != nil {
+		return fmt.Errorf("failed to find database %s, err: %v", source, err)
+	}
+	if _, err := os.Stat(target); err == nil {
+		if !opts.Force {
+			return fmt.Errorf("KV store %s exists, use --force to overwrite it", target)
+		}
+		if err := os.Remove(target); err != nil {
+			return fmt.Errorf("failed to remove KV store %s, err: %v", target, err)
+		}
+	}
+
+	db, err := gorm.Open(sqlite.Open(source), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return fmt.Errorf("failed to open database %s, err: %v", source, err)
+	}
+	store, err := kv.Open(target)
+	if err != nil {
+		return err
+	}
+	result, err := dbclient.MigrateToKV(db, store)
+	if closeErr := store.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// do not leave a partial store which the next run would refuse
+		_ = os.Remove(target)
+		return fmt.Errorf("failed to migrate database %s to KV store %s, err: %v", source, target, err)
+	}
+
+	tables := make([]string, 0, len(result))
+	for table := range result {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		fmt.Printf("migrated %d rows of table %s\n", result[table], table)
+	}
+	fmt.Printf("database %s is migrated to KV store %s, set database.driverName to %q to use it\n",
+		source, target, v1alpha2.DataBaseDriverKV)
+	return nil
+}
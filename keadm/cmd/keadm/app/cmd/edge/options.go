@@ -154,3 +154,19 @@ func AddConfigUpdateFlags(cmd *cobra.Command, opts *ConfigUpdateOptions) {
 	cmd.Flags().StringVar(&opts.Sets, "set", opts.Sets,
 		"Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 }
+
+type MigrateDBOptions struct {
+	// Config is the path to the edgecore config file, the paths of the
+	// sqlite database and the KV store are read from it.
+	Config string
+	// Force overwrites an existing KV store.
+	Force bool
+}
+
+// AddMigrateDBFlags adds some flags to the migrate-db command, and use MigrateDBOptions struct to map these flags.
+func AddMigrateDBFlags(cmd *cobra.Command, opts *MigrateDBOptions) {
+	cmd.Flags().StringVar(&opts.Config, "config", constants.EdgecoreConfigPath,
+		"Use this key to specify the path to the edgecore configuration file.")
+	cmd.Flags().BoolVar(&opts.Force, "force", opts.Force,
+		"Overwrite the KV store if it exists.")
+}
@@ -16,7 +16,7 @@ limitations under the License.
 
 package constants
 
-// Constants for protocol, datatype, configmap, deviceProfile
+// Constants of the device data types and resources shared by the cloud and the edge
 const (
 	DataTypeInt     = "int"
 	DataTypeInteger = "integer"
@@ -26,12 +26,16 @@ const (
 	DataTypeBoolean = "boolean"
 	DataTypeBytes   = "bytes"
 
-	ResourceTypeDeviceModel  = "devicemodel"
-	ResourceTypeDevice       = "device"
-	ResourceTypeDeviceMapper = "devicemapper"
+	ResourceTypeDeviceModel   = "devicemodel"
+	ResourceTypeDevice        = "device"
+	ResourceTypeDeviceMapper  = "devicemapper"
+	ResourceTypeDeviceMethod  = "devicemethod"
+	ResourceTypeDeviceHistory = "devicehistory"
+	ResourceTypeDeviceGroup   = "devicegroup"
 
 	KindTypeDevice       = "Device"
 	KindTypeDeviceModel  = "DeviceModel"
 	KindTypeDeviceStatus = "DeviceStatus"
+	KindTypeDeviceList   = "DeviceList"
 	UnixNetworkType      = "unix"
 )
@@ -30,6 +30,10 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// MirrorUsername is the username of the basic authentication to the image mirror,
+// the token is the password.
+const MirrorUsername = "kubeedge"
+
 type Runtime interface {
 	// PullImages pulls images. If authentication is required, currently pulled images
 	// only support one authentication configuration.
@@ -43,6 +47,12 @@ type Runtime interface {
 type RuntimeImpl struct {
 	endpoint string
 	imgsvc   internalapi.ImageManagerService
+	// tagger tags the images pulled from the image mirror with their original references.
+	tagger imageTagger
+	// mirror is the endpoint of the image mirror that the images are pulled through.
+	mirror string
+	// mirrorToken is the token that authenticates to the image mirror.
+	mirrorToken string
 }
 
 // Check the RuntimeImpl implements the Runtime interface
@@ -55,10 +65,21 @@ func NewImageRuntime(endpoint string, timeout time.Duration) (*RuntimeImpl, erro
 		return nil, fmt.Errorf("failed to new remote image service, err: %v", err)
 	}
 	return &RuntimeImpl{
-		imgsvc: imgsvc,
+		endpoint: endpoint,
+		imgsvc:   imgsvc,
+		tagger:   &containerdTagger{endpoint: endpoint},
 	}, nil
 }
 
+// WithMirror returns a copy of the runtime that pulls the images through the image mirror
+// at the endpoint, which is in host:port format. The token authenticates to the image mirror.
+func (runtime *RuntimeImpl) WithMirror(endpoint, token string) *RuntimeImpl {
+	res := *runtime
+	res.mirror = endpoint
+	res.mirrorToken = token
+	return &res
+}
+
 func (runtime *RuntimeImpl) PullImages(
 	ctx context.Context,
 	images []string,
@@ -99,18 +120,106 @@ func (runtime *RuntimeImpl) PullImage(
 	authConfig *runtimeapi.AuthConfig,
 	sandboxConfig *runtimeapi.PodSandboxConfig,
 ) error {
+	_, err := runtime.PullImageThroughMirror(ctx, image, authConfig, sandboxConfig)
+	return err
+}
+
+// PullImageThroughMirror pulls the specified image like PullImage. If the image cannot be pulled
+// from the image mirror, it is pulled from the upstream registry, and fellBack is true.
+func (runtime *RuntimeImpl) PullImageThroughMirror(
+	ctx context.Context,
+	image string,
+	authConfig *runtimeapi.AuthConfig,
+	sandboxConfig *runtimeapi.PodSandboxConfig,
+) (fellBack bool, err error) {
 	image = ConvToCRIImage(image)
 	imageSpec := &runtimeapi.ImageSpec{Image: image}
 	status, err := runtime.imgsvc.ImageStatus(ctx, imageSpec, true)
 	if err != nil {
-		return err
+		return false, err
+	}
+	if status != nil && status.Image != nil {
+		return false, nil
 	}
-	if status == nil || status.Image == nil {
-		if _, err := runtime.imgsvc.PullImage(ctx, imageSpec, authConfig, sandboxConfig); err != nil {
-			return err
+	// The image pulled from the image mirror is tagged with its original reference, so that
+	// the node needs neither the access to the upstream registry nor its credentials.
+	if runtime.mirror != "" {
+		err := runtime.pullFromMirror(ctx, image, sandboxConfig)
+		if err == nil {
+			return false, nil
 		}
+		klog.Warningf("failed to pull image %s through the image mirror, pull it from the upstream registry, err: %v",
+			image, err)
+		fellBack = true
 	}
-	return nil
+	if _, err := runtime.imgsvc.PullImage(ctx, imageSpec, authConfig, sandboxConfig); err != nil {
+		return fellBack, err
+	}
+	return fellBack, nil
+}
+
+// pullFromMirror pulls the image from the image mirror with the token of the image mirror, and tags
+// it with the original reference. The image mirror pulls the images from the upstream registry
+// with its own credentials.
+func (runtime *RuntimeImpl) pullFromMirror(
+	ctx context.Context,
+	image string,
+	sandboxConfig *runtimeapi.PodSandboxConfig,
+) error {
+	mirrorImage, err := MirrorImage(image, runtime.mirror)
+	if err != nil {
+		return err
+	}
+	imageSpec := &runtimeapi.ImageSpec{Image: mirrorImage}
+	authConfig := &runtimeapi.AuthConfig{
+		Username:      MirrorUsername,
+		Password:      runtime.mirrorToken,
+		ServerAddress: runtime.mirror,
+	}
+	if _, err := runtime.imgsvc.PullImage(ctx, imageSpec, authConfig, sandboxConfig); err != nil {
+		return fmt.Errorf("failed to pull image from the image mirror %s, err: %v", runtime.mirror, err)
+	}
+	if runtime.tagger == nil {
+		return fmt.Errorf("the container runtime %s does not support tagging images", runtime.endpoint)
+	}
+	return runtime.tagger.Tag(ctx, mirrorImage, image)
+}
+
+// MirrorImage returns the reference of the image in the image mirror at the endpoint.
+// The domain of the image is kept in the repository of the image mirror, e.g., the image
+// "nginx:1.25" in the image mirror "10.0.0.5:10552" is "10.0.0.5:10552/docker.io/library/nginx:1.25".
+// The port of the domain is separated by "_", because ":" is not allowed in the repository.
+func MirrorImage(image, endpoint string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image %s, err: %v", image, err)
+	}
+	named = reference.TagNameOnly(named)
+	domain := strings.ReplaceAll(strings.ToLower(reference.Domain(named)), ":", "_")
+	res := endpoint + "/" + domain + "/" + reference.Path(named)
+	if tagged, ok := named.(reference.Tagged); ok {
+		res += ":" + tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		res += "@" + digested.Digest().String()
+	}
+	if _, err := reference.ParseNormalizedNamed(res); err != nil {
+		return "", fmt.Errorf("the image %s is not supported by the image mirror, err: %v", image, err)
+	}
+	return res, nil
+}
+
+// ParseMirrorRepository parses the repository in the image mirror, which is returned by MirrorImage,
+// to the domain and the path of the repository in the upstream registry.
+func ParseMirrorRepository(repo string) (domain, path string, err error) {
+	domain, path, ok := strings.Cut(repo, "/")
+	if !ok || domain == "" || path == "" {
+		return "", "", fmt.Errorf("invalid image mirror repository %s", repo)
+	}
+	if i := strings.LastIndex(domain, "_"); i >= 0 {
+		domain = domain[:i] + ":" + domain[i+1:]
+	}
+	return domain, path, nil
 }
 
 func ConvToCRIImage(image string) string {
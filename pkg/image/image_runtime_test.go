@@ -182,3 +182,170 @@ func TestConvToCRIImage(t *testing.T) {
 		})
 	}
 }
+
+func TestPullImageWithMirror(t *testing.T) {
+	ctx := context.TODO()
+	image := "docker.io/kubeedge/installation-package:v1.20.0"
+	mirrorImage := "10.0.0.5:10552/docker.io/kubeedge/installation-package:v1.20.0"
+	authConfig := &runtimeapi.AuthConfig{Username: "test"}
+
+	t.Run("pull the image through the mirror", func(t *testing.T) {
+		fakeImgSvc := criapitesting.NewFakeImageService()
+		tagger := &fakeTagger{}
+		imgrt := (&RuntimeImpl{imgsvc: fakeImgSvc, tagger: tagger}).WithMirror("10.0.0.5:10552", "test-token")
+		fellBack, err := imgrt.PullImageThroughMirror(ctx, image, authConfig, nil)
+		require.NoError(t, err)
+		require.False(t, fellBack)
+		fakeImgSvc.AssertImagePulledWithAuth(t, &runtimeapi.ImageSpec{Image: mirrorImage},
+			&runtimeapi.AuthConfig{Username: MirrorUsername, Password: "test-token", ServerAddress: "10.0.0.5:10552"},
+			"the image should be pulled from the mirror with the token")
+		require.NotContains(t, fakeImgSvc.Images, image, "the image should not be pulled from the upstream registry")
+		require.Equal(t, [][2]string{{mirrorImage, image}}, tagger.tagged)
+	})
+
+	t.Run("fall back to the upstream registry when the mirror fails", func(t *testing.T) {
+		fakeImgSvc := criapitesting.NewFakeImageService()
+		fakeImgSvc.InjectError("PullImage", errors.New("test pull image error"))
+		imgrt := (&RuntimeImpl{imgsvc: fakeImgSvc, tagger: &fakeTagger{}}).WithMirror("10.0.0.5:10552", "test-token")
+		fellBack, err := imgrt.PullImageThroughMirror(ctx, image, authConfig, nil)
+		require.NoError(t, err)
+		require.True(t, fellBack)
+		require.Contains(t, fakeImgSvc.Images, image)
+		require.NotContains(t, fakeImgSvc.Images, mirrorImage)
+	})
+
+	t.Run("fall back to the upstream registry when the image cannot be tagged", func(t *testing.T) {
+		fakeImgSvc := criapitesting.NewFakeImageService()
+		tagger := &fakeTagger{err: errors.New("test tag image error")}
+		imgrt := (&RuntimeImpl{imgsvc: fakeImgSvc, tagger: tagger}).WithMirror("10.0.0.5:10552", "test-token")
+		fellBack, err := imgrt.PullImageThroughMirror(ctx, image, authConfig, nil)
+		require.NoError(t, err)
+		require.True(t, fellBack)
+		fakeImgSvc.AssertImagePulledWithAuth(t, &runtimeapi.ImageSpec{Image: image}, authConfig,
+			"the image should be pulled from the upstream registry with its auth config")
+	})
+
+	t.Run("failed to pull the image from the upstream registry", func(t *testing.T) {
+		fakeImgSvc := criapitesting.NewFakeImageService()
+		fakeImgSvc.InjectError("PullImage", errors.New("test pull image error"))
+		fakeImgSvc.InjectError("PullImage", errors.New("test pull image error"))
+		imgrt := (&RuntimeImpl{imgsvc: fakeImgSvc}).WithMirror("10.0.0.5:10552", "test-token")
+		err := imgrt.PullImage(ctx, image, authConfig, nil)
+		require.ErrorContains(t, err, "test pull image error")
+		require.NotContains(t, fakeImgSvc.Images, image)
+	})
+
+	t.Run("the image already exists", func(t *testing.T) {
+		fakeImgSvc := criapitesting.NewFakeImageService()
+		fakeImgSvc.SetFakeImages([]string{image})
+		imgrt := (&RuntimeImpl{imgsvc: fakeImgSvc}).WithMirror("10.0.0.5:10552", "test-token")
+		require.NoError(t, imgrt.PullImage(ctx, image, authConfig, nil))
+		require.NotContains(t, fakeImgSvc.Called, "PullImage")
+	})
+}
+
+func TestMirrorImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "docker.io default registry",
+			input:    "nginx:1.25",
+			expected: "10.0.0.5:10552/docker.io/library/nginx:1.25",
+		},
+		{
+			name:     "default to latest tag",
+			input:    "kubeedge/cloudcore",
+			expected: "10.0.0.5:10552/docker.io/kubeedge/cloudcore:latest",
+		},
+		{
+			name:     "port registry with digest",
+			input:    "registry.local:5000/kubeedge/installation-package@sha256:e47afdf2746ad10ee76dd64289eae01895000327c0f23c5b498959eca6953695",
+			expected: "10.0.0.5:10552/registry.local_5000/kubeedge/installation-package@sha256:e47afdf2746ad10ee76dd64289eae01895000327c0f23c5b498959eca6953695",
+		},
+		{
+			name:     "uppercase domain",
+			input:    "Registry.Example.com/kubeedge/cloudcore:v1.21.0",
+			expected: "10.0.0.5:10552/registry.example.com/kubeedge/cloudcore:v1.21.0",
+		},
+		{
+			name:    "malformed name",
+			input:   "invalid@@image!!name",
+			wantErr: true,
+		},
+		{
+			name:    "ipv6 registry",
+			input:   "[::1]:5000/kubeedge/cloudcore:v1.21.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := MirrorImage(tc.input, "10.0.0.5:10552")
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, output)
+		})
+	}
+}
+
+func TestParseMirrorRepository(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantDomain string
+		wantPath   string
+		wantErr    bool
+	}{
+		{
+			name:       "docker.io",
+			input:      "docker.io/library/nginx",
+			wantDomain: "docker.io",
+			wantPath:   "library/nginx",
+		},
+		{
+			name:       "port registry",
+			input:      "registry.local_5000/kubeedge/installation-package",
+			wantDomain: "registry.local:5000",
+			wantPath:   "kubeedge/installation-package",
+		},
+		{
+			name:    "no path",
+			input:   "docker.io",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			domain, path, err := ParseMirrorRepository(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantDomain, domain)
+			require.Equal(t, tc.wantPath, path)
+		})
+	}
+}
+
+type fakeTagger struct {
+	tagged [][2]string
+	err    error
+}
+
+func (f *fakeTagger) Tag(_ context.Context, source, target string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.tagged = append(f.tagged, [2]string{source, target})
+	return nil
+}
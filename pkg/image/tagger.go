@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"k8s.io/cri-client/pkg/util"
+)
+
+// criNamespace is the containerd namespace of the images managed by the CRI plugin.
+const criNamespace = "k8s.io"
+
+// imageTagger tags the images in the container runtime, which is not supported by the CRI.
+type imageTagger interface {
+	// Tag makes the target reference point to the image of the source reference.
+	Tag(ctx context.Context, source, target string) error
+}
+
+// containerdTagger tags the images through the images service of containerd, which is served
+// on the same socket as the CRI. The CRI plugin of containerd picks up the tagged images by
+// the image events. The other container runtimes fail with codes.Unimplemented.
+type containerdTagger struct {
+	endpoint string
+}
+
+func (t *containerdTagger) Tag(ctx context.Context, source, target string) error {
+	addr, dialer, err := util.GetAddressAndDialer(t.endpoint)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithAuthority("localhost"),
+		grpc.WithContextDialer(dialer))
+	if err != nil {
+		return fmt.Errorf("failed to connect to the container runtime %s, err: %v", t.endpoint, err)
+	}
+	defer conn.Close()
+	return tagImage(ctx, imagesapi.NewImagesClient(conn), source, target)
+}
+
+func tagImage(ctx context.Context, client imagesapi.ImagesClient, source, target string) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, "containerd-namespace", criNamespace)
+	resp, err := client.Get(ctx, &imagesapi.GetImageRequest{Name: source})
+	if err != nil {
+		return fmt.Errorf("failed to get image %s, err: %v", source, err)
+	}
+	img := &imagesapi.Image{
+		Name:   target,
+		Labels: resp.GetImage().GetLabels(),
+		Target: resp.GetImage().GetTarget(),
+	}
+	_, err = client.Create(ctx, &imagesapi.CreateImageRequest{Image: img})
+	if status.Code(err) == codes.AlreadyExists {
+		_, err = client.Update(ctx, &imagesapi.UpdateImageRequest{
+			Image:      img,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels", "target"}},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to tag image %s as %s, err: %v", source, target, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"testing"
+
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeImagesClient struct {
+	imagesapi.ImagesClient
+	images map[string]*imagesapi.Image
+}
+
+func (f *fakeImagesClient) Get(ctx context.Context, in *imagesapi.GetImageRequest, _ ...grpc.CallOption,
+) (*imagesapi.GetImageResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if ns := md.Get("containerd-namespace"); len(ns) != 1 || ns[0] != criNamespace {
+		return nil, status.Error(codes.FailedPrecondition, "namespace is required")
+	}
+	img, ok := f.images[in.Name]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "image not found")
+	}
+	return &imagesapi.GetImageResponse{Image: img}, nil
+}
+
+func (f *fakeImagesClient) Create(_ context.Context, in *imagesapi.CreateImageRequest, _ ...grpc.CallOption,
+) (*imagesapi.CreateImageResponse, error) {
+	if _, ok := f.images[in.Image.Name]; ok {
+		return nil, status.Error(codes.AlreadyExists, "image already exists")
+	}
+	f.images[in.Image.Name] = in.Image
+	return &imagesapi.CreateImageResponse{Image: in.Image}, nil
+}
+
+func (f *fakeImagesClient) Update(_ context.Context, in *imagesapi.UpdateImageRequest, _ ...grpc.CallOption,
+) (*imagesapi.UpdateImageResponse, error) {
+	f.images[in.Image.Name] = in.Image
+	return &imagesapi.UpdateImageResponse{Image: in.Image}, nil
+}
+
+func TestTagImage(t *testing.T) {
+	ctx := context.TODO()
+	source := "10.0.0.5:10552/docker.io/library/nginx:1.25"
+	target := "docker.io/library/nginx:1.25"
+	newTarget := &types.Descriptor{MediaType: "application/vnd.oci.image.index.v1+json", Digest: "sha256:new"}
+
+	client := &fakeImagesClient{images: map[string]*imagesapi.Image{
+		source: {Name: source, Labels: map[string]string{"io.cri-containerd.image": "managed"}, Target: newTarget},
+	}}
+	require.NoError(t, tagImage(ctx, client, source, target))
+	require.Equal(t, newTarget, client.images[target].Target)
+	require.Equal(t, "managed", client.images[target].Labels["io.cri-containerd.image"])
+
+	// The existing image is updated to the new target.
+	client.images[target].Target = &types.Descriptor{Digest: "sha256:old"}
+	require.NoError(t, tagImage(ctx, client, source, target))
+	require.Equal(t, newTarget, client.images[target].Target)
+
+	require.ErrorContains(t, tagImage(ctx, client, "unknown:latest", target), "not found")
+}
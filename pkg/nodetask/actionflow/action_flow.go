@@ -69,6 +69,8 @@ var (
 	FlowImagePrePullJob = initImagePrePullJob()
 	// FlowConfigUpdateJob defines the action flow of config update job.
 	FlowConfigUpdateJob = initConfigUpdateJobFlow()
+	// FlowCommandJob defines the action flow of command job.
+	FlowCommandJob = initCommandJobFlow()
 )
 
 // initNodeUpgradeJobFlow initializes the action flow of node upgrade job.
@@ -116,3 +118,17 @@ func initConfigUpdateJobFlow() *Flow {
 		First: check,
 	}
 }
+
+// initCommandJobFlow initializes the action flow of command job.
+//
+//	Check --> Run --> Verify
+func initCommandJobFlow() *Flow {
+	check := &Action{Name: string(v1alpha2.CommandJobActionCheck)}
+	run := &Action{Name: string(v1alpha2.CommandJobActionRun)}
+	check.NextSuccessful = run
+	verify := &Action{Name: string(v1alpha2.CommandJobActionVerify)}
+	run.NextSuccessful = verify
+	return &Flow{
+		First: check,
+	}
+}
@@ -41,6 +41,19 @@ func TestNodeUpgradeActionFlow(t *testing.T) {
 	require.Equal(t, string(v1alpha2.NodeUpgradeJobActionRollBack), rollback.Name)
 }
 
+func TestCommandJobActionFlow(t *testing.T) {
+	check := FlowCommandJob.First
+	require.Equal(t, string(v1alpha2.CommandJobActionCheck), check.Name)
+	require.Nil(t, check.Next(false))
+	run := check.Next(true)
+	require.Equal(t, string(v1alpha2.CommandJobActionRun), run.Name)
+	require.Nil(t, run.Next(false))
+	verify := run.Next(true)
+	require.Equal(t, string(v1alpha2.CommandJobActionVerify), verify.Name)
+	require.Nil(t, verify.Next(true))
+	require.Nil(t, verify.Next(false))
+}
+
 func TestFound(t *testing.T) {
 	require.NotNil(t, FlowNodeUpgradeJob.Find(string(v1alpha2.NodeUpgradeJobActionCheck)))
 	require.NotNil(t, FlowNodeUpgradeJob.Find(string(v1alpha2.NodeUpgradeJobActionWaitingConfirmation)))
@@ -0,0 +1,245 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandbundle
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+// payloadVersion identifies the layout of the canonical payload.
+const payloadVersion = "commandbundle.kubeedge.io/v1"
+
+// canonicalPayload is the signed content of a command bundle. The fields are
+// encoded in this order, and the signature is not part of it.
+type canonicalPayload struct {
+	Version            string   `json:"version"`
+	Name               string   `json:"name"`
+	JobName            string   `json:"jobName"`
+	NodeNames          []string `json:"nodeNames"`
+	ExpirationTime     string   `json:"expirationTime"`
+	Commands           []string `json:"commands"`
+	VerifyCommands     []string `json:"verifyCommands"`
+	ExecTimeoutSeconds uint32   `json:"execTimeoutSeconds"`
+}
+
+// Payload returns the signed content of the command bundle. It is the compact JSON
+// object of the fields version ("commandbundle.kubeedge.io/v1"), name, jobName,
+// nodeNames, expirationTime, commands, verifyCommands and execTimeoutSeconds in this
+// order, without HTML escaping. The node names are sorted, the expiration time is
+// in RFC 3339 format in UTC, absent lists are empty lists and an absent timeout is 0,
+// so the payload does not depend on how the bundle was encoded.
+func Payload(bundle operationsv1alpha2.CommandBundle) ([]byte, error) {
+	p := canonicalPayload{
+		Version:        payloadVersion,
+		Name:           bundle.Name,
+		JobName:        bundle.JobName,
+		NodeNames:      slices.Sorted(slices.Values(bundle.NodeNames)),
+		ExpirationTime: bundle.ExpirationTime.UTC().Format(time.RFC3339),
+		Commands:       bundle.Commands,
+		VerifyCommands: bundle.VerifyCommands,
+	}
+	if p.NodeNames == nil {
+		p.NodeNames = []string{}
+	}
+	if p.Commands == nil {
+		p.Commands = []string{}
+	}
+	if p.VerifyCommands == nil {
+		p.VerifyCommands = []string{}
+	}
+	if bundle.ExecTimeoutSeconds != nil {
+		p.ExecTimeoutSeconds = *bundle.ExecTimeoutSeconds
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(p); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// CheckBinding returns an error if the command bundle is not signed for the job
+// and the node, or it has expired at now.
+func CheckBinding(bundle operationsv1alpha2.CommandBundle, jobName, nodeName string, now time.Time) error {
+	if bundle.JobName != jobName {
+		return fmt.Errorf("the command bundle %s is signed for job %q, not for job %q", bundle.Name, bundle.JobName, jobName)
+	}
+	if len(bundle.NodeNames) > 0 && !slices.Contains(bundle.NodeNames, nodeName) {
+		return fmt.Errorf("the command bundle %s is not signed for node %s", bundle.Name, nodeName)
+	}
+	if bundle.ExpirationTime.IsZero() {
+		return fmt.Errorf("the command bundle %s has no expiration time", bundle.Name)
+	}
+	if !now.Before(bundle.ExpirationTime.Time) {
+		return fmt.Errorf("the command bundle %s expired at %s", bundle.Name, bundle.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Sign signs the command bundle with the private key, and returns the base64 encoded signature.
+// The private key must be an *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey.
+func Sign(bundle operationsv1alpha2.CommandBundle, key crypto.Signer) (string, error) {
+	payload, err := Payload(bundle)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the payload of command bundle, err: %v", err)
+	}
+	var sig []byte
+	switch key.(type) {
+	case ed25519.PrivateKey:
+		sig, err = key.Sign(rand.Reader, payload, crypto.Hash(0))
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+		digest := sha256.Sum256(payload)
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", key)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the command bundle, err: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Verify verifies the signature of the command bundle. It returns nil if the signature
+// is verified by any of the public keys.
+func Verify(bundle operationsv1alpha2.CommandBundle, keys []crypto.PublicKey) error {
+	if len(keys) == 0 {
+		return errors.New("no public key is trusted to verify the command bundle")
+	}
+	if bundle.Signature == "" {
+		return errors.New("the command bundle is not signed")
+	}
+	sig, err := base64.StdEncoding.DecodeString(bundle.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode the signature of command bundle, err: %v", err)
+	}
+	payload, err := Payload(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to get the payload of command bundle, err: %v", err)
+	}
+	digest := sha256.Sum256(payload)
+	for _, key := range keys {
+		switch key := key.(type) {
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, sig) {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, digest[:], sig) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the signature of command bundle %s is not verified by any trusted public key", bundle.Name)
+}
+
+// LoadPublicKeys loads the PEM encoded public keys from the files.
+// Each file may contain multiple PKIX public keys.
+func LoadPublicKeys(files []string) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key file %s, err: %v", file, err)
+		}
+		fileKeys, err := ParsePublicKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key file %s, err: %v", file, err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return keys, nil
+}
+
+// ParsePublicKeys parses the PEM encoded PKIX public keys.
+func ParsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	return keys, nil
+}
+
+// ParsePrivateKey parses the first PEM encoded private key in PKCS #8, PKCS #1 (RSA)
+// or SEC 1 (EC) form.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no PEM encoded private key found")
+		}
+		var (
+			key any
+			err error
+		)
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+}
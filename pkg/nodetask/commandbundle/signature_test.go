@@ -0,0 +1,306 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandbundle
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	bundle := operationsv1alpha2.CommandBundle{
+		Name:           "restart-app",
+		Commands:       []string{"systemctl restart app"},
+		VerifyCommands: []string{"systemctl is-active app"},
+		JobName:        "restart-app-job",
+		NodeNames:      []string{"edge-node1"},
+		ExpirationTime: metav1.NewTime(time.Now().Add(time.Hour)),
+	}
+	cases := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{name: "case1 ed25519", key: edKey},
+		{name: "case2 ecdsa", key: ecKey},
+		{name: "case3 rsa", key: rsaKey},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			signed := bundle
+			signed.Signature, err = Sign(bundle, c.key)
+			require.NoError(t, err)
+
+			keys := []crypto.PublicKey{rsaKey.Public(), ecKey.Public(), edKey.Public()}
+			assert.NoError(t, Verify(signed, keys))
+
+			tampered := signed
+			tampered.Commands = []string{"rm -rf /"}
+			assert.Error(t, Verify(tampered, keys))
+
+			rebound := signed
+			rebound.JobName = "another-job"
+			assert.Error(t, Verify(rebound, keys))
+		})
+	}
+
+	t.Run("case4 not signed", func(t *testing.T) {
+		err := Verify(bundle, []crypto.PublicKey{edKey.Public()})
+		assert.ErrorContains(t, err, "not signed")
+	})
+
+	t.Run("case5 no trusted public key", func(t *testing.T) {
+		err := Verify(bundle, nil)
+		assert.ErrorContains(t, err, "no public key")
+	})
+
+	t.Run("case6 signed by an untrusted key", func(t *testing.T) {
+		signed := bundle
+		signed.Signature, err = Sign(bundle, edKey)
+		require.NoError(t, err)
+		err := Verify(signed, []crypto.PublicKey{ecKey.Public()})
+		assert.ErrorContains(t, err, "not verified")
+	})
+}
+
+func TestPayload(t *testing.T) {
+	timeout := uint32(30)
+	bundle := operationsv1alpha2.CommandBundle{
+		Name:               "restart-app",
+		Commands:           []string{"test -f /etc/app && systemctl restart app"},
+		ExecTimeoutSeconds: &timeout,
+		JobName:            "restart-app-job",
+		NodeNames:          []string{"edge-node2", "edge-node1"},
+		ExpirationTime:     metav1.NewTime(time.Date(2025, 1, 2, 11, 4, 5, 0, time.FixedZone("", 3600))),
+		Signature:          "c2lnbmF0dXJl",
+	}
+	payload, err := Payload(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, `{"version":"commandbundle.kubeedge.io/v1","name":"restart-app","jobName":"restart-app-job",`+
+		`"nodeNames":["edge-node1","edge-node2"],"expirationTime":"2025-01-02T10:04:05Z",`+
+		`"commands":["test -f /etc/app && systemctl restart app"],"verifyCommands":[],"execTimeoutSeconds":30}`,
+		string(payload))
+	// the node names of the bundle are not reordered
+	assert.Equal(t, []string{"edge-node2", "edge-node1"}, bundle.NodeNames)
+
+	t.Run("case1 the signature is not signed", func(t *testing.T) {
+		b := bundle
+		b.Signature = ""
+		p, err := Payload(b)
+		require.NoError(t, err)
+		assert.Equal(t, payload, p)
+	})
+
+	t.Run("case2 the binding fields are signed", func(t *testing.T) {
+		for _, change := range []func(b *operationsv1alpha2.CommandBundle){
+			func(b *operationsv1alpha2.CommandBundle) { b.JobName = "another-job" },
+			func(b *operationsv1alpha2.CommandBundle) { b.NodeNames = nil },
+			func(b *operationsv1alpha2.CommandBundle) {
+				b.ExpirationTime = metav1.NewTime(b.ExpirationTime.Add(time.Hour))
+			},
+		} {
+			b := bundle
+			change(&b)
+			p, err := Payload(b)
+			require.NoError(t, err)
+			assert.NotEqual(t, payload, p)
+		}
+	})
+}
+
+func TestCheckBinding(t *testing.T) {
+	now := time.Now()
+	bundle := operationsv1alpha2.CommandBundle{
+		Name:           "restart-app",
+		JobName:        "restart-app-job",
+		NodeNames:      []string{"edge-node1"},
+		ExpirationTime: metav1.NewTime(now.Add(time.Hour)),
+	}
+	cases := []struct {
+		name     string
+		bundle   func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle
+		jobName  string
+		nodeName string
+		wantErr  string
+	}{
+		{
+			name:     "case1 the bundle is bound to the job and the node",
+			jobName:  "restart-app-job",
+			nodeName: "edge-node1",
+		},
+		{
+			name:     "case2 the bundle is signed for another job",
+			jobName:  "another-job",
+			nodeName: "edge-node1",
+			wantErr:  `is signed for job "restart-app-job", not for job "another-job"`,
+		},
+		{
+			name:     "case3 the bundle is not signed for the node",
+			jobName:  "restart-app-job",
+			nodeName: "edge-node2",
+			wantErr:  "is not signed for node edge-node2",
+		},
+		{
+			name: "case4 the bundle is signed for all the nodes",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.NodeNames = nil
+				return b
+			},
+			jobName:  "restart-app-job",
+			nodeName: "edge-node2",
+		},
+		{
+			name: "case5 the bundle has expired",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.ExpirationTime = metav1.NewTime(now.Add(-time.Minute))
+				return b
+			},
+			jobName:  "restart-app-job",
+			nodeName: "edge-node1",
+			wantErr:  "expired at",
+		},
+		{
+			name: "case6 the bundle has no expiration time",
+			bundle: func(b operationsv1alpha2.CommandBundle) operationsv1alpha2.CommandBundle {
+				b.ExpirationTime = metav1.Time{}
+				return b
+			},
+			jobName:  "restart-app-job",
+			nodeName: "edge-node1",
+			wantErr:  "has no expiration time",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := bundle
+			if c.bundle != nil {
+				b = c.bundle(b)
+			}
+			err := CheckBinding(b, c.jobName, c.nodeName, now)
+			if c.wantErr != "" {
+				assert.ErrorContains(t, err, c.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	sec1, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		data []byte
+		want crypto.Signer
+	}{
+		{
+			name: "case1 PKCS #8",
+			data: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+			want: edKey,
+		},
+		{
+			name: "case2 SEC 1 after other blocks",
+			data: append(pem.EncodeToMemory(&pem.Block{Type: "EC PARAMETERS", Bytes: []byte{6, 8}}),
+				pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1})...),
+			want: ecKey,
+		},
+		{
+			name: "case3 PKCS #1",
+			data: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			want: rsaKey,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(c.data)
+			require.NoError(t, err)
+			assert.True(t, c.want.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(key.Public()))
+		})
+	}
+
+	t.Run("case4 no private key", func(t *testing.T) {
+		_, err := ParsePrivateKey([]byte("invalid"))
+		assert.ErrorContains(t, err, "no PEM encoded private key found")
+	})
+}
+
+func TestLoadPublicKeys(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var data []byte
+	for _, key := range []crypto.PublicKey{edPub, ecKey.Public()} {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "keys.pem")
+	require.NoError(t, os.WriteFile(keyFile, data, 0600))
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("invalid"), 0600))
+
+	t.Run("case1 load multiple keys", func(t *testing.T) {
+		keys, err := LoadPublicKeys([]string{keyFile})
+		require.NoError(t, err)
+		assert.Len(t, keys, 2)
+	})
+
+	t.Run("case2 no public key in the file", func(t *testing.T) {
+		_, err := LoadPublicKeys([]string{invalidFile})
+		assert.ErrorContains(t, err, "no PEM encoded public key found")
+	})
+
+	t.Run("case3 file not found", func(t *testing.T) {
+		_, err := LoadPublicKeys([]string{filepath.Join(dir, "notfound.pem")})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configprofile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+// reportedFields are the fields of EdgeCore configuration that are reported to the cloud, a field
+// is reported together with all its subfields. Any other field is never reported, so the new fields
+// holding credentials are not leaked to the cloud by default. A module is listed as a whole only if
+// none of its fields holds credentials.
+var reportedFields = []string{
+	"apiVersion",
+	"kind",
+	"edgecoreVersion",
+	"featureGates",
+	"database.driverName",
+	"database.aliasName",
+	"modules.edged",
+	"modules.edgeHub.enable",
+	"modules.edgeHub.heartbeat",
+	"modules.edgeHub.messageQPS",
+	"modules.edgeHub.messageBurst",
+	"modules.edgeHub.projectID",
+	"modules.edgeHub.tlsCaFile",
+	"modules.edgeHub.tlsCertFile",
+	"modules.edgeHub.tlsPrivateKeyFile",
+	"modules.edgeHub.quic",
+	"modules.edgeHub.websocket",
+	"modules.edgeHub.httpServer",
+	"modules.edgeHub.rotateCertificates",
+	"modules.edgeHub.outboundQueue",
+	"modules.edgeHub.reconnectBackoff",
+	"modules.edgeHub.serverSelection",
+	"modules.edgeHub.compression",
+	"modules.eventBus.enable",
+	"modules.eventBus.mqttQOS",
+	"modules.eventBus.mqttRetain",
+	"modules.eventBus.mqttSessionQueueSize",
+	"modules.eventBus.mqttServerInternal",
+	"modules.eventBus.mqttServerExternal",
+	"modules.eventBus.mqttSubClientID",
+	"modules.eventBus.mqttPubClientID",
+	"modules.eventBus.mqttMode",
+	"modules.eventBus.eventBusTLS",
+	"modules.metaManager",
+	"modules.serviceBus",
+	"modules.deviceTwin",
+	"modules.dbTest",
+	"modules.edgeStream",
+	"modules.taskManager",
+}
+
+// Snapshot returns the reported fields of the effective EdgeCore configuration in JSON format,
+// and the hash of the snapshot. The keys of the JSON objects are sorted, so the same
+// configuration always has the same hash.
+func Snapshot(cfg *edgecoreconfig.EdgeCoreConfig) ([]byte, string, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal edgecore config, err: %v", err)
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal edgecore config, err: %v", err)
+	}
+	reported := make(map[string]any)
+	for _, field := range reportedFields {
+		copyField(reported, obj, strings.Split(field, "."))
+	}
+	data, err = json.Marshal(reported)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal edgecore config snapshot, err: %v", err)
+	}
+	return data, Hash(data), nil
+}
+
+// Hash returns the hex encoded SHA-256 hash of the configuration snapshot.
+func Hash(snapshot []byte) string {
+	sum := sha256.Sum256(snapshot)
+	return hex.EncodeToString(sum[:])
+}
+
+// IsReportedField returns whether the field is reported by the edge nodes,
+// the effective values of the other fields cannot be compared.
+func IsReportedField(field string) bool {
+	for _, it := range reportedFields {
+		if field == it || strings.HasPrefix(field, it+".") || strings.HasPrefix(field, it+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares the desired fields with the configuration snapshot reported by the edge node,
+// and returns the fields whose effective values differ, sorted by the field paths.
+// The fields not reported by the edge nodes are skipped.
+func Diff(fields map[string]string, snapshot []byte) ([]operationsv1alpha2.EdgeCoreConfigFieldDiff, error) {
+	var obj any
+	if err := json.Unmarshal(snapshot, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal edgecore config snapshot, err: %v", err)
+	}
+	var diff []operationsv1alpha2.EdgeCoreConfigFieldDiff
+	for field, desired := range fields {
+		if !IsReportedField(field) {
+			continue
+		}
+		value, found, err := lookup(obj, field)
+		if err != nil {
+			return nil, err
+		}
+		var actual string
+		if found {
+			actual = formatValue(value)
+		}
+		if found && valueEqual(desired, actual) {
+			continue
+		}
+		diff = append(diff, operationsv1alpha2.EdgeCoreConfigFieldDiff{
+			Field:   field,
+			Desired: desired,
+			Actual:  actual,
+		})
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Field < diff[j].Field
+	})
+	return diff, nil
+}
+
+// lookup returns the value of the field in the object. The field path is in the same format
+// as the --set flag of keadm config-update, e.g., "modules.edged.tailoredKubeletConfig.clusterDNS[0]".
+func lookup(obj any, field string) (any, bool, error) {
+	cur := obj
+	for _, part := range strings.Split(field, ".") {
+		name, indexes, err := parseFieldPart(part)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid field %s, err: %v", field, err)
+		}
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false, nil
+		}
+		if cur, ok = m[name]; !ok {
+			return nil, false, nil
+		}
+		for _, index := range indexes {
+			list, ok := cur.([]any)
+			if !ok || index >= len(list) {
+				return nil, false, nil
+			}
+			cur = list[index]
+		}
+	}
+	return cur, true, nil
+}
+
+// parseFieldPart parses the part of field path like "name" or "name[1][2]".
+func parseFieldPart(part string) (string, []int, error) {
+	name, rest, found := strings.Cut(part, "[")
+	if name == "" {
+		return "", nil, fmt.Errorf("empty name in %q", part)
+	}
+	if !found {
+		return name, nil, nil
+	}
+	var indexes []int
+	for _, it := range strings.Split("["+rest, "[") {
+		if it == "" {
+			continue
+		}
+		s, ok := strings.CutSuffix(it, "]")
+		if !ok {
+			return "", nil, fmt.Errorf("unclosed index in %q", part)
+		}
+		index, err := strconv.Atoi(s)
+		if err != nil || index < 0 {
+			return "", nil, fmt.Errorf("invalid index %q in %q", s, part)
+		}
+		indexes = append(indexes, index)
+	}
+	return name, indexes, nil
+}
+
+// formatValue formats the value in the same format as the --set flag of keadm config-update.
+// The list of scalar values is formatted as "{a,b}", and other objects are formatted in JSON.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, it := range v {
+			switch it.(type) {
+			case map[string]any, []any:
+				data, _ := json.Marshal(v)
+				return string(data)
+			}
+			items = append(items, formatValue(it))
+		}
+		return "{" + strings.Join(items, ",") + "}"
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// valueEqual returns whether the desired value equals to the formatted effective value.
+// The numbers are compared by their values, so "1.0" equals to "1".
+func valueEqual(desired, actual string) bool {
+	if desired == actual {
+		return true
+	}
+	d, err := strconv.ParseFloat(desired, 64)
+	if err != nil {
+		return false
+	}
+	a, err := strconv.ParseFloat(actual, 64)
+	if err != nil {
+		return false
+	}
+	return d == a
+}
+
+// copyField copies the field given by its path from src to dst, the objects on
+// the path are created in dst. Nothing is copied if the field is not in src.
+func copyField(dst, src map[string]any, parts []string) {
+	for i, part := range parts {
+		value, ok := src[part]
+		if !ok {
+			return
+		}
+		if i == len(parts)-1 {
+			dst[part] = value
+			return
+		}
+		next, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		sub, ok := dst[part].(map[string]any)
+		if !ok {
+			sub = make(map[string]any)
+			dst[part] = sub
+		}
+		src, dst = next, sub
+	}
+}
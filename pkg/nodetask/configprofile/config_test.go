@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configprofile
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecoreconfig "github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+func TestSnapshot(t *testing.T) {
+	cfg := edgecoreconfig.NewDefaultEdgeCoreConfig()
+	cfg.Modules.EdgeHub.Token = "secret-token"
+	cfg.Modules.EventBus.MqttPassword = "secret-password"
+	cfg.Modules.EventBus.MqttUsername = "secret-username"
+	cfg.DataBase.DataSource = "user:secret-dsn@/edgecore"
+
+	data, hash, err := Snapshot(cfg)
+	require.NoError(t, err)
+	for _, secret := range []string{"secret-token", "secret-password", "secret-username", "secret-dsn"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	assert.Equal(t, Hash(data), hash)
+
+	// The reported fields are kept with their subfields.
+	var obj map[string]any
+	require.NoError(t, json.Unmarshal(data, &obj))
+	for field, want := range map[string]any{
+		"modules.edgeHub.heartbeat":           float64(cfg.Modules.EdgeHub.Heartbeat),
+		"modules.edgeHub.websocket.server":    cfg.Modules.EdgeHub.WebSocket.Server,
+		"modules.edged.tailoredKubeletConfig": nil,
+		"database.driverName":                 cfg.DataBase.DriverName,
+	} {
+		value, found, err := lookup(obj, field)
+		require.NoError(t, err)
+		assert.True(t, found, field)
+		if want != nil {
+			assert.Equal(t, want, value, field)
+		}
+	}
+	for _, field := range []string{"modules.edgeHub.token", "modules.eventBus.mqttUsername", "database.dataSource"} {
+		_, found, err := lookup(obj, field)
+		require.NoError(t, err)
+		assert.False(t, found, field)
+	}
+
+	// The hash is stable for the same configuration, and changes with the configuration.
+	_, again, err := Snapshot(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	cfg.Modules.EdgeHub.Heartbeat++
+	_, changed, err := Snapshot(cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	// The fields not reported do not change the hash.
+	cfg.Modules.EdgeHub.Heartbeat--
+	cfg.Modules.EdgeHub.Token = "another-token"
+	_, tokenChanged, err := Snapshot(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, tokenChanged)
+}
+
+func TestDiff(t *testing.T) {
+	snapshot := []byte(`{"modules":{"edgeHub":{"heartbeat":15,"enable":true,"projectID":"e632aba927ea4ac2b575ec1603d56f10"},` +
+		`"edged":{"tailoredKubeletConfig":{"clusterDNS":["169.254.96.16","10.0.0.10"],"cpuCFSQuota":null}}}}`)
+
+	cases := []struct {
+		name     string
+		fields   map[string]string
+		wantDiff []operationsv1alpha2.EdgeCoreConfigFieldDiff
+		wantErr  bool
+	}{
+		{
+			name: "case1 all the fields are in sync",
+			fields: map[string]string{
+				"modules.edgeHub.heartbeat":                         "15.0",
+				"modules.edgeHub.enable":                            "true",
+				"modules.edged.tailoredKubeletConfig.clusterDNS":    "{169.254.96.16,10.0.0.10}",
+				"modules.edged.tailoredKubeletConfig.clusterDNS[1]": "10.0.0.10",
+				"modules.edged.tailoredKubeletConfig.cpuCFSQuota":   "null",
+			},
+		},
+		{
+			name: "case2 the fields drift or are not set",
+			fields: map[string]string{
+				"modules.edgeHub.heartbeat":                         "30",
+				"modules.edgeHub.projectID":                         "e632aba927ea4ac2b575ec1603d56f10",
+				"modules.edgeHub.rotateCertificates":                "true",
+				"modules.edged.tailoredKubeletConfig.clusterDNS[2]": "10.0.0.11",
+			},
+			wantDiff: []operationsv1alpha2.EdgeCoreConfigFieldDiff{
+				{Field: "modules.edgeHub.heartbeat", Desired: "30", Actual: "15"},
+				{Field: "modules.edgeHub.rotateCertificates", Desired: "true"},
+				{Field: "modules.edged.tailoredKubeletConfig.clusterDNS[2]", Desired: "10.0.0.11"},
+			},
+		},
+		{
+			name: "case3 the fields not reported are skipped",
+			fields: map[string]string{
+				"modules.edgeHub.token":         "secret-token",
+				"modules.eventBus.mqttUsername": "user",
+			},
+		},
+		{
+			name: "case4 invalid field path",
+			fields: map[string]string{
+				"modules.edged.tailoredKubeletConfig.clusterDNS[x]": "10.0.0.10",
+			},
+			wantErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diff, err := Diff(c.fields, snapshot)
+			if c.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.wantDiff, diff)
+		})
+	}
+}
+
+func TestIsReportedField(t *testing.T) {
+	cases := map[string]bool{
+		"modules.edgeHub.heartbeat":                         true,
+		"modules.edgeHub.websocket.server":                  true,
+		"modules.edged.tailoredKubeletConfig.clusterDNS[0]": true,
+		"modules.edgeHub":                                   false,
+		"modules.edgeHub.token":                             false,
+		"modules.edgeHub.heartbeatTimeout":                  false,
+		"modules.eventBus.mqttPassword":                     false,
+		"database.dataSource":                               false,
+		"modules.newModule.password":                        false,
+	}
+	for field, want := range cases {
+		assert.Equal(t, want, IsReportedField(field), field)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit limits how far the next start time of a schedule is searched,
+// the schedules that never match (e.g., "0 0 30 2 *") stop at this limit.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// cronField defines the range of a field of the cron expression.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var (
+	fieldMinute     = cronField{name: "minute", min: 0, max: 59}
+	fieldHour       = cronField{name: "hour", min: 0, max: 23}
+	fieldDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31}
+	fieldMonth      = cronField{name: "month", min: 1, max: 12}
+	// The day-of-week 7 is also Sunday.
+	fieldDayOfWeek = cronField{name: "day-of-week", min: 0, max: 7}
+)
+
+// schedule is a parsed standard cron expression with five fields, each field
+// is a bitmask of the matched values.
+type schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// anyDayOfMonth and anyDayOfWeek indicate whether the day fields are "*".
+	// If both of them are restricted, the day matches either of them.
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// parseSchedule parses the cron expression in the format of
+// "minute hour day-of-month month day-of-week". Each field supports
+// "*", values, ranges "a-b", lists "a,b" and steps "*/n" or "a-b/n".
+func parseSchedule(expr string) (*schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q, expected 5 fields but got %d", expr, len(fields))
+	}
+	var (
+		s   schedule
+		err error
+	)
+	if s.minute, err = parseField(fields[0], fieldMinute); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], fieldHour); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = parseField(fields[2], fieldDayOfMonth); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], fieldMonth); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = parseField(fields[4], fieldDayOfWeek); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+	s.anyDayOfMonth = fields[2] == "*"
+	s.anyDayOfWeek = fields[4] == "*"
+	return &s, nil
+}
+
+func parseField(value string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rng, stepValue, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepValue); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q of the %s field", stepValue, field.name)
+			}
+		}
+		start, end := field.min, field.max
+		if rng != "*" {
+			low, high, isRange := strings.Cut(rng, "-")
+			var err error
+			if start, err = parseValue(low, field); err != nil {
+				return 0, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseValue(high, field); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				end = field.max
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q of the %s field", rng, field.name)
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(value string, field cronField) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < field.min || i > field.max {
+		return 0, fmt.Errorf("invalid value %q of the %s field, it must be in [%d, %d]",
+			value, field.name, field.min, field.max)
+	}
+	return i, nil
+}
+
+// next returns the first start time that is not before t.
+// Returns false if no start time is found within the search limit.
+func (s *schedule) next(t time.Time) (time.Time, bool) {
+	// Round up to the whole minute.
+	if truncated := t.Truncate(time.Minute); !truncated.Equal(t) {
+		t = truncated.Add(time.Minute)
+	}
+	limit := t.Add(searchLimit)
+	loc := t.Location()
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (s *schedule) matchDay(t time.Time) bool {
+	dom := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dow
+	case s.anyDayOfWeek:
+		return dom
+	default:
+		return dom || dow
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+// Window is the parsed maintenance window of the node jobs.
+type Window struct {
+	schedules []*schedule
+	duration  time.Duration
+	location  *time.Location
+}
+
+// New parses the maintenance window spec.
+func New(spec *operationsv1alpha2.MaintenanceWindow) (*Window, error) {
+	if len(spec.Schedules) == 0 {
+		return nil, errors.New("the schedules of the maintenance window cannot be empty")
+	}
+	if spec.DurationSeconds == 0 {
+		return nil, errors.New("the duration of the maintenance window must be greater than zero")
+	}
+	w := &Window{
+		duration: time.Duration(spec.DurationSeconds) * time.Second,
+		location: time.UTC,
+	}
+	if spec.TimeZone != "" {
+		loc, err := time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %s of the maintenance window, err: %v", spec.TimeZone, err)
+		}
+		w.location = loc
+	}
+	for _, expr := range spec.Schedules {
+		s, err := parseSchedule(expr)
+		if err != nil {
+			return nil, err
+		}
+		w.schedules = append(w.schedules, s)
+	}
+	return w, nil
+}
+
+// ForNode returns the maintenance window of the node. The window in the node annotation
+// overrides the window of the job. Returns nil if the job has no maintenance window,
+// that means the node tasks can be performed at any time.
+func ForNode(spec *operationsv1alpha2.MaintenanceWindow, nodeAnnotations map[string]string) (*Window, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	if value, ok := nodeAnnotations[operationsv1alpha2.AnnotationMaintenanceWindow]; ok {
+		var override operationsv1alpha2.MaintenanceWindow
+		if err := json.Unmarshal([]byte(value), &override); err != nil {
+			return nil, fmt.Errorf("invalid annotation %s of the node, err: %v",
+				operationsv1alpha2.AnnotationMaintenanceWindow, err)
+		}
+		spec = &override
+	}
+	return New(spec)
+}
+
+// Open returns whether the window is open at the time t, and the start time of the open window.
+// A nil window is always open.
+func (w *Window) Open(t time.Time) (time.Time, bool) {
+	if w == nil {
+		return time.Time{}, true
+	}
+	// The window is open if it starts in (t-duration, t].
+	from := t.In(w.location).Add(-w.duration).Add(time.Nanosecond)
+	var (
+		start time.Time
+		open  bool
+	)
+	for _, s := range w.schedules {
+		next, ok := s.next(from)
+		if !ok || next.After(t) {
+			continue
+		}
+		if !open || next.Before(start) {
+			start, open = next, true
+		}
+	}
+	return start, open
+}
+
+// NextOpen returns the next time when the window opens after the time t.
+// Returns false if the window never opens.
+func (w *Window) NextOpen(t time.Time) (time.Time, bool) {
+	if w == nil {
+		return t, true
+	}
+	var (
+		res   time.Time
+		found bool
+	)
+	for _, s := range w.schedules {
+		next, ok := s.next(t.In(w.location))
+		if ok && (!found || next.Before(res)) {
+			res, found = next, true
+		}
+	}
+	return res, found
+}
+
+// WaitingReason returns the reason of the node task waiting for the window at the time t.
+func (w *Window) WaitingReason(t time.Time) string {
+	if next, ok := w.NextOpen(t); ok {
+		return fmt.Sprintf("waiting for the maintenance window, which opens at %s", next.Format(time.RFC3339))
+	}
+	return "waiting for the maintenance window, which never opens"
+}
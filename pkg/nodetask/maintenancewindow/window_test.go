@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	operationsv1alpha2 "github.com/kubeedge/api/apis/operations/v1alpha2"
+)
+
+func TestParseSchedule(t *testing.T) {
+	cases := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "case1 every minute", expr: "* * * * *"},
+		{name: "case2 lists, ranges and steps", expr: "0,30 8-18/2 1-15 */3 1-5"},
+		{name: "case3 sunday as 7", expr: "0 0 * * 7"},
+		{name: "case4 too few fields", expr: "0 0 * *", wantErr: true},
+		{name: "case5 out of range", expr: "60 0 * * *", wantErr: true},
+		{name: "case6 invalid range", expr: "0 18-8 * * *", wantErr: true},
+		{name: "case7 invalid step", expr: "*/0 * * * *", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := parseSchedule(c.expr)
+			assert.Equal(t, c.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	base := time.Date(2025, 3, 14, 10, 15, 30, 0, time.UTC) // Friday
+	cases := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{
+			name: "case1 next minute",
+			expr: "* * * * *",
+			want: time.Date(2025, 3, 14, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name: "case2 later today",
+			expr: "0 22 * * *",
+			want: time.Date(2025, 3, 14, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "case3 next monday",
+			expr: "0 6 * * 1",
+			want: time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "case4 day of month or day of week",
+			expr: "0 6 20 * 0",
+			want: time.Date(2025, 3, 16, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "case5 next year",
+			expr: "0 0 1 1 *",
+			want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s, err := parseSchedule(c.expr)
+			require.NoError(t, err)
+			next, ok := s.next(base)
+			assert.True(t, ok)
+			assert.Equal(t, c.want, next)
+		})
+	}
+
+	s, err := parseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	_, ok := s.next(base)
+	assert.False(t, ok)
+}
+
+func TestWindowOpen(t *testing.T) {
+	w, err := New(&operationsv1alpha2.MaintenanceWindow{
+		Schedules:       []string{"0 22 * * *"},
+		DurationSeconds: 2 * 3600,
+		TimeZone:        "Asia/Shanghai",
+	})
+	require.NoError(t, err)
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		at        time.Time
+		wantOpen  bool
+		wantStart time.Time
+	}{
+		{
+			name: "case1 before the window",
+			at:   time.Date(2025, 3, 14, 21, 59, 0, 0, shanghai),
+		},
+		{
+			name:      "case2 the window opens",
+			at:        time.Date(2025, 3, 14, 22, 0, 0, 0, shanghai),
+			wantOpen:  true,
+			wantStart: time.Date(2025, 3, 14, 22, 0, 0, 0, shanghai),
+		},
+		{
+			name:      "case3 the window crosses midnight",
+			at:        time.Date(2025, 3, 14, 23, 30, 0, 0, shanghai),
+			wantOpen:  true,
+			wantStart: time.Date(2025, 3, 14, 22, 0, 0, 0, shanghai),
+		},
+		{
+			name: "case4 the window closes",
+			at:   time.Date(2025, 3, 15, 0, 0, 0, 0, shanghai),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			start, open := w.Open(c.at)
+			assert.Equal(t, c.wantOpen, open)
+			if c.wantOpen {
+				assert.True(t, c.wantStart.Equal(start), "start %s", start)
+			}
+		})
+	}
+
+	next, ok := w.NextOpen(time.Date(2025, 3, 15, 0, 0, 0, 0, shanghai))
+	assert.True(t, ok)
+	assert.True(t, time.Date(2025, 3, 15, 22, 0, 0, 0, shanghai).Equal(next))
+
+	var always *Window
+	_, open := always.Open(time.Now())
+	assert.True(t, open)
+}
+
+func TestForNode(t *testing.T) {
+	spec := &operationsv1alpha2.MaintenanceWindow{Schedules: []string{"0 22 * * *"}, DurationSeconds: 3600}
+	at := time.Date(2025, 3, 14, 6, 30, 0, 0, time.UTC)
+
+	w, err := ForNode(nil, map[string]string{
+		operationsv1alpha2.AnnotationMaintenanceWindow: `{"schedules":["0 6 * * *"],"durationSeconds":3600}`,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, w)
+
+	w, err = ForNode(spec, nil)
+	require.NoError(t, err)
+	_, open := w.Open(at)
+	assert.False(t, open)
+
+	w, err = ForNode(spec, map[string]string{
+		operationsv1alpha2.AnnotationMaintenanceWindow: `{"schedules":["0 6 * * *"],"durationSeconds":3600}`,
+	})
+	require.NoError(t, err)
+	_, open = w.Open(at)
+	assert.True(t, open)
+
+	_, err = ForNode(spec, map[string]string{operationsv1alpha2.AnnotationMaintenanceWindow: "6-7"})
+	assert.Error(t, err)
+}
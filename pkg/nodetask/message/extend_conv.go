@@ -53,3 +53,21 @@ func ParseImagePrePullJobExtend(extend string) ([]operationsv1alpha2.ImageStatus
 	}
 	return statusItems, nil
 }
+
+// FormatCommandJobExtend formats the command job extend.
+func FormatCommandJobExtend(output operationsv1alpha2.CommandOutput) (string, error) {
+	bff, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+	return string(bff), nil
+}
+
+// ParseCommandJobExtend parses the command job extend.
+func ParseCommandJobExtend(extend string) (*operationsv1alpha2.CommandOutput, error) {
+	var output operationsv1alpha2.CommandOutput
+	if err := json.Unmarshal([]byte(extend), &output); err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
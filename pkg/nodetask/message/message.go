@@ -28,6 +28,11 @@ import (
 
 const (
 	OperationUpdateNodeActionStatus = "UpdateNodeActionStatus"
+
+	// ActionWaitingWindow is the upstream message action reported when the node task
+	// is waiting for the maintenance window of the node to open. It is not an action
+	// of the action flows and does not finish the node task.
+	ActionWaitingWindow = string(operationsv1alpha2.NodeTaskPhaseWaitingWindow)
 )
 
 // UpstreamMessage defines the upstream message content of the node job.
@@ -45,6 +50,20 @@ type UpstreamMessage struct {
 	Extend string `json:"extend"`
 }
 
+// ImagePrePullJobTaskSpec defines the spec of ImagePrePullJob sent to the edge node.
+// It appends the pull source of the node task to the spec of the job.
+type ImagePrePullJobTaskSpec struct {
+	operationsv1alpha2.ImagePrePullJobSpec `json:",inline"`
+	// PeerEndpoint defines the endpoint of the image mirror of the peer node that the images are pulled from.
+	PeerEndpoint string `json:"peerEndpoint,omitempty"`
+	// ServePeers defines whether the edge node is elected to serve the images for its peers.
+	// If it is true, the images are pulled through the local image mirror so that they are cached.
+	ServePeers bool `json:"servePeers,omitempty"`
+	// PeerToken defines the token that authenticates the peers to the image mirror of the elected node.
+	// It is set with the PeerEndpoint or the ServePeers.
+	PeerToken string `json:"peerToken,omitempty"`
+}
+
 // Resource defines the message resource of the node job.
 type Resource struct {
 	// APIVersion defines the group/version of the node job resource
@@ -10,6 +10,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/mux"
 )
@@ -38,6 +39,9 @@ type Options struct {
 	HandshakeTimeout time.Duration
 	// consumer for raw data
 	Consumer io.Writer
+	// Compression offers the message compression to the server,
+	// the messages are not compressed if it is nil
+	Compression *compress.Options
 }
 
 // client including common options and extend options
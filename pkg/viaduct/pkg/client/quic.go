@@ -1,7 +1,9 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/lucas-clemente/quic-go"
@@ -10,6 +12,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
 )
@@ -65,29 +68,44 @@ func (c *QuicClient) getControlLane(s quic.Session) error {
 	return nil
 }
 
-// send the headers
+// send the headers and get the compression selected by the server
 // TODO: add timeout?
-func (c *QuicClient) sendHeader() error {
+func (c *QuicClient) sendHeader() (*compress.Compressor, error) {
+	if offer := compress.Offer(c.options.Compression); offer != "" {
+		if c.exOpts.Header == nil {
+			c.exOpts.Header = make(http.Header)
+		}
+		c.exOpts.Header.Set(comm.HeaderCompression, offer)
+	}
 	msg := model.NewMessage("").
 		BuildRouter("", "", comm.ControlTypeHeader, comm.ControlTypeHeader).
 		FillBody(c.exOpts.Header)
 	err := c.ctrlLane.WriteMessage(msg)
 	if err != nil {
 		klog.Errorf("failed to write message, error: %+v", err)
-		return err
+		return nil, err
 	}
 
 	// receive the response
-	// ignore the response
-	// TODO: check the response content
+	// the server supporting compression replies the response headers,
+	// the others reply ack
 	var response model.Message
 	err = c.ctrlLane.ReadMessage(&response)
 	if err != nil {
 		klog.Errorf("failed to read message, error: %+v", err)
-		return err
+		return nil, err
 	}
 	klog.Infof("get response: %+v", response)
-	return nil
+
+	content, ok := response.GetContent().([]byte)
+	if !ok {
+		return nil, nil
+	}
+	respHeader := make(http.Header)
+	if err := json.Unmarshal(content, &respHeader); err != nil {
+		return nil, nil
+	}
+	return compress.Negotiated(respHeader.Get(comm.HeaderCompression), c.options.Compression), nil
 }
 
 // try to dial server and get connection interface for operations
@@ -107,7 +125,7 @@ func (c *QuicClient) Connect() (conn.Connection, error) {
 	}
 
 	// send headers
-	err = c.sendHeader()
+	compressor, err := c.sendHeader()
 	if err != nil {
 		klog.Warningf("failed to send headers, error: %+v", err)
 	}
@@ -124,7 +142,9 @@ func (c *QuicClient) Connect() (conn.Connection, error) {
 			State:            api.StatConnected,
 			Headers:          c.exOpts.Header,
 			PeerCertificates: session.ConnectionState().PeerCertificates,
+			Compressor:       compressor,
 		},
-		AutoRoute: c.options.AutoRoute,
+		AutoRoute:  c.options.AutoRoute,
+		Compressor: compressor,
 	}), nil
 }
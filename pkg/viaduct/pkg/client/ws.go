@@ -10,6 +10,7 @@ import (
 
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
 )
@@ -42,6 +43,9 @@ func NewWSClient(options Options, exOpts interface{}) *WSClient {
 func (c *WSClient) Connect() (conn.Connection, error) {
 	header := c.exOpts.Header
 	header.Add("ConnectionUse", string(c.options.ConnUse))
+	if offer := compress.Offer(c.options.Compression); offer != "" {
+		header.Set(comm.HeaderCompression, offer)
+	}
 	wsConn, resp, err := c.dialer.Dial(c.options.Addr, header)
 	if err == nil {
 		klog.Infof("dial %s successfully", c.options.Addr)
@@ -51,8 +55,13 @@ func (c *WSClient) Connect() (conn.Connection, error) {
 			c.exOpts.Callback(wsConn, resp)
 		}
 		var peerCerts []*x509.Certificate
-		if resp != nil && resp.TLS != nil {
-			peerCerts = resp.TLS.PeerCertificates
+		var compressor *compress.Compressor
+		if resp != nil {
+			if resp.TLS != nil {
+				peerCerts = resp.TLS.PeerCertificates
+			}
+			// the server which does not support compression replies no algorithm
+			compressor = compress.Negotiated(resp.Header.Get(comm.HeaderCompression), c.options.Compression)
 		}
 		return conn.NewConnection(&conn.ConnectionOptions{
 			ConnType: api.ProtocolTypeWS,
@@ -65,8 +74,10 @@ func (c *WSClient) Connect() (conn.Connection, error) {
 				State:            api.StatConnected,
 				Headers:          c.exOpts.Header.Clone(),
 				PeerCertificates: peerCerts,
+				Compressor:       compressor,
 			},
-			AutoRoute: c.options.AutoRoute,
+			AutoRoute:  c.options.AutoRoute,
+			Compressor: compressor,
 		}), nil
 	}
 
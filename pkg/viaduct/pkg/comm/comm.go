@@ -20,6 +20,10 @@ const (
 	// the max size of message fifo
 	MessageFiFoSizeMax = 100
 
+	// HeaderCompression is the header to negotiate the message compression,
+	// the client offers the algorithms it accepts and the server replies the selected one
+	HeaderCompression = "X-Viaduct-Compression"
+
 	// MaxReadLength is the max length of http response body
 	MaxReadLength = 1 << 20 // 1 MiB
 )
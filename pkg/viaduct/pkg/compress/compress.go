@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/packer"
+)
+
+// Algorithm is the name of a compression algorithm
+type Algorithm string
+
+const (
+	// None means the messages are not compressed
+	None Algorithm = ""
+	Zstd Algorithm = "zstd"
+	Gzip Algorithm = "gzip"
+)
+
+// DefaultThreshold is the min size of a message to be compressed, smaller
+// messages do not benefit from compression
+const DefaultThreshold = 1024
+
+// maxDecompressedSize limits the size of a decompressed message the same
+// as the payload limit of the packer
+const maxDecompressedSize = int64(packer.MaxPayloadLen)
+
+// the id of the algorithm written in the low bits of the package flags or
+// in the first byte of a compressed websocket frame
+const (
+	idZstd uint8 = 0x01
+	idGzip uint8 = 0x02
+	idMask uint8 = 0x0f
+)
+
+// ID returns the wire id of the algorithm, 0 for unknown algorithms
+func (a Algorithm) ID() uint8 {
+	switch a {
+	case Zstd:
+		return idZstd
+	case Gzip:
+		return idGzip
+	}
+	return 0
+}
+
+// AlgorithmOf returns the algorithm of the wire id
+func AlgorithmOf(id uint8) (Algorithm, error) {
+	switch id & idMask {
+	case idZstd:
+		return Zstd, nil
+	case idGzip:
+		return Gzip, nil
+	}
+	return None, fmt.Errorf("unknown compression algorithm id %d", id)
+}
+
+// Supported returns whether the algorithm is implemented
+func Supported(a Algorithm) bool {
+	return a.ID() != 0
+}
+
+// Options is the compression config of a client or server
+type Options struct {
+	// Algorithms are the algorithms accepted, in the order of preference
+	Algorithms []Algorithm
+	// Threshold is the min size in bytes of a message to be compressed
+	Threshold int
+}
+
+// Offer returns the value of the compression header sent by the client
+func Offer(opts *Options) string {
+	if opts == nil {
+		return ""
+	}
+	var offered []string
+	for _, a := range opts.Algorithms {
+		if Supported(a) {
+			offered = append(offered, string(a))
+		}
+	}
+	return strings.Join(offered, ",")
+}
+
+// Negotiate selects the algorithm used by the connection from the algorithms
+// offered by the peer, the first algorithm of opts accepted by the peer is selected.
+// None is returned if the peer does not support compression.
+func Negotiate(offer string, opts *Options) Algorithm {
+	if opts == nil || offer == "" {
+		return None
+	}
+	offered := make(map[Algorithm]bool)
+	for _, a := range strings.Split(offer, ",") {
+		offered[Algorithm(strings.TrimSpace(a))] = true
+	}
+	for _, a := range opts.Algorithms {
+		if Supported(a) && offered[a] {
+			return a
+		}
+	}
+	return None
+}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+
+	gzipWriters = sync.Pool{
+		New: func() interface{} {
+			return gzip.NewWriter(nil)
+		},
+	}
+)
+
+// the zstd encoder and decoder are safe for concurrent use by EncodeAll and DecodeAll
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderMaxMemory(uint64(maxDecompressedSize)))
+	})
+	return zstdErr
+}
+
+// Encode compresses data with the algorithm
+func Encode(a Algorithm, data []byte) ([]byte, error) {
+	switch a {
+	case Zstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	case Gzip:
+		var buf bytes.Buffer
+		w := gzipWriters.Get().(*gzip.Writer)
+		defer gzipWriters.Put(w)
+		w.Reset(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return nil, fmt.Errorf("unsupported compression algorithm %q", a)
+}
+
+// Decode decompresses data compressed with the algorithm
+func Decode(a Algorithm, data []byte) ([]byte, error) {
+	switch a {
+	case Zstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdDecoder.DecodeAll(data, nil)
+	case Gzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		out, err := io.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(out)) > maxDecompressedSize {
+			return nil, fmt.Errorf("decompressed message exceeds maximum %d", maxDecompressedSize)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported compression algorithm %q", a)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	server := &Options{Algorithms: []Algorithm{Zstd, Gzip}}
+	cases := []struct {
+		name     string
+		offer    string
+		opts     *Options
+		expected Algorithm
+	}{
+		{"server preference", "gzip, zstd", server, Zstd},
+		{"only gzip offered", "gzip", server, Gzip},
+		{"no offer", "", server, None},
+		{"unknown algorithm", "br", server, None},
+		{"compression disabled", "zstd", nil, None},
+		{"unsupported algorithm configured", "br", &Options{Algorithms: []Algorithm{"br"}}, None},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, Negotiate(c.offer, c.opts))
+		})
+	}
+}
+
+func TestOffer(t *testing.T) {
+	assert.Equal(t, "", Offer(nil))
+	assert.Equal(t, "zstd,gzip", Offer(&Options{Algorithms: []Algorithm{Zstd, "br", Gzip}}))
+}
+
+func TestAlgorithmID(t *testing.T) {
+	for _, a := range []Algorithm{Zstd, Gzip} {
+		got, err := AlgorithmOf(a.ID())
+		require.NoError(t, err)
+		assert.Equal(t, a, got)
+	}
+	_, err := AlgorithmOf(0)
+	assert.Error(t, err)
+}
+
+func TestCompressor(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"resource":"default/nodestatus/node1"}`), 100)
+	for _, a := range []Algorithm{Zstd, Gzip} {
+		t.Run(string(a), func(t *testing.T) {
+			c := NewCompressor(a, 0)
+			out, ok := c.Compress(data)
+			require.True(t, ok)
+			assert.Less(t, len(out), len(data))
+
+			got, err := c.Decompress(a, out)
+			require.NoError(t, err)
+			assert.Equal(t, data, got)
+
+			// messages smaller than the threshold are not compressed
+			small, ok := c.Compress([]byte("ping"))
+			assert.False(t, ok)
+			assert.Equal(t, []byte("ping"), small)
+
+			stats := c.Stats()
+			assert.Equal(t, a, stats.Algorithm)
+			assert.Equal(t, int64(1), stats.CompressedMessages)
+			assert.Equal(t, int64(1), stats.UncompressedMessages)
+			assert.Equal(t, int64(len(data)), stats.BytesIn)
+			assert.Equal(t, int64(len(out)), stats.BytesOut)
+			assert.Equal(t, int64(1), stats.DecompressedMessages)
+			assert.Greater(t, stats.Ratio(), float64(1))
+		})
+	}
+}
+
+func TestNilCompressor(t *testing.T) {
+	var c *Compressor
+	assert.Nil(t, Negotiated("zstd", nil))
+	assert.Nil(t, NewCompressor(None, 0))
+
+	data := bytes.Repeat([]byte("a"), 4096)
+	out, ok := c.Compress(data)
+	assert.False(t, ok)
+	assert.Equal(t, data, out)
+
+	// messages compressed by the peer are decompressed anyway
+	compressed, err := Encode(Gzip, data)
+	require.NoError(t, err)
+	got, err := c.Decompress(Gzip, compressed)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	assert.Equal(t, Stats{}, c.Stats())
+}
+
+func TestDecodeInvalidData(t *testing.T) {
+	for _, a := range []Algorithm{Zstd, Gzip} {
+		_, err := Decode(a, []byte("not compressed"))
+		assert.Error(t, err, a)
+	}
+}
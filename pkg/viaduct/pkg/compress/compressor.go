@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compress
+
+import (
+	"sync/atomic"
+
+	"k8s.io/klog/v2"
+)
+
+// Stats are the compression statistics of a connection
+type Stats struct {
+	Algorithm Algorithm
+	// CompressedMessages is the number of messages sent compressed
+	CompressedMessages int64
+	// UncompressedMessages is the number of messages sent uncompressed,
+	// because they are smaller than the threshold or not smaller after compression
+	UncompressedMessages int64
+	// BytesIn is the size of the compressed messages before compression
+	BytesIn int64
+	// BytesOut is the size of the compressed messages after compression
+	BytesOut int64
+	// DecompressedMessages is the number of compressed messages received
+	DecompressedMessages int64
+}
+
+// Ratio returns the compression ratio of the sent messages
+func (s Stats) Ratio() float64 {
+	if s.BytesOut == 0 {
+		return 0
+	}
+	return float64(s.BytesIn) / float64(s.BytesOut)
+}
+
+// Compressor compresses the messages of a connection with the negotiated
+// algorithm, and collects the statistics of the connection.
+// A nil Compressor sends all messages uncompressed.
+type Compressor struct {
+	algorithm Algorithm
+	threshold int
+
+	compressedMessages   atomic.Int64
+	uncompressedMessages atomic.Int64
+	bytesIn              atomic.Int64
+	bytesOut             atomic.Int64
+	decompressedMessages atomic.Int64
+}
+
+// NewCompressor returns the Compressor of a connection, it returns nil if
+// no algorithm is negotiated
+func NewCompressor(algorithm Algorithm, threshold int) *Compressor {
+	if !Supported(algorithm) {
+		return nil
+	}
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	return &Compressor{
+		algorithm: algorithm,
+		threshold: threshold,
+	}
+}
+
+// Negotiated returns the Compressor of the algorithm negotiated from offer,
+// it returns nil if no algorithm is negotiated
+func Negotiated(offer string, opts *Options) *Compressor {
+	algorithm := Negotiate(offer, opts)
+	if algorithm == None {
+		return nil
+	}
+	return NewCompressor(algorithm, opts.Threshold)
+}
+
+// Algorithm returns the negotiated algorithm
+func (c *Compressor) Algorithm() Algorithm {
+	if c == nil {
+		return None
+	}
+	return c.algorithm
+}
+
+// Compress compresses data if it is not smaller than the threshold.
+// It returns the data unchanged and false if the data is not compressed.
+func (c *Compressor) Compress(data []byte) ([]byte, bool) {
+	if c == nil {
+		return data, false
+	}
+	if len(data) < c.threshold {
+		c.uncompressedMessages.Add(1)
+		return data, false
+	}
+	out, err := Encode(c.algorithm, data)
+	if err != nil {
+		klog.Warningf("failed to compress message with %s, send it uncompressed: %v", c.algorithm, err)
+		c.uncompressedMessages.Add(1)
+		return data, false
+	}
+	if len(out) >= len(data) {
+		c.uncompressedMessages.Add(1)
+		return data, false
+	}
+	c.compressedMessages.Add(1)
+	c.bytesIn.Add(int64(len(data)))
+	c.bytesOut.Add(int64(len(out)))
+	return out, true
+}
+
+// Decompress decompresses data received compressed with the algorithm,
+// the algorithm is given by the data so it may differ from the negotiated one
+func (c *Compressor) Decompress(algorithm Algorithm, data []byte) ([]byte, error) {
+	out, err := Decode(algorithm, data)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		c.decompressedMessages.Add(1)
+	}
+	return out, nil
+}
+
+// Stats returns the statistics of the connection
+func (c *Compressor) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+	return Stats{
+		Algorithm:            c.algorithm,
+		CompressedMessages:   c.compressedMessages.Load(),
+		UncompressedMessages: c.uncompressedMessages.Load(),
+		BytesIn:              c.bytesIn.Load(),
+		BytesOut:             c.bytesOut.Load(),
+		DecompressedMessages: c.decompressedMessages.Load(),
+	}
+}
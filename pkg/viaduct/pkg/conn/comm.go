@@ -1,21 +1,25 @@
 package conn
 
 import (
+	"net"
+
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
 )
 
 type responseWriter struct {
-	Type string
-	Van  interface{}
+	Type       string
+	Van        interface{}
+	Compressor *compress.Compressor
 }
 
 // write response
 func (r *responseWriter) WriteResponse(msg *model.Message, content interface{}) {
 	response := msg.NewRespByMessage(msg, content)
-	err := lane.NewLane(r.Type, r.Van).WriteMessage(response)
+	err := lane.NewCompressedLane(r.Type, r.Van, r.Compressor).WriteMessage(response)
 	if err != nil {
 		klog.Errorf("failed to write response, error: %+v", err)
 	}
@@ -24,8 +28,19 @@ func (r *responseWriter) WriteResponse(msg *model.Message, content interface{})
 // write error
 func (r *responseWriter) WriteError(msg *model.Message, errMsg string) {
 	response := model.NewErrorMessage(msg, errMsg)
-	err := lane.NewLane(r.Type, r.Van).WriteMessage(response)
+	err := lane.NewCompressedLane(r.Type, r.Van, r.Compressor).WriteMessage(response)
 	if err != nil {
 		klog.Errorf("failed to write error, error: %+v", err)
 	}
 }
+
+// logCompressionStats logs the compression statistics when the connection is closed
+func logCompressionStats(compressor *compress.Compressor, remoteAddr net.Addr) {
+	if compressor == nil {
+		return
+	}
+	stats := compressor.Stats()
+	klog.V(2).Infof("connection to %v closed, compression %s: %d messages compressed (%d -> %d bytes, ratio %.2f), %d messages uncompressed, %d messages decompressed",
+		remoteAddr, stats.Algorithm, stats.CompressedMessages, stats.BytesIn, stats.BytesOut, stats.Ratio(),
+		stats.UncompressedMessages, stats.DecompressedMessages)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 )
 
 // connection states
@@ -15,6 +16,12 @@ type ConnectionState struct {
 	State            string
 	Headers          http.Header
 	PeerCertificates []*x509.Certificate
+	// Compressor is the message compressor negotiated by the connection,
+	// it provides the compression statistics of the connection
+	Compressor *compress.Compressor
+	// CompressionStats is the snapshot of the compression statistics
+	// of the connection when the state is returned by ConnectionState
+	CompressionStats compress.Stats
 }
 
 // the operation set of connection
@@ -44,6 +51,10 @@ type Connection interface {
 
 	// WriteMessageAsync writes data to the connection and don't care about the response.
 	WriteMessageAsync(msg *model.Message) error
+	// WriteFrameAsync writes data to the connection like WriteMessageAsync,
+	// and returns the size of the frame written, which is the message encoded
+	// and compressed for the wire.
+	WriteFrameAsync(msg *model.Message) (int, error)
 
 	// WriteMessageSync writes data to the connection and care about the response.
 	WriteMessageSync(msg *model.Message) (*model.Message, error)
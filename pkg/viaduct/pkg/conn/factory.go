@@ -6,6 +6,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/mux"
 )
 
@@ -30,6 +31,9 @@ type ConnectionOptions struct {
 	AutoRoute bool
 	// OnReadTransportErr
 	OnReadTransportErr func(nodeID, projectID string)
+	// Compressor compresses the messages with the negotiated algorithm,
+	// nil if the peers do not negotiate compression
+	Compressor *compress.Compressor
 }
 
 // get connection interface by ConnTye
@@ -16,6 +16,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/fifo"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/keeper"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
@@ -43,6 +44,7 @@ type QuicConnection struct {
 	messageFifo        *fifo.MessageFifo
 	autoRoute          bool
 	OnReadTransportErr func(nodeID, projectID string)
+	compressor         *compress.Compressor
 	locker             sync.Mutex
 }
 
@@ -60,6 +62,7 @@ func NewQuicConn(options *ConnectionOptions) *QuicConnection {
 		autoRoute:          options.AutoRoute,
 		messageFifo:        fifo.NewMessageFifo(),
 		OnReadTransportErr: options.OnReadTransportErr,
+		compressor:         options.Compressor,
 		streamManager:      smgr.NewStreamManager(smgr.NumStreamsMax, autoFree, quicSession),
 	}
 }
@@ -217,7 +220,7 @@ func (conn *QuicConnection) handleRawData(stream *smgr.Stream) {
 func (conn *QuicConnection) handleMessage(stream *smgr.Stream) {
 	msg := &model.Message{}
 	for {
-		err := lane.NewLane(api.ProtocolTypeQuic, stream.Stream).ReadMessage(msg)
+		err := lane.NewCompressedLane(api.ProtocolTypeQuic, stream.Stream, conn.compressor).ReadMessage(msg)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
 				klog.Errorf("failed to read message, error: %+v", err)
@@ -247,8 +250,9 @@ func (conn *QuicConnection) handleMessage(stream *smgr.Stream) {
 			PeerCertificates: conn.state.PeerCertificates,
 			Message:          msg,
 		}, &responseWriter{
-			Type: api.ProtocolTypeQuic,
-			Van:  stream.Stream,
+			Type:       api.ProtocolTypeQuic,
+			Van:        stream.Stream,
+			Compressor: conn.compressor,
 		})
 	}
 }
@@ -257,6 +261,9 @@ func (conn *QuicConnection) handleMessage(stream *smgr.Stream) {
 // close the session
 func (conn *QuicConnection) Close() error {
 	conn.state.State = api.StatDisconnected
+	if conn.compressor != nil {
+		logCompressionStats(conn.compressor, conn.RemoteAddr())
+	}
 	conn.streamManager.Destroy()
 	return conn.session.Close()
 }
@@ -279,7 +286,7 @@ func (conn *QuicConnection) WriteMessageSync(msg *model.Message) (*model.Message
 	}
 	defer conn.streamManager.ReleaseStream(api.UseTypeMessage, stream)
 
-	lane := lane.NewLane(api.ProtocolTypeQuic, stream)
+	lane := lane.NewCompressedLane(api.ProtocolTypeQuic, stream, conn.compressor)
 	_ = lane.SetWriteDeadline(conn.writeDeadline)
 	msg.Header.Sync = true
 	err = lane.WriteMessage(msg)
@@ -297,9 +304,15 @@ func (conn *QuicConnection) WriteMessageSync(msg *model.Message) (*model.Message
 
 // WriteMessageAsync send async message
 func (conn *QuicConnection) WriteMessageAsync(msg *model.Message) error {
+	_, err := conn.WriteFrameAsync(msg)
+	return err
+}
+
+// WriteFrameAsync send async message and returns the size of the frame
+func (conn *QuicConnection) WriteFrameAsync(msg *model.Message) (int, error) {
 	if conn.session.Sess == nil {
 		klog.Error("bad connection session")
-		return fmt.Errorf("bad connection session")
+		return 0, fmt.Errorf("bad connection session")
 	}
 
 	conn.locker.Lock()
@@ -308,15 +321,15 @@ func (conn *QuicConnection) WriteMessageAsync(msg *model.Message) error {
 	stream, err := conn.streamManager.GetStream(api.UseTypeMessage, true, conn.openStreamSync)
 	if err != nil {
 		klog.Errorf("failed to acquire stream sync, error:%+v", err)
-		return fmt.Errorf("failed to acquire stream sync, error:%+v", err)
+		return 0, fmt.Errorf("failed to acquire stream sync, error:%+v", err)
 	}
 	defer conn.streamManager.ReleaseStream(api.UseTypeMessage, stream)
 
-	lane := lane.NewLane(api.ProtocolTypeQuic, stream)
+	lane := lane.NewCompressedLane(api.ProtocolTypeQuic, stream, conn.compressor)
 	_ = lane.SetWriteDeadline(conn.writeDeadline)
 	msg.Header.Sync = false
 
-	return lane.WriteMessage(msg)
+	return lane.WriteFrame(msg)
 }
 
 // ReadMessage read message from fifo
@@ -344,5 +357,7 @@ func (conn *QuicConnection) LocalAddr() net.Addr {
 }
 
 func (conn *QuicConnection) ConnectionState() ConnectionState {
-	return *conn.state
+	state := *conn.state
+	state.CompressionStats = conn.compressor.Stats()
+	return state
 }
@@ -194,6 +194,21 @@ func (mr *MockConnectionMockRecorder) Write(raw interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockConnection)(nil).Write), raw)
 }
 
+// WriteFrameAsync mocks base method.
+func (m *MockConnection) WriteFrameAsync(msg *model.Message) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteFrameAsync", msg)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteFrameAsync indicates an expected call of WriteFrameAsync.
+func (mr *MockConnectionMockRecorder) WriteFrameAsync(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteFrameAsync", reflect.TypeOf((*MockConnection)(nil).WriteFrameAsync), msg)
+}
+
 // WriteMessageAsync mocks base method.
 func (m *MockConnection) WriteMessageAsync(msg *model.Message) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/fifo"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/keeper"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
@@ -32,6 +33,7 @@ type WSConnection struct {
 	messageFifo        *fifo.MessageFifo
 	locker             sync.Mutex
 	OnReadTransportErr func(nodeID, projectID string)
+	compressor         *compress.Compressor
 }
 
 func NewWSConn(options *ConnectionOptions) *WSConnection {
@@ -44,6 +46,7 @@ func NewWSConn(options *ConnectionOptions) *WSConnection {
 		autoRoute:          options.AutoRoute,
 		messageFifo:        fifo.NewMessageFifo(),
 		OnReadTransportErr: options.OnReadTransportErr,
+		compressor:         options.Compressor,
 	}
 }
 
@@ -71,7 +74,7 @@ func (conn *WSConnection) filterControlMessage(msg *model.Message) bool {
 	// feedback the response
 	resp := msg.NewRespByMessage(msg, comm.RespTypeAck)
 	conn.locker.Lock()
-	err := lane.NewLane(api.ProtocolTypeWS, conn.wsConn).WriteMessage(resp)
+	err := lane.NewCompressedLane(api.ProtocolTypeWS, conn.wsConn, conn.compressor).WriteMessage(resp)
 	conn.locker.Unlock()
 	if err != nil {
 		klog.Errorf("failed to send response back, error:%+v", err)
@@ -102,7 +105,7 @@ func (conn *WSConnection) handleRawData() {
 func (conn *WSConnection) handleMessage() {
 	for {
 		msg := &model.Message{}
-		err := lane.NewLane(api.ProtocolTypeWS, conn.wsConn).ReadMessage(msg)
+		err := lane.NewCompressedLane(api.ProtocolTypeWS, conn.wsConn, conn.compressor).ReadMessage(msg)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
 				klog.Errorf("failed to read message, error: %+v", err)
@@ -143,8 +146,9 @@ func (conn *WSConnection) handleMessage() {
 			PeerCertificates: conn.state.PeerCertificates,
 			Message:          msg,
 		}, &responseWriter{
-			Type: api.ProtocolTypeWS,
-			Van:  conn.wsConn,
+			Type:       api.ProtocolTypeWS,
+			Van:        conn.wsConn,
+			Compressor: conn.compressor,
 		})
 	}
 }
@@ -168,16 +172,21 @@ func (conn *WSConnection) Write(raw []byte) (int, error) {
 }
 
 func (conn *WSConnection) WriteMessageAsync(msg *model.Message) error {
-	lane := lane.NewLane(api.ProtocolTypeWS, conn.wsConn)
+	_, err := conn.WriteFrameAsync(msg)
+	return err
+}
+
+func (conn *WSConnection) WriteFrameAsync(msg *model.Message) (int, error) {
+	lane := lane.NewCompressedLane(api.ProtocolTypeWS, conn.wsConn, conn.compressor)
 	_ = lane.SetWriteDeadline(conn.WriteDeadline)
 	msg.Header.Sync = false
 	conn.locker.Lock()
 	defer conn.locker.Unlock()
-	return lane.WriteMessage(msg)
+	return lane.WriteFrame(msg)
 }
 
 func (conn *WSConnection) WriteMessageSync(msg *model.Message) (*model.Message, error) {
-	lane := lane.NewLane(api.ProtocolTypeWS, conn.wsConn)
+	lane := lane.NewCompressedLane(api.ProtocolTypeWS, conn.wsConn, conn.compressor)
 	// send msg
 	_ = lane.SetWriteDeadline(conn.WriteDeadline)
 	msg.Header.Sync = true
@@ -206,6 +215,7 @@ func (conn *WSConnection) LocalAddr() net.Addr {
 }
 
 func (conn *WSConnection) Close() error {
+	logCompressionStats(conn.compressor, conn.wsConn.RemoteAddr())
 	conn.messageFifo.Close()
 	return conn.wsConn.Close()
 }
@@ -213,5 +223,7 @@ func (conn *WSConnection) Close() error {
 // get connection state
 // TODO:
 func (conn *WSConnection) ConnectionState() ConnectionState {
-	return *conn.state
+	state := *conn.state
+	state.CompressionStats = conn.compressor.Stats()
+	return state
 }
@@ -26,7 +26,9 @@ import (
 
 	"github.com/gorilla/websocket"
 
+	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 )
 
 var upgrader = websocket.Upgrader{
@@ -121,3 +123,44 @@ func TestHandleRawDataDoesNotPanic(t *testing.T) {
 		t.Errorf("unexpected payload: got %q, want %q", consumer.Bytes(), payload)
 	}
 }
+
+// TestConnectionStateCompressionStats verifies that the connection state
+// reports the compression statistics of the messages written.
+func TestConnectionStateCompressionStats(t *testing.T) {
+	serverConn, _ := wsTestPair(t)
+
+	wsConn := &WSConnection{
+		wsConn:     serverConn,
+		state:      &ConnectionState{State: api.StatConnected},
+		compressor: compress.NewCompressor(compress.Zstd, 64),
+	}
+	if stats := wsConn.ConnectionState().CompressionStats; stats != (compress.Stats{Algorithm: compress.Zstd}) {
+		t.Fatalf("unexpected stats before writing: %+v", stats)
+	}
+
+	large := model.NewMessage("").FillBody(strings.Repeat("compressible ", 100))
+	if _, err := wsConn.WriteFrameAsync(large); err != nil {
+		t.Fatalf("write large message failed: %v", err)
+	}
+	small := model.NewMessage("").FillBody("x")
+	if _, err := wsConn.WriteFrameAsync(small); err != nil {
+		t.Fatalf("write small message failed: %v", err)
+	}
+
+	stats := wsConn.ConnectionState().CompressionStats
+	if stats.CompressedMessages != 1 || stats.UncompressedMessages != 1 {
+		t.Errorf("unexpected message counts: %+v", stats)
+	}
+	if stats.BytesIn <= stats.BytesOut || stats.Ratio() <= 1 {
+		t.Errorf("unexpected byte counts: %+v, ratio %.2f", stats, stats.Ratio())
+	}
+
+	// The connection without compression reports empty statistics.
+	plain := &WSConnection{
+		wsConn: serverConn,
+		state:  &ConnectionState{State: api.StatConnected},
+	}
+	if stats := plain.ConnectionState().CompressionStats; stats != (compress.Stats{}) {
+		t.Errorf("unexpected stats without compression: %+v", stats)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 )
 
 type Lane interface {
@@ -14,16 +15,34 @@ type Lane interface {
 	SetWriteDeadline(t time.Time) error
 	ReadMessage(msg *model.Message) error
 	WriteMessage(msg *model.Message) error
+	// WriteFrame writes the message like WriteMessage, and returns the size of
+	// the frame written, which is the message encoded and compressed for the wire.
+	WriteFrame(msg *model.Message) (int, error)
 	Read(raw []byte) (int, error)
 	Write(raw []byte) (int, error)
 }
 
 func NewLane(protoType string, van interface{}) Lane {
+	return NewCompressedLane(protoType, van, nil)
+}
+
+// NewCompressedLane returns a lane which compresses the messages written by
+// the compressor negotiated by the connection, a nil compressor disables compression.
+// Compressed messages received are decompressed by all lanes.
+func NewCompressedLane(protoType string, van interface{}, compressor *compress.Compressor) Lane {
 	switch protoType {
 	case api.ProtocolTypeQuic:
-		return NewQuicLane(van)
+		if l := NewQuicLane(van); l != nil {
+			l.compressor = compressor
+			return l
+		}
+		return nil
 	case api.ProtocolTypeWS:
-		return NewWSLaneWithoutPack(van)
+		if l := NewWSLaneWithoutPack(van); l != nil {
+			l.compressor = compressor
+			return l
+		}
+		return nil
 	}
 	klog.Errorf("bad protocol type(%s)", protoType)
 	return nil
@@ -7,6 +7,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/packer"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/translator"
 )
@@ -15,6 +16,7 @@ type QuicLane struct {
 	writeDeadline time.Time
 	readDeadline  time.Time
 	stream        quic.Stream
+	compressor    *compress.Compressor
 }
 
 func NewQuicLane(van interface{}) *QuicLane {
@@ -26,11 +28,23 @@ func NewQuicLane(van interface{}) *QuicLane {
 }
 
 func (l *QuicLane) ReadMessage(msg *model.Message) error {
-	rawData, err := packer.NewReader(l.stream).Read()
+	header, rawData, err := packer.NewReader(l.stream).ReadPackage()
 	if err != nil {
 		return err
 	}
 
+	if header.GetFlags()&packer.FlagCompressed != 0 {
+		algorithm, err := compress.AlgorithmOf(header.GetFlags())
+		if err != nil {
+			return err
+		}
+		rawData, err = l.compressor.Decompress(algorithm, rawData)
+		if err != nil {
+			klog.Errorf("failed to decompress message with %s", algorithm)
+			return err
+		}
+	}
+
 	err = translator.NewTran().Decode(rawData, msg)
 	if err != nil {
 		klog.Error("failed to decode message")
@@ -41,14 +55,28 @@ func (l *QuicLane) ReadMessage(msg *model.Message) error {
 }
 
 func (l *QuicLane) WriteMessage(msg *model.Message) error {
+	_, err := l.WriteFrame(msg)
+	return err
+}
+
+func (l *QuicLane) WriteFrame(msg *model.Message) (int, error) {
 	rawData, err := translator.NewTran().Encode(msg)
 	if err != nil {
 		klog.Error("failed to encode message")
-		return err
+		return 0, err
 	}
 
-	_, err = packer.NewWriter(l.stream).Write(rawData)
-	return err
+	var flags uint8
+	if data, compressed := l.compressor.Compress(rawData); compressed {
+		rawData = data
+		flags = packer.FlagCompressed | l.compressor.Algorithm().ID()
+	}
+
+	n, err := packer.NewWriter(l.stream).WriteWithFlags(rawData, flags)
+	if err != nil {
+		return 0, err
+	}
+	return packer.HeaderSize + n, nil
 }
 
 func (l *QuicLane) Read(raw []byte) (int, error) {
@@ -64,14 +64,22 @@ func (l *WSLane) Write(p []byte) (int, error) {
 }
 
 func (l *WSLane) WriteMessage(msg *model.Message) error {
+	_, err := l.WriteFrame(msg)
+	return err
+}
+
+func (l *WSLane) WriteFrame(msg *model.Message) (int, error) {
 	rawData, err := translator.NewTran().Encode(msg)
 	if err != nil {
 		klog.Error("failed to encode message")
-		return err
+		return 0, err
 	}
 
-	_, err = packer.NewWriter(l).Write(rawData)
-	return err
+	n, err := packer.NewWriter(l).Write(rawData)
+	if err != nil {
+		return 0, err
+	}
+	return packer.HeaderSize + n, nil
 }
 
 func (l *WSLane) SetReadDeadline(t time.Time) error {
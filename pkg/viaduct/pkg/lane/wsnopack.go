@@ -1,6 +1,7 @@
 package lane
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
 	"time"
@@ -9,12 +10,14 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 )
 
 type WSLaneWithoutPack struct {
 	writeDeadline time.Time
 	readDeadline  time.Time
 	conn          *websocket.Conn
+	compressor    *compress.Compressor
 }
 
 func NewWSLaneWithoutPack(van interface{}) *WSLaneWithoutPack {
@@ -37,8 +40,39 @@ func (l *WSLaneWithoutPack) Read(p []byte) (int, error) {
 	return len(msgData), err
 }
 
+// ReadMessage reads a json message, the compressed messages are sent in binary
+// frames with the id of the compression algorithm in the first byte
 func (l *WSLaneWithoutPack) ReadMessage(msg *model.Message) error {
-	return l.conn.ReadJSON(msg)
+	messageType, r, err := l.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	if messageType != websocket.BinaryMessage {
+		err = json.NewDecoder(r).Decode(msg)
+		if errors.Is(err, io.EOF) {
+			// one value is expected in the message.
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return io.ErrUnexpectedEOF
+	}
+	algorithm, err := compress.AlgorithmOf(data[0])
+	if err != nil {
+		return err
+	}
+	data, err = l.compressor.Decompress(algorithm, data[1:])
+	if err != nil {
+		klog.Errorf("failed to decompress message with %s", algorithm)
+		return err
+	}
+	return json.Unmarshal(data, msg)
 }
 
 func (l *WSLaneWithoutPack) Write(p []byte) (int, error) {
@@ -51,7 +85,25 @@ func (l *WSLaneWithoutPack) Write(p []byte) (int, error) {
 }
 
 func (l *WSLaneWithoutPack) WriteMessage(msg *model.Message) error {
-	return l.conn.WriteJSON(msg)
+	_, err := l.WriteFrame(msg)
+	return err
+}
+
+// WriteFrame writes a json message in a text frame, or in a binary frame with
+// the id of the compression algorithm in the first byte if it is compressed
+func (l *WSLaneWithoutPack) WriteFrame(msg *model.Message) (int, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return 0, err
+	}
+	compressed, ok := l.compressor.Compress(data)
+	if !ok {
+		return len(data), l.conn.WriteMessage(websocket.TextMessage, data)
+	}
+	frame := make([]byte, 0, len(compressed)+1)
+	frame = append(frame, l.compressor.Algorithm().ID())
+	frame = append(frame, compressed...)
+	return len(frame), l.conn.WriteMessage(websocket.BinaryMessage, frame)
 }
 
 func (l *WSLaneWithoutPack) SetReadDeadline(t time.Time) error {
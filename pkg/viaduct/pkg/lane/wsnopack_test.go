@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lane
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
+)
+
+func wsTestPair(t *testing.T) (server, client *websocket.Conn) {
+	t.Helper()
+	serverChan := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade failed: %v", err)
+			return
+		}
+		serverChan <- c
+	}))
+	t.Cleanup(srv.Close)
+
+	c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+
+	select {
+	case s := <-serverChan:
+		t.Cleanup(func() { s.Close() })
+		return s, c
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for server WebSocket connection")
+		return nil, nil
+	}
+}
+
+func TestWSLaneCompression(t *testing.T) {
+	server, client := wsTestPair(t)
+	compressor := compress.NewCompressor(compress.Zstd, 64)
+	writer := NewCompressedLane(api.ProtocolTypeWS, client, compressor)
+	// the reader has no compressor, like a connection not negotiated yet
+	reader := NewLane(api.ProtocolTypeWS, server)
+
+	large := model.NewMessage("").BuildRouter("edgehub", "resource", "default/pod/p1", model.UpdateOperation).
+		FillBody(strings.Repeat("container status ", 100))
+	small := model.NewMessage("").BuildRouter("edgehub", "resource", "default/pod/p1", model.UpdateOperation).
+		FillBody("ok")
+	for _, msg := range []*model.Message{large, small} {
+		require.NoError(t, writer.WriteMessage(msg))
+
+		var got model.Message
+		require.NoError(t, reader.ReadMessage(&got))
+		assert.Equal(t, msg.GetID(), got.GetID())
+		assert.Equal(t, msg.GetContent(), got.GetContent())
+	}
+
+	stats := compressor.Stats()
+	assert.Equal(t, int64(1), stats.CompressedMessages)
+	assert.Equal(t, int64(1), stats.UncompressedMessages)
+}
+
+func TestWSLaneWriteFrame(t *testing.T) {
+	server, client := wsTestPair(t)
+	compressor := compress.NewCompressor(compress.Zstd, 64)
+
+	large := model.NewMessage("").BuildRouter("edgehub", "resource", "default/pod/p1", model.UpdateOperation).
+		FillBody(strings.Repeat("container status ", 100))
+	small := model.NewMessage("").BuildRouter("edgehub", "resource", "default/pod/p1", model.UpdateOperation).
+		FillBody("ok")
+	cases := []struct {
+		name       string
+		compressor *compress.Compressor
+		msg        *model.Message
+	}{
+		{name: "case1 not negotiated", msg: large},
+		{name: "case2 compressed", compressor: compressor, msg: large},
+		{name: "case3 under the threshold", compressor: compressor, msg: small},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, err := NewCompressedLane(api.ProtocolTypeWS, client, c.compressor).WriteFrame(c.msg)
+			require.NoError(t, err)
+
+			_, frame, err := server.ReadMessage()
+			require.NoError(t, err)
+			assert.Equal(t, len(frame), n)
+		})
+	}
+}
@@ -7,6 +7,7 @@ const (
 	UserDefined PackageType = 0x04
 
 	// flags
+	// the payload is compressed, the low 4 bits of the flags are the id of the compression algorithm
 	FlagCompressed = 0x80
 
 	// the len of magic sequence
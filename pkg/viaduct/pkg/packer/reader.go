@@ -22,9 +22,15 @@ func NewReader(r io.Reader) *Reader {
 // 2)unpack the package header and get the payload length
 // 3)read the payload
 func (r *Reader) Read() ([]byte, error) {
+	_, payload, err := r.ReadPackage()
+	return payload, err
+}
+
+// ReadPackage reads the package header and the payload
+func (r *Reader) ReadPackage() (*PackageHeader, []byte, error) {
 	if r.reader == nil {
 		klog.Error("bad io reader")
-		return nil, fmt.Errorf("bad io reader")
+		return nil, nil, fmt.Errorf("bad io reader")
 	}
 
 	headerBuffer := make([]byte, HeaderSize)
@@ -33,14 +39,14 @@ func (r *Reader) Read() ([]byte, error) {
 		if !errors.Is(err, io.EOF) {
 			klog.Error("failed to read package header from buffer")
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	header := PackageHeader{}
 	header.Unpack(headerBuffer)
 
 	if header.PayloadLen > MaxPayloadLen {
-		return nil, fmt.Errorf("payload length %d exceeds maximum %d", header.PayloadLen, MaxPayloadLen)
+		return nil, nil, fmt.Errorf("payload length %d exceeds maximum %d", header.PayloadLen, MaxPayloadLen)
 	}
 
 	payloadBuffer := make([]byte, header.PayloadLen)
@@ -49,8 +55,8 @@ func (r *Reader) Read() ([]byte, error) {
 		if !errors.Is(err, io.EOF) {
 			klog.Error("failed to read payload from buffer")
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
-	return payloadBuffer, nil
+	return &header, payloadBuffer, nil
 }
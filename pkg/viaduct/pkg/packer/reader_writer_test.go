@@ -108,3 +108,23 @@ func TestWriterWriteAllowsPayloadWithinLimit(t *testing.T) {
 		t.Fatalf("expected payload %q, got %q", payload, got)
 	}
 }
+
+func TestReaderReadPackageReturnsFlags(t *testing.T) {
+	payload := []byte("compressed")
+
+	var buf bytes.Buffer
+	if _, err := NewWriter(&buf).WriteWithFlags(payload, FlagCompressed|0x01); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	header, got, err := NewReader(&buf).ReadPackage()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if header.GetFlags() != FlagCompressed|0x01 {
+		t.Fatalf("expected flags %#x, got %#x", FlagCompressed|0x01, header.GetFlags())
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("expected payload %q, got %q", payload, got)
+	}
+}
@@ -22,6 +22,11 @@ func NewWriter(w io.Writer) *Writer {
 // 2) write header
 // 3) write message raw data
 func (w *Writer) Write(data []byte) (int, error) {
+	return w.WriteWithFlags(data, 0)
+}
+
+// WriteWithFlags writes message raw data with the flags set in the package header
+func (w *Writer) WriteWithFlags(data []byte, flags uint8) (int, error) {
 	if w.writer == nil {
 		klog.Error("bad io writer")
 		return 0, fmt.Errorf("bad io writer")
@@ -33,6 +38,7 @@ func (w *Writer) Write(data []byte) (int, error) {
 	// packing header
 	header := NewPackageHeader(Message)
 	header.SetPayloadLen(uint32(len(data)))
+	header.SetFlags(flags)
 	var headerBuffer []byte
 	header.Pack(&headerBuffer)
 
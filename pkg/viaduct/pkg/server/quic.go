@@ -12,6 +12,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
 )
@@ -69,18 +70,18 @@ func (srv *QuicServer) acceptControlStream(session quic.Session) quic.Stream {
 	return stream
 }
 
-// receive header from control lane
-func (srv *QuicServer) receiveHeader(lane lane.Lane) (http.Header, error) {
+// receive header from control lane, and negotiate the compression
+func (srv *QuicServer) receiveHeader(lane lane.Lane) (http.Header, *compress.Compressor, error) {
 	var msg model.Message
 	// read control message
 	err := lane.ReadMessage(&msg)
 	if err != nil {
 		klog.Error("failed read control message")
-		return nil, err
+		return nil, nil, err
 	}
 
 	// process control message
-	result := comm.RespTypeAck
+	var result interface{} = comm.RespTypeAck
 	headers := make(http.Header)
 	err = json.Unmarshal(msg.GetContent().([]byte), &headers)
 	if err != nil {
@@ -88,14 +89,21 @@ func (srv *QuicServer) receiveHeader(lane lane.Lane) (http.Header, error) {
 		result = comm.RespTypeNack
 	}
 
+	// reply the selected compression to the client offering compression,
+	// the clients which do not support compression get ack as before
+	compressor := compress.Negotiated(headers.Get(comm.HeaderCompression), srv.options.Compression)
+	if compressor != nil {
+		result = http.Header{comm.HeaderCompression: []string{string(compressor.Algorithm())}}
+	}
+
 	// feedback the response
 	resp := msg.NewRespByMessage(&msg, result)
 	err = lane.WriteMessage(resp)
 	if err != nil {
 		klog.Errorf("failed to send response back, error:%+v", err)
-		return nil, err
+		return nil, nil, err
 	}
-	return headers, nil
+	return headers, compressor, nil
 }
 
 // handle session
@@ -111,7 +119,7 @@ func (srv *QuicServer) handleSession(session quic.Session) {
 	}
 
 	ctrlLane := lane.NewLane(api.ProtocolTypeQuic, ctrlStream)
-	header, err := srv.receiveHeader(ctrlLane)
+	header, compressor, err := srv.receiveHeader(ctrlLane)
 	if err != nil {
 		klog.Errorf("failed to complete get header, error: %+v", err)
 	}
@@ -128,9 +136,11 @@ func (srv *QuicServer) handleSession(session quic.Session) {
 			State:            api.StatConnected,
 			Headers:          header,
 			PeerCertificates: session.ConnectionState().PeerCertificates,
+			Compressor:       compressor,
 		},
 		AutoRoute:          srv.options.AutoRoute,
 		OnReadTransportErr: srv.options.OnReadTransportErr,
+		Compressor:         compressor,
 	})
 
 	// connection callback
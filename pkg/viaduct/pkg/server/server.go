@@ -8,6 +8,7 @@ import (
 
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/cmgr"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/mux"
 )
@@ -33,6 +34,7 @@ type Options struct {
 	HandshakeTimeout   time.Duration
 	Handler            mux.Handler
 	Consumer           io.Writer
+	Compression        *compress.Options
 }
 
 type Server struct {
@@ -56,6 +58,9 @@ type Server struct {
 	Handler mux.Handler
 	// consumer for raw data
 	Consumer io.Writer
+	// Compression is the message compression accepted by the server,
+	// the messages are not compressed if it is nil
+	Compression *compress.Options
 	// extend options
 	ExOpts interface{}
 
@@ -120,6 +125,7 @@ func (s *Server) ListenAndServeTLS(certFile, keyFile string) error {
 		Handler:            s.Handler,
 		Consumer:           s.Consumer,
 		OnReadTransportErr: s.OnReadTransportErr,
+		Compression:        s.Compression,
 	})
 	if err != nil {
 		return err
@@ -10,6 +10,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/comm"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/compress"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/lane"
 )
@@ -54,11 +56,15 @@ func NewWSServer(opts Options, exOpts interface{}) *WSServer {
 	return wsServer
 }
 
-func (srv *WSServer) upgrade(w http.ResponseWriter, r *http.Request) *websocket.Conn {
+func (srv *WSServer) upgrade(w http.ResponseWriter, r *http.Request, compressor *compress.Compressor) *websocket.Conn {
 	upgrader := websocket.Upgrader{
 		HandshakeTimeout: srv.options.HandshakeTimeout,
 	}
-	conn, err := upgrader.Upgrade(w, r, nil)
+	var responseHeader http.Header
+	if compressor != nil {
+		responseHeader = http.Header{comm.HeaderCompression: []string{string(compressor.Algorithm())}}
+	}
+	conn, err := upgrader.Upgrade(w, r, responseHeader)
 	if err != nil {
 		klog.Error("failed to upgrade to websocket")
 		return nil
@@ -74,7 +80,9 @@ func (srv *WSServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
-	wsConn := srv.upgrade(w, req)
+	// the client which does not offer compression gets uncompressed messages
+	compressor := compress.Negotiated(req.Header.Get(comm.HeaderCompression), srv.options.Compression)
+	wsConn := srv.upgrade(w, req, compressor)
 	if wsConn == nil {
 		return
 	}
@@ -90,9 +98,11 @@ func (srv *WSServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			State:            api.StatConnected,
 			Headers:          req.Header.Clone(),
 			PeerCertificates: req.TLS.PeerCertificates,
+			Compressor:       compressor,
 		},
 		AutoRoute:          srv.options.AutoRoute,
 		OnReadTransportErr: srv.options.OnReadTransportErr,
+		Compressor:         compressor,
 	})
 
 	// connection callback
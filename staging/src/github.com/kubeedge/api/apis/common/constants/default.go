@@ -32,9 +32,10 @@ const (
 	DefaultClusterDomain               = "cluster.local"
 
 	// MetaManager
-	DefaultRemoteQueryTimeout = 60
-	DefaultMetaServerAddr     = "127.0.0.1:10550"
-	DefaultDummyServerAddr    = "169.254.30.10:10550"
+	DefaultRemoteQueryTimeout       = 60
+	DefaultMetaServerAddr           = "127.0.0.1:10550"
+	DefaultDummyServerAddr          = "169.254.30.10:10550"
+	DefaultMetaServerWatchCacheSize = 1000
 
 	// Config
 	DefaultKubeContentType = "application/vnd.kubernetes.protobuf"
@@ -87,12 +88,24 @@ const (
 	DefaultUpdateDeviceStatesBuffer  = 1024
 	DefaultDeviceEventBuffer         = 1
 	DefaultDeviceModelEventBuffer    = 1
+	DefaultDeviceGroupEventBuffer    = 1
 	DefaultUpdateDeviceStatusWorkers = 1
 
 	// TaskManager
 	DefaultNodeUpgradeJobStatusBuffer = 1024
 	DefaultNodeUpgradeJobEventBuffer  = 1
 	DefaultNodeUpgradeJobWorkers      = 1
+	DefaultCommandJobMaxOutputBytes   = 4096
+
+	DefaultImageMirrorPort             = 10552
+	DefaultImageMirrorCacheSizeLimitMB = 10240
+
+	// DeviceTwin, the number of recent values kept in memory for each device property.
+	// The history is opt-in as it takes memory for every property of the devices.
+	DefaultDeviceHistorySize = 0
+	// DeviceTwin, the number of previous states kept for each device twin.
+	// The history is opt-in as it adds database writes to every twin update.
+	DefaultTwinHistorySize = 0
 
 	ServerAddress = "127.0.0.1"
 	// ServerPort is the default port for the edgecore server on each host machine.
@@ -103,6 +116,21 @@ const (
 	DefaultQPS   = 30
 	DefaultBurst = 60
 
+	// EdgeHub outbound queue
+	DefaultOutboundQueueMaxMessages = 10000
+	DefaultOutboundQueueMaxAge      = 86400
+
+	// EdgeHub reconnect backoff
+	DefaultReconnectInitialInterval = 2
+	DefaultReconnectMaxInterval     = 60
+	DefaultReconnectFactor          = 2.0
+	DefaultReconnectJitter          = 0.5
+
+	// viaduct message compression
+	CompressionAlgorithmZstd    = "zstd"
+	CompressionAlgorithmGzip    = "gzip"
+	DefaultCompressionThreshold = 1024
+
 	KubeEdgeBinaryName = "edgecore"
 	KeadmBinaryName    = "keadm"
 )
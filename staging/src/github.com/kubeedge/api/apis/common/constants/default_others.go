@@ -41,6 +41,9 @@ const (
 	DefaultCNICacheDir           = "/var/lib/cni/cache"
 	DefaultVolumePluginDir       = "/usr/libexec/kubernetes/kubelet-plugins/volume/exec/"
 
+	// DefaultImageMirrorCacheDir is the default directory of the image blobs cached by the image mirror
+	DefaultImageMirrorCacheDir = "/var/lib/kubeedge/image-mirror"
+
 	// DefaultManifestsDir edge node default static pod path
 	DefaultManifestsDir = "/etc/kubeedge/manifests"
 )
@@ -40,6 +40,9 @@ const (
 	DefaultCNICacheDir           = "C:\\var\\lib\\cni\\cache"
 	DefaultVolumePluginDir       = "C:\\usr\\libexec\\kubernetes\\kubelet-plugins\\volume\\exec\\"
 
+	// DefaultImageMirrorCacheDir is the default directory of the image blobs cached by the image mirror
+	DefaultImageMirrorCacheDir = "C:\\var\\lib\\kubeedge\\image-mirror"
+
 	// DefaultManifestsDir edge node default static pod path
 	DefaultManifestsDir = "C:\\etc\\kubeedge\\manifests\\"
 )
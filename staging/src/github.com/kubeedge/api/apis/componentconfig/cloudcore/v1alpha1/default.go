@@ -91,6 +91,14 @@ func NewDefaultCloudCoreConfig() *CloudCoreConfig {
 						},
 					},
 				},
+				Compression: &CloudHubCompression{
+					Enable:     false,
+					Algorithms: []string{constants.CompressionAlgorithmZstd, constants.CompressionAlgorithmGzip},
+					Threshold:  constants.DefaultCompressionThreshold,
+				},
+				BandwidthLimit: &CloudHubBandwidthLimit{
+					Enable: false,
+				},
 			},
 			EdgeController: &EdgeController{
 				Enable:              true,
@@ -105,6 +113,7 @@ func NewDefaultCloudCoreConfig() *CloudCoreConfig {
 					UpdateDeviceStates: constants.DefaultUpdateDeviceStatesBuffer,
 					DeviceEvent:        constants.DefaultDeviceEventBuffer,
 					DeviceModelEvent:   constants.DefaultDeviceModelEventBuffer,
+					DeviceGroupEvent:   constants.DefaultDeviceGroupEventBuffer,
 				},
 				Load: &DeviceControllerLoad{
 					UpdateDeviceStatusWorkers: constants.DefaultUpdateDeviceStatusWorkers,
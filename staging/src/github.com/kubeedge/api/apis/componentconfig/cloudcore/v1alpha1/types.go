@@ -153,6 +153,38 @@ type CloudHub struct {
 	TokenRefreshDuration time.Duration `json:"tokenRefreshDuration,omitempty"`
 	// Authorization authz configurations
 	Authorization *CloudHubAuthorization `json:"authorization,omitempty"`
+	// Compression indicates the compression of the messages exchanged with edgeHub,
+	// it takes effect only for the edge nodes offering the compression
+	Compression *CloudHubCompression `json:"compression,omitempty"`
+	// BandwidthLimit indicates the byte rate limits of the messages sent to edge nodes
+	BandwidthLimit *CloudHubBandwidthLimit `json:"bandwidthLimit,omitempty"`
+}
+
+// CloudHubBandwidthLimit indicates the byte rate limits of the messages sent to edge nodes,
+// a message is sent when both the limit of its node and the limit of the NodeGroup of its node allow
+type CloudHubBandwidthLimit struct {
+	// Enable indicates whether the messages sent to edge nodes are rate limited
+	// default false
+	Enable bool `json:"enable"`
+	// NodeBytesPerSecond indicates the byte rate limit of each edge node, 0 is unlimited.
+	// It is overridden by the node annotation "cloudhub.kubeedge.io/bandwidth-limit".
+	// default 0
+	NodeBytesPerSecond int64 `json:"nodeBytesPerSecond,omitempty"`
+	// NodeGroups indicates the byte rate limits shared by all nodes of a NodeGroup, by NodeGroup name
+	NodeGroups map[string]int64 `json:"nodeGroups,omitempty"`
+}
+
+// CloudHubCompression indicates the compression accepted from edgeHub
+type CloudHubCompression struct {
+	// Enable indicates whether the compression offered by edgeHub is accepted
+	// default false
+	Enable bool `json:"enable"`
+	// Algorithms indicates the accepted algorithms in the order of preference, zstd or gzip
+	// default [zstd, gzip]
+	Algorithms []string `json:"algorithms,omitempty"`
+	// Threshold indicates the min size of a message to be compressed (byte)
+	// default 1024
+	Threshold int32 `json:"threshold,omitempty"`
 }
 
 // CloudHubQUIC indicates the quic server config
@@ -417,6 +449,9 @@ type DeviceControllerBuffer struct {
 	// DeviceStatusEvent indicates the buffer of device status event
 	// default 1
 	DeviceStatusEvent int32 `json:"deviceStatusEvent,omitempty"`
+	// DeviceGroupEvent indicates the buffer of device group event
+	// default 1
+	DeviceGroupEvent int32 `json:"deviceGroupEvent,omitempty"`
 }
 
 // DeviceControllerLoad indicates the deviceController load
@@ -29,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 	netutils "k8s.io/utils/net"
 
+	"github.com/kubeedge/api/apis/common/constants"
 	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
 	utilvalidation "github.com/kubeedge/api/apis/util/validation"
 )
@@ -127,6 +128,39 @@ func ValidateModuleCloudHub(c v1alpha1.CloudHub) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("TokenRefreshDuration"),
 			c.TokenRefreshDuration, "TokenRefreshDuration must be positive"))
 	}
+	if cp := c.Compression; cp != nil && cp.Enable {
+		allErrs = append(allErrs, validateCompression(field.NewPath("compression"), cp.Algorithms, cp.Threshold)...)
+	}
+	if b := c.BandwidthLimit; b != nil && b.Enable {
+		if b.NodeBytesPerSecond < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("bandwidthLimit", "nodeBytesPerSecond"),
+				b.NodeBytesPerSecond, "NodeBytesPerSecond must not be a negative number"))
+		}
+		for name, limit := range b.NodeGroups {
+			if limit < 0 {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("bandwidthLimit", "nodeGroups").Key(name),
+					limit, "the limit must not be a negative number"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateCompression(fldPath *field.Path, algorithms []string, threshold int32) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(algorithms) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("algorithms"), "at least one algorithm is required"))
+	}
+	for i, a := range algorithms {
+		if a != constants.CompressionAlgorithmZstd && a != constants.CompressionAlgorithmGzip {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("algorithms").Index(i), a,
+				[]string{constants.CompressionAlgorithmZstd, constants.CompressionAlgorithmGzip}))
+		}
+	}
+	if threshold < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("threshold"), threshold,
+			"Threshold must not be a negative number"))
+	}
 	return allErrs
 }
 
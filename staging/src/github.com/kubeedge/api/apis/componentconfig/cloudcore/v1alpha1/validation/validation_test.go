@@ -225,6 +225,38 @@ func TestValidateModuleCloudHub(t *testing.T) {
 			expected: field.ErrorList{field.Invalid(field.NewPath("TokenRefreshDuration"),
 				time.Duration(0), "TokenRefreshDuration must be positive")},
 		},
+		{
+			name: "case9 invalid bandwidth limit",
+			input: v1alpha1.CloudHub{
+				Enable: true,
+				HTTPS: &v1alpha1.CloudHubHTTPS{
+					Port: 10000,
+				},
+				WebSocket: &v1alpha1.CloudHubWebSocket{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				Quic: &v1alpha1.CloudHubQUIC{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				UnixSocket: &v1alpha1.CloudHubUnixSocket{
+					Address: unixAddr,
+				},
+				TokenRefreshDuration: 1,
+				BandwidthLimit: &v1alpha1.CloudHubBandwidthLimit{
+					Enable:             true,
+					NodeBytesPerSecond: -1,
+					NodeGroups:         map[string]int64{"group1": -1},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("bandwidthLimit", "nodeBytesPerSecond"),
+					int64(-1), "NodeBytesPerSecond must not be a negative number"),
+				field.Invalid(field.NewPath("bandwidthLimit", "nodeGroups").Key("group1"),
+					int64(-1), "the limit must not be a negative number"),
+			},
+		},
 	}
 
 	for _, c := range cases {
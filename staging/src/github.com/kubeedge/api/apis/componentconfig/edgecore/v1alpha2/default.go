@@ -43,9 +43,10 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 			APIVersion: path.Join(GroupName, APIVersion),
 		},
 		DataBase: &DataBase{
-			DriverName: DataBaseDriverName,
-			AliasName:  DataBaseAliasName,
-			DataSource: DataBaseDataSource,
+			DriverName:   DataBaseDriverName,
+			AliasName:    DataBaseAliasName,
+			DataSource:   DataBaseDataSource,
+			KVDataSource: DataBaseKVDataSource,
 		},
 		Modules: &Modules{
 			Edged: &Edged{
@@ -98,6 +99,23 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 				}).String(),
 				Token:              "",
 				RotateCertificates: true,
+				OutboundQueue: &EdgeHubOutboundQueue{
+					Enable:      false,
+					MaxMessages: constants.DefaultOutboundQueueMaxMessages,
+					MaxAge:      constants.DefaultOutboundQueueMaxAge,
+				},
+				ReconnectBackoff: &EdgeHubReconnectBackoff{
+					InitialInterval: constants.DefaultReconnectInitialInterval,
+					MaxInterval:     constants.DefaultReconnectMaxInterval,
+					Factor:          constants.DefaultReconnectFactor,
+					Jitter:          constants.DefaultReconnectJitter,
+				},
+				ServerSelection: ServerSelectionOrdered,
+				Compression: &EdgeHubCompression{
+					Enable:     false,
+					Algorithms: []string{constants.CompressionAlgorithmZstd, constants.CompressionAlgorithmGzip},
+					Threshold:  constants.DefaultCompressionThreshold,
+				},
 			},
 			EventBus: &EventBus{
 				Enable:               true,
@@ -131,6 +149,7 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 					TLSPrivateKeyFile:     constants.DefaultKeyFile,
 					ServiceAccountIssuers: []string{constants.DefaultServiceAccountIssuer},
 					DummyServer:           constants.DefaultDummyServerAddr,
+					WatchCacheSize:        constants.DefaultMetaServerWatchCacheSize,
 				},
 			},
 			ServiceBus: &ServiceBus{
@@ -140,8 +159,13 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 				Timeout: 60,
 			},
 			DeviceTwin: &DeviceTwin{
-				Enable:      true,
-				DMISockPath: constants.KubeEdgePath,
+				Enable:                 true,
+				DMISockPath:            constants.KubeEdgePath,
+				DeviceHistorySize:      constants.DefaultDeviceHistorySize,
+				MapperProbePeriod:      DefaultMapperProbePeriod,
+				MapperFailureThreshold: DefaultMapperFailureThreshold,
+				TwinHistorySize:        constants.DefaultTwinHistorySize,
+				TwinConflictPolicy:     TwinConflictPolicyEdgeWins,
 			},
 			DBTest: &DBTest{
 				Enable: false,
@@ -158,6 +182,15 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 			},
 			TaskManager: &TaskManager{
 				Enable: false,
+				CommandJob: &CommandJobConfig{
+					MaxOutputBytes: constants.DefaultCommandJobMaxOutputBytes,
+				},
+				ImageMirror: &ImageMirrorConfig{
+					Enable:           false,
+					Port:             constants.DefaultImageMirrorPort,
+					CacheDir:         constants.DefaultImageMirrorCacheDir,
+					CacheSizeLimitMB: constants.DefaultImageMirrorCacheSizeLimitMB,
+				},
 			},
 		},
 	}
@@ -182,7 +215,12 @@ func NewMinEdgeCoreConfig() (config *EdgeCoreConfig) {
 		},
 		Modules: &Modules{
 			DeviceTwin: &DeviceTwin{
-				DMISockPath: constants.KubeEdgePath,
+				DMISockPath:            constants.KubeEdgePath,
+				DeviceHistorySize:      constants.DefaultDeviceHistorySize,
+				MapperProbePeriod:      DefaultMapperProbePeriod,
+				MapperFailureThreshold: DefaultMapperFailureThreshold,
+				TwinHistorySize:        constants.DefaultTwinHistorySize,
+				TwinConflictPolicy:     TwinConflictPolicyEdgeWins,
 			},
 			Edged: &Edged{
 				Enable:                true,
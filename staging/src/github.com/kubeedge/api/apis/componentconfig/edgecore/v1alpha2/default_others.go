@@ -10,6 +10,8 @@ const (
 
 	// DataBaseDataSource is edge.db
 	DataBaseDataSource = "/var/lib/kubeedge/edgecore.db"
+	// DataBaseKVDataSource is the key-value store of the kv driver
+	DataBaseKVDataSource = "/var/lib/kubeedge/edgecore.kv"
 
 	DefaultCgroupDriver         = "cgroupfs"
 	DefaultCgroupsPerQOS        = true
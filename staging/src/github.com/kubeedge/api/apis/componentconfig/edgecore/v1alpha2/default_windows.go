@@ -8,6 +8,8 @@ const (
 
 	// DataBaseDataSource is edge.db
 	DataBaseDataSource = "C:\\var\\lib\\kubeedge\\edgecore.db"
+	// DataBaseKVDataSource is the key-value store of the kv driver
+	DataBaseKVDataSource = "C:\\var\\lib\\kubeedge\\edgecore.kv"
 
 	DefaultCgroupDriver         = ""
 	DefaultCgroupsPerQOS        = false
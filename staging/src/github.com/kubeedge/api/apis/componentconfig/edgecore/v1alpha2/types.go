@@ -31,15 +31,47 @@ const (
 	MqttModeExternal MqttMode = 2
 )
 
+const (
+	// ServerSelectionOrdered tries the servers in the order they are configured
+	ServerSelectionOrdered ServerSelection = "Ordered"
+	// ServerSelectionRandom tries the servers in a random order
+	ServerSelectionRandom ServerSelection = "Random"
+)
+
 const (
 	// DataBaseDriverName is sqlite3
 	DataBaseDriverName = "sqlite3"
+	// DataBaseDriverKV is the embedded key-value store
+	DataBaseDriverKV = "kv"
 	// DataBaseAliasName is default
 	DataBaseAliasName = "default"
 )
 
+const (
+	// DefaultMapperProbePeriod is the default period in seconds to probe the health of the registered mappers
+	DefaultMapperProbePeriod = 10
+	// DefaultMapperFailureThreshold is the default number of consecutive failed probes
+	// before a mapper is considered unhealthy
+	DefaultMapperFailureThreshold = 3
+)
+
+// TwinConflictPolicy is how edgecore resolves a twin value updated on both the edge and the cloud
+// while they were disconnected
+type TwinConflictPolicy string
+
+const (
+	// TwinConflictPolicyEdgeWins keeps the edge value and syncs it back to the cloud
+	TwinConflictPolicyEdgeWins TwinConflictPolicy = "EdgeWins"
+	// TwinConflictPolicyCloudWins replaces the edge value with the cloud value
+	TwinConflictPolicyCloudWins TwinConflictPolicy = "CloudWins"
+	// TwinConflictPolicyReject keeps the edge value without syncing it back, the conflict
+	// is left to be resolved by a new update
+	TwinConflictPolicyReject TwinConflictPolicy = "Reject"
+)
+
 type ProtocolName string
 type MqttMode int
+type ServerSelection string
 
 // EdgeCoreConfig indicates the EdgeCore config which read from EdgeCore config file
 type EdgeCoreConfig struct {
@@ -58,7 +90,9 @@ type EdgeCoreConfig struct {
 
 // DataBase indicates the database info
 type DataBase struct {
-	// DriverName indicates database driver name
+	// DriverName indicates database driver name, "sqlite3" or "kv".
+	// With "kv", the metadata, devices and topics are stored in the embedded
+	// key-value store at KVDataSource, the other tables stay in sqlite.
 	// default "sqlite3"
 	DriverName string `json:"driverName,omitempty"`
 	// AliasName indicates alias name
@@ -67,6 +101,9 @@ type DataBase struct {
 	// DataSource indicates the data source path
 	// default "/var/lib/kubeedge/edgecore.db"
 	DataSource string `json:"dataSource,omitempty"`
+	// KVDataSource indicates the path of the key-value store used by the "kv" driver
+	// default "/var/lib/kubeedge/edgecore.kv"
+	KVDataSource string `json:"kvDataSource,omitempty"`
 }
 
 // Modules indicates the modules which edgeCore will be used
@@ -902,6 +939,67 @@ type EdgeHub struct {
 	// RotateCertificates indicates whether edge certificate can be rotated
 	// default true
 	RotateCertificates bool `json:"rotateCertificates,omitempty"`
+	// OutboundQueue indicates the config of the queue keeping upstream messages
+	// while the connection to cloudHub is broken
+	OutboundQueue *EdgeHubOutboundQueue `json:"outboundQueue,omitempty"`
+	// ReconnectBackoff indicates the backoff between attempts to connect cloudHub,
+	// twice the heartbeat is waited between attempts if it is not set
+	ReconnectBackoff *EdgeHubReconnectBackoff `json:"reconnectBackoff,omitempty"`
+	// ServerSelection indicates how a server is selected when several cloudHub
+	// servers are configured, Ordered or Random. Servers failed recently are
+	// skipped, and the server connected last is preferred.
+	// default Ordered
+	ServerSelection ServerSelection `json:"serverSelection,omitempty"`
+	// Compression indicates the compression of the messages exchanged with cloudHub,
+	// it takes effect only if cloudHub enables the compression too
+	Compression *EdgeHubCompression `json:"compression,omitempty"`
+}
+
+// EdgeHubCompression indicates the compression offered to cloudHub when connecting
+type EdgeHubCompression struct {
+	// Enable indicates whether the compression is offered to cloudHub
+	// default false
+	Enable bool `json:"enable"`
+	// Algorithms indicates the accepted algorithms in the order of preference, zstd or gzip
+	// default [zstd, gzip]
+	Algorithms []string `json:"algorithms,omitempty"`
+	// Threshold indicates the min size of a message to be compressed (byte)
+	// default 1024
+	Threshold int32 `json:"threshold,omitempty"`
+}
+
+// EdgeHubReconnectBackoff indicates the exponential backoff with jitter between
+// attempts to connect cloudHub
+type EdgeHubReconnectBackoff struct {
+	// InitialInterval indicates the wait time before the first reconnect attempt (second)
+	// default 2
+	InitialInterval int32 `json:"initialInterval,omitempty"`
+	// MaxInterval indicates the max wait time between reconnect attempts (second)
+	// default 60
+	MaxInterval int32 `json:"maxInterval,omitempty"`
+	// Factor indicates the multiplier of the wait time after every failed attempt
+	// default 2
+	Factor float64 `json:"factor,omitempty"`
+	// Jitter indicates the max fraction of the wait time removed at random, in the range of [0, 1]
+	// default 0.5
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+// EdgeHubOutboundQueue indicates the config of the EdgeHub outbound queue,
+// which is stored in the edgecore database
+type EdgeHubOutboundQueue struct {
+	// Enable indicates whether upstream messages are queued while disconnected,
+	// they are dropped if it is false
+	// default false
+	Enable bool `json:"enable"`
+	// MaxMessages indicates the max number of queued messages, the oldest
+	// message is dropped when the queue is full
+	// default 10000
+	MaxMessages int32 `json:"maxMessages,omitempty"`
+	// MaxAge indicates the max age of queued messages (second), older
+	// messages are dropped
+	// default 86400
+	MaxAge int32 `json:"maxAge,omitempty"`
 }
 
 // EdgeHubQUIC indicates the quic client config
@@ -918,6 +1016,9 @@ type EdgeHubQUIC struct {
 	// Server indicates quic server address (ip:port)
 	// +Required
 	Server string `json:"server,omitempty"`
+	// Servers indicates the backup quic server addresses (ip:port), which are
+	// tried when Server can not be connected
+	Servers []string `json:"servers,omitempty"`
 	// WriteDeadline indicates write deadline (second)
 	// default 15
 	WriteDeadline int32 `json:"writeDeadline,omitempty"`
@@ -937,6 +1038,9 @@ type EdgeHubWebSocket struct {
 	// Server indicates websocket server address (ip:port)
 	// +Required
 	Server string `json:"server,omitempty"`
+	// Servers indicates the backup websocket server addresses (ip:port), which
+	// are tried when Server can not be connected
+	Servers []string `json:"servers,omitempty"`
 	// WriteDeadline indicates write deadline (second)
 	// default 15
 	WriteDeadline int32 `json:"writeDeadline,omitempty"`
@@ -1035,6 +1139,11 @@ type MetaServer struct {
 	// DummyServer defines the IP address of dummy interface and port
 	// that MetaServer listen on for edge pods to connect, format: ip:port
 	DummyServer string `json:"dummyServer"`
+	// WatchCacheSize indicates the number of the latest resource events kept in memory,
+	// watches resumed from a resourceVersion older than the kept events get 410 Gone
+	// and continue tokens older than them expire
+	// default 1000
+	WatchCacheSize int32 `json:"watchCacheSize,omitempty"`
 }
 
 // ServiceBus indicates the ServiceBus module config
@@ -1074,6 +1183,31 @@ type DeviceTwin struct {
 	// DMISockPath sets the path to dmi.sock
 	// default "/etc/kubeedge/dmi.sock"
 	DMISockPath string `json:"dmiSockPath,omitempty"`
+	// DeviceHistorySize sets the number of recent values kept in memory for each device property
+	// reported by mappers, the history can be queried through the MetaServer. 0 disables the history.
+	// When it is enabled, each property of the devices on the node keeps up to the size of values,
+	// which takes about 40 bytes each for short values, e.g. 1000 values of 100 properties take about 4MB.
+	// default 0
+	DeviceHistorySize int32 `json:"deviceHistorySize,omitempty"`
+	// MapperProbePeriod sets the period in seconds to probe the health of the registered mappers
+	// over the DMI socket. 0 disables the probing.
+	// default 10
+	MapperProbePeriod int32 `json:"mapperProbePeriod"`
+	// MapperFailureThreshold sets the number of consecutive failed probes after which a mapper
+	// is considered unhealthy and its devices are marked offline.
+	// default 3
+	MapperFailureThreshold int32 `json:"mapperFailureThreshold,omitempty"`
+	// TwinHistorySize sets the number of previous states kept in the database for each device twin.
+	// 0 disables the history. When it is enabled, every twin update inserts a row for each changed
+	// twin and deletes its rows beyond the size in the same transaction, which adds to the database
+	// writes of devices reporting frequently.
+	// default 0
+	TwinHistorySize int32 `json:"twinHistorySize"`
+	// TwinConflictPolicy sets how a twin value updated on both the edge and the cloud while they were
+	// disconnected is resolved, the conflicts are recorded as events of the device whatever the policy.
+	// Supported values are EdgeWins, CloudWins and Reject.
+	// default EdgeWins
+	TwinConflictPolicy TwinConflictPolicy `json:"twinConflictPolicy,omitempty"`
 }
 
 // DBTest indicates the DBTest module config
@@ -1120,4 +1254,43 @@ type TaskManager struct {
 	// Enable indicates whether TaskManager is enabled.
 	// Default false
 	Enable bool `json:"enable"`
+	// CommandJob indicates the config of running the command bundles of CommandJob
+	CommandJob *CommandJobConfig `json:"commandJob,omitempty"`
+	// ImageMirror indicates the config of the image mirror, which serves the images pulled by
+	// the ImagePrePullJobs in the Peer pull mode for the other edge nodes of the NodeGroup
+	ImageMirror *ImageMirrorConfig `json:"imageMirror,omitempty"`
+}
+
+// CommandJobConfig indicates the config of running the command bundles of CommandJob
+type CommandJobConfig struct {
+	// AllowedBundles indicates the names of the command bundles that can be run on the edge node.
+	// If it is empty, no command bundle can be run.
+	AllowedBundles []string `json:"allowedBundles,omitempty"`
+	// PublicKeyFiles indicates the PEM encoded public key files, which are used to verify
+	// the signatures of the command bundles. RSA, ECDSA and Ed25519 keys are supported.
+	PublicKeyFiles []string `json:"publicKeyFiles,omitempty"`
+	// MaxOutputBytes indicates the max bytes of the stdout and stderr of the commands reported to the cloud.
+	// Only the tail of the output is reported if it exceeds the limit.
+	// default 4096
+	MaxOutputBytes int32 `json:"maxOutputBytes,omitempty"`
+}
+
+// ImageMirrorConfig indicates the config of the image mirror run by EdgeCore
+type ImageMirrorConfig struct {
+	// Enable indicates whether the image mirror is enabled.
+	// The image mirror serves the images over plain HTTP, so the container runtimes of the edge nodes
+	// in the same NodeGroup need to be configured to allow it as an insecure registry.
+	// default false
+	Enable bool `json:"enable"`
+	// Port indicates the port that the image mirror listens on. The image mirror only listens on
+	// the IP address of the node, and only serves the peers with the token of the node job.
+	// default 10552
+	Port int32 `json:"port,omitempty"`
+	// CacheDir indicates the directory where the image mirror caches the image blobs
+	// default "/var/lib/kubeedge/image-mirror"
+	CacheDir string `json:"cacheDir,omitempty"`
+	// CacheSizeLimitMB indicates the max size of the cached image blobs.
+	// The least recently used blobs are removed when the limit is exceeded.
+	// default 10240
+	CacheSizeLimitMB int32 `json:"cacheSizeLimitMB,omitempty"`
 }
@@ -25,6 +25,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/apis/core/validation"
 
+	"github.com/kubeedge/api/apis/common/constants"
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
 	utilvalidation "github.com/kubeedge/api/apis/util/validation"
 )
@@ -54,6 +55,25 @@ func ValidateDataBase(db v1alpha2.DataBase) field.ErrorList {
 				fmt.Sprintf("create DataSoure dir %v error ", sourceDir)))
 		}
 	}
+	switch db.DriverName {
+	case "", v1alpha2.DataBaseDriverName:
+	case v1alpha2.DataBaseDriverKV:
+		if db.KVDataSource == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("KVDataSource"),
+				"KVDataSource is required by the kv driver"))
+			break
+		}
+		kvDir := path.Dir(db.KVDataSource)
+		if !utilvalidation.FileIsExist(kvDir) {
+			if err := os.MkdirAll(kvDir, os.ModePerm); err != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("KVDataSource"), db.KVDataSource,
+					fmt.Sprintf("create KVDataSource dir %v error ", kvDir)))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("DriverName"), db.DriverName,
+			[]string{v1alpha2.DataBaseDriverName, v1alpha2.DataBaseDriverKV}))
+	}
 	return allErrs
 }
 
@@ -98,6 +118,47 @@ func ValidateModuleEdgeHub(h v1alpha2.EdgeHub) field.ErrorList {
 			"MessageBurst must not be a negative number"))
 	}
 
+	if q := h.OutboundQueue; q != nil && q.Enable {
+		if q.MaxMessages < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("outboundQueue", "maxMessages"), q.MaxMessages,
+				"MaxMessages must not be a negative number"))
+		}
+		if q.MaxAge < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("outboundQueue", "maxAge"), q.MaxAge,
+				"MaxAge must not be a negative number"))
+		}
+	}
+
+	if b := h.ReconnectBackoff; b != nil {
+		if b.InitialInterval <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("reconnectBackoff", "initialInterval"), b.InitialInterval,
+				"InitialInterval must be a positive number"))
+		}
+		if b.MaxInterval < b.InitialInterval {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("reconnectBackoff", "maxInterval"), b.MaxInterval,
+				"MaxInterval must not be less than InitialInterval"))
+		}
+		if b.Factor < 1 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("reconnectBackoff", "factor"), b.Factor,
+				"Factor must not be less than 1"))
+		}
+		if b.Jitter < 0 || b.Jitter > 1 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("reconnectBackoff", "jitter"), b.Jitter,
+				"Jitter must be in the range of [0, 1]"))
+		}
+	}
+
+	if c := h.Compression; c != nil && c.Enable {
+		allErrs = append(allErrs, validateCompression(field.NewPath("compression"), c.Algorithms, c.Threshold)...)
+	}
+
+	switch h.ServerSelection {
+	case "", v1alpha2.ServerSelectionOrdered, v1alpha2.ServerSelectionRandom:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("serverSelection"), h.ServerSelection,
+			[]string{string(v1alpha2.ServerSelectionOrdered), string(v1alpha2.ServerSelectionRandom)}))
+	}
+
 	return allErrs
 }
 
@@ -121,6 +182,10 @@ func ValidateModuleMetaManager(m v1alpha2.MetaManager) field.ErrorList {
 		return field.ErrorList{}
 	}
 	allErrs := field.ErrorList{}
+	if m.MetaServer != nil && m.MetaServer.WatchCacheSize < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("MetaServer").Child("WatchCacheSize"),
+			m.MetaServer.WatchCacheSize, "WatchCacheSize must not be a negative number"))
+	}
 	return allErrs
 }
 
@@ -139,6 +204,29 @@ func ValidateModuleDeviceTwin(d v1alpha2.DeviceTwin) field.ErrorList {
 		return field.ErrorList{}
 	}
 	allErrs := field.ErrorList{}
+	if d.DeviceHistorySize < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("DeviceHistorySize"), d.DeviceHistorySize,
+			"DeviceHistorySize must not be negative"))
+	}
+	if d.MapperProbePeriod < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("MapperProbePeriod"), d.MapperProbePeriod,
+			"MapperProbePeriod must not be negative"))
+	}
+	if d.MapperFailureThreshold < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("MapperFailureThreshold"), d.MapperFailureThreshold,
+			"MapperFailureThreshold must not be negative"))
+	}
+	if d.TwinHistorySize < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("TwinHistorySize"), d.TwinHistorySize,
+			"TwinHistorySize must not be negative"))
+	}
+	switch d.TwinConflictPolicy {
+	case "", v1alpha2.TwinConflictPolicyEdgeWins, v1alpha2.TwinConflictPolicyCloudWins, v1alpha2.TwinConflictPolicyReject:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("TwinConflictPolicy"), d.TwinConflictPolicy,
+			[]string{string(v1alpha2.TwinConflictPolicyEdgeWins), string(v1alpha2.TwinConflictPolicyCloudWins),
+				string(v1alpha2.TwinConflictPolicyReject)}))
+	}
 	return allErrs
 }
 
@@ -159,3 +247,21 @@ func ValidateModuleEdgeStream(m v1alpha2.EdgeStream) field.ErrorList {
 	}
 	return allErrs
 }
+
+func validateCompression(fldPath *field.Path, algorithms []string, threshold int32) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(algorithms) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("algorithms"), "at least one algorithm is required"))
+	}
+	for i, a := range algorithms {
+		if a != constants.CompressionAlgorithmZstd && a != constants.CompressionAlgorithmGzip {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("algorithms").Index(i), a,
+				[]string{constants.CompressionAlgorithmZstd, constants.CompressionAlgorithmGzip}))
+		}
+	}
+	if threshold < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("threshold"), threshold,
+			"Threshold must not be a negative number"))
+	}
+	return allErrs
+}
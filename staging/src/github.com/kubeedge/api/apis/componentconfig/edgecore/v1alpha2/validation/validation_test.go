@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	utilvalidation "github.com/kubeedge/api/apis/util/validation"
 )
 
 func TestValidateEdgeCoreConfiguration(t *testing.T) {
@@ -69,6 +70,27 @@ func TestValidateDataBase(t *testing.T) {
 	if errs := ValidateDataBase(db); len(errs) > 0 {
 		t.Errorf("file %v should not created, err is %v", nonexistentFile, errs)
 	}
+
+	kvFile := filepath.Join(dir, "kv_dir", "edgecore.kv")
+	db = v1alpha2.DataBase{
+		DriverName:   v1alpha2.DataBaseDriverKV,
+		DataSource:   ef.Name(),
+		KVDataSource: kvFile,
+	}
+	if errs := ValidateDataBase(db); len(errs) > 0 {
+		t.Errorf("kv driver %v should be valid, err is %v", db, errs)
+	}
+	if !utilvalidation.FileIsExist(filepath.Dir(kvFile)) {
+		t.Errorf("dir of %v should be created", kvFile)
+	}
+
+	db = v1alpha2.DataBase{
+		DriverName: "mysql",
+		DataSource: ef.Name(),
+	}
+	if errs := ValidateDataBase(db); len(errs) != 1 {
+		t.Errorf("driver %v should not be supported, errs is %v", db.DriverName, errs)
+	}
 }
 
 func TestValidateModuleEdged(t *testing.T) {
@@ -203,6 +225,81 @@ func TestValidateModuleEdgeHub(t *testing.T) {
 			result: field.ErrorList{field.Invalid(field.NewPath("messageBurst"),
 				int32(-1), "MessageBurst must not be a negative number")},
 		},
+		{
+			name: "case6 outbound queue limits must not be negative numbers",
+			input: v1alpha2.EdgeHub{
+				Enable: true,
+				WebSocket: &v1alpha2.EdgeHubWebSocket{
+					Enable: true,
+				},
+				Quic: &v1alpha2.EdgeHubQUIC{
+					Enable: false,
+				},
+				OutboundQueue: &v1alpha2.EdgeHubOutboundQueue{
+					Enable:      true,
+					MaxMessages: -1,
+					MaxAge:      -1,
+				},
+			},
+			result: field.ErrorList{
+				field.Invalid(field.NewPath("outboundQueue", "maxMessages"),
+					int32(-1), "MaxMessages must not be a negative number"),
+				field.Invalid(field.NewPath("outboundQueue", "maxAge"),
+					int32(-1), "MaxAge must not be a negative number"),
+			},
+		},
+		{
+			name: "case7 invalid reconnect backoff and server selection",
+			input: v1alpha2.EdgeHub{
+				Enable: true,
+				WebSocket: &v1alpha2.EdgeHubWebSocket{
+					Enable: true,
+				},
+				Quic: &v1alpha2.EdgeHubQUIC{
+					Enable: false,
+				},
+				ReconnectBackoff: &v1alpha2.EdgeHubReconnectBackoff{
+					InitialInterval: 10,
+					MaxInterval:     5,
+					Factor:          0.5,
+					Jitter:          2,
+				},
+				ServerSelection: "RoundRobin",
+			},
+			result: field.ErrorList{
+				field.Invalid(field.NewPath("reconnectBackoff", "maxInterval"),
+					int32(5), "MaxInterval must not be less than InitialInterval"),
+				field.Invalid(field.NewPath("reconnectBackoff", "factor"),
+					0.5, "Factor must not be less than 1"),
+				field.Invalid(field.NewPath("reconnectBackoff", "jitter"),
+					float64(2), "Jitter must be in the range of [0, 1]"),
+				field.NotSupported(field.NewPath("serverSelection"), v1alpha2.ServerSelection("RoundRobin"),
+					[]string{"Ordered", "Random"}),
+			},
+		},
+		{
+			name: "case8 invalid compression",
+			input: v1alpha2.EdgeHub{
+				Enable: true,
+				WebSocket: &v1alpha2.EdgeHubWebSocket{
+					Enable: true,
+				},
+				Quic: &v1alpha2.EdgeHubQUIC{
+					Enable: false,
+				},
+				Compression: &v1alpha2.EdgeHubCompression{
+					Enable:     true,
+					Algorithms: []string{"zstd", "br"},
+					Threshold:  -1,
+				},
+			},
+			result: field.ErrorList{
+				field.NotSupported(field.NewPath("compression", "algorithms").Index(1), "br",
+					[]string{"zstd", "gzip"}),
+				field.Invalid(field.NewPath("compression", "threshold"),
+					int32(-1), "Threshold must not be a negative number"),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -272,6 +369,17 @@ func TestValidateModuleMetaManager(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 negative watch cache size",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				MetaServer: &v1alpha2.MetaServer{
+					WatchCacheSize: -1,
+				},
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("MetaServer").Child("WatchCacheSize"),
+				int32(-1), "WatchCacheSize must not be a negative number")},
+		},
 	}
 
 	for _, c := range cases {
@@ -330,6 +438,33 @@ func TestValidateModuleDeviceTwin(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 negative device history size",
+			input: v1alpha2.DeviceTwin{
+				Enable:            true,
+				DeviceHistorySize: -1,
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("DeviceHistorySize"), int32(-1),
+				"DeviceHistorySize must not be negative")},
+		},
+		{
+			name: "case4 negative mapper probe period",
+			input: v1alpha2.DeviceTwin{
+				Enable:            true,
+				MapperProbePeriod: -1,
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("MapperProbePeriod"), int32(-1),
+				"MapperProbePeriod must not be negative")},
+		},
+		{
+			name: "case5 unsupported twin conflict policy",
+			input: v1alpha2.DeviceTwin{
+				Enable:             true,
+				TwinConflictPolicy: "LastWriterWins",
+			},
+			expected: field.ErrorList{field.NotSupported(field.NewPath("TwinConflictPolicy"),
+				v1alpha2.TwinConflictPolicy("LastWriterWins"), []string{"EdgeWins", "CloudWins", "Reject"})},
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeviceGroupSpec defines the devices of the group and the desired values applied to them.
+type DeviceGroupSpec struct {
+	// Selector selects the devices of the group in the namespace of the DeviceGroup.
+	// A nil selector selects no device.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Template is the desired twin applied to all the selected devices.
+	Template DeviceGroupTemplate `json:"template,omitempty"`
+}
+
+// DeviceGroupTemplate describes the desired twin of the devices in a group.
+type DeviceGroupTemplate struct {
+	// Properties are the desired values of the device properties.
+	// The properties which are not defined in a device are ignored for that device.
+	// properties list item must be unique by properties.Name.
+	// +optional
+	Properties []DeviceGroupProperty `json:"properties,omitempty"`
+}
+
+// DeviceGroupProperty is the desired value of a device property in a group.
+type DeviceGroupProperty struct {
+	// Required: The device property name.
+	Name string `json:"name"`
+	// Required: The desired property value.
+	Desired TwinProperty `json:"desired"`
+}
+
+// DeviceGroupStatus reports the aggregated convergence of the devices in a group.
+type DeviceGroupStatus struct {
+	// ObservedGeneration is the generation of the DeviceGroup that the status is computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// MatchedDevices is the number of devices selected by the group.
+	// +optional
+	MatchedDevices int32 `json:"matchedDevices,omitempty"`
+	// UpdatedDevices is the number of selected devices whose desired values equal the template.
+	// +optional
+	UpdatedDevices int32 `json:"updatedDevices,omitempty"`
+	// ConvergedDevices is the number of selected devices whose reported values reached the template.
+	// +optional
+	ConvergedDevices int32 `json:"convergedDevices,omitempty"`
+	// PendingDevices lists the names of the selected devices which have not converged yet,
+	// at most 100 names are listed.
+	// +optional
+	PendingDevices []string `json:"pendingDevices,omitempty"`
+	// LastUpdateTime is the last time the status changed.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceGroup applies the same desired twin to all the devices selected by a label selector,
+// the updates are batched per edge node.
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MATCHED",type=integer,JSONPath=`.status.matchedDevices`
+// +kubebuilder:printcolumn:name="CONVERGED",type=integer,JSONPath=`.status.convergedDevices`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+type DeviceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DeviceGroupSpec   `json:"spec,omitempty"`
+	Status            DeviceGroupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceGroupList contains a list of DeviceGroup
+type DeviceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceGroup `json:"items"`
+}
@@ -29,7 +29,11 @@ type DeviceModelSpec struct {
 	Protocol string `json:"protocol,omitempty"`
 	// Optional: Any config data.It can be overridden in the device instance.
 	// +kubebuilder:validation:XPreserveUnknownFields
-	ProtocolConfigData *CustomizedValue `json:"protocolConfigData,omitempty"`	
+	ProtocolConfigData *CustomizedValue `json:"protocolConfigData,omitempty"`
+	// Optional: List of device methods, which are called with typed input parameters
+	// and return typed output parameters.
+	// +optional
+	Methods []ModelMethod `json:"methods,omitempty"`
 }
 
 // ModelProperty describes an individual device property / attribute like temperature / humidity etc.
@@ -56,6 +60,38 @@ type ModelProperty struct {
 	Visitors *VisitorConfig `json:"visitors,omitempty"`
 }
 
+// ModelMethod describes a method of the device like reboot / calibrate etc.
+type ModelMethod struct {
+	// Required: The device method name. It must be unique in the device model.
+	Name string `json:"name,omitempty"`
+	// The device method description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// List of input parameters of the method.
+	// +optional
+	Inputs []MethodParameter `json:"inputs,omitempty"`
+	// List of output parameters of the method.
+	// +optional
+	Outputs []MethodParameter `json:"outputs,omitempty"`
+}
+
+// MethodParameter describes an input or output parameter of a device method.
+type MethodParameter struct {
+	// Required: The parameter name. It must be unique in the inputs or outputs of the method.
+	Name string `json:"name,omitempty"`
+	// The parameter description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Required: Type of the parameter, ENUM: INT,FLOAT,DOUBLE,STRING,BOOLEAN,BYTES
+	Type PropertyType `json:"type,omitempty"`
+	// Whether the input parameter must be provided when the method is called.
+	// +optional
+	Required bool `json:"required,omitempty"`
+	// The unit of the parameter
+	// +optional
+	Unit string `json:"unit,omitempty"`
+}
+
 // The type of device property.
 // +kubebuilder:validation:Enum=INT;FLOAT;DOUBLE;STRING;BOOLEAN;BYTES;STREAM
 type PropertyType string
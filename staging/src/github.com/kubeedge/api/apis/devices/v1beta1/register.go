@@ -65,6 +65,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Device{},
 		&DeviceList{},
+		&DeviceGroup{},
+		&DeviceGroupList{},
 		&DeviceModel{},
 		&DeviceModelList{},
 		&DeviceStatus{},
@@ -78,6 +80,9 @@ func AddDeviceCrds(scheme *runtime.Scheme) error {
 	// Add Device
 	scheme.AddKnownTypes(SchemeGroupVersion, &Device{}, &DeviceList{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	// Add DeviceGroup
+	scheme.AddKnownTypes(SchemeGroupVersion, &DeviceGroup{}, &DeviceGroupList{})
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	// Add DeviceModel
 	scheme.AddKnownTypes(SchemeGroupVersion, &DeviceModel{}, &DeviceModelList{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -22,7 +22,8 @@ limitations under the License.
 package v1beta1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -179,6 +180,154 @@ func (in *Device) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroup) DeepCopyInto(out *DeviceGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroup.
+func (in *DeviceGroup) DeepCopy() *DeviceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupList) DeepCopyInto(out *DeviceGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupList.
+func (in *DeviceGroupList) DeepCopy() *DeviceGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupProperty) DeepCopyInto(out *DeviceGroupProperty) {
+	*out = *in
+	in.Desired.DeepCopyInto(&out.Desired)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupProperty.
+func (in *DeviceGroupProperty) DeepCopy() *DeviceGroupProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupSpec) DeepCopyInto(out *DeviceGroupSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupSpec.
+func (in *DeviceGroupSpec) DeepCopy() *DeviceGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupStatus) DeepCopyInto(out *DeviceGroupStatus) {
+	*out = *in
+	if in.PendingDevices != nil {
+		in, out := &in.PendingDevices, &out.PendingDevices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupStatus.
+func (in *DeviceGroupStatus) DeepCopy() *DeviceGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupTemplate) DeepCopyInto(out *DeviceGroupTemplate) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]DeviceGroupProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupTemplate.
+func (in *DeviceGroupTemplate) DeepCopy() *DeviceGroupTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceList) DeepCopyInto(out *DeviceList) {
 	*out = *in
@@ -307,6 +456,13 @@ func (in *DeviceModelSpec) DeepCopyInto(out *DeviceModelSpec) {
 		in, out := &in.ProtocolConfigData, &out.ProtocolConfigData
 		*out = (*in).DeepCopy()
 	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]ModelMethod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -348,7 +504,7 @@ func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 	*out = *in
 	if in.DeviceModelRef != nil {
 		in, out := &in.DeviceModelRef, &out.DeviceModelRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.Properties != nil {
@@ -540,6 +696,48 @@ func (in *Influxdb2DataConfig) DeepCopy() *Influxdb2DataConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MethodParameter) DeepCopyInto(out *MethodParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MethodParameter.
+func (in *MethodParameter) DeepCopy() *MethodParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(MethodParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelMethod) DeepCopyInto(out *ModelMethod) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]MethodParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]MethodParameter, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelMethod.
+func (in *ModelMethod) DeepCopy() *ModelMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelProperty) DeepCopyInto(out *ModelProperty) {
 	*out = *in
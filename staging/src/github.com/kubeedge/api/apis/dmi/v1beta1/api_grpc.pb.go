@@ -19,7 +19,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.4.0
 // - protoc             v3.15.8
-// source: api.proto
+// source: dmi/v1beta1/api.proto
 
 package v1beta1
 
@@ -227,7 +227,7 @@ var DeviceManagerService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
+	Metadata: "dmi/v1beta1/api.proto",
 }
 
 const (
@@ -238,6 +238,7 @@ const (
 	DeviceMapperService_RemoveDeviceModel_FullMethodName = "/v1beta1.DeviceMapperService/RemoveDeviceModel"
 	DeviceMapperService_UpdateDeviceModel_FullMethodName = "/v1beta1.DeviceMapperService/UpdateDeviceModel"
 	DeviceMapperService_GetDevice_FullMethodName         = "/v1beta1.DeviceMapperService/GetDevice"
+	DeviceMapperService_CallDeviceMethod_FullMethodName  = "/v1beta1.DeviceMapperService/CallDeviceMethod"
 )
 
 // DeviceMapperServiceClient is the client API for DeviceMapperService service.
@@ -294,6 +295,12 @@ type DeviceMapperServiceClient interface {
 	// When the mapper gets the request of querying with the device name,
 	// it should return the device information.
 	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error)
+	// CallDeviceMethod calls a method of a device synchronously.
+	// Device manager sends the name of a method declared in the device model and its input parameters
+	// to the mapper through the interface of CallDeviceMethod.
+	// When the mapper gets the request of calling with the method name,
+	// it should execute the method on the real physical device and return the output parameters of the method.
+	CallDeviceMethod(ctx context.Context, in *CallDeviceMethodRequest, opts ...grpc.CallOption) (*CallDeviceMethodResponse, error)
 }
 
 type deviceMapperServiceClient struct {
@@ -374,6 +381,16 @@ func (c *deviceMapperServiceClient) GetDevice(ctx context.Context, in *GetDevice
 	return out, nil
 }
 
+func (c *deviceMapperServiceClient) CallDeviceMethod(ctx context.Context, in *CallDeviceMethodRequest, opts ...grpc.CallOption) (*CallDeviceMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallDeviceMethodResponse)
+	err := c.cc.Invoke(ctx, DeviceMapperService_CallDeviceMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceMapperServiceServer is the server API for DeviceMapperService service.
 // All implementations must embed UnimplementedDeviceMapperServiceServer
 // for forward compatibility
@@ -428,6 +445,12 @@ type DeviceMapperServiceServer interface {
 	// When the mapper gets the request of querying with the device name,
 	// it should return the device information.
 	GetDevice(context.Context, *GetDeviceRequest) (*GetDeviceResponse, error)
+	// CallDeviceMethod calls a method of a device synchronously.
+	// Device manager sends the name of a method declared in the device model and its input parameters
+	// to the mapper through the interface of CallDeviceMethod.
+	// When the mapper gets the request of calling with the method name,
+	// it should execute the method on the real physical device and return the output parameters of the method.
+	CallDeviceMethod(context.Context, *CallDeviceMethodRequest) (*CallDeviceMethodResponse, error)
 	mustEmbedUnimplementedDeviceMapperServiceServer()
 }
 
@@ -456,6 +479,9 @@ func (UnimplementedDeviceMapperServiceServer) UpdateDeviceModel(context.Context,
 func (UnimplementedDeviceMapperServiceServer) GetDevice(context.Context, *GetDeviceRequest) (*GetDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
 }
+func (UnimplementedDeviceMapperServiceServer) CallDeviceMethod(context.Context, *CallDeviceMethodRequest) (*CallDeviceMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallDeviceMethod not implemented")
+}
 func (UnimplementedDeviceMapperServiceServer) mustEmbedUnimplementedDeviceMapperServiceServer() {}
 
 // UnsafeDeviceMapperServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -595,6 +621,24 @@ func _DeviceMapperService_GetDevice_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceMapperService_CallDeviceMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallDeviceMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMapperServiceServer).CallDeviceMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceMapperService_CallDeviceMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMapperServiceServer).CallDeviceMethod(ctx, req.(*CallDeviceMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceMapperService_ServiceDesc is the grpc.ServiceDesc for DeviceMapperService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -630,7 +674,11 @@ var DeviceMapperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDevice",
 			Handler:    _DeviceMapperService_GetDevice_Handler,
 		},
+		{
+			MethodName: "CallDeviceMethod",
+			Handler:    _DeviceMapperService_CallDeviceMethod_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
+	Metadata: "dmi/v1beta1/api.proto",
 }
@@ -69,6 +69,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ImagePrePullJobList{},
 		&ConfigUpdateJob{},
 		&ConfigUpdateJobList{},
+		&CommandJob{},
+		&CommandJobList{},
+		&EdgeCoreConfigProfile{},
+		&EdgeCoreConfigProfileList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	ResourceCommandJob = "commandjob"
+
+	FinalizerCommandJob = "kubeedge.io/commandjob-controller"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CommandJob is used to run a signed command bundle on edge nodes from cloud side.
+// The edge nodes only run the command bundles that are allowed by their EdgeCore configuration,
+// and whose signatures are verified by the trusted public keys.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+type CommandJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of CommandJob.
+	// +optional
+	Spec CommandJobSpec `json:"spec,omitempty"`
+	// Most recently observed status of the CommandJob.
+	// +optional
+	Status CommandJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CommandJobList is a list of CommandJob.
+type CommandJobList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of CommandJob.
+	Items []CommandJob `json:"items"`
+}
+
+// CommandJobSpec represents the specification of the desired behavior of CommandJob.
+type CommandJobSpec struct {
+	// NodeNames is a request to select some specific nodes. If it is non-empty,
+	// the command job simply select these edge nodes to run the command bundle.
+	// Please note that sets of NodeNames and LabelSelector are ORed.
+	// Users must set one and can only set one.
+	// +optional
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// LabelSelector is a filter to select member clusters by labels.
+	// It must match a node's labels for the CommandJob to be operated on that node.
+	// Please note that sets of NodeNames and LabelSelector are ORed.
+	// Users must set one and can only set one.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Bundle is the signed command bundle to run on the edge nodes.
+	// +required
+	Bundle CommandBundle `json:"bundle"`
+
+	// TimeoutSeconds limits the duration of the node task on each edge node.
+	// Default to 300.
+	// If set to 0, we'll use the default value 300.
+	// +optional
+	TimeoutSeconds *uint32 `json:"timeoutSeconds,omitempty"`
+
+	// Concurrency specifies the maximum number of concurrent that edge nodes associated with
+	// each CloudCore instance can run the command bundle at the same time.
+	// The default Concurrency value is 1.
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// FailureTolerate specifies the task tolerance failure ratio.
+	// The default FailureTolerate value is 0.1.
+	// +optional
+	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// MaintenanceWindow specifies the time windows in which the node tasks can be performed.
+	// The node tasks wait in the WaitingWindow phase until the windows of their nodes open.
+	// If it is nil, the node tasks are performed as soon as the job is created.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// CommandBundle defines a named set of commands that is signed by a trusted key.
+// The signature covers all fields of the bundle except the Signature itself,
+// so none of them can be changed without signing the bundle again.
+type CommandBundle struct {
+	// Name is the name of the command bundle. The edge nodes only run the
+	// command bundles whose names are in the allow list of their EdgeCore configuration.
+	// +required
+	Name string `json:"name"`
+
+	// Commands are run in order by the shell of the edge node in the Run action.
+	// The following commands are skipped once a command fails.
+	// +required
+	Commands []string `json:"commands"`
+
+	// VerifyCommands are run in order by the shell of the edge node in the Verify action,
+	// after all the Commands succeed. If it is empty, the Verify action always succeeds.
+	// +optional
+	VerifyCommands []string `json:"verifyCommands,omitempty"`
+
+	// ExecTimeoutSeconds limits the duration of running the commands of each action
+	// on the edge node. The running command is killed when it times out.
+	// Default to 60.
+	// If set to 0, we'll use the default value 60.
+	// +optional
+	ExecTimeoutSeconds *uint32 `json:"execTimeoutSeconds,omitempty"`
+
+	// JobName is the name of the CommandJob the command bundle is signed for.
+	// The edge nodes refuse the bundle in a CommandJob with another name.
+	// +required
+	JobName string `json:"jobName"`
+
+	// NodeNames are the edge nodes the command bundle is signed for. If it is empty,
+	// the bundle can run on all the edge nodes selected by the CommandJob.
+	// +optional
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// ExpirationTime is the time after which the edge nodes refuse the command bundle.
+	// +required
+	ExpirationTime metav1.Time `json:"expirationTime"`
+
+	// Signature is the base64 encoded signature of the command bundle. It is signed over the
+	// canonical payload of the bundle, which contains all the fields above and is built by
+	// the Payload function of package pkg/nodetask/commandbundle, using SHA-256 digest for RSA
+	// (PKCS #1 v1.5) and ECDSA (ASN.1) keys, or using Ed25519 keys directly.
+	// The "keadm sign commandjob" command signs the command bundle of a CommandJob.
+	// +required
+	Signature string `json:"signature"`
+}
+
+type CommandJobAction string
+
+const (
+	CommandJobActionCheck  CommandJobAction = "Check"
+	CommandJobActionRun    CommandJobAction = "Run"
+	CommandJobActionVerify CommandJobAction = "Verify"
+)
+
+// CommandJobStatus stores the status of CommandJob.
+// contains multiple edge nodes command running status.
+// +kubebuilder:validation:Type=object
+type CommandJobStatus struct {
+	// Phase represents for the phase of the CommandJob
+	Phase JobPhase `json:"phase"`
+
+	// NodeStatus contains command running status for each edge node.
+	NodeStatus []CommandJobNodeTaskStatus `json:"nodeStatus,omitempty"`
+
+	// Reason represents for the reason of the CommandJob.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// CommandJobNodeTaskStatus stores the status of running the command bundle for each edge node.
+// +kubebuilder:validation:Type=object
+type CommandJobNodeTaskStatus struct {
+	// ActionFlow represents for the results of executing the action flow.
+	ActionFlow []CommandJobActionStatus `json:"actionFlow,omitempty"`
+
+	// NodeName is the name of edge node.
+	NodeName string `json:"nodeName,omitempty"`
+
+	// Phase represents for the phase of the node task.
+	Phase NodeTaskPhase `json:"phase,omitempty"`
+
+	// Reason represents the reason for the failure of the node task.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Output is the output of the last action that ran commands on the edge node.
+	// +optional
+	Output *CommandOutput `json:"output,omitempty"`
+}
+
+// CommandOutput defines the result of running the commands of an action on the edge node.
+// +kubebuilder:validation:Type=object
+type CommandOutput struct {
+	// Action is the action that ran the commands.
+	Action CommandJobAction `json:"action,omitempty"`
+
+	// ExitCode is the exit code of the last command that was run.
+	// It is -1 if the command was killed or could not be started.
+	ExitCode int32 `json:"exitCode"`
+
+	// Stdout is the standard output of the commands. Only the tail of the output
+	// is kept if it exceeds the size limit of the edge node.
+	// +optional
+	Stdout string `json:"stdout,omitempty"`
+
+	// Stderr is the standard error of the commands. Only the tail of the output
+	// is kept if it exceeds the size limit of the edge node.
+	// +optional
+	Stderr string `json:"stderr,omitempty"`
+
+	// Truncated represents whether the stdout or stderr is truncated.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// CommandJobActionStatus defines the results of executing the action.
+// +kubebuilder:validation:Type=object
+type CommandJobActionStatus struct {
+	// Action represents for the action phase of the CommandJob
+	Action CommandJobAction `json:"action,omitempty"`
+
+	// State represents for the status of this action on the edge node.
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+
+	// Reason represents the reason for the failure of the action.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Time represents for the running time of the node task.
+	Time string `json:"time,omitempty"`
+}
@@ -35,6 +35,9 @@ const (
 	NodeTaskPhaseSuccessful NodeTaskPhase = "Successful"
 	NodeTaskPhaseFailure    NodeTaskPhase = "Failure"
 	NodeTaskPhaseUnknown    NodeTaskPhase = "Unknown"
+	// NodeTaskPhaseWaitingWindow indicates that the node task is waiting for
+	// the maintenance window of the node to open.
+	NodeTaskPhaseWaitingWindow NodeTaskPhase = "WaitingWindow"
 )
 
 // Constants for node job check items.
@@ -43,3 +46,23 @@ const (
 	CheckItemMem  string = "mem"
 	CheckItemDisk string = "disk"
 )
+
+// AnnotationMaintenanceWindow is the node annotation used to override the maintenance window
+// of the node jobs for the node. Its value is a MaintenanceWindow in JSON format, e.g.,
+// {"schedules":["0 22 * * *"],"durationSeconds":7200,"timeZone":"Asia/Shanghai"}
+const AnnotationMaintenanceWindow = "operations.kubeedge.io/maintenance-window"
+
+// MaintenanceWindow defines the recurring time windows in which the node tasks can be performed.
+type MaintenanceWindow struct {
+	// Schedules are the cron expressions of the start times of the windows, in the format of
+	// "minute hour day-of-month month day-of-week", e.g., "0 6,18 * * 1-5".
+	Schedules []string `json:"schedules"`
+
+	// DurationSeconds specifies how long each window lasts.
+	DurationSeconds uint32 `json:"durationSeconds"`
+
+	// TimeZone is the IANA time zone name of the schedules, e.g., "Asia/Shanghai".
+	// Default to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
@@ -95,6 +95,12 @@ type ConfigUpdateJobSpec struct {
 	// The default FailureTolerate value is 0.1.
 	// +optional
 	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// MaintenanceWindow specifies the time windows in which the node tasks can be performed.
+	// The node tasks wait in the WaitingWindow phase until the windows of their nodes open.
+	// If it is nil, the node tasks are performed as soon as the job is created.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 type ConfigUpdateJobAction string
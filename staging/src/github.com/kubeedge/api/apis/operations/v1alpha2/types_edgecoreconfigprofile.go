@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	ResourceEdgeCoreConfigProfile = "edgecoreconfigprofile"
+
+	// AnnotationEdgeCoreConfigHash is the node annotation of the hash of the effective
+	// EdgeCore configuration. It is reported by the edge node when it connects to the cloud.
+	AnnotationEdgeCoreConfigHash = "operations.kubeedge.io/edgecore-config-hash"
+
+	// AnnotationEdgeCoreConfig is the node annotation of the effective EdgeCore configuration
+	// in JSON format, it only holds the fields without credentials. It is reported together with the hash.
+	AnnotationEdgeCoreConfig = "operations.kubeedge.io/edgecore-config"
+
+	// LabelEdgeCoreConfigProfile is the label of the ConfigUpdateJobs created by
+	// the EdgeCoreConfigProfile, its value is the name of the profile.
+	LabelEdgeCoreConfigProfile = "operations.kubeedge.io/edgecore-config-profile"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EdgeCoreConfigProfile declares the desired EdgeCore configuration of the edge nodes in NodeGroups.
+// The configuration drift of the edge nodes is detected by the hash of their effective configuration,
+// and is reported in the status or fixed by ConfigUpdateJobs according to the drift policy.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+type EdgeCoreConfigProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired EdgeCore configuration.
+	// +optional
+	Spec EdgeCoreConfigProfileSpec `json:"spec,omitempty"`
+	// Most recently observed configuration state of the edge nodes.
+	// +optional
+	Status EdgeCoreConfigProfileStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EdgeCoreConfigProfileList is a list of EdgeCoreConfigProfile.
+type EdgeCoreConfigProfileList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EdgeCoreConfigProfile.
+	Items []EdgeCoreConfigProfile `json:"items"`
+}
+
+// EdgeCoreConfigProfileSpec represents the specification of the desired EdgeCore configuration.
+type EdgeCoreConfigProfileSpec struct {
+	// NodeGroups are the names of the NodeGroups that the profile is bound to.
+	// The profile applies to all the edge nodes that belong to these NodeGroups.
+	// +required
+	NodeGroups []string `json:"nodeGroups"`
+
+	// Fields specify the desired values of certain fields in EdgeCore configuration,
+	// in the same format as the UpdateFields of ConfigUpdateJob, e.g., "modules.edgeHub.heartbeat": "15".
+	// +required
+	Fields map[string]string `json:"fields"`
+
+	// DriftPolicy specifies how to handle the edge nodes whose configuration drifts from the profile.
+	// Default to Alert.
+	// +optional
+	DriftPolicy EdgeCoreConfigDriftPolicy `json:"driftPolicy,omitempty"`
+
+	// UpdateStrategy specifies how the ConfigUpdateJobs created by the AutoUpdate policy are run.
+	// +optional
+	UpdateStrategy *EdgeCoreConfigUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+type EdgeCoreConfigDriftPolicy string
+
+const (
+	// EdgeCoreConfigDriftPolicyAlert reports the drifted edge nodes in the status and
+	// the warning events of the profile.
+	EdgeCoreConfigDriftPolicyAlert EdgeCoreConfigDriftPolicy = "Alert"
+	// EdgeCoreConfigDriftPolicyAutoUpdate also creates a ConfigUpdateJob to update
+	// the configuration of the drifted edge nodes.
+	EdgeCoreConfigDriftPolicyAutoUpdate EdgeCoreConfigDriftPolicy = "AutoUpdate"
+)
+
+// EdgeCoreConfigUpdateStrategy defines the options of the ConfigUpdateJobs created by the profile.
+type EdgeCoreConfigUpdateStrategy struct {
+	// TimeoutSeconds limits the duration of the config update job.
+	// Default to 300.
+	// +optional
+	TimeoutSeconds *uint32 `json:"timeoutSeconds,omitempty"`
+
+	// Concurrency specifies the maximum number of concurrent that edge nodes associated with
+	// each CloudCore instance can be updated at the same time.
+	// The default Concurrency value is 1.
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+
+	// FailureTolerate specifies the task tolerance failure ratio.
+	// The default FailureTolerate value is 0.1.
+	// +optional
+	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// MaintenanceWindow specifies the time windows in which the node tasks can be performed.
+	// The node tasks wait in the WaitingWindow phase until the windows of their nodes open.
+	// If it is nil, the node tasks are performed as soon as the job is created.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+type EdgeCoreConfigState string
+
+const (
+	EdgeCoreConfigStateInSync  EdgeCoreConfigState = "InSync"
+	EdgeCoreConfigStateDrifted EdgeCoreConfigState = "Drifted"
+	// EdgeCoreConfigStateUnknown means that the edge node has not reported its configuration,
+	// or the reported configuration cannot be parsed.
+	EdgeCoreConfigStateUnknown EdgeCoreConfigState = "Unknown"
+)
+
+// EdgeCoreConfigProfileStatus stores the configuration state of the edge nodes bound to the profile.
+// +kubebuilder:validation:Type=object
+type EdgeCoreConfigProfileStatus struct {
+	// ObservedGeneration is the generation of the profile that the status is calculated from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DriftedNodes is the number of edge nodes whose configuration drifts from the profile.
+	// +optional
+	DriftedNodes int32 `json:"driftedNodes,omitempty"`
+
+	// NodeStatus contains the configuration state for each edge node.
+	// +optional
+	NodeStatus []EdgeCoreConfigNodeStatus `json:"nodeStatus,omitempty"`
+
+	// LastUpdateJob is the name of the last ConfigUpdateJob created by the profile.
+	// +optional
+	LastUpdateJob string `json:"lastUpdateJob,omitempty"`
+}
+
+// EdgeCoreConfigNodeStatus stores the configuration state of an edge node.
+// +kubebuilder:validation:Type=object
+type EdgeCoreConfigNodeStatus struct {
+	// NodeName is the name of edge node.
+	NodeName string `json:"nodeName,omitempty"`
+
+	// NodeGroup is the name of the NodeGroup that the edge node belongs to.
+	NodeGroup string `json:"nodeGroup,omitempty"`
+
+	// State represents whether the configuration of the edge node is in sync with the profile.
+	State EdgeCoreConfigState `json:"state,omitempty"`
+
+	// ConfigHash is the hash of the effective EdgeCore configuration reported by the edge node.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Diff contains the fields whose effective values differ from the desired values.
+	// +optional
+	Diff []EdgeCoreConfigFieldDiff `json:"diff,omitempty"`
+
+	// Reason represents the reason for the Unknown state.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// EdgeCoreConfigFieldDiff defines the difference of a field in EdgeCore configuration.
+type EdgeCoreConfigFieldDiff struct {
+	// Field is the path of the field, in the same format as the key of Fields.
+	Field string `json:"field"`
+
+	// Desired is the desired value of the field in the profile.
+	Desired string `json:"desired"`
+
+	// Actual is the effective value of the field on the edge node.
+	// It is empty if the field is not set.
+	// +optional
+	Actual string `json:"actual,omitempty"`
+}
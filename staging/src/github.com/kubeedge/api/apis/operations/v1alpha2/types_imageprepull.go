@@ -27,6 +27,11 @@ const (
 	ResourceImagePrePullJob = "imageprepulljob"
 
 	FinalizerImagePrePullJob = "kubeedge.io/imageprepulljob-controller"
+
+	// AnnotationImageMirrorPort is the node annotation of the port of the image mirror run by EdgeCore.
+	// It is reported by the edge node when the image mirror is enabled, only these edge nodes can be
+	// elected to serve the images for their peers in the Peer pull mode.
+	AnnotationImageMirrorPort = "operations.kubeedge.io/image-mirror-port"
 )
 
 // +genclient
@@ -120,8 +125,40 @@ type ImagePrePullTemplate struct {
 	// Default to 0
 	// +optional
 	RetryTimes int32 `json:"retryTimes,omitempty"`
+
+	// MaintenanceWindow specifies the time windows in which the node tasks can be performed.
+	// The node tasks wait in the WaitingWindow phase until the windows of their nodes open.
+	// If it is nil, the node tasks are performed as soon as the job is created.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// PullMode specifies where the edge nodes pull the images from.
+	// In the Peer mode, one edge node of each NodeGroup is elected to pull the images from the
+	// upstream registries first, and then the other edge nodes of the NodeGroup pull the images
+	// from the image mirror of the elected node.
+	// Default to Registry.
+	// +optional
+	PullMode ImagePullMode `json:"pullMode,omitempty"`
 }
 
+type ImagePullMode string
+
+const (
+	// ImagePullModeRegistry pulls the images from the upstream registries on every edge node.
+	ImagePullModeRegistry ImagePullMode = "Registry"
+	// ImagePullModePeer pulls the images from the image mirror of the elected edge node of the NodeGroup.
+	// The edge nodes that do not belong to any NodeGroup, or whose NodeGroup has no edge node with
+	// the image mirror enabled, still pull the images from the upstream registries.
+	ImagePullModePeer ImagePullMode = "Peer"
+)
+
+type ImagePullSource string
+
+const (
+	ImagePullSourceRegistry ImagePullSource = "Registry"
+	ImagePullSourcePeer     ImagePullSource = "Peer"
+)
+
 type ImagePrePullJobAction string
 
 const (
@@ -139,6 +176,10 @@ type ImagePrePullJobStatus struct {
 	// NodeStatus contains image prepull status for each edge node.
 	NodeStatus []ImagePrePullNodeTaskStatus `json:"nodeStatus,omitempty"`
 
+	// Peers contains the edge nodes elected to serve the images for their NodeGroups in the Peer pull mode.
+	// +optional
+	Peers []ImagePrePullPeer `json:"peers,omitempty"`
+
 	// Reason represents for the reason of the ImagePrePullJob.
 	// +optional
 	Reason string `json:"reason,omitempty"`
@@ -190,6 +231,34 @@ type ImagePrePullNodeTaskStatus struct {
 	// Reason represents the reason for the failure of the node task.
 	// +optional
 	Reason string `json:"reason,omitempty"`
+
+	// PullSource represents where the edge node pulls the images from. It is only set in the Peer pull mode.
+	// It is empty while the node task waits for the elected node of its NodeGroup to pull the images.
+	// It is changed to Registry if any image falls back to the upstream registries.
+	// +optional
+	PullSource ImagePullSource `json:"pullSource,omitempty"`
+
+	// PeerNode is the name of the elected edge node of the NodeGroup that the node task waits for.
+	// The images are pulled from its image mirror if it succeeds, otherwise from the upstream registries.
+	// +optional
+	PeerNode string `json:"peerNode,omitempty"`
+}
+
+// ImagePrePullPeer defines the edge node elected to serve the images for its NodeGroup.
+type ImagePrePullPeer struct {
+	// NodeGroup is the name of the NodeGroup.
+	NodeGroup string `json:"nodeGroup"`
+
+	// NodeName is the name of the elected edge node.
+	NodeName string `json:"nodeName"`
+
+	// Endpoint is the address of the image mirror run by the elected edge node, in host:port format.
+	Endpoint string `json:"endpoint"`
+
+	// ReleaseTime is the time when the elected edge node finishes pulling the images,
+	// and the other edge nodes of the NodeGroup start to pull the images.
+	// +optional
+	ReleaseTime *metav1.Time `json:"releaseTime,omitempty"`
 }
 
 // ImagePrePullJobActionStatus defines the results of executing the action.
@@ -221,4 +290,9 @@ type ImageStatus struct {
 	// Reason represents the fail reason if image pull failed
 	// +optional
 	Reason string `json:"reason,omitempty"`
+
+	// PullSource represents where the image is pulled from. It is only set for the node tasks
+	// that pull the images from the elected nodes of their NodeGroups in the Peer pull mode.
+	// +optional
+	PullSource ImagePullSource `json:"pullSource,omitempty"`
 }
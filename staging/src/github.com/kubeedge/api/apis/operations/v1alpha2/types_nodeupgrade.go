@@ -122,6 +122,78 @@ type NodeUpgradeJobSpec struct {
 	// The default RequireConfirmation value is false.
 	// +optional
 	RequireConfirmation bool `json:"requireConfirmation,omitempty"`
+
+	// Rollout specifies the waves in which the edge nodes are upgraded.
+	// If it is nil, all edge nodes are upgraded at once.
+	// +optional
+	Rollout *NodeUpgradeRollout `json:"rollout,omitempty"`
+
+	// MaintenanceWindow specifies the time windows in which the node tasks can be performed.
+	// The node tasks wait in the WaitingWindow phase until the windows of their nodes open.
+	// If it is nil, the node tasks are performed as soon as the job is created.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// NodeUpgradeRollout defines a staged rollout of the node upgrade job.
+// The waves are upgraded one by one, the next wave starts when all nodes of
+// the previous wave are upgraded, its health gate is passed and the soak time is over.
+type NodeUpgradeRollout struct {
+	// Waves specifies the waves of the rollout in order, the first one is usually a small canary batch.
+	// The nodes that are not selected by any wave are upgraded in an extra last wave.
+	Waves []NodeUpgradeWave `json:"waves"`
+
+	// HealthGate specifies the checks that each wave must pass before the next wave starts.
+	// The rollout is paused automatically when the health gate fails.
+	// +optional
+	HealthGate *NodeUpgradeHealthGate `json:"healthGate,omitempty"`
+
+	// Paused pauses the rollout before the next wave starts.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// NodeUpgradeWave defines the nodes of a rollout wave.
+// Only one of NodeNames, NodeGroup and Percent can be set.
+type NodeUpgradeWave struct {
+	// Name is the name of the wave, it must be unique in the rollout.
+	Name string `json:"name"`
+
+	// NodeNames selects the nodes of the job with these names.
+	// +optional
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// NodeGroup selects the nodes of the job that belong to this NodeGroup.
+	// +optional
+	NodeGroup string `json:"nodeGroup,omitempty"`
+
+	// Percent selects the percentage of all nodes of the job, rounded up,
+	// from the nodes that are not selected by the waves with NodeNames or NodeGroup.
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+
+	// SoakSeconds specifies how long to wait after the wave is upgraded before the next wave starts.
+	// The health gate is still checked during the soak time.
+	// +optional
+	SoakSeconds uint32 `json:"soakSeconds,omitempty"`
+}
+
+// NodeUpgradeHealthGate defines the checks of the upgraded nodes of a wave.
+type NodeUpgradeHealthGate struct {
+	// FailureTolerate specifies the tolerance failure ratio of the node tasks in a wave.
+	// If it is empty, the FailureTolerate of the job is used.
+	// +optional
+	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// SkipNodeReady disables the check that the upgraded nodes are Ready.
+	// +optional
+	SkipNodeReady bool `json:"skipNodeReady,omitempty"`
+
+	// MaxRestartedPods specifies the maximum number of pods on each upgraded node
+	// whose containers restarted after the wave started.
+	// If it is nil, the restarts of the pods are not checked.
+	// +optional
+	MaxRestartedPods *int32 `json:"maxRestartedPods,omitempty"`
 }
 
 // ImageDigestGetter used to define a method for getting the image digest.
@@ -173,6 +245,11 @@ type NodeUpgradeJobStatus struct {
 	// +optional
 	Reason string `json:"reason,omitempty"`
 
+	// Rollout represents for the progress of the rollout waves.
+	// It is only set when the rollout of the NodeUpgradeJob is specified.
+	// +optional
+	Rollout *NodeUpgradeJobRolloutStatus `json:"rollout,omitempty"`
+
 	// State represents for the state phase of the NodeUpgradeJob.
 	// There are several possible state values: "", Upgrading, BackingUp, RollingBack and Checking.
 	// +optional
@@ -258,3 +335,66 @@ type NodeUpgradeJobActionStatus struct {
 	// Time represents for the running time of the node task.
 	Time string `json:"time,omitempty"`
 }
+
+type RolloutWavePhase string
+
+// Constants for rollout wave phase.
+const (
+	RolloutWavePhasePending    RolloutWavePhase = "Pending"
+	RolloutWavePhaseInProgress RolloutWavePhase = "InProgress"
+	RolloutWavePhaseSoaking    RolloutWavePhase = "Soaking"
+	RolloutWavePhaseCompleted  RolloutWavePhase = "Completed"
+	RolloutWavePhasePaused     RolloutWavePhase = "Paused"
+)
+
+// AnnotationResumeRolloutWave is the annotation used to resume the rollout that is paused
+// because the health gate failed. Its value is the name of the paused wave, the wave is
+// considered completed and the next wave starts.
+const AnnotationResumeRolloutWave = "operations.kubeedge.io/resume-rollout-wave"
+
+// NodeUpgradeJobRolloutStatus stores the progress of the rollout waves.
+type NodeUpgradeJobRolloutStatus struct {
+	// CurrentWave is the index of the wave being rolled out.
+	CurrentWave int32 `json:"currentWave"`
+
+	// Paused indicates whether the rollout is paused, by the spec or by a failed health gate.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Waves contains the status of each wave.
+	Waves []NodeUpgradeJobWaveStatus `json:"waves,omitempty"`
+}
+
+// NodeUpgradeJobWaveStatus stores the status of a rollout wave.
+type NodeUpgradeJobWaveStatus struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+
+	// Phase represents for the phase of the wave.
+	Phase RolloutWavePhase `json:"phase"`
+
+	// NodeNames are the names of the nodes in the wave.
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// SucceededNodes is the number of nodes in the wave that are upgraded successfully.
+	SucceededNodes int32 `json:"succeededNodes"`
+
+	// FailedNodes is the number of nodes in the wave that failed to upgrade.
+	FailedNodes int32 `json:"failedNodes"`
+
+	// StartTime is the time when the nodes of the wave start to upgrade.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// SoakStartTime is the time when the wave is upgraded and starts to soak.
+	// +optional
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+
+	// CompletionTime is the time when the wave is completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Reason represents the reason why the wave is paused.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
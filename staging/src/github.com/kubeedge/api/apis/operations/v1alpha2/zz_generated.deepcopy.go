@@ -27,6 +27,222 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandBundle) DeepCopyInto(out *CommandBundle) {
+	*out = *in
+	if in.Commands != nil {
+		in, out := &in.Commands, &out.Commands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifyCommands != nil {
+		in, out := &in.VerifyCommands, &out.VerifyCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExecTimeoutSeconds != nil {
+		in, out := &in.ExecTimeoutSeconds, &out.ExecTimeoutSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandBundle.
+func (in *CommandBundle) DeepCopy() *CommandBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJob) DeepCopyInto(out *CommandJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJob.
+func (in *CommandJob) DeepCopy() *CommandJob {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CommandJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJobActionStatus) DeepCopyInto(out *CommandJobActionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJobActionStatus.
+func (in *CommandJobActionStatus) DeepCopy() *CommandJobActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJobActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJobList) DeepCopyInto(out *CommandJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CommandJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJobList.
+func (in *CommandJobList) DeepCopy() *CommandJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CommandJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJobNodeTaskStatus) DeepCopyInto(out *CommandJobNodeTaskStatus) {
+	*out = *in
+	if in.ActionFlow != nil {
+		in, out := &in.ActionFlow, &out.ActionFlow
+		*out = make([]CommandJobActionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(CommandOutput)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJobNodeTaskStatus.
+func (in *CommandJobNodeTaskStatus) DeepCopy() *CommandJobNodeTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJobNodeTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJobSpec) DeepCopyInto(out *CommandJobSpec) {
+	*out = *in
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Bundle.DeepCopyInto(&out.Bundle)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJobSpec.
+func (in *CommandJobSpec) DeepCopy() *CommandJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandJobStatus) DeepCopyInto(out *CommandJobStatus) {
+	*out = *in
+	if in.NodeStatus != nil {
+		in, out := &in.NodeStatus, &out.NodeStatus
+		*out = make([]CommandJobNodeTaskStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandJobStatus.
+func (in *CommandJobStatus) DeepCopy() *CommandJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandOutput) DeepCopyInto(out *CommandOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandOutput.
+func (in *CommandOutput) DeepCopy() *CommandOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigUpdateJob) DeepCopyInto(out *ConfigUpdateJob) {
 	*out = *in
@@ -150,6 +366,11 @@ func (in *ConfigUpdateJobSpec) DeepCopyInto(out *ConfigUpdateJobSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -186,6 +407,186 @@ func (in *ConfigUpdateJobStatus) DeepCopy() *ConfigUpdateJobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigFieldDiff) DeepCopyInto(out *EdgeCoreConfigFieldDiff) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigFieldDiff.
+func (in *EdgeCoreConfigFieldDiff) DeepCopy() *EdgeCoreConfigFieldDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigFieldDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigNodeStatus) DeepCopyInto(out *EdgeCoreConfigNodeStatus) {
+	*out = *in
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = make([]EdgeCoreConfigFieldDiff, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigNodeStatus.
+func (in *EdgeCoreConfigNodeStatus) DeepCopy() *EdgeCoreConfigNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigProfile) DeepCopyInto(out *EdgeCoreConfigProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigProfile.
+func (in *EdgeCoreConfigProfile) DeepCopy() *EdgeCoreConfigProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgeCoreConfigProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigProfileList) DeepCopyInto(out *EdgeCoreConfigProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgeCoreConfigProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigProfileList.
+func (in *EdgeCoreConfigProfileList) DeepCopy() *EdgeCoreConfigProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgeCoreConfigProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigProfileSpec) DeepCopyInto(out *EdgeCoreConfigProfileSpec) {
+	*out = *in
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(EdgeCoreConfigUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigProfileSpec.
+func (in *EdgeCoreConfigProfileSpec) DeepCopy() *EdgeCoreConfigProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigProfileStatus) DeepCopyInto(out *EdgeCoreConfigProfileStatus) {
+	*out = *in
+	if in.NodeStatus != nil {
+		in, out := &in.NodeStatus, &out.NodeStatus
+		*out = make([]EdgeCoreConfigNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigProfileStatus.
+func (in *EdgeCoreConfigProfileStatus) DeepCopy() *EdgeCoreConfigProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgeCoreConfigUpdateStrategy) DeepCopyInto(out *EdgeCoreConfigUpdateStrategy) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgeCoreConfigUpdateStrategy.
+func (in *EdgeCoreConfigUpdateStrategy) DeepCopy() *EdgeCoreConfigUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgeCoreConfigUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDigestGetter) DeepCopyInto(out *ImageDigestGetter) {
 	*out = *in
@@ -311,6 +712,13 @@ func (in *ImagePrePullJobStatus) DeepCopyInto(out *ImagePrePullJobStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]ImagePrePullPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = make([]v1alpha1.ImagePrePullStatus, len(*in))
@@ -357,6 +765,26 @@ func (in *ImagePrePullNodeTaskStatus) DeepCopy() *ImagePrePullNodeTaskStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullPeer) DeepCopyInto(out *ImagePrePullPeer) {
+	*out = *in
+	if in.ReleaseTime != nil {
+		in, out := &in.ReleaseTime, &out.ReleaseTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullPeer.
+func (in *ImagePrePullPeer) DeepCopy() *ImagePrePullPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePrePullTemplate) DeepCopyInto(out *ImagePrePullTemplate) {
 	*out = *in
@@ -385,6 +813,11 @@ func (in *ImagePrePullTemplate) DeepCopyInto(out *ImagePrePullTemplate) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -414,6 +847,48 @@ func (in *ImageStatus) DeepCopy() *ImageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeHealthGate) DeepCopyInto(out *NodeUpgradeHealthGate) {
+	*out = *in
+	if in.MaxRestartedPods != nil {
+		in, out := &in.MaxRestartedPods, &out.MaxRestartedPods
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeHealthGate.
+func (in *NodeUpgradeHealthGate) DeepCopy() *NodeUpgradeHealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeHealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeUpgradeJob) DeepCopyInto(out *NodeUpgradeJob) {
 	*out = *in
@@ -512,6 +987,29 @@ func (in *NodeUpgradeJobNodeTaskStatus) DeepCopy() *NodeUpgradeJobNodeTaskStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeJobRolloutStatus) DeepCopyInto(out *NodeUpgradeJobRolloutStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]NodeUpgradeJobWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeJobRolloutStatus.
+func (in *NodeUpgradeJobRolloutStatus) DeepCopy() *NodeUpgradeJobRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeJobRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeUpgradeJobSpec) DeepCopyInto(out *NodeUpgradeJobSpec) {
 	*out = *in
@@ -540,6 +1038,16 @@ func (in *NodeUpgradeJobSpec) DeepCopyInto(out *NodeUpgradeJobSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(NodeUpgradeRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -563,6 +1071,11 @@ func (in *NodeUpgradeJobStatus) DeepCopyInto(out *NodeUpgradeJobStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(NodeUpgradeJobRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -576,6 +1089,93 @@ func (in *NodeUpgradeJobStatus) DeepCopy() *NodeUpgradeJobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeJobWaveStatus) DeepCopyInto(out *NodeUpgradeJobWaveStatus) {
+	*out = *in
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SoakStartTime != nil {
+		in, out := &in.SoakStartTime, &out.SoakStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeJobWaveStatus.
+func (in *NodeUpgradeJobWaveStatus) DeepCopy() *NodeUpgradeJobWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeJobWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeRollout) DeepCopyInto(out *NodeUpgradeRollout) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]NodeUpgradeWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HealthGate != nil {
+		in, out := &in.HealthGate, &out.HealthGate
+		*out = new(NodeUpgradeHealthGate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeRollout.
+func (in *NodeUpgradeRollout) DeepCopy() *NodeUpgradeRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeWave) DeepCopyInto(out *NodeUpgradeWave) {
+	*out = *in
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeWave.
+func (in *NodeUpgradeWave) DeepCopy() *NodeUpgradeWave {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryAPI) DeepCopyInto(out *RegistryAPI) {
 	*out = *in
@@ -19,7 +19,104 @@ type RuleSpec struct {
 	// targetResource is a map representing the resource info of target. For api
 	// ruleendpoint type its value is {"resource":"http://a.com"}. For eventbus ruleendpoint
 	// type its value is {"topic":"/xxxx"}. For servicebus ruleendpoint type its value is {"path":"/request_path"}.
+	// For kafka ruleendpoint type its value is {"topic":"devices.{{.NodeName}}","key":"{{.NodeName}}"}, topic
+	// and key are go templates, and "batch_size"/"linger_ms" override the batching of the ruleendpoint.
 	TargetResource map[string]string `json:"targetResource"`
+	// RetryPolicy defines how failed deliveries to the target are retried. Messages which still
+	// fail after all attempts are kept in a bounded dead-letter queue of the rule.
+	// Failed messages are dropped when it is not set.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Transform defines how the payload of messages is reshaped before delivery to the target.
+	// Messages are delivered as they are when it is not set.
+	// +optional
+	Transform *Transform `json:"transform,omitempty"`
+}
+
+// RetryPolicy defines retries of message delivery with exponential backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of delivery attempts of a message, including the first one.
+	// Default to 3.
+	// +optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// InitialBackoff is the time to wait before the first retry, it is doubled for every
+	// following retry. Default to 1s.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the upper bound of the time to wait between two attempts. Default to 30s.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// Timeout is the timeout of a single delivery attempt. No timeout is applied when it is not set.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// DeadLetterQueueSize is the max number of failed messages kept for the rule, the oldest
+	// message is discarded when the queue is full. Default to 100.
+	// +optional
+	DeadLetterQueueSize int32 `json:"deadLetterQueueSize,omitempty"`
+}
+
+// Transform defines the transformation of a message payload, which must be a JSON object.
+// Filter is evaluated on the original payload first, then Select, Rename and Enrich are
+// applied in order.
+type Transform struct {
+	// Filter drops the messages which do not match all of the conditions.
+	// +optional
+	Filter []FilterCondition `json:"filter,omitempty"`
+	// Select replaces the payload with the values selected by JSONPath expressions, keyed by
+	// the output field name. For example {"temperature":"{.twin.temperature.actual.value}"}.
+	// +optional
+	Select map[string]string `json:"select,omitempty"`
+	// Rename renames top-level fields of the payload, keyed by the original field name.
+	// +optional
+	Rename map[string]string `json:"rename,omitempty"`
+	// Enrich adds static fields and labels of the edge node or device to the payload.
+	// +optional
+	Enrich *Enrichment `json:"enrich,omitempty"`
+}
+
+// FilterCondition is a predicate on a value of the payload.
+type FilterCondition struct {
+	// Path is a JSONPath expression selecting the value, for example "{.twin.temperature.actual.value}".
+	Path string `json:"path"`
+	// Operator is the operator applied to the selected value.
+	Operator FilterOperator `json:"operator"`
+	// Value is compared with the selected value. It is ignored by Exists and DoesNotExist,
+	// and it is a regular expression for Matches.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// FilterOperator defines the operator of a filter condition.
+type FilterOperator string
+
+// FilterCondition's operators.
+const (
+	FilterOpExists       FilterOperator = "Exists"
+	FilterOpDoesNotExist FilterOperator = "DoesNotExist"
+	FilterOpEquals       FilterOperator = "Equals"
+	FilterOpNotEquals    FilterOperator = "NotEquals"
+	FilterOpGreaterThan  FilterOperator = "GreaterThan"
+	FilterOpLessThan     FilterOperator = "LessThan"
+	FilterOpMatches      FilterOperator = "Matches"
+)
+
+// Enrichment defines the fields added to the payload.
+type Enrichment struct {
+	// Static is a map of fields added to the payload.
+	// +optional
+	Static map[string]string `json:"static,omitempty"`
+	// NodeLabelsField is the field the labels of the edge node sending the message are added to.
+	// Node labels are not added when it is empty.
+	// +optional
+	NodeLabelsField string `json:"nodeLabelsField,omitempty"`
+	// DeviceNamePath is a JSONPath expression selecting the device name in the payload. The
+	// device is looked up in the namespace of the rule.
+	// +optional
+	DeviceNamePath string `json:"deviceNamePath,omitempty"`
+	// DeviceLabelsField is the field the labels of the device are added to.
+	// Device labels are not added when it or DeviceNamePath is empty.
+	// +optional
+	DeviceLabelsField string `json:"deviceLabelsField,omitempty"`
 }
 
 // RuleStatus defines status of message delivery.
@@ -28,8 +125,14 @@ type RuleStatus struct {
 	SuccessMessages int64 `json:"successMessages"`
 	// FailMessages represents failed count of message delivery of rule.
 	FailMessages int64 `json:"failMessages"`
+	// FilteredMessages represents count of messages dropped by the transform filter of rule.
+	// +optional
+	FilteredMessages int64 `json:"filteredMessages"`
 	// Errors represents failed reasons of message delivery of rule.
 	Errors []string `json:"errors"`
+	// DeadLetters represents the number of messages in the dead-letter queue of rule.
+	// +optional
+	DeadLetters int64 `json:"deadLetters"`
 }
 
 // +genclient
@@ -54,11 +157,13 @@ type RuleList struct {
 
 // RuleEndpointSpec defines endpoint of rule.
 type RuleEndpointSpec struct {
-	// RuleEndpointType defines type: servicebus, rest
+	// RuleEndpointType defines type: servicebus, rest, eventbus, kafka
 	RuleEndpointType RuleEndpointTypeDef `json:"ruleEndpointType"`
 	// Properties: properties of endpoint. for example:
 	// servicebus:
 	// {"service_port":"8080"}
+	// kafka:
+	// {"brokers":"kafka-0:9092,kafka-1:9092","acks":"all","batch_size":"100","linger_ms":"10"}
 	Properties map[string]string `json:"properties,omitempty"`
 }
 
@@ -70,6 +175,7 @@ const (
 	RuleEndpointTypeRest       RuleEndpointTypeDef = "rest"
 	RuleEndpointTypeEventBus   RuleEndpointTypeDef = "eventbus"
 	RuleEndpointTypeServiceBus RuleEndpointTypeDef = "servicebus"
+	RuleEndpointTypeKafka      RuleEndpointTypeDef = "kafka"
 )
 
 // +genclient
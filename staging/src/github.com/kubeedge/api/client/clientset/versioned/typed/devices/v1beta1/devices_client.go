@@ -29,6 +29,7 @@ import (
 type DevicesV1beta1Interface interface {
 	RESTClient() rest.Interface
 	DevicesGetter
+	DeviceGroupsGetter
 	DeviceModelsGetter
 	DeviceStatusesGetter
 }
@@ -42,6 +43,10 @@ func (c *DevicesV1beta1Client) Devices(namespace string) DeviceInterface {
 	return newDevices(c, namespace)
 }
 
+func (c *DevicesV1beta1Client) DeviceGroups(namespace string) DeviceGroupInterface {
+	return newDeviceGroups(c, namespace)
+}
+
 func (c *DevicesV1beta1Client) DeviceModels(namespace string) DeviceModelInterface {
 	return newDeviceModels(c, namespace)
 }
@@ -32,6 +32,10 @@ func (c *FakeDevicesV1beta1) Devices(namespace string) v1beta1.DeviceInterface {
 	return newFakeDevices(c, namespace)
 }
 
+func (c *FakeDevicesV1beta1) DeviceGroups(namespace string) v1beta1.DeviceGroupInterface {
+	return newFakeDeviceGroups(c, namespace)
+}
+
 func (c *FakeDevicesV1beta1) DeviceModels(namespace string) v1beta1.DeviceModelInterface {
 	return newFakeDeviceModels(c, namespace)
 }
@@ -20,6 +20,8 @@ package v1beta1
 
 type DeviceExpansion interface{}
 
+type DeviceGroupExpansion interface{}
+
 type DeviceModelExpansion interface{}
 
 type DeviceStatusExpansion interface{}
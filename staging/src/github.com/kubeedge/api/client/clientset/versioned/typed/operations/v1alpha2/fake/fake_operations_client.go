@@ -28,10 +28,18 @@ type FakeOperationsV1alpha2 struct {
 	*testing.Fake
 }
 
+func (c *FakeOperationsV1alpha2) CommandJobs() v1alpha2.CommandJobInterface {
+	return newFakeCommandJobs(c)
+}
+
 func (c *FakeOperationsV1alpha2) ConfigUpdateJobs() v1alpha2.ConfigUpdateJobInterface {
 	return newFakeConfigUpdateJobs(c)
 }
 
+func (c *FakeOperationsV1alpha2) EdgeCoreConfigProfiles() v1alpha2.EdgeCoreConfigProfileInterface {
+	return newFakeEdgeCoreConfigProfiles(c)
+}
+
 func (c *FakeOperationsV1alpha2) ImagePrePullJobs() v1alpha2.ImagePrePullJobInterface {
 	return newFakeImagePrePullJobs(c)
 }
@@ -18,8 +18,12 @@ limitations under the License.
 
 package v1alpha2
 
+type CommandJobExpansion interface{}
+
 type ConfigUpdateJobExpansion interface{}
 
+type EdgeCoreConfigProfileExpansion interface{}
+
 type ImagePrePullJobExpansion interface{}
 
 type NodeUpgradeJobExpansion interface{}
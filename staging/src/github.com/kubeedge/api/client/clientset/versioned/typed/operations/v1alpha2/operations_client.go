@@ -28,7 +28,9 @@ import (
 
 type OperationsV1alpha2Interface interface {
 	RESTClient() rest.Interface
+	CommandJobsGetter
 	ConfigUpdateJobsGetter
+	EdgeCoreConfigProfilesGetter
 	ImagePrePullJobsGetter
 	NodeUpgradeJobsGetter
 }
@@ -38,10 +40,18 @@ type OperationsV1alpha2Client struct {
 	restClient rest.Interface
 }
 
+func (c *OperationsV1alpha2Client) CommandJobs() CommandJobInterface {
+	return newCommandJobs(c)
+}
+
 func (c *OperationsV1alpha2Client) ConfigUpdateJobs() ConfigUpdateJobInterface {
 	return newConfigUpdateJobs(c)
 }
 
+func (c *OperationsV1alpha2Client) EdgeCoreConfigProfiles() EdgeCoreConfigProfileInterface {
+	return newEdgeCoreConfigProfiles(c)
+}
+
 func (c *OperationsV1alpha2Client) ImagePrePullJobs() ImagePrePullJobInterface {
 	return newImagePrePullJobs(c)
 }
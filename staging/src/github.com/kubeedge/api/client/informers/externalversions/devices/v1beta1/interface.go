@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// Devices returns a DeviceInformer.
 	Devices() DeviceInformer
+	// DeviceGroups returns a DeviceGroupInformer.
+	DeviceGroups() DeviceGroupInformer
 	// DeviceModels returns a DeviceModelInformer.
 	DeviceModels() DeviceModelInformer
 	// DeviceStatuses returns a DeviceStatusInformer.
@@ -48,6 +50,11 @@ func (v *version) Devices() DeviceInformer {
 	return &deviceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DeviceGroups returns a DeviceGroupInformer.
+func (v *version) DeviceGroups() DeviceGroupInformer {
+	return &deviceGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DeviceModels returns a DeviceModelInformer.
 func (v *version) DeviceModels() DeviceModelInformer {
 	return &deviceModelInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
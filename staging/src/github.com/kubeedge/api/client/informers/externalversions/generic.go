@@ -67,6 +67,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=devices, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("devices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().Devices().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("devicegroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DeviceGroups().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("devicemodels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DeviceModels().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("devicestatuses"):
@@ -79,8 +81,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().NodeUpgradeJobs().Informer()}, nil
 
 		// Group=operations, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithResource("commandjobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha2().CommandJobs().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("configupdatejobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha2().ConfigUpdateJobs().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("edgecoreconfigprofiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha2().EdgeCoreConfigProfiles().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("imageprepulljobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha2().ImagePrePullJobs().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("nodeupgradejobs"):
@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// CommandJobs returns a CommandJobInformer.
+	CommandJobs() CommandJobInformer
 	// ConfigUpdateJobs returns a ConfigUpdateJobInformer.
 	ConfigUpdateJobs() ConfigUpdateJobInformer
+	// EdgeCoreConfigProfiles returns a EdgeCoreConfigProfileInformer.
+	EdgeCoreConfigProfiles() EdgeCoreConfigProfileInformer
 	// ImagePrePullJobs returns a ImagePrePullJobInformer.
 	ImagePrePullJobs() ImagePrePullJobInformer
 	// NodeUpgradeJobs returns a NodeUpgradeJobInformer.
@@ -43,11 +47,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// CommandJobs returns a CommandJobInformer.
+func (v *version) CommandJobs() CommandJobInformer {
+	return &commandJobInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ConfigUpdateJobs returns a ConfigUpdateJobInformer.
 func (v *version) ConfigUpdateJobs() ConfigUpdateJobInformer {
 	return &configUpdateJobInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EdgeCoreConfigProfiles returns a EdgeCoreConfigProfileInformer.
+func (v *version) EdgeCoreConfigProfiles() EdgeCoreConfigProfileInformer {
+	return &edgeCoreConfigProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ImagePrePullJobs returns a ImagePrePullJobInformer.
 func (v *version) ImagePrePullJobs() ImagePrePullJobInformer {
 	return &imagePrePullJobInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -26,6 +26,14 @@ type DeviceListerExpansion interface{}
 // DeviceNamespaceLister.
 type DeviceNamespaceListerExpansion interface{}
 
+// DeviceGroupListerExpansion allows custom methods to be added to
+// DeviceGroupLister.
+type DeviceGroupListerExpansion interface{}
+
+// DeviceGroupNamespaceListerExpansion allows custom methods to be added to
+// DeviceGroupNamespaceLister.
+type DeviceGroupNamespaceListerExpansion interface{}
+
 // DeviceModelListerExpansion allows custom methods to be added to
 // DeviceModelLister.
 type DeviceModelListerExpansion interface{}
@@ -18,10 +18,18 @@ limitations under the License.
 
 package v1alpha2
 
+// CommandJobListerExpansion allows custom methods to be added to
+// CommandJobLister.
+type CommandJobListerExpansion interface{}
+
 // ConfigUpdateJobListerExpansion allows custom methods to be added to
 // ConfigUpdateJobLister.
 type ConfigUpdateJobListerExpansion interface{}
 
+// EdgeCoreConfigProfileListerExpansion allows custom methods to be added to
+// EdgeCoreConfigProfileLister.
+type EdgeCoreConfigProfileListerExpansion interface{}
+
 // ImagePrePullJobListerExpansion allows custom methods to be added to
 // ImagePrePullJobLister.
 type ImagePrePullJobListerExpansion interface{}
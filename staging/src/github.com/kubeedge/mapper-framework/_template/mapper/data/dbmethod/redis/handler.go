@@ -22,11 +22,11 @@ import (
 
 	"k8s.io/klog/v2"
 
-	"github.com/kubeedge/Template/driver"
+	"github.com/kubeedge/mapper-framework/pkg/collector"
 	"github.com/kubeedge/mapper-framework/pkg/common"
 )
 
-func DataHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
+func DataHandler(ctx context.Context, twin *common.Twin, property *collector.Property, dataModel *common.DataModel) {
 	dbConfig, err := NewDataBaseClient(twin.Property.PushMethod.DBMethod.DBConfig.RedisClientConfig)
 	if err != nil {
 		klog.Errorf("new database client error: %v", err)
@@ -41,34 +41,23 @@ func DataHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 	if reportCycle == 0 {
 		reportCycle = common.DefaultReportCycle
 	}
-	ticker := time.NewTicker(reportCycle)
 	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				deviceData, err := client.GetDeviceData(visitorConfig)
-				if err != nil {
-					klog.Errorf("publish error: %v", err)
-					continue
-				}
-				sData, err := common.ConvertToString(deviceData)
-				if err != nil {
-					klog.Errorf("Failed to convert publish method data : %v", err)
-					continue
-				}
-				dataModel.SetValue(sData)
-				dataModel.SetTimeStamp()
-
-				err = dbConfig.AddData(dataModel)
-				if err != nil {
-					klog.Errorf("redis database add data error: %v", err)
-					return
-				}
-			case <-ctx.Done():
-				dbConfig.CloseSession()
-				return
-			}
-		}
+		<-ctx.Done()
+		dbConfig.CloseSession()
 	}()
+	property.AddConsumer(ctx, reportCycle, func(reading collector.Reading) {
+		sData, err := common.ConvertToString(reading.Value)
+		if err != nil {
+			klog.Errorf("Failed to convert publish method data : %v", err)
+			return
+		}
+		data := *dataModel
+		data.SetValue(sData)
+		data.TimeStamp = reading.Timestamp.UnixMilli()
 
+		err = dbConfig.AddData(&data)
+		if err != nil {
+			klog.Errorf("redis database add data error: %v", err)
+		}
+	})
 }
@@ -25,13 +25,13 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"k8s.io/klog/v2"
 
-	"github.com/kubeedge/Template/driver"
+	"github.com/kubeedge/mapper-framework/pkg/collector"
 	"github.com/kubeedge/mapper-framework/pkg/common"
 )
 
 const meterName = "github.com/kubeedge/Template/data/dbmethod/otel"
 
-func DataHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
+func DataHandler(ctx context.Context, twin *common.Twin, property *collector.Property, dataModel *common.DataModel) {
 	cfg, err := NewConfig(twin.Property.PushMethod.MethodConfig)
 	if err != nil {
 		klog.Errorf("new config fail: %v", err)
@@ -63,12 +63,13 @@ func DataHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 	}
 
 	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
-		data, err := client.GetDeviceData(visitorConfig)
-		if err != nil {
-			return fmt.Errorf("get device data fail: %v", err)
+		reading, ok := property.Latest()
+		if !ok {
+			// no value is collected yet
+			return nil
 		}
 
-		strData, err := common.ConvertToString(data)
+		strData, err := common.ConvertToString(reading.Value)
 		if err != nil {
 			return fmt.Errorf("failed to parse device data to string: %v", err)
 		}
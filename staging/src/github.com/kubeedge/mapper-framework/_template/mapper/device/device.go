@@ -23,6 +23,7 @@ import (
 	"github.com/kubeedge/Template/data/stream"
 	"github.com/kubeedge/Template/driver"
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/mapper-framework/pkg/collector"
 	"github.com/kubeedge/mapper-framework/pkg/common"
 	"github.com/kubeedge/mapper-framework/pkg/global"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
@@ -37,6 +38,12 @@ type DevPanel struct {
 	quitChan     chan os.Signal
 }
 
+var (
+	_ global.DevPanel       = &DevPanel{}
+	_ global.UnitDevPanel   = &DevPanel{}
+	_ global.MethodDevPanel = &DevPanel{}
+)
+
 var (
 	devPanel *DevPanel
 	once     sync.Once
@@ -146,41 +153,121 @@ func dataHandler(ctx context.Context, dev *driver.CustomizedDev) {
 			continue
 		}
 
+		// the values of the property are delivered to the consumers below,
+		// consumed is false if none of them is configured
+		property := collector.NewProperty(twin.PropertyName)
+		consumed := false
+
 		// handle twin
-		twinData := &TwinData{
-			DeviceName:      dev.Instance.Name,
-			DeviceNamespace: dev.Instance.Namespace,
-			Client:          dev.CustomizedClient,
-			Name:            twin.PropertyName,
-			Type:            twin.ObservedDesired.Metadata.Type,
-			ObservedDesired: twin.ObservedDesired,
-			VisitorConfig:   &visitorConfig,
-			Topic:           fmt.Sprintf(common.TopicTwinUpdate, dev.Instance.ID),
-			CollectCycle:    time.Millisecond * time.Duration(twin.Property.CollectCycle),
-			ReportToCloud:   twin.Property.ReportToCloud,
-		}
-		go twinData.Run(ctx)
+		if twin.Property.ReportToCloud {
+			consumed = true
+			twinData := &TwinData{
+				DeviceName:      dev.Instance.Name,
+				DeviceNamespace: dev.Instance.Namespace,
+				Client:          dev.CustomizedClient,
+				Name:            twin.PropertyName,
+				Type:            twin.ObservedDesired.Metadata.Type,
+				ObservedDesired: twin.ObservedDesired,
+				VisitorConfig:   &visitorConfig,
+				Topic:           fmt.Sprintf(common.TopicTwinUpdate, dev.Instance.ID),
+				CollectCycle:    time.Millisecond * time.Duration(twin.Property.CollectCycle),
+				ReportToCloud:   twin.Property.ReportToCloud,
+			}
+			property.AddConsumer(ctx, time.Millisecond*time.Duration(twin.Property.ReportCycle), twinData.Report)
+		}
 
 		dataModel := common.NewDataModel(dev.Instance.Name, twin.Property.PropertyName, dev.Instance.Namespace, common.WithType(twin.ObservedDesired.Metadata.Type))
 		// handle push method
 		if twin.Property.PushMethod.MethodConfig != nil && twin.Property.PushMethod.MethodName != "" {
-			pushHandler(ctx, &twin, dev.CustomizedClient, &visitorConfig, dataModel)
+			consumed = true
+			pushHandler(ctx, &twin, property, dataModel)
 		}
 		// handle anomaly detection
 		if twin.Property.PushMethod.AnomalyDetectionConfig != nil && twin.Property.PushMethod.AnomalyDetectionEnabled {
-			adHandler(ctx, &twin, dev.CustomizedClient, &visitorConfig)
+			consumed = true
+			adHandler(ctx, &twin, dev.CustomizedClient, &visitorConfig, property)
 		}
 		// handle database
 		if twin.Property.PushMethod.DBMethod.DBMethodName != "" {
-			dbHandler(ctx, &twin, dev.CustomizedClient, &visitorConfig, dataModel)
+			consumed = true
+			dbHandler(ctx, &twin, property, dataModel)
+		}
+
+		// collect the property only if its values are consumed, so that the
+		// device is not polled or subscribed for nothing
+		if !consumed {
+			klog.V(3).Infof("property %s of device %s has no consumer, skip collecting it", twin.PropertyName, dev.Instance.Name)
+			continue
+		}
+		go collect(ctx, dev.CustomizedClient, &visitorConfig, &twin, property)
+	}
+}
+
+// collect collects the values of the property. The property without collectCycle is
+// pushed by the driver if the driver supports it, the others are polled every collectCycle.
+func collect(ctx context.Context, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, twin *common.Twin, property *collector.Property) {
+	if twin.Property.CollectCycle == 0 {
+		err := client.SubscribeDeviceData(ctx, visitorConfig, func(value interface{}, err error) {
+			if err == nil {
+				value, err = toModelUnit(twin.Property, value)
+			}
+			property.Emit(value, err)
+		})
+		if err == nil {
+			return
 		}
+		if !errors.Is(err, collector.ErrPushNotSupported) {
+			klog.Errorf("subscribe %s error, poll it instead: %v", twin.PropertyName, err)
+		}
+	}
+	property.Poll(ctx, time.Millisecond*time.Duration(twin.Property.CollectCycle), func() (interface{}, error) {
+		value, err := client.GetDeviceData(visitorConfig)
+		if err != nil {
+			return nil, err
+		}
+		return toModelUnit(twin.Property, value)
+	})
+}
+
+// toModelUnit converts the value collected from the device to the unit of the model property,
+// the device uses the unit in the visitor config if it's set
+func toModelUnit(property *common.DeviceProperty, value interface{}) (interface{}, error) {
+	return common.ConvertValueUnit(property.PProperty.DataType, value, common.VisitorUnit(property.Visitors), property.PProperty.Unit)
+}
+
+// toDeviceUnit converts the value in the unit of the model property to the unit that the device uses
+func toDeviceUnit(property *common.DeviceProperty, value interface{}) (interface{}, error) {
+	return common.ConvertValueUnit(property.PProperty.DataType, value, property.PProperty.Unit, common.VisitorUnit(property.Visitors))
+}
+
+// convertRequestedUnit converts the value between the unit of the model property and the unit
+// requested by the API caller, toModel indicates the direction of the conversion
+func convertRequestedUnit(property *common.DeviceProperty, value interface{}, unit string, toModel bool) (interface{}, error) {
+	if unit == "" {
+		return value, nil
+	}
+	if property.PProperty.Unit == "" {
+		return nil, fmt.Errorf("property %s has no unit to convert to %s", property.PropertyName, unit)
 	}
+	if toModel {
+		return common.ConvertValueUnit(property.PProperty.DataType, value, unit, property.PProperty.Unit)
+	}
+	return common.ConvertValueUnit(property.PProperty.DataType, value, property.PProperty.Unit, unit)
+}
+
+// reportCycle returns the report cycle of the push methods and the databases
+func reportCycle(twin *common.Twin) time.Duration {
+	reportCycle := time.Millisecond * time.Duration(twin.Property.ReportCycle)
+	if reportCycle <= 0 {
+		reportCycle = common.DefaultReportCycle
+	}
+	return reportCycle
 }
 
 // pushHandler start data panel work
-func pushHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
+func pushHandler(ctx context.Context, twin *common.Twin, property *collector.Property, dataModel *common.DataModel) {
 	if twin.Property.PushMethod.MethodName == common.PushMethodOTEL {
-		otelMethod.DataHandler(ctx, twin, client, visitorConfig, dataModel)
+		otelMethod.DataHandler(ctx, twin, property, dataModel)
 		return
 	}
 
@@ -205,88 +292,52 @@ func pushHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 		klog.Errorf("init publish method err: %v", err)
 		return
 	}
-	reportCycle := time.Millisecond * time.Duration(twin.Property.ReportCycle)
-	if reportCycle == 0 {
-		reportCycle = common.DefaultReportCycle
-	}
-	ticker := time.NewTicker(reportCycle)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				deviceData, err := client.GetDeviceData(visitorConfig)
-				if err != nil {
-					klog.Errorf("publish error: %v", err)
-					continue
-				}
-				sData, err := common.ConvertToString(deviceData)
-				if err != nil {
-					klog.Errorf("Failed to convert publish method data : %v", err)
-					continue
-				}
-				dataModel.SetValue(sData)
-				dataModel.SetTimeStamp()
-				dataPanel.Push(dataModel)
-			case <-ctx.Done():
-				return
-			}
+	property.AddConsumer(ctx, reportCycle(twin), func(reading collector.Reading) {
+		sData, err := common.ConvertToString(reading.Value)
+		if err != nil {
+			klog.Errorf("Failed to convert publish method data : %v", err)
+			return
 		}
-	}()
+		data := *dataModel
+		data.SetValue(sData)
+		data.TimeStamp = reading.Timestamp.UnixMilli()
+		dataPanel.Push(&data)
+	})
 }
 
 // dbHandler start db client to save data
-func dbHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
+func dbHandler(ctx context.Context, twin *common.Twin, property *collector.Property, dataModel *common.DataModel) {
 	switch twin.Property.PushMethod.DBMethod.DBMethodName {
 	// TODO add more database
 	case "influx":
-		dbInflux.DataHandler(ctx, twin, client, visitorConfig, dataModel)
+		dbInflux.DataHandler(ctx, twin, property, dataModel)
 
 	case "redis":
-		dbRedis.DataHandler(ctx, twin, client, visitorConfig, dataModel)
+		dbRedis.DataHandler(ctx, twin, property, dataModel)
 
 	case "tdengine":
-		dbTdengine.DataHandler(ctx, twin, client, visitorConfig, dataModel)
+		dbTdengine.DataHandler(ctx, twin, property, dataModel)
 
 	case "mysql":
-		dbMysql.DataHandler(ctx, twin, client, visitorConfig, dataModel)
+		dbMysql.DataHandler(ctx, twin, property, dataModel)
 	}
 }
 
 // adHandler start anomaly detection processing
-func adHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig) {
+func adHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, property *collector.Property) {
 	klog.Infof("Start anomaly detection processing for twin %s", twin.PropertyName)
 
-	reportCycle := time.Millisecond * time.Duration(twin.Property.ReportCycle)
-	if reportCycle <= 0 {
-		reportCycle = common.DefaultReportCycle
-	}
-
-	ticker := time.NewTicker(reportCycle)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				deviceData, err := client.GetDeviceData(visitorConfig)
-				if err != nil {
-					klog.Errorf("Anomaly detection for %s get device data error: %v", twin.PropertyName, err)
-					continue
-				}
-				req := &driver.AnomalyDetectionRequest{
-					Enabled:                twin.Property.PushMethod.AnomalyDetectionEnabled,
-					AnomalyDetectionConfig: twin.Property.PushMethod.AnomalyDetectionConfig,
-					VisitorConfig:          *visitorConfig,
-					Data:                   deviceData,
-				}
-				err = client.AnomalyDetectionProcess(req)
-				if err != nil {
-					klog.Errorf("Anomaly detection processing for %s error: %v", twin.PropertyName, err)
-					continue
-				}
-			case <-ctx.Done():
-				return
-			}
+	property.AddConsumer(ctx, reportCycle(twin), func(reading collector.Reading) {
+		req := &driver.AnomalyDetectionRequest{
+			Enabled:                twin.Property.PushMethod.AnomalyDetectionEnabled,
+			AnomalyDetectionConfig: twin.Property.PushMethod.AnomalyDetectionConfig,
+			VisitorConfig:          *visitorConfig,
+			Data:                   reading.Value,
 		}
-	}()
+		if err := client.AnomalyDetectionProcess(req); err != nil {
+			klog.Errorf("Anomaly detection processing for %s error: %v", twin.PropertyName, err)
+		}
+	})
 }
 
 // setVisitor check if visitor property is readonly, if not then set it.
@@ -298,12 +349,15 @@ func setVisitor(visitorConfig *driver.VisitorConfig, twin *common.Twin, dev *dri
 	klog.V(2).Infof("Convert type: %s, value: %s ", twin.Property.PProperty.DataType, twin.ObservedDesired.Value)
 	var value interface{}
 	if twin.ObservedDesired.Value != "" {
-		convertedValue, err := common.Convert(twin.Property.PProperty.DataType, twin.ObservedDesired.Value)
+		convertedValue, err := common.ValidateValue(twin.Property.PProperty, twin.ObservedDesired.Value)
 		if err != nil {
-			klog.Errorf("Failed to convert value as %s : %v", twin.Property.PProperty.DataType, err)
+			klog.Errorf("Failed to validate value as %s : %v", twin.Property.PProperty.DataType, err)
 			return err
 		}
-		value = convertedValue
+		value, err = toDeviceUnit(twin.Property, convertedValue)
+		if err != nil {
+			return fmt.Errorf("%s convert unit error: %v", twin.PropertyName, err)
+		}
 	} else {
 		value = twin.ObservedDesired.Value
 	}
@@ -431,7 +485,16 @@ func getTwinData(deviceID string, twin common.Twin, dev *driver.CustomizedDev) (
 		VisitorConfig: &visitorConfig,
 		Topic:         fmt.Sprintf(common.TopicTwinUpdate, deviceID),
 	}
-	return twinData.GetPayLoad()
+	visitorConfig.VisitorConfigData.DataType = strings.ToLower(visitorConfig.VisitorConfigData.DataType)
+	data, err := dev.CustomizedClient.GetDeviceData(&visitorConfig)
+	if err != nil {
+		return nil, fmt.Errorf("get device data failed: %v", err)
+	}
+	data, err = toModelUnit(twin.Property, data)
+	if err != nil {
+		return nil, err
+	}
+	return twinData.payload(data)
 }
 
 // GetDevice get device instance
@@ -469,6 +532,11 @@ func (d *DevPanel) RemoveDevice(deviceID string) error {
 
 // WriteDevice write value to the device
 func (d *DevPanel) WriteDevice(deviceMethodName, deviceID, propertyName, data string) error {
+	return d.WriteDeviceInUnit(deviceMethodName, deviceID, propertyName, data, "")
+}
+
+// WriteDeviceInUnit write value in the unit to the device, empty unit means the unit of the model property
+func (d *DevPanel) WriteDeviceInUnit(deviceMethodName, deviceID, propertyName, data, unit string) error {
 	var dataType string
 	var deviceproperty common.DeviceProperty
 	d.serviceMutex.Lock()
@@ -519,6 +587,17 @@ func (d *DevPanel) WriteDevice(deviceMethodName, deviceID, propertyName, data st
 	if err != nil {
 		return fmt.Errorf("conversion data format failed, datatype is %s, data is %s", strings.ToLower(dataType), data)
 	}
+	writeData, err = convertRequestedUnit(&deviceproperty, writeData, unit, true)
+	if err != nil {
+		return err
+	}
+	if err = common.CheckRange(deviceproperty.PProperty, writeData); err != nil {
+		return err
+	}
+	writeData, err = toDeviceUnit(&deviceproperty, writeData)
+	if err != nil {
+		return err
+	}
 	var visitorConfig driver.VisitorConfig
 	err = json.Unmarshal(deviceproperty.Visitors, &visitorConfig)
 	if err != nil {
@@ -532,6 +611,70 @@ func (d *DevPanel) WriteDevice(deviceMethodName, deviceID, propertyName, data st
 	return nil
 }
 
+// CallDeviceMethod call a method declared in the device model, the inputs and outputs are encoded as strings
+func (d *DevPanel) CallDeviceMethod(deviceID, methodName string, inputs map[string]string) (map[string]string, error) {
+	d.serviceMutex.Lock()
+	defer d.serviceMutex.Unlock()
+	dev, ok := d.devices[deviceID]
+	if !ok {
+		return nil, fmt.Errorf("not found device %s", deviceID)
+	}
+	model, ok := d.models[parse.GetResourceID(dev.Instance.Namespace, dev.Instance.Model)]
+	if !ok {
+		return nil, fmt.Errorf("not found deviceModel %s of device %s", dev.Instance.Model, deviceID)
+	}
+	var method *common.ModelMethod
+	for i := range model.Methods {
+		if model.Methods[i].Name == methodName {
+			method = &model.Methods[i]
+			break
+		}
+	}
+	if method == nil {
+		return nil, fmt.Errorf("method %s does not exist in deviceModel %s", methodName, model.Name)
+	}
+
+	params := make(map[string]interface{}, len(inputs))
+	for _, param := range method.Inputs {
+		value, ok := inputs[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, fmt.Errorf("required input %s of method %s is missing", param.Name, methodName)
+			}
+			continue
+		}
+		dataType := strings.ToLower(param.DataType)
+		if dataType == "bytes" {
+			// bytes are kept base64 encoded
+			params[param.Name] = value
+			continue
+		}
+		converted, err := common.Convert(dataType, value)
+		if err != nil {
+			return nil, fmt.Errorf("conversion input %s failed, datatype is %s, data is %s", param.Name, dataType, value)
+		}
+		params[param.Name] = converted
+	}
+	if len(params) != len(inputs) {
+		return nil, fmt.Errorf("inputs of method %s contain undeclared parameters", methodName)
+	}
+
+	klog.V(2).Infof("start calling method %s of device %s with inputs %v", methodName, deviceID, params)
+	results, err := dev.CustomizedClient.CallDeviceMethod(methodName, params)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string, len(results))
+	for name, result := range results {
+		value, err := common.ConvertToString(result)
+		if err != nil {
+			return nil, fmt.Errorf("conversion output %s of method %s failed: %v", name, methodName, err)
+		}
+		outputs[name] = value
+	}
+	return outputs, nil
+}
+
 // stopDev stop device and goroutine
 func (d *DevPanel) stopDev(dev *driver.CustomizedDev, id string) error {
 	cancelFunc, ok := d.deviceMuxs[id]
@@ -573,6 +716,11 @@ func (d *DevPanel) RemoveModel(modelID string) {
 
 // GetTwinResult Get twin's value and data type
 func (d *DevPanel) GetTwinResult(deviceID string, twinName string) (string, string, error) {
+	return d.GetTwinResultInUnit(deviceID, twinName, "")
+}
+
+// GetTwinResultInUnit Get twin's value in the unit and data type, empty unit means the unit of the model property
+func (d *DevPanel) GetTwinResultInUnit(deviceID string, twinName string, unit string) (string, string, error) {
 	d.serviceMutex.Lock()
 	defer d.serviceMutex.Unlock()
 	dev, ok := d.devices[deviceID]
@@ -596,6 +744,14 @@ func (d *DevPanel) GetTwinResult(deviceID string, twinName string) (string, stri
 		if err != nil {
 			return "", "", fmt.Errorf("get device data failed: %v", err)
 		}
+		data, err = toModelUnit(twin.Property, data)
+		if err != nil {
+			return "", "", err
+		}
+		data, err = convertRequestedUnit(twin.Property, data, unit, false)
+		if err != nil {
+			return "", "", err
+		}
 		res, err = common.ConvertToString(data)
 		if err != nil {
 			return "", "", err
@@ -1,7 +1,6 @@
 package device
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/kubeedge/Template/driver"
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/mapper-framework/pkg/collector"
 	"github.com/kubeedge/mapper-framework/pkg/common"
 	"github.com/kubeedge/mapper-framework/pkg/grpcclient"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
@@ -37,7 +37,12 @@ func (td *TwinData) GetPayLoad() ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get device data failed: %v", err)
 	}
-	sData, err := common.ConvertToString(td.Results)
+	return td.payload(td.Results)
+}
+
+// payload creates the message of the twin update with the value of the property
+func (td *TwinData) payload(results interface{}) ([]byte, error) {
+	sData, err := common.ConvertToString(results)
 	if err != nil {
 		klog.Errorf("Failed to convert %s %s value as string : %v", td.DeviceName, td.Name, err)
 		return nil, err
@@ -66,9 +71,13 @@ func (td *TwinData) PushToEdgeCore() {
 		klog.Errorf("twindata %s unmarshal failed, err: %s", td.Name, err)
 		return
 	}
+	td.report(payload)
+}
 
+// report reports the twin update payload to edgecore
+func (td *TwinData) report(payload []byte) {
 	var msg common.DeviceTwinUpdate
-	if err = json.Unmarshal(payload, &msg); err != nil {
+	if err := json.Unmarshal(payload, &msg); err != nil {
 		klog.Errorf("twindata %s unmarshal failed, err: %s", td.Name, err)
 		return
 	}
@@ -89,20 +98,14 @@ func (td *TwinData) PushToEdgeCore() {
 	}
 }
 
-func (td *TwinData) Run(ctx context.Context) {
-	if !td.ReportToCloud {
+// Report reports a collected value of the property to edgecore, it's the consumer
+// of the property when the property is reported to the cloud
+func (td *TwinData) Report(reading collector.Reading) {
+	td.Results = reading.Value
+	payload, err := td.payload(reading.Value)
+	if err != nil {
+		klog.Errorf("twindata %s unmarshal failed, err: %s", td.Name, err)
 		return
 	}
-	if td.CollectCycle == 0 {
-		td.CollectCycle = common.DefaultCollectCycle
-	}
-	ticker := time.NewTicker(td.CollectCycle)
-	for {
-		select {
-		case <-ticker.C:
-			td.PushToEdgeCore()
-		case <-ctx.Done():
-			return
-		}
-	}
+	td.report(payload)
 }
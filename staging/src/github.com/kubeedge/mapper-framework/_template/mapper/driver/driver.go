@@ -1,8 +1,11 @@
 package driver
 
 import (
+	"context"
+	"fmt"
 	"sync"
 
+	"github.com/kubeedge/mapper-framework/pkg/collector"
 	"github.com/kubeedge/mapper-framework/pkg/common"
 )
 
@@ -27,12 +30,25 @@ func (c *CustomizedClient) GetDeviceData(visitor *VisitorConfig) (interface{}, e
 	return nil, nil
 }
 
+func (c *CustomizedClient) SubscribeDeviceData(ctx context.Context, visitor *VisitorConfig, emit collector.Emit) error {
+	// TODO: if the device pushes the data of the property, like by notifications or subscriptions,
+	// call emit with each new value until ctx is done, it's used for the property without collectCycle.
+	// return collector.ErrPushNotSupported to poll the data by GetDeviceData instead
+	return collector.ErrPushNotSupported
+}
+
 func (c *CustomizedClient) DeviceDataWrite(visitor *VisitorConfig, deviceMethodName string, propertyName string, data interface{}) error {
 	// TODO: add the code to write device's data
 	// you can use c.ProtocolConfig and visitor to write data to device
 	return nil
 }
 
+func (c *CustomizedClient) CallDeviceMethod(methodName string, inputs map[string]interface{}) (map[string]interface{}, error) {
+	// TODO: add the code to call the method declared in the device model
+	// the inputs have been converted to the types of the method parameters
+	return nil, fmt.Errorf("method %s is not supported", methodName)
+}
+
 func (c *CustomizedClient) SetDeviceData(data interface{}, visitor *VisitorConfig) error {
 	// TODO: set device's data
 	// you can use c.ProtocolConfig and visitor
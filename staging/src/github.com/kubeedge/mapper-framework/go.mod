@@ -7,10 +7,12 @@ toolchain go1.24.2
 require (
 	github.com/avast/retry-go v3.0.0+incompatible
 	github.com/golang/protobuf v1.5.4
+	github.com/gopcua/opcua v0.8.0
 	github.com/gorilla/mux v1.8.0
 	github.com/kubeedge/api v0.0.0
 	github.com/spf13/pflag v1.0.6-0.20210604193023-d5e0c0615ace
 	golang.org/x/net v0.37.0 // indirect
+	golang.org/x/sys v0.31.0
 	google.golang.org/grpc v1.67.1
 	google.golang.org/protobuf v1.35.2
 	gopkg.in/yaml.v2 v2.4.0
@@ -19,10 +21,10 @@ require (
 
 require (
 	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/rogpeppe/go-internal v1.12.0 // indirect
-	golang.org/x/sys v0.31.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241007155032-5fefd90f89a9 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collector distributes the values of the device properties to their
+// consumers, like the twin report to edgecore, the push methods and the databases.
+// The values of a property are polled from the driver every CollectCycle, or
+// emitted by the driver when the device pushes them. Either way, a consumer
+// receives a value only if it changed, and at most once per its ReportCycle.
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+// ErrPushNotSupported is returned by the drivers which can't push the values of
+// a property, the property is polled instead
+var ErrPushNotSupported = errors.New("pushing device data is not supported by the driver")
+
+// Emit is called by a driver with each new value of a pushed property, err is not
+// nil if the value could not be collected
+type Emit func(value interface{}, err error)
+
+// Reading is a collected value of a property
+type Reading struct {
+	Value     interface{}
+	Timestamp time.Time
+}
+
+// consumer receives the readings of a property
+type consumer struct {
+	reportCycle time.Duration
+	handle      func(Reading)
+	// pending is the latest reading which is not delivered yet
+	pending *Reading
+	wake    chan struct{}
+}
+
+// Property collects the values of a device property and delivers them to its consumers
+type Property struct {
+	Name string
+
+	mutex     sync.Mutex
+	consumers []*consumer
+	latest    *Reading
+}
+
+// NewProperty returns the Property of name
+func NewProperty(name string) *Property {
+	return &Property{Name: name}
+}
+
+// AddConsumer adds a consumer which receives the changed values of the property
+// at most once per reportCycle until ctx is done. The latest value is delivered
+// after a throttled period, and 0 reportCycle delivers every changed value.
+func (p *Property) AddConsumer(ctx context.Context, reportCycle time.Duration, handle func(Reading)) {
+	c := &consumer{reportCycle: reportCycle, handle: handle, wake: make(chan struct{}, 1)}
+	p.mutex.Lock()
+	p.consumers = append(p.consumers, c)
+	if p.latest != nil {
+		latest := *p.latest
+		c.pending = &latest
+		c.wake <- struct{}{}
+	}
+	p.mutex.Unlock()
+	go p.deliver(ctx, c)
+}
+
+// Emit collects a value of the property, it never blocks. It's the Emit of the
+// drivers pushing the values.
+func (p *Property) Emit(value interface{}, err error) {
+	if err != nil {
+		klog.Errorf("collect property %s error: %v", p.Name, err)
+		return
+	}
+	r := Reading{Value: value, Timestamp: time.Now()}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.latest = &r
+	for _, c := range p.consumers {
+		pending := r
+		c.pending = &pending
+		select {
+		case c.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Latest returns the latest collected value
+func (p *Property) Latest() (Reading, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.latest == nil {
+		return Reading{}, false
+	}
+	return *p.latest, true
+}
+
+// Poll collects the value by get every cycle until ctx is done
+func (p *Property) Poll(ctx context.Context, cycle time.Duration, get func() (interface{}, error)) {
+	if cycle <= 0 {
+		cycle = common.DefaultCollectCycle
+	}
+	ticker := time.NewTicker(cycle)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.Emit(get())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver delivers the pending readings to c, it skips the unchanged values and
+// waits for the report cycle after each delivery
+func (p *Property) deliver(ctx context.Context, c *consumer) {
+	var last string
+	var delivered bool
+	for {
+		select {
+		case <-c.wake:
+		case <-ctx.Done():
+			return
+		}
+		p.mutex.Lock()
+		r := c.pending
+		c.pending = nil
+		p.mutex.Unlock()
+		if r == nil {
+			continue
+		}
+		value := valueKey(r.Value)
+		if delivered && value == last {
+			continue
+		}
+		c.handle(*r)
+		last, delivered = value, true
+
+		if c.reportCycle > 0 {
+			timer := time.NewTimer(c.reportCycle)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}
+}
+
+// valueKey returns the string form of a value to compare the values
+func valueKey(value interface{}) string {
+	if s, err := common.ConvertToString(value); err == nil {
+		return s
+	}
+	return fmt.Sprintf("%#v", value)
+}
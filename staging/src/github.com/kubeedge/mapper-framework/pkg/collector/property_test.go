@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder records the delivered values
+type recorder struct {
+	mutex  sync.Mutex
+	values []interface{}
+}
+
+func (r *recorder) handle(reading Reading) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.values = append(r.values, reading.Value)
+}
+
+func (r *recorder) wait(t *testing.T, expect []interface{}) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		r.mutex.Lock()
+		values := append([]interface{}{}, r.values...)
+		r.mutex.Unlock()
+		if reflect.DeepEqual(values, expect) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("delivered values = %v, want %v", values, expect)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	tests := []struct {
+		name        string
+		reportCycle time.Duration
+		emit        []interface{}
+		expect      []interface{}
+	}{
+		{
+			name:   "case1 unchanged values are skipped",
+			emit:   []interface{}{1, 1, 2, 2, 1},
+			expect: []interface{}{1, 2, 1},
+		},
+		{
+			name:        "case2 the latest value is delivered after the report cycle",
+			reportCycle: 200 * time.Millisecond,
+			emit:        []interface{}{1, 2, 3, 4},
+			expect:      []interface{}{1, 4},
+		},
+		{
+			name:        "case3 the value changed back is skipped",
+			reportCycle: 200 * time.Millisecond,
+			emit:        []interface{}{"on", "off", "on"},
+			expect:      []interface{}{"on"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			p := NewProperty("temperature")
+			r := &recorder{}
+			p.AddConsumer(ctx, tt.reportCycle, r.handle)
+			for i, v := range tt.emit {
+				p.Emit(v, nil)
+				if tt.reportCycle == 0 || i == 0 {
+					// let the consumer see the value before the next one
+					time.Sleep(20 * time.Millisecond)
+				}
+			}
+			r.wait(t, tt.expect)
+		})
+	}
+}
+
+func TestConsumers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := NewProperty("temperature")
+	p.Emit(nil, errors.New("device is offline"))
+	if _, ok := p.Latest(); ok {
+		t.Fatalf("Latest() of a property without value should be false")
+	}
+
+	p.Emit(20, nil)
+	// a new consumer receives the latest value
+	late := &recorder{}
+	p.AddConsumer(ctx, 0, late.handle)
+	late.wait(t, []interface{}{20})
+
+	canceled := &recorder{}
+	consumerCtx, cancelConsumer := context.WithCancel(ctx)
+	p.AddConsumer(consumerCtx, 0, canceled.handle)
+	canceled.wait(t, []interface{}{20})
+	cancelConsumer()
+	time.Sleep(20 * time.Millisecond)
+
+	p.Emit(21, nil)
+	late.wait(t, []interface{}{20, 21})
+	canceled.wait(t, []interface{}{20})
+	if latest, ok := p.Latest(); !ok || latest.Value != 21 {
+		t.Errorf("Latest() = %v, %v, want 21", latest.Value, ok)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := NewProperty("counter")
+	r := &recorder{}
+	p.AddConsumer(ctx, 0, r.handle)
+
+	var mutex sync.Mutex
+	n := 0
+	go p.Poll(ctx, 10*time.Millisecond, func() (interface{}, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if n < 3 {
+			n++
+		}
+		return n, nil
+	})
+	r.wait(t, []interface{}{1, 2, 3})
+}
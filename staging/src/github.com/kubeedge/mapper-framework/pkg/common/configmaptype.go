@@ -39,6 +39,7 @@ type DeviceModel struct {
 	Namespace   string          `json:"namespace,omitempty"`
 	Description string          `json:"description,omitempty"`
 	Properties  []ModelProperty `json:"properties,omitempty"`
+	Methods     []ModelMethod   `json:"methods,omitempty"`
 }
 
 // ModelProperty is structure to store deviceModel property.
@@ -52,6 +53,23 @@ type ModelProperty struct {
 	Unit        string `json:"unit,omitempty"`
 }
 
+// ModelMethod is structure to store a method declared by the deviceModel.
+type ModelMethod struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Inputs      []MethodParameter `json:"inputs,omitempty"`
+	Outputs     []MethodParameter `json:"outputs,omitempty"`
+}
+
+// MethodParameter is structure to store an input or output parameter of a model method.
+type MethodParameter struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	DataType    string `json:"dataType,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+}
+
 // ProtocolConfig is structure to store protocol information in device.
 type ProtocolConfig struct {
 	// Unique protocol name
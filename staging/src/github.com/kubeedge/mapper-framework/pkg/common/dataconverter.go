@@ -47,6 +47,72 @@ func Convert(valueType string, value string) (result interface{}, err error) {
 	}
 }
 
+// ValidateValue converts the string value to the data type of the model property
+// and checks that it's in the range between the minimum and the maximum of the property
+func ValidateValue(property ModelProperty, value string) (interface{}, error) {
+	dataType := strings.ToLower(property.DataType)
+	result, err := Convert(dataType, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not of type %s", value, dataType)
+	}
+	if err = CheckRange(property, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CheckRange checks that the numeric value is in the range between the minimum
+// and the maximum of the model property, the other values are always in range
+func CheckRange(property ModelProperty, value interface{}) error {
+	number, ok := toFloat64(value)
+	if !ok {
+		return nil
+	}
+	if property.Minimum != "" {
+		if minimum, err := strconv.ParseFloat(property.Minimum, 64); err == nil && number < minimum {
+			return fmt.Errorf("%v is less than the minimum %s of property %s", value, property.Minimum, property.Name)
+		}
+	}
+	if property.Maximum != "" {
+		if maximum, err := strconv.ParseFloat(property.Maximum, 64); err == nil && number > maximum {
+			return fmt.Errorf("%v is greater than the maximum %s of property %s", value, property.Maximum, property.Name)
+		}
+	}
+	return nil
+}
+
+// toFloat64 converts the numeric value to float64
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
 // ConvertToString other types to string
 func ConvertToString(value interface{}) (string, error) {
 	var result string
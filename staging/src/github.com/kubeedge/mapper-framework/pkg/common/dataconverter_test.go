@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import "testing"
+
+func TestValidateValue(t *testing.T) {
+	property := ModelProperty{Name: "temperature", DataType: "INT", Minimum: "-40", Maximum: "125"}
+	tests := []struct {
+		name    string
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "in range", value: "25", want: int64(25)},
+		{name: "at maximum", value: "125", want: int64(125)},
+		{name: "greater than maximum", value: "126", wantErr: true},
+		{name: "less than minimum", value: "-41", wantErr: true},
+		{name: "wrong type", value: "warm", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateValue(property, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ValidateValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
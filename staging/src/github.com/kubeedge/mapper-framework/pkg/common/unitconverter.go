@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// unit is a linear function of the base unit of its dimension, base = value*scale + offset
+type unit struct {
+	dimension string
+	scale     float64
+	offset    float64
+}
+
+// units are the supported units, the symbols are case-sensitive because of the SI prefixes
+var units = map[string]unit{
+	// temperature, the base unit is kelvin
+	"K":  {dimension: "temperature", scale: 1},
+	"°C": {dimension: "temperature", scale: 1, offset: 273.15},
+	"℃":  {dimension: "temperature", scale: 1, offset: 273.15},
+	"°F": {dimension: "temperature", scale: 5.0 / 9, offset: 273.15 - 32*5.0/9},
+	"℉":  {dimension: "temperature", scale: 5.0 / 9, offset: 273.15 - 32*5.0/9},
+	// length, the base unit is meter
+	"m":  {dimension: "length", scale: 1},
+	"km": {dimension: "length", scale: 1e3},
+	"cm": {dimension: "length", scale: 1e-2},
+	"mm": {dimension: "length", scale: 1e-3},
+	"in": {dimension: "length", scale: 0.0254},
+	"ft": {dimension: "length", scale: 0.3048},
+	// mass, the base unit is kilogram
+	"kg": {dimension: "mass", scale: 1},
+	"g":  {dimension: "mass", scale: 1e-3},
+	"mg": {dimension: "mass", scale: 1e-6},
+	"lb": {dimension: "mass", scale: 0.45359237},
+	"oz": {dimension: "mass", scale: 0.028349523125},
+	// pressure, the base unit is pascal
+	"Pa":   {dimension: "pressure", scale: 1},
+	"hPa":  {dimension: "pressure", scale: 1e2},
+	"kPa":  {dimension: "pressure", scale: 1e3},
+	"MPa":  {dimension: "pressure", scale: 1e6},
+	"bar":  {dimension: "pressure", scale: 1e5},
+	"mbar": {dimension: "pressure", scale: 1e2},
+	"psi":  {dimension: "pressure", scale: 6894.757293168},
+	"atm":  {dimension: "pressure", scale: 101325},
+	// time, the base unit is second
+	"s":   {dimension: "time", scale: 1},
+	"ms":  {dimension: "time", scale: 1e-3},
+	"us":  {dimension: "time", scale: 1e-6},
+	"min": {dimension: "time", scale: 60},
+	"h":   {dimension: "time", scale: 3600},
+	// voltage, the base unit is volt
+	"V":  {dimension: "voltage", scale: 1},
+	"mV": {dimension: "voltage", scale: 1e-3},
+	"kV": {dimension: "voltage", scale: 1e3},
+	// current, the base unit is ampere
+	"A":  {dimension: "current", scale: 1},
+	"mA": {dimension: "current", scale: 1e-3},
+	// power, the base unit is watt
+	"W":  {dimension: "power", scale: 1},
+	"mW": {dimension: "power", scale: 1e-3},
+	"kW": {dimension: "power", scale: 1e3},
+	"MW": {dimension: "power", scale: 1e6},
+	// ratio, the base unit is 1
+	"%":   {dimension: "ratio", scale: 1e-2},
+	"‰":   {dimension: "ratio", scale: 1e-3},
+	"ppm": {dimension: "ratio", scale: 1e-6},
+}
+
+// unitAliases are the case-insensitive names of the units
+var unitAliases = map[string]string{
+	"kelvin":     "K",
+	"c":          "°C",
+	"degc":       "°C",
+	"celsius":    "°C",
+	"f":          "°F",
+	"degf":       "°F",
+	"fahrenheit": "°F",
+	"meter":      "m",
+	"metre":      "m",
+	"inch":       "in",
+	"foot":       "ft",
+	"feet":       "ft",
+	"percent":    "%",
+}
+
+func lookupUnit(name string) (unit, bool) {
+	name = strings.TrimSpace(name)
+	if u, ok := units[name]; ok {
+		return u, true
+	}
+	if symbol, ok := unitAliases[strings.ToLower(name)]; ok {
+		return units[symbol], true
+	}
+	return unit{}, false
+}
+
+// ConvertUnit converts the value from a unit to another unit of the same dimension
+func ConvertUnit(value float64, from, to string) (float64, error) {
+	if from == to {
+		return value, nil
+	}
+	fromUnit, ok := lookupUnit(from)
+	if !ok {
+		return 0, fmt.Errorf("unsupported unit %s", from)
+	}
+	toUnit, ok := lookupUnit(to)
+	if !ok {
+		return 0, fmt.Errorf("unsupported unit %s", to)
+	}
+	if fromUnit.dimension != toUnit.dimension {
+		return 0, fmt.Errorf("can't convert %s to %s", from, to)
+	}
+	return (value*fromUnit.scale + fromUnit.offset - toUnit.offset) / toUnit.scale, nil
+}
+
+// ConvertValueUnit converts the value of the data type from a unit to another unit,
+// the value is returned as it is if any of the units is empty
+func ConvertValueUnit(dataType string, value interface{}, from, to string) (interface{}, error) {
+	if from == "" || to == "" || from == to {
+		return value, nil
+	}
+	number, ok := toFloat64(value)
+	if !ok {
+		return nil, fmt.Errorf("can't convert the unit of %v which is not a number", value)
+	}
+	converted, err := ConvertUnit(number, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(dataType) == "int" {
+		return int64(math.Round(converted)), nil
+	}
+	return converted, nil
+}
+
+// VisitorUnit returns the unit that the device uses for the property,
+// it's set by the unit field in the configData of the visitor config
+func VisitorUnit(visitors json.RawMessage) string {
+	var visitor struct {
+		ConfigData struct {
+			Unit string `json:"unit"`
+		} `json:"configData"`
+	}
+	if len(visitors) == 0 || json.Unmarshal(visitors, &visitor) != nil {
+		return ""
+	}
+	return visitor.ConfigData.Unit
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestConvertUnit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		from    string
+		to      string
+		want    float64
+		wantErr bool
+	}{
+		{name: "celsius to fahrenheit", value: 100, from: "°C", to: "°F", want: 212},
+		{name: "fahrenheit alias to kelvin", value: 32, from: "degF", to: "K", want: 273.15},
+		{name: "kilopascal to psi", value: 6.894757293168, from: "kPa", to: "psi", want: 1},
+		{name: "milliseconds to minutes", value: 90000, from: "ms", to: "min", want: 1.5},
+		{name: "percent to ppm", value: 1, from: "%", to: "ppm", want: 10000},
+		{name: "same unit", value: 3, from: "foo", to: "foo", want: 3},
+		{name: "milliwatt is not megawatt", value: 1, from: "MW", to: "mW", want: 1e9},
+		{name: "different dimensions", value: 1, from: "m", to: "s", wantErr: true},
+		{name: "unknown unit", value: 1, from: "m", to: "parsec", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertUnit(tt.value, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-9*math.Max(1, math.Abs(tt.want)) {
+				t.Errorf("ConvertUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertValueUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType string
+		value    interface{}
+		from     string
+		to       string
+		want     interface{}
+		wantErr  bool
+	}{
+		{name: "int is rounded", dataType: "int", value: int64(1), from: "in", to: "mm", want: int64(25)},
+		{name: "float", dataType: "float", value: float64(1500), from: "g", to: "kg", want: 1.5},
+		{name: "empty unit keeps value", dataType: "string", value: "on", from: "", to: "m", want: "on"},
+		{name: "not a number", dataType: "string", value: "on", from: "m", to: "cm", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertValueUnit(tt.dataType, tt.value, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertValueUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ConvertValueUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisitorUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		visitors json.RawMessage
+		want     string
+	}{
+		{name: "unit in config data", visitors: json.RawMessage(`{"protocolName":"modbus","configData":{"register":"HoldingRegister","unit":"°F"}}`), want: "°F"},
+		{name: "no unit", visitors: json.RawMessage(`{"protocolName":"modbus","configData":{}}`), want: ""},
+		{name: "empty visitors", visitors: nil, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VisitorUnit(tt.visitors); got != tt.want {
+				t.Errorf("VisitorUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modbus is a modbus TCP/RTU driver for the mappers. Its Client has the
+// methods of driver.CustomizedClient of the mapper template, so a modbus mapper
+// can forward them to the Client with the ConfigData of its device and visitors.
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+// Client is the modbus client of a device, the requests of a Client are serialized
+type Client struct {
+	ProtocolConfig
+
+	mutex     sync.Mutex
+	transport transporter
+}
+
+// NewClient returns the Client of the device of protocol
+func NewClient(protocol ProtocolConfig) (*Client, error) {
+	protocol.setDefaults()
+	if err := protocol.validate(); err != nil {
+		return nil, err
+	}
+	return &Client{ProtocolConfig: protocol}, nil
+}
+
+func (c *Client) dial() (transporter, error) {
+	switch c.Transport {
+	case TransportRTU:
+		port, err := openSerialPort(&c.ConfigData)
+		if err != nil {
+			return nil, err
+		}
+		return newRTUTransporter(port, c.BaudRate, c.timeout()), nil
+	default:
+		return dialTCP(c.Address, c.timeout())
+	}
+}
+
+// InitDevice connects to the device
+func (c *Client) InitDevice() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.connect()
+}
+
+func (c *Client) connect() error {
+	if c.transport != nil {
+		return nil
+	}
+	t, err := c.dial()
+	if err != nil {
+		return fmt.Errorf("failed to connect modbus device: %v", err)
+	}
+	c.transport = t
+	return nil
+}
+
+// StopDevice disconnects from the device
+func (c *Client) StopDevice() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.transport == nil {
+		return nil
+	}
+	err := c.transport.close()
+	c.transport = nil
+	return err
+}
+
+// GetDeviceStates returns DeviceStatusOK if the device is connected
+func (c *Client) GetDeviceStates() (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := c.connect(); err != nil {
+		return common.DeviceStatusDisCONN, nil
+	}
+	return common.DeviceStatusOK, nil
+}
+
+// Send sends request to the device and returns its response. The connection
+// is reopened by the next request after an error other than an exception.
+func (c *Client) Send(request PDU) (PDU, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := c.connect(); err != nil {
+		return PDU{}, err
+	}
+	response, err := c.transport.send(c.SlaveID, request)
+	var exception *ExceptionError
+	if err != nil && !errors.As(err, &exception) {
+		klog.V(4).Infof("close modbus connection after error: %v", err)
+		_ = c.transport.close()
+		c.transport = nil
+	}
+	return response, err
+}
+
+// GetDeviceData reads the value of visitor
+func (c *Client) GetDeviceData(visitor *VisitorConfig) (interface{}, error) {
+	values, err := c.GetDeviceDataBatch([]*VisitorConfig{visitor})
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// GetDeviceDataBatch reads the values of visitors. The visitors of contiguous or
+// overlapping registers of the same type are read by one request.
+func (c *Client) GetDeviceDataBatch(visitors []*VisitorConfig) ([]interface{}, error) {
+	for _, v := range visitors {
+		if err := v.validate(); err != nil {
+			return nil, err
+		}
+	}
+	values := make([]interface{}, len(visitors))
+	for _, b := range batches(visitors) {
+		data, err := c.read(b.register, b.offset, b.quantity)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range b.visitors {
+			v := &visitors[i].VisitorConfigData
+			start := int(v.Offset - b.offset)
+			switch v.Register {
+			case CoilRegister, DiscreteInputRegister:
+				bits := unpackBits(data, int(b.quantity))
+				values[i], err = decodeBits(v, bits[start:start+int(v.limit())])
+			default:
+				values[i], err = decodeRegisters(v, data[start*2:(start+int(v.limit()))*2])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode %s at %d: %v", v.Register, v.Offset, err)
+			}
+		}
+	}
+	return values, nil
+}
+
+// batch is a read request of the registers of some visitors
+type batch struct {
+	register string
+	offset   uint16
+	quantity uint16
+	// visitors are the indexes of the visitors read by the batch
+	visitors []int
+}
+
+func batches(visitors []*VisitorConfig) []batch {
+	indexes := make([]int, len(visitors))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := &visitors[indexes[i]].VisitorConfigData, &visitors[indexes[j]].VisitorConfigData
+		if a.Register != b.Register {
+			return a.Register < b.Register
+		}
+		return a.Offset < b.Offset
+	})
+
+	var result []batch
+	for _, i := range indexes {
+		v := &visitors[i].VisitorConfigData
+		end := uint32(v.Offset) + uint32(v.limit())
+		if n := len(result); n > 0 {
+			last := &result[n-1]
+			lastEnd := uint32(last.offset) + uint32(last.quantity)
+			maxQuantity := uint32(maxReadRegisters)
+			if v.Register == CoilRegister || v.Register == DiscreteInputRegister {
+				maxQuantity = maxReadBits
+			}
+			if last.register == v.Register && uint32(v.Offset) <= lastEnd && end-uint32(last.offset) <= maxQuantity {
+				if end > lastEnd {
+					last.quantity = uint16(end - uint32(last.offset))
+				}
+				last.visitors = append(last.visitors, i)
+				continue
+			}
+		}
+		result = append(result, batch{register: v.Register, offset: v.Offset, quantity: v.limit(), visitors: []int{i}})
+	}
+	return result
+}
+
+// read returns the packed bits or the registers of quantity at offset
+func (c *Client) read(register string, offset, quantity uint16) ([]byte, error) {
+	var function byte
+	size := int(quantity) * 2
+	switch register {
+	case CoilRegister:
+		function, size = FuncReadCoils, (int(quantity)+7)/8
+	case DiscreteInputRegister:
+		function, size = FuncReadDiscreteInputs, (int(quantity)+7)/8
+	case HoldingRegister:
+		function = FuncReadHoldingRegisters
+	case InputRegister:
+		function = FuncReadInputRegisters
+	default:
+		return nil, fmt.Errorf("unsupported register %q", register)
+	}
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint16(data[0:], offset)
+	binary.BigEndian.PutUint16(data[2:], quantity)
+	response, err := c.Send(PDU{Function: function, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %d %s at %d: %v", quantity, register, offset, err)
+	}
+	if len(response.Data) != size+1 || int(response.Data[0]) != size {
+		return nil, fmt.Errorf("invalid response of %d bytes, expected %d bytes", len(response.Data)-1, size)
+	}
+	return response.Data[1:], nil
+}
+
+// DeviceDataWrite writes data to visitor
+func (c *Client) DeviceDataWrite(visitor *VisitorConfig, deviceMethodName string, propertyName string, data interface{}) error {
+	klog.V(4).Infof("write %v to property %s by method %s", data, propertyName, deviceMethodName)
+	return c.SetDeviceData(data, visitor)
+}
+
+// SetDeviceData writes data to visitor, only coils and holding registers are writable
+func (c *Client) SetDeviceData(data interface{}, visitor *VisitorConfig) error {
+	v := &visitor.VisitorConfigData
+	if err := v.validate(); err != nil {
+		return err
+	}
+	var request PDU
+	switch v.Register {
+	case CoilRegister:
+		bits, err := encodeBits(v, data)
+		if err != nil {
+			return err
+		}
+		if len(bits) > maxWriteBits {
+			return fmt.Errorf("limit %d exceeds %d bits of a write", len(bits), maxWriteBits)
+		}
+		if len(bits) == 1 {
+			value := uint16(0x0000)
+			if bits[0] {
+				value = 0xFF00
+			}
+			request = PDU{Function: FuncWriteSingleCoil, Data: binary.BigEndian.AppendUint16(
+				binary.BigEndian.AppendUint16(nil, v.Offset), value)}
+			break
+		}
+		packed := packBits(bits)
+		request = PDU{Function: FuncWriteMultipleCoils, Data: append(binary.BigEndian.AppendUint16(
+			binary.BigEndian.AppendUint16(nil, v.Offset), uint16(len(bits))), append([]byte{byte(len(packed))}, packed...)...)}
+	case HoldingRegister:
+		registers, err := encodeRegisters(v, data)
+		if err != nil {
+			return err
+		}
+		quantity := len(registers) / 2
+		if quantity > maxWriteRegisters {
+			return fmt.Errorf("limit %d exceeds %d registers of a write", quantity, maxWriteRegisters)
+		}
+		if quantity == 1 {
+			request = PDU{Function: FuncWriteSingleRegister, Data: append(binary.BigEndian.AppendUint16(nil, v.Offset), registers...)}
+			break
+		}
+		request = PDU{Function: FuncWriteMultipleRegisters, Data: append(binary.BigEndian.AppendUint16(
+			binary.BigEndian.AppendUint16(nil, v.Offset), uint16(quantity)), append([]byte{byte(len(registers))}, registers...)...)}
+	default:
+		return fmt.Errorf("%s is read-only", v.Register)
+	}
+
+	response, err := c.Send(request)
+	if err != nil {
+		return fmt.Errorf("failed to write %s at %d: %v", v.Register, v.Offset, err)
+	}
+	// the response echoes the address and the value or the quantity
+	if len(response.Data) != 4 || binary.BigEndian.Uint16(response.Data) != v.Offset {
+		return fmt.Errorf("invalid write response %x", response.Data)
+	}
+	return nil
+}
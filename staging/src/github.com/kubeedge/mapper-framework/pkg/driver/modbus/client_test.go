@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/kubeedge/mapper-framework/pkg/driver/modbus/modbustest"
+)
+
+func newTestClient(t *testing.T) (*Client, *modbustest.Server) {
+	server, err := modbustest.NewServer()
+	if err != nil {
+		t.Fatalf("failed to start modbus server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	client, err := NewClient(ProtocolConfig{ProtocolName: ProtocolName, ConfigData: ConfigData{Address: server.Addr, SlaveID: 1}})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.StopDevice() })
+	return client, server
+}
+
+func visitor(data VisitorConfigData) *VisitorConfig {
+	return &VisitorConfig{ProtocolName: ProtocolName, VisitorConfigData: data}
+}
+
+func TestGetDeviceData(t *testing.T) {
+	client, server := newTestClient(t)
+	server.SetCoils(3, true)
+	server.SetDiscreteInputs(10, true, false, true)
+	server.SetHoldingRegisters(0, 0xFFFE)
+	server.SetHoldingRegisters(10, 0x4148, 0xF5C3)
+	server.SetHoldingRegisters(20, 0xF5C3, 0x4148)
+	server.SetHoldingRegisters(30, 0x4841, 0xC3F5)
+	server.SetHoldingRegisters(40, 0x6B65, 0x6465, 0x6765)
+	server.SetInputRegisters(5, 235)
+
+	cases := []struct {
+		name    string
+		visitor VisitorConfigData
+		want    interface{}
+	}{
+		{
+			name:    "case1 coil",
+			visitor: VisitorConfigData{DataType: DataTypeBoolean, Register: CoilRegister, Offset: 3},
+			want:    true,
+		},
+		{
+			name:    "case2 discrete inputs as integer",
+			visitor: VisitorConfigData{DataType: DataTypeInt, Register: DiscreteInputRegister, Offset: 10, Limit: 3},
+			want:    int64(5),
+		},
+		{
+			name:    "case3 signed holding register",
+			visitor: VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 0},
+			want:    int64(-2),
+		},
+		{
+			name:    "case4 unsigned holding register",
+			visitor: VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 0, IsUnsigned: true},
+			want:    uint64(0xFFFE),
+		},
+		{
+			name:    "case5 float32",
+			visitor: VisitorConfigData{DataType: DataTypeFloat, Register: HoldingRegister, Offset: 10, Limit: 2},
+			want:    float64(float32(12.56)),
+		},
+		{
+			name:    "case6 float32 with register swap",
+			visitor: VisitorConfigData{DataType: DataTypeFloat, Register: HoldingRegister, Offset: 20, Limit: 2, IsRegisterSwap: true},
+			want:    float64(float32(12.56)),
+		},
+		{
+			name:    "case7 float32 with byte swap",
+			visitor: VisitorConfigData{DataType: DataTypeFloat, Register: HoldingRegister, Offset: 30, Limit: 2, IsSwap: true},
+			want:    float64(float32(12.56)),
+		},
+		{
+			name:    "case8 string",
+			visitor: VisitorConfigData{DataType: DataTypeString, Register: HoldingRegister, Offset: 40, Limit: 3},
+			want:    "kedege",
+		},
+		{
+			name:    "case9 scaled input register",
+			visitor: VisitorConfigData{DataType: DataTypeFloat, Register: InputRegister, Offset: 5, Scale: 0.1},
+			want:    23.5,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := client.GetDeviceData(visitor(c.visitor))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if f, ok := got.(float64); ok {
+				if want := c.want.(float64); math.Abs(f-want) > 1e-9 {
+					t.Errorf("got %v, want %v", f, want)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGetDeviceDataBatch(t *testing.T) {
+	client, server := newTestClient(t)
+	server.SetHoldingRegisters(0, 1, 2, 3, 4)
+	server.SetHoldingRegisters(100, 5)
+	server.SetInputRegisters(0, 6)
+
+	visitors := []*VisitorConfig{
+		visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 2}),
+		visitor(VisitorConfigData{DataType: DataTypeInt, Register: InputRegister, Offset: 0}),
+		visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 0, Limit: 2}),
+		visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 100}),
+		visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 1, Limit: 2}),
+	}
+	got, err := client.GetDeviceDataBatch(visitors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []interface{}{int64(3), int64(6), int64(1<<16 | 2), int64(5), int64(2<<16 | 3)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// offsets 0-2 are read by one request, offset 100 and the input register by the others
+	requests := server.Requests()
+	if !bytes.Equal(requests, []byte{FuncReadHoldingRegisters, FuncReadHoldingRegisters, FuncReadInputRegisters}) {
+		t.Errorf("unexpected requests %v", requests)
+	}
+}
+
+func TestBatches(t *testing.T) {
+	visitors := []*VisitorConfig{
+		visitor(VisitorConfigData{Register: HoldingRegister, Offset: 0, Limit: 100}),
+		visitor(VisitorConfigData{Register: HoldingRegister, Offset: 100, Limit: 25}),
+		// exceeds the max quantity of a read
+		visitor(VisitorConfigData{Register: HoldingRegister, Offset: 125}),
+		visitor(VisitorConfigData{Register: CoilRegister, Offset: 0, Limit: 1000}),
+		visitor(VisitorConfigData{Register: CoilRegister, Offset: 1000, Limit: 1000}),
+	}
+	want := []batch{
+		{register: CoilRegister, offset: 0, quantity: 2000, visitors: []int{3, 4}},
+		{register: HoldingRegister, offset: 0, quantity: 125, visitors: []int{0, 1}},
+		{register: HoldingRegister, offset: 125, quantity: 1, visitors: []int{2}},
+	}
+	if got := batches(visitors); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestSetDeviceData(t *testing.T) {
+	client, server := newTestClient(t)
+
+	cases := []struct {
+		name     string
+		visitor  VisitorConfigData
+		value    interface{}
+		function byte
+		check    func() bool
+	}{
+		{
+			name:     "case1 single coil",
+			visitor:  VisitorConfigData{DataType: DataTypeBoolean, Register: CoilRegister, Offset: 7},
+			value:    "true",
+			function: FuncWriteSingleCoil,
+			check:    func() bool { return server.Coils(7, 1)[0] },
+		},
+		{
+			name:     "case2 multiple coils",
+			visitor:  VisitorConfigData{DataType: DataTypeInt, Register: CoilRegister, Offset: 10, Limit: 10},
+			value:    0x201,
+			function: FuncWriteMultipleCoils,
+			check: func() bool {
+				coils := server.Coils(10, 10)
+				return coils[0] && coils[9] && !coils[1]
+			},
+		},
+		{
+			name:     "case3 single register",
+			visitor:  VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, Offset: 1},
+			value:    -2,
+			function: FuncWriteSingleRegister,
+			check:    func() bool { return server.HoldingRegisters(1, 1)[0] == 0xFFFE },
+		},
+		{
+			name:     "case4 scaled float of multiple registers",
+			visitor:  VisitorConfigData{DataType: DataTypeFloat, Register: HoldingRegister, Offset: 2, Limit: 2, Scale: 0.5, IsRegisterSwap: true},
+			value:    6.28,
+			function: FuncWriteMultipleRegisters,
+			check: func() bool {
+				return reflect.DeepEqual(server.HoldingRegisters(2, 2), []uint16{0xF5C3, 0x4148})
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := client.SetDeviceData(c.value, visitor(c.visitor)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			requests := server.Requests()
+			if last := requests[len(requests)-1]; last != c.function {
+				t.Errorf("got function %d, want %d", last, c.function)
+			}
+			if !c.check() {
+				t.Errorf("unexpected data of the server")
+			}
+		})
+	}
+
+	err := client.SetDeviceData(1, visitor(VisitorConfigData{DataType: DataTypeInt, Register: InputRegister}))
+	if err == nil {
+		t.Errorf("expected error of writing input register")
+	}
+	err = client.SetDeviceData(70000, visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister, IsUnsigned: true}))
+	if err == nil {
+		t.Errorf("expected error of overflow")
+	}
+}
+
+func TestException(t *testing.T) {
+	client, _ := newTestClient(t)
+	_, err := client.Send(PDU{Function: 0x2B, Data: []byte{0, 0, 0, 1}})
+	var exception *ExceptionError
+	if !errors.As(err, &exception) || exception.ExceptionCode != ExceptionIllegalFunction {
+		t.Fatalf("expected illegal function exception, got %v", err)
+	}
+	// the connection is kept after an exception
+	if client.transport == nil {
+		t.Errorf("connection is closed after an exception")
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	client, server := newTestClient(t)
+	server.SetHoldingRegisters(0, 42)
+	v := visitor(VisitorConfigData{DataType: DataTypeInt, Register: HoldingRegister})
+	if _, err := client.GetDeviceData(v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.CloseConnections()
+	if _, err := client.GetDeviceData(v); err == nil {
+		t.Fatalf("expected error of the closed connection")
+	}
+	got, err := client.GetDeviceData(v)
+	if err != nil {
+		t.Fatalf("failed to reconnect: %v", err)
+	}
+	if got != int64(42) {
+		t.Errorf("got %v, want 42", got)
+	}
+}
+
+func TestCRC16(t *testing.T) {
+	if crc := crc16([]byte{0x01, 0x03, 0x00, 0x00, 0x00, 0x0A}); crc != 0xCDC5 {
+		t.Errorf("got crc %#04x, want 0xCDC5", crc)
+	}
+}
+
+// fakePort replies response to any request
+type fakePort struct {
+	bytes.Buffer
+	request []byte
+}
+
+func (p *fakePort) Write(b []byte) (int, error) {
+	p.request = append(p.request, b...)
+	return len(b), nil
+}
+
+func (p *fakePort) Close() error {
+	return nil
+}
+
+func TestRTUTransporter(t *testing.T) {
+	response := []byte{0x01, FuncReadHoldingRegisters, 0x04, 0x00, 0x0A, 0x00, 0x0B}
+	response = binary.LittleEndian.AppendUint16(response, crc16(response))
+	port := &fakePort{}
+	port.Buffer.Write(response)
+
+	transport := newRTUTransporter(port, 115200, defaultTimeout)
+	got, err := transport.send(1, PDU{Function: FuncReadHoldingRegisters, Data: []byte{0x00, 0x00, 0x00, 0x02}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got.Data, []byte{0x04, 0x00, 0x0A, 0x00, 0x0B}) {
+		t.Errorf("unexpected response %x", got.Data)
+	}
+	if want := []byte{0x01, 0x03, 0x00, 0x00, 0x00, 0x02, 0xC4, 0x0B}; !bytes.Equal(port.request, want) {
+		t.Errorf("got request %x, want %x", port.request, want)
+	}
+
+	// a corrupted frame
+	response[3] ^= 0xFF
+	port.Buffer.Write(response)
+	if _, err := transport.send(1, PDU{Function: FuncReadHoldingRegisters, Data: []byte{0x00, 0x00, 0x00, 0x02}}); err == nil {
+		t.Errorf("expected crc error")
+	}
+}
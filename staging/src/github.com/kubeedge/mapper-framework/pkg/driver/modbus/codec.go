@@ -0,0 +1,307 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// orderBytes converts the registers between the order of the device and big
+// endian. Both swaps are their own inverse, so it also converts back.
+func orderBytes(v *VisitorConfigData, data []byte) []byte {
+	buf := make([]byte, len(data))
+	copy(buf, data)
+	if v.IsRegisterSwap {
+		for i, j := 0, len(buf)-2; i < j; i, j = i+2, j-2 {
+			buf[i], buf[i+1], buf[j], buf[j+1] = buf[j], buf[j+1], buf[i], buf[i+1]
+		}
+	}
+	if v.IsSwap {
+		for i := 0; i+1 < len(buf); i += 2 {
+			buf[i], buf[i+1] = buf[i+1], buf[i]
+		}
+	}
+	return buf
+}
+
+// decodeRegisters converts the registers of a holding or input register visitor to its value
+func decodeRegisters(v *VisitorConfigData, data []byte) (interface{}, error) {
+	buf := orderBytes(v, data)
+	switch v.DataType {
+	case DataTypeString:
+		return strings.TrimRight(string(buf), "\x00"), nil
+	case DataTypeBoolean:
+		for _, b := range buf {
+			if b != 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case DataTypeFloat, DataTypeDouble:
+		switch len(buf) {
+		case 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(buf))) * v.scale(), nil
+		case 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(buf)) * v.scale(), nil
+		}
+		n, err := decodeInteger(buf, v.IsUnsigned)
+		if err != nil {
+			return nil, err
+		}
+		return n * v.scale(), nil
+	case DataTypeInt, "":
+		n, err := decodeInteger(buf, v.IsUnsigned)
+		if err != nil {
+			return nil, err
+		}
+		if v.IsUnsigned && v.scale() == 1 {
+			// keep the precision of the big unsigned integers
+			return binary.BigEndian.Uint64(append(make([]byte, 8-len(buf)), buf...)), nil
+		}
+		return int64(math.Round(n * v.scale())), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %q", v.DataType)
+	}
+}
+
+// decodeInteger converts 1, 2 or 4 big endian registers to an integer
+func decodeInteger(buf []byte, unsigned bool) (float64, error) {
+	switch len(buf) {
+	case 2:
+		u := binary.BigEndian.Uint16(buf)
+		if unsigned {
+			return float64(u), nil
+		}
+		return float64(int16(u)), nil
+	case 4:
+		u := binary.BigEndian.Uint32(buf)
+		if unsigned {
+			return float64(u), nil
+		}
+		return float64(int32(u)), nil
+	case 8:
+		u := binary.BigEndian.Uint64(buf)
+		if unsigned {
+			return float64(u), nil
+		}
+		return float64(int64(u)), nil
+	default:
+		return 0, fmt.Errorf("integer of %d registers is unsupported, the limit should be 1, 2 or 4", len(buf)/2)
+	}
+}
+
+// encodeRegisters converts value to the registers of a holding register visitor
+func encodeRegisters(v *VisitorConfigData, value interface{}) ([]byte, error) {
+	size := int(v.limit()) * 2
+	buf := make([]byte, size)
+	switch v.DataType {
+	case DataTypeString:
+		s := fmt.Sprint(value)
+		if len(s) > size {
+			return nil, fmt.Errorf("string of %d bytes exceeds %d registers", len(s), v.limit())
+		}
+		copy(buf, s)
+		return orderBytes(v, buf), nil
+	case DataTypeBoolean:
+		b, err := toBool(value)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			buf[size-1] = 1
+		}
+		return orderBytes(v, buf), nil
+	}
+
+	f, err := toFloat64(value)
+	if err != nil {
+		return nil, err
+	}
+	f /= v.scale()
+	if v.DataType == DataTypeFloat || v.DataType == DataTypeDouble {
+		switch size {
+		case 4:
+			binary.BigEndian.PutUint32(buf, math.Float32bits(float32(f)))
+			return orderBytes(v, buf), nil
+		case 8:
+			binary.BigEndian.PutUint64(buf, math.Float64bits(f))
+			return orderBytes(v, buf), nil
+		}
+	} else if v.DataType != DataTypeInt && v.DataType != "" {
+		return nil, fmt.Errorf("unsupported data type %q", v.DataType)
+	}
+
+	f = math.Round(f)
+	bits := size * 8
+	if size != 2 && size != 4 && size != 8 {
+		return nil, fmt.Errorf("integer of %d registers is unsupported, the limit should be 1, 2 or 4", v.limit())
+	}
+	var u uint64
+	if v.IsUnsigned {
+		if f < 0 || f >= math.Exp2(float64(bits)) {
+			return nil, fmt.Errorf("value %v overflows %d bits unsigned integer", value, bits)
+		}
+		u = uint64(f)
+	} else {
+		if f < -math.Exp2(float64(bits-1)) || f >= math.Exp2(float64(bits-1)) {
+			return nil, fmt.Errorf("value %v overflows %d bits integer", value, bits)
+		}
+		u = uint64(int64(f))
+	}
+	switch size {
+	case 2:
+		binary.BigEndian.PutUint16(buf, uint16(u))
+	case 4:
+		binary.BigEndian.PutUint32(buf, uint32(u))
+	case 8:
+		binary.BigEndian.PutUint64(buf, u)
+	}
+	return orderBytes(v, buf), nil
+}
+
+// decodeBits converts the bits of a coil or discrete input visitor to its value,
+// the first bit is the least significant one of an integer
+func decodeBits(v *VisitorConfigData, bits []bool) (interface{}, error) {
+	switch v.DataType {
+	case DataTypeBoolean:
+		if len(bits) != 1 {
+			return nil, fmt.Errorf("boolean needs 1 bit, but the limit is %d", len(bits))
+		}
+		return bits[0], nil
+	case DataTypeInt, "":
+		if len(bits) > 64 {
+			return nil, fmt.Errorf("integer of %d bits is unsupported", len(bits))
+		}
+		var n int64
+		for i, bit := range bits {
+			if bit {
+				n |= 1 << i
+			}
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %q of bits", v.DataType)
+	}
+}
+
+// encodeBits converts value to the bits of a coil visitor
+func encodeBits(v *VisitorConfigData, value interface{}) ([]bool, error) {
+	limit := int(v.limit())
+	bits := make([]bool, limit)
+	switch v.DataType {
+	case DataTypeBoolean:
+		b, err := toBool(value)
+		if err != nil {
+			return nil, err
+		}
+		if limit != 1 {
+			return nil, fmt.Errorf("boolean needs 1 bit, but the limit is %d", limit)
+		}
+		bits[0] = b
+	case DataTypeInt, "":
+		f, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		if limit > 64 || f < 0 || f >= math.Exp2(float64(limit)) {
+			return nil, fmt.Errorf("value %v overflows %d bits", value, limit)
+		}
+		n := uint64(f)
+		for i := range bits {
+			bits[i] = n&(1<<i) != 0
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type %q of bits", v.DataType)
+	}
+	return bits, nil
+}
+
+// unpackBits returns the first n bits of data, the bits are packed from the lowest bit of each byte
+func unpackBits(data []byte, n int) []bool {
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = data[i/8]&(1<<(i%8)) != 0
+	}
+	return bits
+}
+
+func packBits(bits []bool) []byte {
+	data := make([]byte, (len(bits)+7)/8)
+	for i, bit := range bits {
+		if bit {
+			data[i/8] |= 1 << (i % 8)
+		}
+	}
+	return data
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int8:
+		return float64(v), nil
+	case int16:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint8:
+		return float64(v), nil
+	case uint16:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	default:
+		f, err := toFloat64(value)
+		if err != nil {
+			return false, err
+		}
+		return f != 0, nil
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modbus
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProtocolName is the protocol name of the modbus devices
+const ProtocolName = "modbus"
+
+// Transport definition.
+const (
+	TransportTCP = "tcp"
+	TransportRTU = "rtu"
+)
+
+// Register types, which are the modbus data tables.
+const (
+	CoilRegister          = "CoilRegister"
+	DiscreteInputRegister = "DiscreteInputRegister"
+	HoldingRegister       = "HoldingRegister"
+	InputRegister         = "InputRegister"
+)
+
+// Data types of the visitor, they are the lower case data types of the device property.
+const (
+	DataTypeInt     = "int"
+	DataTypeFloat   = "float"
+	DataTypeDouble  = "double"
+	DataTypeBoolean = "boolean"
+	DataTypeString  = "string"
+)
+
+const (
+	defaultTimeout  = time.Second
+	defaultBaudRate = 19200
+	defaultDataBits = 8
+	defaultStopBits = 1
+	defaultParity   = "E"
+)
+
+// ProtocolConfig is the protocol config of a modbus device
+type ProtocolConfig struct {
+	ProtocolName string `json:"protocolName"`
+	ConfigData   `json:"configData"`
+}
+
+// ConfigData is the connection of a modbus device
+type ConfigData struct {
+	// Transport is tcp or rtu, default tcp
+	Transport string `json:"transport,omitempty"`
+	// SlaveID is the unit identifier of the device
+	SlaveID byte `json:"slaveID,omitempty"`
+	// Address is the host:port of the device for tcp
+	Address string `json:"address,omitempty"`
+	// SerialPort is the serial port of the device for rtu, like /dev/ttyS0
+	SerialPort string `json:"serialPort,omitempty"`
+	// BaudRate of the serial port, default 19200
+	BaudRate int `json:"baudRate,omitempty"`
+	// DataBits of the serial port, default 8
+	DataBits int `json:"dataBits,omitempty"`
+	// Parity of the serial port, N(none), E(even) or O(odd), default E
+	Parity string `json:"parity,omitempty"`
+	// StopBits of the serial port, default 1
+	StopBits int `json:"stopBits,omitempty"`
+	// Timeout of a request in milliseconds, default 1000
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// VisitorConfig is the visitor config of a device property
+type VisitorConfig struct {
+	ProtocolName      string `json:"protocolName"`
+	VisitorConfigData `json:"configData"`
+}
+
+// VisitorConfigData tells where the property is and how to convert it
+type VisitorConfigData struct {
+	// DataType is the data type of the property
+	DataType string `json:"dataType"`
+	// Register is the register type of the property
+	Register string `json:"register"`
+	// Offset is the address of the first register
+	Offset uint16 `json:"offset"`
+	// Limit is the number of registers of the property, default 1
+	Limit uint16 `json:"limit,omitempty"`
+	// Scale multiplies the read value and divides the written value, default 1
+	Scale float64 `json:"scale,omitempty"`
+	// IsSwap swaps the two bytes of each register, the registers are big endian by default
+	IsSwap bool `json:"isSwap,omitempty"`
+	// IsRegisterSwap reverses the order of the registers, the first register is
+	// the most significant one by default
+	IsRegisterSwap bool `json:"isRegisterSwap,omitempty"`
+	// IsUnsigned reads integers as unsigned
+	IsUnsigned bool `json:"isUnsigned,omitempty"`
+}
+
+// ParseProtocolConfig parses the ConfigData of a device protocol
+func ParseProtocolConfig(data []byte) (ProtocolConfig, error) {
+	var config ProtocolConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("unmarshal modbus protocol config error: %v", err)
+	}
+	return config, nil
+}
+
+// ParseVisitorConfig parses the visitors of a device property
+func ParseVisitorConfig(data []byte) (VisitorConfig, error) {
+	var visitor VisitorConfig
+	if err := json.Unmarshal(data, &visitor); err != nil {
+		return visitor, fmt.Errorf("unmarshal modbus visitor config error: %v", err)
+	}
+	visitor.DataType = strings.ToLower(visitor.DataType)
+	return visitor, nil
+}
+
+func (c *ConfigData) setDefaults() {
+	if c.Transport == "" {
+		c.Transport = TransportTCP
+	}
+	if c.BaudRate == 0 {
+		c.BaudRate = defaultBaudRate
+	}
+	if c.DataBits == 0 {
+		c.DataBits = defaultDataBits
+	}
+	if c.StopBits == 0 {
+		c.StopBits = defaultStopBits
+	}
+	if c.Parity == "" {
+		c.Parity = defaultParity
+	}
+}
+
+func (c *ConfigData) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultTimeout
+	}
+	return time.Duration(c.Timeout) * time.Millisecond
+}
+
+func (c *ConfigData) validate() error {
+	switch c.Transport {
+	case TransportTCP:
+		if c.Address == "" {
+			return fmt.Errorf("address is required by the %s transport", c.Transport)
+		}
+	case TransportRTU:
+		if c.SerialPort == "" {
+			return fmt.Errorf("serialPort is required by the %s transport", c.Transport)
+		}
+		if c.Parity != "N" && c.Parity != "E" && c.Parity != "O" {
+			return fmt.Errorf("unsupported parity %q", c.Parity)
+		}
+	default:
+		return fmt.Errorf("unsupported transport %q", c.Transport)
+	}
+	return nil
+}
+
+func (v *VisitorConfigData) limit() uint16 {
+	if v.Limit == 0 {
+		return 1
+	}
+	return v.Limit
+}
+
+func (v *VisitorConfigData) scale() float64 {
+	if v.Scale == 0 {
+		return 1
+	}
+	return v.Scale
+}
+
+func (v *VisitorConfigData) validate() error {
+	limit := v.limit()
+	switch v.Register {
+	case CoilRegister, DiscreteInputRegister:
+		if limit > maxReadBits {
+			return fmt.Errorf("limit %d exceeds %d bits", limit, maxReadBits)
+		}
+	case HoldingRegister, InputRegister:
+		if limit > maxReadRegisters {
+			return fmt.Errorf("limit %d exceeds %d registers", limit, maxReadRegisters)
+		}
+	default:
+		return fmt.Errorf("unsupported register %q", v.Register)
+	}
+	if uint32(v.Offset)+uint32(limit) > 0x10000 {
+		return fmt.Errorf("offset %d and limit %d exceed the address space", v.Offset, limit)
+	}
+	return nil
+}
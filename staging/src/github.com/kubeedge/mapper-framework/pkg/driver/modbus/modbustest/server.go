@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modbustest provides an in-process modbus TCP slave to test the mappers.
+package modbustest
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	addressSpace = 0x10000
+
+	exceptionIllegalFunction    = 0x01
+	exceptionIllegalDataAddress = 0x02
+	exceptionIllegalDataValue   = 0x03
+)
+
+// Server is a modbus TCP slave which keeps its four data tables in memory
+type Server struct {
+	// Addr is the host:port the server listens on
+	Addr string
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mutex            sync.Mutex
+	conns            map[net.Conn]struct{}
+	coils            []bool
+	discreteInputs   []bool
+	holdingRegisters []uint16
+	inputRegisters   []uint16
+	requests         []byte
+}
+
+// NewServer starts a Server on a random port of the loopback address
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Addr:             listener.Addr().String(),
+		listener:         listener,
+		conns:            make(map[net.Conn]struct{}),
+		coils:            make([]bool, addressSpace),
+		discreteInputs:   make([]bool, addressSpace),
+		holdingRegisters: make([]uint16, addressSpace),
+		inputRegisters:   make([]uint16, addressSpace),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close stops the server and closes its connections
+func (s *Server) Close() {
+	s.listener.Close()
+	s.CloseConnections()
+	s.wg.Wait()
+}
+
+// CloseConnections closes the accepted connections, like a restart of the device
+func (s *Server) CloseConnections() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// SetCoils sets the coils from address
+func (s *Server) SetCoils(address uint16, values ...bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	copy(s.coils[address:], values)
+}
+
+// Coils returns quantity coils from address
+func (s *Server) Coils(address, quantity uint16) []bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]bool{}, s.coils[address:int(address)+int(quantity)]...)
+}
+
+// SetDiscreteInputs sets the discrete inputs from address
+func (s *Server) SetDiscreteInputs(address uint16, values ...bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	copy(s.discreteInputs[address:], values)
+}
+
+// SetHoldingRegisters sets the holding registers from address
+func (s *Server) SetHoldingRegisters(address uint16, values ...uint16) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	copy(s.holdingRegisters[address:], values)
+}
+
+// HoldingRegisters returns quantity holding registers from address
+func (s *Server) HoldingRegisters(address, quantity uint16) []uint16 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]uint16{}, s.holdingRegisters[address:int(address)+int(quantity)]...)
+}
+
+// SetInputRegisters sets the input registers from address
+func (s *Server) SetInputRegisters(address uint16, values ...uint16) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	copy(s.inputRegisters[address:], values)
+}
+
+// Requests returns the function codes of the served requests in order
+func (s *Server) Requests() []byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]byte{}, s.requests...)
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.conns[conn] = struct{}{}
+		s.mutex.Unlock()
+		s.wg.Add(1)
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, conn)
+		s.mutex.Unlock()
+		conn.Close()
+	}()
+
+	header := make([]byte, 7)
+	for {
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		length := int(binary.BigEndian.Uint16(header[4:]))
+		if length < 2 {
+			return
+		}
+		body := make([]byte, length-1)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+		response := s.handle(body[0], body[1:])
+		adu := make([]byte, 7, 7+len(response))
+		copy(adu, header[:4])
+		binary.BigEndian.PutUint16(adu[4:], uint16(1+len(response)))
+		adu[6] = header[6]
+		if _, err := conn.Write(append(adu, response...)); err != nil {
+			return
+		}
+	}
+}
+
+// handle serves the request pdu and returns the response pdu
+func (s *Server) handle(function byte, data []byte) []byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, function)
+
+	response, err := s.handleLocked(function, data)
+	var code exceptionCode
+	if errors.As(err, &code) {
+		return []byte{function | 0x80, byte(code)}
+	}
+	return append([]byte{function}, response...)
+}
+
+type exceptionCode byte
+
+func (e exceptionCode) Error() string {
+	return "modbus exception"
+}
+
+func (s *Server) handleLocked(function byte, data []byte) ([]byte, error) {
+	if len(data) < 4 {
+		return nil, exceptionCode(exceptionIllegalDataValue)
+	}
+	address := int(binary.BigEndian.Uint16(data[0:]))
+	value := binary.BigEndian.Uint16(data[2:])
+	quantity := int(value)
+
+	switch function {
+	case 0x01, 0x02:
+		if quantity < 1 || quantity > 2000 {
+			return nil, exceptionCode(exceptionIllegalDataValue)
+		}
+		if address+quantity > addressSpace {
+			return nil, exceptionCode(exceptionIllegalDataAddress)
+		}
+		table := s.coils
+		if function == 0x02 {
+			table = s.discreteInputs
+		}
+		packed := make([]byte, (quantity+7)/8)
+		for i := 0; i < quantity; i++ {
+			if table[address+i] {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		return append([]byte{byte(len(packed))}, packed...), nil
+	case 0x03, 0x04:
+		if quantity < 1 || quantity > 125 {
+			return nil, exceptionCode(exceptionIllegalDataValue)
+		}
+		if address+quantity > addressSpace {
+			return nil, exceptionCode(exceptionIllegalDataAddress)
+		}
+		table := s.holdingRegisters
+		if function == 0x04 {
+			table = s.inputRegisters
+		}
+		response := []byte{byte(quantity * 2)}
+		for i := 0; i < quantity; i++ {
+			response = binary.BigEndian.AppendUint16(response, table[address+i])
+		}
+		return response, nil
+	case 0x05:
+		if value != 0xFF00 && value != 0x0000 {
+			return nil, exceptionCode(exceptionIllegalDataValue)
+		}
+		s.coils[address] = value == 0xFF00
+		return data[:4], nil
+	case 0x06:
+		s.holdingRegisters[address] = value
+		return data[:4], nil
+	case 0x0F, 0x10:
+		if len(data) < 5 || int(data[4]) != len(data)-5 || quantity < 1 {
+			return nil, exceptionCode(exceptionIllegalDataValue)
+		}
+		if address+quantity > addressSpace {
+			return nil, exceptionCode(exceptionIllegalDataAddress)
+		}
+		values := data[5:]
+		if function == 0x0F {
+			if len(values) != (quantity+7)/8 {
+				return nil, exceptionCode(exceptionIllegalDataValue)
+			}
+			for i := 0; i < quantity; i++ {
+				s.coils[address+i] = values[i/8]&(1<<(i%8)) != 0
+			}
+		} else {
+			if len(values) != quantity*2 {
+				return nil, exceptionCode(exceptionIllegalDataValue)
+			}
+			for i := 0; i < quantity; i++ {
+				s.holdingRegisters[address+i] = binary.BigEndian.Uint16(values[i*2:])
+			}
+		}
+		return data[:4], nil
+	default:
+		return nil, exceptionCode(exceptionIllegalFunction)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// Function codes.
+const (
+	FuncReadCoils              = 0x01
+	FuncReadDiscreteInputs     = 0x02
+	FuncReadHoldingRegisters   = 0x03
+	FuncReadInputRegisters     = 0x04
+	FuncWriteSingleCoil        = 0x05
+	FuncWriteSingleRegister    = 0x06
+	FuncWriteMultipleCoils     = 0x0F
+	FuncWriteMultipleRegisters = 0x10
+)
+
+// Exception codes.
+const (
+	ExceptionIllegalFunction    = 0x01
+	ExceptionIllegalDataAddress = 0x02
+	ExceptionIllegalDataValue   = 0x03
+	ExceptionSlaveDeviceFailure = 0x04
+)
+
+// The quantity limits of the modbus application protocol.
+const (
+	maxReadBits       = 2000
+	maxReadRegisters  = 125
+	maxWriteBits      = 1968
+	maxWriteRegisters = 123
+)
+
+const (
+	tcpHeaderSize = 7
+	// the max size of a pdu is 253 bytes
+	maxPDUSize = 253
+)
+
+// ExceptionError is the exception response of a device
+type ExceptionError struct {
+	Function      byte
+	ExceptionCode byte
+}
+
+func (e *ExceptionError) Error() string {
+	return fmt.Sprintf("modbus exception %d of function %d", e.ExceptionCode, e.Function)
+}
+
+// PDU is the protocol data unit, which is independent of the transport
+type PDU struct {
+	Function byte
+	Data     []byte
+}
+
+// transporter sends a request to a slave and receives its response
+type transporter interface {
+	send(slaveID byte, request PDU) (PDU, error)
+	close() error
+}
+
+// responsePDU checks the exception of response
+func responsePDU(request, response PDU) (PDU, error) {
+	if response.Function == request.Function|0x80 {
+		if len(response.Data) != 1 {
+			return response, fmt.Errorf("invalid exception response length %d", len(response.Data))
+		}
+		return response, &ExceptionError{Function: request.Function, ExceptionCode: response.Data[0]}
+	}
+	if response.Function != request.Function {
+		return response, fmt.Errorf("response function %d does not match request function %d",
+			response.Function, request.Function)
+	}
+	return response, nil
+}
+
+// tcpTransporter frames the pdus with the MBAP header of modbus TCP
+type tcpTransporter struct {
+	conn          net.Conn
+	timeout       time.Duration
+	transactionID uint16
+}
+
+func dialTCP(address string, timeout time.Duration) (*tcpTransporter, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &tcpTransporter{conn: conn, timeout: timeout}, nil
+}
+
+func (t *tcpTransporter) send(slaveID byte, request PDU) (PDU, error) {
+	t.transactionID++
+	adu := make([]byte, tcpHeaderSize+1+len(request.Data))
+	binary.BigEndian.PutUint16(adu[0:], t.transactionID)
+	// protocol identifier 0 is modbus
+	binary.BigEndian.PutUint16(adu[2:], 0)
+	binary.BigEndian.PutUint16(adu[4:], uint16(2+len(request.Data)))
+	adu[6] = slaveID
+	adu[7] = request.Function
+	copy(adu[8:], request.Data)
+
+	if err := t.conn.SetDeadline(time.Now().Add(t.timeout)); err != nil {
+		return PDU{}, err
+	}
+	if _, err := t.conn.Write(adu); err != nil {
+		return PDU{}, err
+	}
+
+	header := make([]byte, tcpHeaderSize)
+	if _, err := io.ReadFull(t.conn, header); err != nil {
+		return PDU{}, err
+	}
+	length := int(binary.BigEndian.Uint16(header[4:]))
+	if length < 2 || length > maxPDUSize+1 {
+		return PDU{}, fmt.Errorf("invalid response length %d", length)
+	}
+	body := make([]byte, length-1)
+	if _, err := io.ReadFull(t.conn, body); err != nil {
+		return PDU{}, err
+	}
+	if id := binary.BigEndian.Uint16(header[0:]); id != t.transactionID {
+		return PDU{}, fmt.Errorf("response transaction id %d does not match request transaction id %d", id, t.transactionID)
+	}
+	if header[6] != slaveID {
+		return PDU{}, fmt.Errorf("response slave id %d does not match request slave id %d", header[6], slaveID)
+	}
+	return responsePDU(request, PDU{Function: body[0], Data: body[1:]})
+}
+
+func (t *tcpTransporter) close() error {
+	return t.conn.Close()
+}
+
+// rtuTransporter frames the pdus with the slave id and CRC of modbus RTU
+type rtuTransporter struct {
+	port    io.ReadWriteCloser
+	timeout time.Duration
+	// frameDelay is the silent interval of 3.5 characters between frames
+	frameDelay time.Duration
+	lastActive time.Time
+}
+
+func newRTUTransporter(port io.ReadWriteCloser, baudRate int, timeout time.Duration) *rtuTransporter {
+	// a character has 11 bits, the interval is fixed to 1750us above 19200 bps
+	frameDelay := 1750 * time.Microsecond
+	if baudRate > 0 && baudRate <= 19200 {
+		frameDelay = time.Duration(35*11*int64(time.Second)/int64(baudRate)) / 10
+	}
+	return &rtuTransporter{port: port, timeout: timeout, frameDelay: frameDelay}
+}
+
+func (t *rtuTransporter) send(slaveID byte, request PDU) (PDU, error) {
+	adu := make([]byte, 0, 4+len(request.Data))
+	adu = append(adu, slaveID, request.Function)
+	adu = append(adu, request.Data...)
+	adu = binary.LittleEndian.AppendUint16(adu, crc16(adu))
+
+	if d := time.Until(t.lastActive.Add(t.frameDelay)); d > 0 {
+		time.Sleep(d)
+	}
+	defer func() { t.lastActive = time.Now() }()
+	if port, ok := t.port.(interface{ SetDeadline(time.Time) error }); ok {
+		if err := port.SetDeadline(time.Now().Add(t.timeout)); err != nil {
+			return PDU{}, err
+		}
+	}
+	if _, err := t.port.Write(adu); err != nil {
+		return PDU{}, err
+	}
+
+	// slave id, function and the first byte of the data
+	head := make([]byte, 3)
+	if _, err := io.ReadFull(t.port, head); err != nil {
+		return PDU{}, err
+	}
+	var remaining int
+	switch {
+	case head[1]&0x80 != 0:
+		remaining = 2
+	case head[1] <= FuncReadInputRegisters:
+		// the first byte is the byte count
+		remaining = int(head[2]) + 2
+	default:
+		// the echo of the address and value or quantity
+		remaining = 3 + 2
+	}
+	frame := make([]byte, len(head)+remaining)
+	copy(frame, head)
+	if _, err := io.ReadFull(t.port, frame[len(head):]); err != nil {
+		return PDU{}, err
+	}
+	n := len(frame) - 2
+	if crc := binary.LittleEndian.Uint16(frame[n:]); crc != crc16(frame[:n]) {
+		return PDU{}, fmt.Errorf("response crc %#04x mismatches", crc)
+	}
+	if frame[0] != slaveID {
+		return PDU{}, fmt.Errorf("response slave id %d does not match request slave id %d", frame[0], slaveID)
+	}
+	return responsePDU(request, PDU{Function: frame[1], Data: frame[2:n]})
+}
+
+func (t *rtuTransporter) close() error {
+	return t.port.Close()
+}
+
+// crc16 is the CRC-16/MODBUS checksum
+func crc16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
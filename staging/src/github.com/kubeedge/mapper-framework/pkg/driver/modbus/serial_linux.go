@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modbus
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+var baudRates = map[int]uint32{
+	1200:   unix.B1200,
+	2400:   unix.B2400,
+	4800:   unix.B4800,
+	9600:   unix.B9600,
+	19200:  unix.B19200,
+	38400:  unix.B38400,
+	57600:  unix.B57600,
+	115200: unix.B115200,
+	230400: unix.B230400,
+}
+
+var dataBits = map[int]uint32{
+	5: unix.CS5,
+	6: unix.CS6,
+	7: unix.CS7,
+	8: unix.CS8,
+}
+
+// openSerialPort opens the serial port in raw mode. It's opened non-blocking,
+// so the deadlines of the requests work.
+func openSerialPort(c *ConfigData) (io.ReadWriteCloser, error) {
+	speed, ok := baudRates[c.BaudRate]
+	if !ok {
+		return nil, fmt.Errorf("unsupported baud rate %d", c.BaudRate)
+	}
+	size, ok := dataBits[c.DataBits]
+	if !ok {
+		return nil, fmt.Errorf("unsupported data bits %d", c.DataBits)
+	}
+	termios := unix.Termios{
+		Iflag:  unix.IGNPAR,
+		Cflag:  speed | size | unix.CREAD | unix.CLOCAL,
+		Ispeed: speed,
+		Ospeed: speed,
+	}
+	if c.StopBits == 2 {
+		termios.Cflag |= unix.CSTOPB
+	}
+	switch c.Parity {
+	case "E":
+		termios.Cflag |= unix.PARENB
+	case "O":
+		termios.Cflag |= unix.PARENB | unix.PARODD
+	}
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+
+	f, err := os.OpenFile(c.SerialPort, os.O_RDWR|unix.O_NOCTTY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Fd() would switch the file to blocking mode, so use the raw conn
+	rawConn, err := f.SyscallConn()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	var ioctlErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		ioctlErr = unix.IoctlSetTermios(int(fd), unix.TCSETS, &termios)
+	}); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if ioctlErr != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to configure serial port %s: %v", c.SerialPort, ioctlErr)
+	}
+	return f, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package opcua is an OPC UA driver for the mappers. Its Client has the methods of
+// driver.CustomizedClient of the mapper template, so an OPC UA mapper can forward
+// them to the Client with the ConfigData of its device and visitors. The twins of
+// the properties with CollectCycle 0 should be collected by SubscribeDeviceData,
+// which pushes the changes of the nodes instead of polling them.
+package opcua
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+// Client is the OPC UA client of a device. The gopcua client is closed after its
+// connection is lost, and a new one is connected by the next request.
+type Client struct {
+	ProtocolConfig
+	security *security
+
+	mutex  sync.Mutex
+	client *opcua.Client
+
+	subscription
+}
+
+// NewClient returns the Client of the device of protocol
+func NewClient(protocol ProtocolConfig) (*Client, error) {
+	sec, err := protocol.security()
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{ProtocolConfig: protocol, security: sec}
+	c.subscription.init()
+	return c, nil
+}
+
+// InitDevice connects to the device
+func (c *Client) InitDevice() error {
+	_, err := c.getClient()
+	return err
+}
+
+// getClient returns the connected gopcua client, it connects if there is none
+func (c *Client) getClient() (*opcua.Client, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.client != nil && c.client.State() != opcua.Closed {
+		return c.client, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+	opts, err := c.clientOptions(ctx, c.security)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect opcua device: %v", err)
+	}
+	client, err := opcua.NewClient(c.Endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect opcua device: %v", err)
+	}
+	if err := client.Connect(ctx); err != nil {
+		_ = client.Close(ctx)
+		return nil, fmt.Errorf("failed to connect opcua device: %v", err)
+	}
+	c.client = client
+	return client, nil
+}
+
+// StopDevice cancels the subscriptions and disconnects from the device
+func (c *Client) StopDevice() error {
+	c.subscription.stop()
+	c.mutex.Lock()
+	client := c.client
+	c.client = nil
+	c.mutex.Unlock()
+	if client != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+		defer cancel()
+		if err := client.Close(ctx); err != nil {
+			klog.V(4).Infof("failed to close opcua client of %s: %v", c.Endpoint, err)
+		}
+	}
+	return nil
+}
+
+// GetDeviceStates returns DeviceStatusOK if the device is connected
+func (c *Client) GetDeviceStates() (string, error) {
+	client, err := c.getClient()
+	if err != nil || client.State() != opcua.Connected {
+		return common.DeviceStatusDisCONN, nil
+	}
+	return common.DeviceStatusOK, nil
+}
+
+// readValue reads the value of node
+func (c *Client) readValue(node *ua.NodeID) (interface{}, error) {
+	client, err := c.getClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+	response, err := client.Read(ctx, &ua.ReadRequest{
+		TimestampsToReturn: ua.TimestampsToReturnNeither,
+		NodesToRead:        []*ua.ReadValueID{{NodeID: node, AttributeID: ua.AttributeIDValue}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", node, err)
+	}
+	if len(response.Results) != 1 {
+		return nil, fmt.Errorf("failed to read %s: %d results of 1 node", node, len(response.Results))
+	}
+	result := response.Results[0]
+	if result.Status != ua.StatusOK && result.Status != ua.StatusGood {
+		return nil, fmt.Errorf("failed to read %s: %v", node, result.Status)
+	}
+	if result.Value == nil {
+		return nil, nil
+	}
+	return result.Value.Value(), nil
+}
+
+// GetDeviceData reads the value of visitor
+func (c *Client) GetDeviceData(visitor *VisitorConfig) (interface{}, error) {
+	node, err := ua.ParseNodeID(visitor.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	value, err := c.readValue(node)
+	if err != nil {
+		return nil, err
+	}
+	return convertValue(visitor.DataType, value)
+}
+
+// DeviceDataWrite writes data to visitor
+func (c *Client) DeviceDataWrite(visitor *VisitorConfig, deviceMethodName string, propertyName string, data interface{}) error {
+	klog.V(4).Infof("write %v to property %s by method %s", data, propertyName, deviceMethodName)
+	return c.SetDeviceData(data, visitor)
+}
+
+// SetDeviceData writes data to visitor, data is converted to the type of the current value of the node
+func (c *Client) SetDeviceData(data interface{}, visitor *VisitorConfig) error {
+	node, err := ua.ParseNodeID(visitor.NodeID)
+	if err != nil {
+		return err
+	}
+	current, err := c.readValue(node)
+	if err != nil {
+		return err
+	}
+	value, err := toVariantValue(data, current)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", node, err)
+	}
+	variant, err := ua.NewVariant(value)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", node, err)
+	}
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+	response, err := client.Write(ctx, &ua.WriteRequest{
+		NodesToWrite: []*ua.WriteValue{{
+			NodeID:      node,
+			AttributeID: ua.AttributeIDValue,
+			Value:       &ua.DataValue{EncodingMask: ua.DataValueValue, Value: variant},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", node, err)
+	}
+	if len(response.Results) != 1 {
+		return fmt.Errorf("failed to write %s: %d results of 1 node", node, len(response.Results))
+	}
+	if status := response.Results[0]; status != ua.StatusOK && status != ua.StatusGood {
+		return fmt.Errorf("failed to write %s: %v", node, status)
+	}
+	return nil
+}
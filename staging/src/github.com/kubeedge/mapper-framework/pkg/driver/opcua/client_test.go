@@ -0,0 +1,457 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opcua
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/driver/opcua/opcuatest"
+)
+
+func newTestServer(t *testing.T) *opcuatest.Server {
+	s, err := opcuatest.NewServer()
+	if err != nil {
+		t.Fatalf("failed to start opcua server: %v", err)
+	}
+	t.Cleanup(s.Close)
+	s.SetValue("ns=2;s=Temperature", 21.5)
+	s.SetValue("ns=2;s=Counter", int32(7))
+	s.SetValue("ns=2;s=Level", uint8(200))
+	s.SetValue("ns=2;s=Running", true)
+	s.SetValue("ns=2;i=1001", "idle")
+	return s
+}
+
+func newTestClient(t *testing.T, config ConfigData) *Client {
+	c, err := NewClient(ProtocolConfig{ProtocolName: ProtocolName, ConfigData: config})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = c.StopDevice() })
+	return c
+}
+
+func visitor(node, dataType string) *VisitorConfig {
+	return &VisitorConfig{ProtocolName: ProtocolName, VisitorConfigData: VisitorConfigData{NodeID: node, DataType: dataType}}
+}
+
+func TestGetDeviceData(t *testing.T) {
+	s := newTestServer(t)
+	c := newTestClient(t, ConfigData{Endpoint: s.Endpoint})
+	if err := c.InitDevice(); err != nil {
+		t.Fatalf("InitDevice() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		visitor *VisitorConfig
+		expect  interface{}
+		wantErr bool
+	}{
+		{name: "case1 double", visitor: visitor("ns=2;s=Temperature", DataTypeDouble), expect: 21.5},
+		{name: "case2 int", visitor: visitor("ns=2;s=Counter", DataTypeInt), expect: int64(7)},
+		{name: "case3 boolean", visitor: visitor("ns=2;s=Running", DataTypeBoolean), expect: true},
+		{name: "case4 string of numeric node", visitor: visitor("ns=2;i=1001", DataTypeString), expect: "idle"},
+		{name: "case5 double as string", visitor: visitor("ns=2;s=Temperature", DataTypeString), expect: "21.5"},
+		{name: "case6 raw value", visitor: visitor("ns=2;s=Level", ""), expect: uint8(200)},
+		{name: "case7 unknown node", visitor: visitor("ns=2;s=Unknown", DataTypeInt), wantErr: true},
+		{name: "case8 invalid node id", visitor: visitor("Temperature", DataTypeInt), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetDeviceData(tt.visitor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDeviceData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expect {
+				t.Errorf("GetDeviceData() = %#v, want %#v", got, tt.expect)
+			}
+		})
+	}
+
+	state, err := c.GetDeviceStates()
+	if err != nil || state != common.DeviceStatusOK {
+		t.Errorf("GetDeviceStates() = %s, %v, want %s", state, err, common.DeviceStatusOK)
+	}
+}
+
+func TestSetDeviceData(t *testing.T) {
+	s := newTestServer(t)
+	c := newTestClient(t, ConfigData{Endpoint: s.Endpoint})
+
+	tests := []struct {
+		name    string
+		node    string
+		data    interface{}
+		expect  interface{}
+		wantErr bool
+	}{
+		{name: "case1 string to int32", node: "ns=2;s=Counter", data: "12", expect: int32(12)},
+		{name: "case2 float to double", node: "ns=2;s=Temperature", data: float32(3.5), expect: 3.5},
+		{name: "case3 string to boolean", node: "ns=2;s=Running", data: "false", expect: false},
+		{name: "case4 int to string", node: "ns=2;i=1001", data: 5, expect: "5"},
+		{name: "case5 overflow", node: "ns=2;s=Level", data: 300, wantErr: true},
+		{name: "case6 fraction to integer", node: "ns=2;s=Counter", data: 1.5, wantErr: true},
+		{name: "case7 unknown node", node: "ns=2;s=Unknown", data: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.DeviceDataWrite(visitor(tt.node, ""), "SetValue", tt.name, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeviceDataWrite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && s.Value(tt.node) != tt.expect {
+				t.Errorf("value of %s = %#v, want %#v", tt.node, s.Value(tt.node), tt.expect)
+			}
+		})
+	}
+}
+
+func TestSecurity(t *testing.T) {
+	s := newTestServer(t)
+	dir := t.TempDir()
+	certFile, keyFile, _, err := opcuatest.WriteKeyPair(dir, "mapper", "urn:kubeedge:test:mapper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userCertFile, userKeyFile, _, err := opcuatest.WriteKeyPair(dir, "user", "urn:kubeedge:test:user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	untrustedCertFile, _, _, err := opcuatest.WriteKeyPair(dir, "untrusted", "urn:kubeedge:test:untrusted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the DER certificate of the server
+	serverCertFile := filepath.Join(dir, "server.der")
+	if err := os.WriteFile(serverCertFile, s.Certificate, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// the gopcua server opens the secure channels without security only, so the
+	// secured clients are tested up to the selection of the endpoint
+	secured := ConfigData{Endpoint: s.Endpoint, Certificate: certFile, PrivateKey: keyFile, ServerCertificate: serverCertFile}
+	tests := []struct {
+		name    string
+		config  func(ConfigData) ConfigData
+		wantErr bool
+	}{
+		{
+			name: "case1 sign and encrypt with password",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy = "Basic256Sha256"
+				c.Username, c.Password = "admin", "secret"
+				return c
+			},
+		},
+		{
+			name: "case2 sign with user certificate",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy, c.SecurityMode = "Basic256Sha256", "Sign"
+				c.UserCertificate, c.UserPrivateKey = userCertFile, userKeyFile
+				return c
+			},
+		},
+		{
+			name: "case3 sign with encrypted password",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy, c.SecurityMode = "Basic256Sha256", "Sign"
+				c.Username, c.Password = "admin", "secret"
+				return c
+			},
+		},
+		{
+			name: "case4 aes128 with password",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy = "Aes128_Sha256_RsaOaep"
+				c.Username, c.Password = "admin", "secret"
+				return c
+			},
+		},
+		{
+			name: "case5 aes256 anonymous",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy = "Aes256_Sha256_RsaPss"
+				return c
+			},
+		},
+		{
+			name: "case6 untrusted server certificate",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy = "Basic256Sha256"
+				c.ServerCertificate = untrustedCertFile
+				c.Username, c.Password = "admin", "secret"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "case7 password without security",
+			config: func(c ConfigData) ConfigData {
+				c = ConfigData{Endpoint: c.Endpoint, ServerCertificate: c.ServerCertificate}
+				c.Username, c.Password = "admin", "secret"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "case8 unsupported security mode of the server",
+			config: func(c ConfigData) ConfigData {
+				c.SecurityPolicy, c.SecurityMode = "Aes128_Sha256_RsaOaep", "Sign"
+				return c
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config(secured)
+			sec, err := config.security()
+			if err == nil {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_, err = config.clientOptions(ctx, sec)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("clientOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the anonymous client without security reads and writes the device
+	c := newTestClient(t, ConfigData{Endpoint: s.Endpoint})
+	if err := c.SetDeviceData(22.5, visitor("ns=2;s=Temperature", "")); err != nil {
+		t.Fatalf("SetDeviceData() error = %v", err)
+	}
+	got, err := c.GetDeviceData(visitor("ns=2;s=Temperature", DataTypeDouble))
+	if err != nil || got != 22.5 {
+		t.Errorf("GetDeviceData() = %v, %v, want 22.5", got, err)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	certFile, keyFile, _, err := opcuatest.WriteKeyPair(t.TempDir(), "mapper", "urn:kubeedge:test:mapper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		config  ConfigData
+		wantErr bool
+	}{
+		{name: "case1 no security", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840"}},
+		{name: "case2 no endpoint", config: ConfigData{}, wantErr: true},
+		{name: "case3 unknown policy", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840", SecurityPolicy: "Basic128Rsa15"}, wantErr: true},
+		{name: "case4 policy without certificate", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840", SecurityPolicy: "Basic256Sha256"}, wantErr: true},
+		{name: "case5 mode without policy", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840", SecurityMode: "Sign"}, wantErr: true},
+		{name: "case6 user name and certificate", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840", Username: "admin", UserCertificate: "user.crt"}, wantErr: true},
+		{name: "case7 policy without server certificate", config: ConfigData{Endpoint: "opc.tcp://127.0.0.1:4840", SecurityPolicy: "Basic256Sha256", Certificate: certFile, PrivateKey: keyFile}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(ProtocolConfig{ProtocolName: ProtocolName, ConfigData: tt.config})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	visitor, err := ParseVisitorConfig([]byte(`{"protocolName":"opcua","configData":{"dataType":"Double","nodeID":"ns=2;s=Temperature","samplingInterval":100}}`))
+	if err != nil {
+		t.Fatalf("ParseVisitorConfig() error = %v", err)
+	}
+	if visitor.DataType != DataTypeDouble || visitor.NodeID != "ns=2;s=Temperature" || visitor.SamplingInterval != 100 {
+		t.Errorf("ParseVisitorConfig() = %+v", visitor)
+	}
+}
+
+func TestUserIdentity(t *testing.T) {
+	userName := func(policyURI string) *ua.EndpointDescription {
+		return &ua.EndpointDescription{
+			SecurityPolicyURI: ua.SecurityPolicyURIBasic256Sha256,
+			UserIdentityTokens: []*ua.UserTokenPolicy{
+				{PolicyID: "anonymous", TokenType: ua.UserTokenTypeAnonymous},
+				{PolicyID: "username", TokenType: ua.UserTokenTypeUserName, SecurityPolicyURI: policyURI},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		config   ConfigData
+		mode     ua.MessageSecurityMode
+		endpoint *ua.EndpointDescription
+		expect   ua.UserTokenType
+		wantErr  bool
+	}{
+		{name: "case1 anonymous", mode: ua.MessageSecurityModeNone, endpoint: userName(""), expect: ua.UserTokenTypeAnonymous},
+		{name: "case2 encrypted password", config: ConfigData{Username: "admin"}, mode: ua.MessageSecurityModeSign, endpoint: userName(ua.SecurityPolicyURIBasic256Sha256), expect: ua.UserTokenTypeUserName},
+		{name: "case3 encrypted channel", config: ConfigData{Username: "admin"}, mode: ua.MessageSecurityModeSignAndEncrypt, endpoint: userName(ua.SecurityPolicyURINone), expect: ua.UserTokenTypeUserName},
+		{name: "case4 cleartext password", config: ConfigData{Username: "admin"}, mode: ua.MessageSecurityModeSign, endpoint: userName(ua.SecurityPolicyURINone), wantErr: true},
+		{name: "case5 password without security", config: ConfigData{Username: "admin"}, mode: ua.MessageSecurityModeNone, endpoint: userName(ua.SecurityPolicyURIBasic256Sha256), wantErr: true},
+		{name: "case6 unsupported user token", config: ConfigData{Username: "admin"}, mode: ua.MessageSecurityModeSignAndEncrypt, endpoint: &ua.EndpointDescription{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.userIdentity(&security{mode: tt.mode}, tt.endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("userIdentity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expect {
+				t.Errorf("userIdentity() = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	s := newTestServer(t)
+	c := newTestClient(t, ConfigData{Endpoint: s.Endpoint, Timeout: 1000})
+	v := visitor("ns=2;s=Counter", DataTypeInt)
+	if _, err := c.GetDeviceData(v); err != nil {
+		t.Fatalf("GetDeviceData() error = %v", err)
+	}
+
+	// the connection is restored after the network is broken
+	s.CloseConnections()
+	var err error
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err = c.GetDeviceData(v); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("GetDeviceData() after reconnect error = %v", err)
+	}
+}
+
+type notification struct {
+	value interface{}
+	err   error
+}
+
+func waitValue(t *testing.T, ch <-chan notification, expect interface{}) {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case n := <-ch:
+			if n.err == nil && n.value == expect {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %v", expect)
+		}
+	}
+}
+
+func TestSubscribeDeviceData(t *testing.T) {
+	s := newTestServer(t)
+	c := newTestClient(t, ConfigData{Endpoint: s.Endpoint, PublishingInterval: 20, Timeout: 1000})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	temperature := make(chan notification, 16)
+	if err := c.SubscribeDeviceData(ctx, visitor("ns=2;s=Temperature", DataTypeDouble), func(value interface{}, err error) {
+		temperature <- notification{value, err}
+	}); err != nil {
+		t.Fatalf("SubscribeDeviceData() error = %v", err)
+	}
+	counterCtx, cancelCounter := context.WithCancel(ctx)
+	counter := make(chan notification, 16)
+	if err := c.SubscribeDeviceData(counterCtx, visitor("ns=2;s=Counter", DataTypeInt), func(value interface{}, err error) {
+		counter <- notification{value, err}
+	}); err != nil {
+		t.Fatalf("SubscribeDeviceData() error = %v", err)
+	}
+
+	// the current values and the changes are pushed
+	waitValue(t, temperature, 21.5)
+	waitValue(t, counter, int64(7))
+	s.SetValue("ns=2;s=Temperature", 23.0)
+	waitValue(t, temperature, 23.0)
+
+	// the subscription is restored after the connection is lost
+	s.CloseConnections()
+	s.SetValue("ns=2;s=Temperature", 25.0)
+	waitValue(t, temperature, 25.0)
+
+	// the canceled subscription is not notified
+	cancelCounter()
+	time.Sleep(200 * time.Millisecond)
+	for len(counter) > 0 {
+		<-counter
+	}
+	s.SetValue("ns=2;s=Counter", int32(8))
+	s.SetValue("ns=2;s=Temperature", 26.0)
+	waitValue(t, temperature, 26.0)
+	select {
+	case n := <-counter:
+		t.Errorf("canceled subscription is notified with %v", n)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := c.StopDevice(); err != nil {
+		t.Fatalf("StopDevice() error = %v", err)
+	}
+	if err := c.SubscribeDeviceData(ctx, visitor("ns=2;s=Counter", DataTypeInt), func(interface{}, error) {}); err == nil {
+		t.Errorf("SubscribeDeviceData() after StopDevice should fail")
+	}
+}
+
+func TestStopDuringSubscribe(t *testing.T) {
+	s := newTestServer(t)
+	// StopDevice runs at the different stages of SubscribeDeviceData, which
+	// takes hundreds of microseconds with a connected client
+	for i := 0; i < 50; i++ {
+		c := newTestClient(t, ConfigData{Endpoint: s.Endpoint, PublishingInterval: 20, Timeout: 1000})
+		if err := c.InitDevice(); err != nil {
+			t.Fatalf("InitDevice() error = %v", err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+
+		done := make(chan error, 1)
+		go func() {
+			done <- c.SubscribeDeviceData(ctx, visitor("ns=2;s=Temperature", DataTypeDouble), func(interface{}, error) {})
+		}()
+		time.Sleep(time.Duration(i) * 10 * time.Microsecond)
+		if err := c.StopDevice(); err != nil {
+			t.Fatalf("StopDevice() error = %v", err)
+		}
+		select {
+		case <-done:
+			// it succeeds before StopDevice or fails after it
+		case <-time.After(5 * time.Second):
+			t.Fatalf("SubscribeDeviceData() is blocked by StopDevice")
+		}
+		cancel()
+
+		c.subMutex.Lock()
+		if c.sub != nil || c.subClient != nil || len(c.items) != 0 {
+			t.Errorf("stopped client keeps the subscription: sub %v, items %d", c.sub, len(c.items))
+		}
+		c.subMutex.Unlock()
+	}
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opcua
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+)
+
+// ProtocolName is the protocol name of the OPC UA devices
+const ProtocolName = "opcua"
+
+// Data types of the visitor, they are the lower case data types of the device property.
+const (
+	DataTypeInt     = "int"
+	DataTypeFloat   = "float"
+	DataTypeDouble  = "double"
+	DataTypeBoolean = "boolean"
+	DataTypeString  = "string"
+)
+
+const (
+	defaultTimeout            = 5 * time.Second
+	defaultPublishingInterval = time.Second
+	defaultSessionTimeout     = time.Hour
+	defaultChannelLifetime    = time.Hour
+	defaultApplicationURI     = "urn:kubeedge:mapper:opcua"
+)
+
+// ProtocolConfig is the protocol config of an OPC UA device
+type ProtocolConfig struct {
+	ProtocolName string `json:"protocolName"`
+	ConfigData   `json:"configData"`
+}
+
+// ConfigData is the connection of an OPC UA device
+type ConfigData struct {
+	// Endpoint is the url of the server, like opc.tcp://127.0.0.1:4840
+	Endpoint string `json:"endpoint"`
+	// SecurityPolicy is None, Basic256Sha256, Aes128_Sha256_RsaOaep or Aes256_Sha256_RsaPss,
+	// default None
+	SecurityPolicy string `json:"securityPolicy,omitempty"`
+	// SecurityMode is None, Sign or SignAndEncrypt, default None without security
+	// policy and SignAndEncrypt with it
+	SecurityMode string `json:"securityMode,omitempty"`
+	// Certificate and PrivateKey are the PEM or DER files of the application
+	// instance certificate of the mapper, they are required by the security policies
+	Certificate string `json:"certificate,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+	// ServerCertificate is the PEM or DER file of the certificate of the server, or of
+	// the CAs which issue it. The certificate of the server is verified by it before the
+	// secure channel is opened or the password is encrypted, it is required by the
+	// security policies
+	ServerCertificate string `json:"serverCertificate,omitempty"`
+	// Username and Password authenticate the user by its name, they require a security
+	// policy, and the password is never sent in cleartext
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// UserCertificate and UserPrivateKey are the PEM or DER files which authenticate
+	// the user by its certificate
+	UserCertificate string `json:"userCertificate,omitempty"`
+	UserPrivateKey  string `json:"userPrivateKey,omitempty"`
+	// Timeout of a request in milliseconds, default 5000
+	Timeout int64 `json:"timeout,omitempty"`
+	// PublishingInterval of the subscription in milliseconds, default 1000
+	PublishingInterval int64 `json:"publishingInterval,omitempty"`
+}
+
+// VisitorConfig is the visitor config of a device property
+type VisitorConfig struct {
+	ProtocolName      string `json:"protocolName"`
+	VisitorConfigData `json:"configData"`
+}
+
+// VisitorConfigData tells which node the property is
+type VisitorConfigData struct {
+	// DataType is the data type of the property
+	DataType string `json:"dataType"`
+	// NodeID is the node of the property, like ns=2;s=Temperature
+	NodeID string `json:"nodeID"`
+	// SamplingInterval of the subscribed node in milliseconds, 0 is the fastest
+	// rate of the server and -1 is the publishing interval
+	SamplingInterval int64 `json:"samplingInterval,omitempty"`
+}
+
+// ParseProtocolConfig parses the ConfigData of a device protocol
+func ParseProtocolConfig(data []byte) (ProtocolConfig, error) {
+	var config ProtocolConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("unmarshal opcua protocol config error: %v", err)
+	}
+	return config, nil
+}
+
+// ParseVisitorConfig parses the visitors of a device property
+func ParseVisitorConfig(data []byte) (VisitorConfig, error) {
+	var visitor VisitorConfig
+	if err := json.Unmarshal(data, &visitor); err != nil {
+		return visitor, fmt.Errorf("unmarshal opcua visitor config error: %v", err)
+	}
+	visitor.DataType = strings.ToLower(visitor.DataType)
+	return visitor, nil
+}
+
+func (c *ConfigData) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultTimeout
+	}
+	return time.Duration(c.Timeout) * time.Millisecond
+}
+
+func (c *ConfigData) publishingInterval() time.Duration {
+	if c.PublishingInterval <= 0 {
+		return defaultPublishingInterval
+	}
+	return time.Duration(c.PublishingInterval) * time.Millisecond
+}
+
+// security is the parsed security of ConfigData
+type security struct {
+	policyURI       string
+	mode            ua.MessageSecurityMode
+	certificate     []byte
+	key             *rsa.PrivateKey
+	userCertificate []byte
+	userKey         *rsa.PrivateKey
+	trustedServers  *x509.CertPool
+}
+
+// supportedPolicies are the security policies of the mappers, the deprecated policies
+// Basic128Rsa15 and Basic256 are not supported
+var supportedPolicies = map[string]string{
+	"None":                  ua.SecurityPolicyURINone,
+	"Basic256Sha256":        ua.SecurityPolicyURIBasic256Sha256,
+	"Aes128_Sha256_RsaOaep": ua.SecurityPolicyURIAes128Sha256RsaOaep,
+	"Aes256_Sha256_RsaPss":  ua.SecurityPolicyURIAes256Sha256RsaPss,
+}
+
+func (c *ConfigData) security() (*security, error) {
+	if c.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint is required")
+	}
+	policy := c.SecurityPolicy
+	if policy == "" {
+		policy = "None"
+	}
+	policyURI, ok := supportedPolicies[strings.TrimPrefix(policy, ua.SecurityPolicyURIPrefix)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported security policy %s", c.SecurityPolicy)
+	}
+	s := &security{policyURI: policyURI, mode: ua.MessageSecurityModeNone}
+	if policyURI != ua.SecurityPolicyURINone {
+		s.mode = ua.MessageSecurityModeSignAndEncrypt
+	}
+	if c.SecurityMode != "" {
+		if s.mode = ua.MessageSecurityModeFromString(c.SecurityMode); s.mode == ua.MessageSecurityModeInvalid {
+			return nil, fmt.Errorf("unsupported security mode %s", c.SecurityMode)
+		}
+	}
+	if (policyURI == ua.SecurityPolicyURINone) != (s.mode == ua.MessageSecurityModeNone) {
+		return nil, fmt.Errorf("security mode %s is invalid with security policy %s", s.mode, policy)
+	}
+
+	var err error
+	if c.Certificate != "" || c.PrivateKey != "" {
+		if s.certificate, s.key, err = loadKeyPair(c.Certificate, c.PrivateKey); err != nil {
+			return nil, fmt.Errorf("invalid certificate of the mapper: %v", err)
+		}
+	} else if policyURI != ua.SecurityPolicyURINone {
+		return nil, fmt.Errorf("certificate and privateKey are required by security policy %s", policy)
+	}
+	if c.ServerCertificate != "" {
+		if s.trustedServers, err = loadCertPool(c.ServerCertificate); err != nil {
+			return nil, fmt.Errorf("invalid server certificate: %v", err)
+		}
+	} else if policyURI != ua.SecurityPolicyURINone {
+		return nil, fmt.Errorf("serverCertificate is required by security policy %s", policy)
+	}
+
+	if c.Username != "" && c.UserCertificate != "" {
+		return nil, fmt.Errorf("username and userCertificate are exclusive")
+	}
+	if c.UserCertificate != "" || c.UserPrivateKey != "" {
+		if s.userCertificate, s.userKey, err = loadKeyPair(c.UserCertificate, c.UserPrivateKey); err != nil {
+			return nil, fmt.Errorf("invalid user certificate: %v", err)
+		}
+	}
+	return s, nil
+}
+
+// clientOptions returns the options of the OPC UA client to connect to the endpoint.
+// The certificate of the server is got from its endpoints by an unsecured channel,
+// so it is verified by the trusted certificates before the secure channel is opened.
+func (c *ConfigData) clientOptions(ctx context.Context, sec *security) ([]opcua.Option, error) {
+	endpoints, err := opcua.GetEndpoints(ctx, c.Endpoint, opcua.DialTimeout(c.timeout()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get endpoints: %v", err)
+	}
+	endpoint, err := opcua.SelectEndpoint(endpoints, sec.policyURI, sec.mode)
+	if err != nil {
+		return nil, err
+	}
+	if sec.policyURI != ua.SecurityPolicyURINone {
+		if err := sec.verifyServerCertificate(endpoint.ServerCertificate); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := []opcua.Option{
+		opcua.ApplicationName("KubeEdge OPC UA mapper"),
+		opcua.ApplicationURI(defaultApplicationURI),
+		opcua.ProductURI(defaultApplicationURI),
+		opcua.RequestTimeout(c.timeout()),
+		opcua.DialTimeout(c.timeout()),
+		opcua.SessionTimeout(defaultSessionTimeout),
+		opcua.Lifetime(defaultChannelLifetime),
+		// the gopcua client closes the session it restores, a new client is
+		// connected after the connection is lost instead
+		opcua.AutoReconnect(false),
+	}
+	if sec.certificate != nil {
+		// the application uri is replaced by the uri in the certificate
+		opts = append(opts, opcua.Certificate(sec.certificate), opcua.PrivateKey(sec.key))
+	}
+	tokenType, err := c.userIdentity(sec, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	switch tokenType {
+	case ua.UserTokenTypeUserName:
+		opts = append(opts, opcua.AuthUsername(c.Username, c.Password))
+	case ua.UserTokenTypeCertificate:
+		opts = append(opts, opcua.AuthCertificate(sec.userCertificate), opcua.AuthPrivateKey(sec.userKey))
+	default:
+		opts = append(opts, opcua.AuthAnonymous())
+	}
+	// the policy id of the user token is set by the endpoint after the user token is chosen
+	return append(opts, opcua.SecurityFromEndpoint(endpoint, tokenType)), nil
+}
+
+// userIdentity returns the type of the user identity token by the user name, the user
+// certificate or anonymous. The password is sent over a secure channel only, because the
+// certificate which encrypts it is sent by the server in the session, and it is trusted
+// only if the secure channel is opened with the verified certificate of the server. The
+// password must not be sent in cleartext, so either the user token policy or the secure
+// channel encrypts it.
+func (c *ConfigData) userIdentity(sec *security, endpoint *ua.EndpointDescription) (ua.UserTokenType, error) {
+	tokenType := ua.UserTokenTypeAnonymous
+	switch {
+	case c.Username != "":
+		tokenType = ua.UserTokenTypeUserName
+	case sec.userCertificate != nil:
+		tokenType = ua.UserTokenTypeCertificate
+	}
+	var policy *ua.UserTokenPolicy
+	for _, p := range endpoint.UserIdentityTokens {
+		if p.TokenType == tokenType {
+			policy = p
+			break
+		}
+	}
+	if policy == nil {
+		return 0, fmt.Errorf("endpoint does not accept the user token of type %s", tokenType)
+	}
+	if tokenType != ua.UserTokenTypeUserName {
+		return tokenType, nil
+	}
+	if sec.mode == ua.MessageSecurityModeNone {
+		return 0, errors.New("the password is sent over a secure channel only, securityPolicy is required by username")
+	}
+	tokenPolicyURI := policy.SecurityPolicyURI
+	if tokenPolicyURI == "" {
+		tokenPolicyURI = endpoint.SecurityPolicyURI
+	}
+	if tokenPolicyURI == ua.SecurityPolicyURINone && sec.mode != ua.MessageSecurityModeSignAndEncrypt {
+		return 0, errors.New("endpoint requires the password in cleartext, neither the user token nor the channel is encrypted")
+	}
+	return tokenType, nil
+}
+
+// verifyServerCertificate verifies the DER certificate of the server by the trusted
+// certificates of ConfigData.ServerCertificate
+func (s *security) verifyServerCertificate(certificate []byte) error {
+	if s.trustedServers == nil {
+		return errors.New("serverCertificate is required to trust the server")
+	}
+	cert, err := x509.ParseCertificate(certificate)
+	if err != nil {
+		return fmt.Errorf("invalid server certificate: %v", err)
+	}
+	// the server is identified by its application uri instead of the host name
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:     s.trustedServers,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("untrusted server certificate: %v", err)
+	}
+	return nil
+}
+
+// loadCertPool loads the certificates of a PEM file, or a DER certificate
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	block, rest := pem.Decode(data)
+	if block == nil {
+		cert, err := x509.ParseCertificate(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %s: %v", file, err)
+		}
+		pool.AddCert(cert)
+		return pool, nil
+	}
+	for ; block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %s: %v", file, err)
+		}
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
+
+// loadKeyPair loads a DER certificate and its RSA private key from PEM or DER files
+func loadKeyPair(certFile, keyFile string) ([]byte, *rsa.PrivateKey, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, nil, fmt.Errorf("both the certificate and the private key are required")
+	}
+	certData, err := readPEMOrDER(certFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(certData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse certificate %s: %v", certFile, err)
+	}
+	keyData, err := readPEMOrDER(keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *rsa.PrivateKey
+	if key, err = x509.ParsePKCS1PrivateKey(keyData); err != nil {
+		parsed, err := x509.ParsePKCS8PrivateKey(keyData)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse private key %s: %v", keyFile, err)
+		}
+		var ok bool
+		if key, ok = parsed.(*rsa.PrivateKey); !ok {
+			return nil, nil, fmt.Errorf("private key %s is %T, not rsa", keyFile, parsed)
+		}
+	}
+	if pub, ok := cert.PublicKey.(*rsa.PublicKey); !ok || !pub.Equal(&key.PublicKey) {
+		return nil, nil, fmt.Errorf("private key %s does not match certificate %s", keyFile, certFile)
+	}
+	return certData, key, nil
+}
+
+func readPEMOrDER(file string) ([]byte, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		return block.Bytes, nil
+	}
+	return data, nil
+}
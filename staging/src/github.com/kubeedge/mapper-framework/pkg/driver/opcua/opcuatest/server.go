@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package opcuatest provides an in-process OPC UA server to test the mappers.
+package opcuatest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gopcua/opcua/server"
+	"github.com/gopcua/opcua/ua"
+)
+
+const applicationURI = "urn:kubeedge:opcuatest"
+
+// Server is an in-process gopcua server which keeps the values of its variable nodes
+// in memory. It has the endpoints of the None, Basic256Sha256, Aes128_Sha256_RsaOaep and
+// Aes256_Sha256_RsaPss security policies, and accepts the anonymous, user name and
+// certificate users without verifying them. The clients connect to the gopcua server
+// through a proxy, whose connections are closed to simulate a broken network.
+type Server struct {
+	// Endpoint is the url of the server, like opc.tcp://127.0.0.1:4840
+	Endpoint string
+	// Certificate is the DER application instance certificate of the server
+	Certificate []byte
+
+	srv      *server.Server
+	cancel   context.CancelFunc
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mutex  sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// NewServer starts a Server on a random port of the loopback address
+func NewServer() (*Server, error) {
+	certificate, key, err := GenerateCertificate(applicationURI)
+	if err != nil {
+		return nil, err
+	}
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	// the gopcua server listens on the first endpoint, and describes the endpoints
+	// of the requested url only
+	srv := server.New(
+		server.EndPoint("127.0.0.1", port),
+		server.EndPoint("127.0.0.1", listener.Addr().(*net.TCPAddr).Port),
+		server.Certificate(certificate),
+		server.PrivateKey(key),
+		server.EnableSecurity("None", ua.MessageSecurityModeNone),
+		server.EnableSecurity("Basic256Sha256", ua.MessageSecurityModeSign),
+		server.EnableSecurity("Basic256Sha256", ua.MessageSecurityModeSignAndEncrypt),
+		server.EnableSecurity("Aes128_Sha256_RsaOaep", ua.MessageSecurityModeSignAndEncrypt),
+		server.EnableSecurity("Aes256_Sha256_RsaPss", ua.MessageSecurityModeSignAndEncrypt),
+		server.EnableAuthMode(ua.UserTokenTypeAnonymous),
+		server.EnableAuthMode(ua.UserTokenTypeUserName),
+		server.EnableAuthMode(ua.UserTokenTypeCertificate),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := srv.Start(ctx); err != nil {
+		cancel()
+		listener.Close()
+		return nil, err
+	}
+	s := &Server{
+		Endpoint:    "opc.tcp://" + listener.Addr().String(),
+		Certificate: certificate,
+		srv:         srv,
+		cancel:      cancel,
+		listener:    listener,
+		conns:       make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve(fmt.Sprintf("127.0.0.1:%d", port))
+	return s, nil
+}
+
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// serve proxies the accepted connections to the gopcua server at addr
+func (s *Server) serve(addr string) {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		upstream, err := net.Dial("tcp", addr)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			conn.Close()
+			upstream.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.conns[upstream] = struct{}{}
+		s.mutex.Unlock()
+		s.wg.Add(2)
+		go s.copy(upstream, conn)
+		go s.copy(conn, upstream)
+	}
+}
+
+func (s *Server) copy(dst, src net.Conn) {
+	defer s.wg.Done()
+	_, _ = io.Copy(dst, src)
+	dst.Close()
+	src.Close()
+	s.mutex.Lock()
+	delete(s.conns, dst)
+	delete(s.conns, src)
+	s.mutex.Unlock()
+}
+
+// Close stops the server and closes its connections
+func (s *Server) Close() {
+	s.listener.Close()
+	s.mutex.Lock()
+	s.closed = true
+	s.mutex.Unlock()
+	s.CloseConnections()
+	s.wg.Wait()
+	s.cancel()
+	s.srv.Close()
+}
+
+// CloseConnections closes the connections of the clients, like a broken network
+func (s *Server) CloseConnections() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// namespace returns the node namespace of index ns, the namespaces up to ns are
+// created if they do not exist. mutex must be held.
+func (s *Server) namespace(ns uint16) *server.NodeNameSpace {
+	for len(s.srv.Namespaces()) <= int(ns) {
+		server.NewNodeNameSpace(s.srv, fmt.Sprintf("%s:%d", applicationURI, len(s.srv.Namespaces())))
+	}
+	namespace, _ := s.srv.Namespace(int(ns))
+	return namespace.(*server.NodeNameSpace)
+}
+
+// SetValue sets the value of the variable node like ns=2;s=Temperature, the node
+// is created if it does not exist and the subscribed clients are notified
+func (s *Server) SetValue(node string, value interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	id := ua.MustParseNodeID(node)
+	namespace := s.namespace(id.Namespace())
+	if namespace.Node(id) == nil {
+		namespace.AddNode(server.NewVariableNode(id, id.String(), value))
+		return
+	}
+	namespace.SetAttribute(id, ua.AttributeIDValue, server.DataValueFromValue(value))
+}
+
+// Value returns the value of the variable node
+func (s *Server) Value(node string) interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	n := s.srv.Node(ua.MustParseNodeID(node))
+	if n == nil {
+		return nil
+	}
+	value := n.Value()
+	if value == nil || value.Value == nil {
+		return nil
+	}
+	return value.Value.Value()
+}
+
+// GenerateCertificate generates a self-signed application instance certificate of
+// the application uri and its RSA private key, the certificate is DER encoded
+func GenerateCertificate(uri string) ([]byte, *rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: uri},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment | x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		URIs:                  []*url.URL{u},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certificate, key, nil
+}
+
+// WriteKeyPair generates a certificate of the application uri by GenerateCertificate,
+// and writes it and its private key as PEM files in dir. It returns the files and
+// the DER certificate.
+func WriteKeyPair(dir, name, uri string) (string, string, []byte, error) {
+	certificate, key, err := GenerateCertificate(uri)
+	if err != nil {
+		return "", "", nil, err
+	}
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0600); err != nil {
+		return "", "", nil, err
+	}
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyFile, keyData, 0600); err != nil {
+		return "", "", nil, err
+	}
+	return certFile, keyFile, certificate, nil
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opcua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+	"k8s.io/klog/v2"
+)
+
+var errClientStopped = errors.New("opcua: client is stopped")
+
+// DataHandler receives the changed values of a subscribed property, the value is
+// converted to the data type of the visitor. err is not nil if the value is bad
+// or the subscription is broken, the subscription is recreated after that.
+type DataHandler func(value interface{}, err error)
+
+// monitoredItem is a subscribed property
+type monitoredItem struct {
+	node             *ua.NodeID
+	dataType         string
+	samplingInterval float64
+	handler          DataHandler
+	// id is the id of the monitored item in the server, 0 if it is not created
+	id uint32
+}
+
+// subscription is the subscription of a Client. All the subscribed properties of
+// the device are monitored items of one gopcua subscription, which is recreated
+// in a new gopcua client after the connection is lost.
+type subscription struct {
+	// syncMutex serializes the synchronization of the items with the server
+	syncMutex sync.Mutex
+
+	subMutex   sync.Mutex
+	items      map[uint32]*monitoredItem
+	nextHandle uint32
+	sub        *opcua.Subscription
+	subClient  *opcua.Client
+	running    bool
+	stopped    bool
+	notifyCh   chan *opcua.PublishNotificationData
+	stopCh     chan struct{}
+}
+
+func (s *subscription) init() {
+	s.items = make(map[uint32]*monitoredItem)
+	s.notifyCh = make(chan *opcua.PublishNotificationData)
+	s.stopCh = make(chan struct{})
+}
+
+func (s *subscription) stop() {
+	s.subMutex.Lock()
+	if s.stopped {
+		s.subMutex.Unlock()
+		return
+	}
+	s.stopped = true
+	close(s.stopCh)
+	s.subMutex.Unlock()
+
+	// the running synchronization sees the stop and cancels the subscription it
+	// creates, wait for it so that it is done before the gopcua client is closed
+	s.syncMutex.Lock()
+	defer s.syncMutex.Unlock()
+	s.subMutex.Lock()
+	defer s.subMutex.Unlock()
+	s.items = make(map[uint32]*monitoredItem)
+	s.sub = nil
+	s.subClient = nil
+}
+
+// SubscribeDeviceData subscribes the value of visitor, handler is called with the
+// current value and each change of it until ctx is done or the Client is stopped
+func (c *Client) SubscribeDeviceData(ctx context.Context, visitor *VisitorConfig, handler DataHandler) error {
+	node, err := ua.ParseNodeID(visitor.NodeID)
+	if err != nil {
+		return err
+	}
+	if err := checkDataType(visitor.DataType); err != nil {
+		return err
+	}
+	item := &monitoredItem{
+		node:             node,
+		dataType:         visitor.DataType,
+		samplingInterval: float64(visitor.SamplingInterval),
+		handler:          handler,
+	}
+
+	c.subMutex.Lock()
+	if c.stopped {
+		c.subMutex.Unlock()
+		return errClientStopped
+	}
+	c.nextHandle++
+	handle := c.nextHandle
+	c.items[handle] = item
+	c.subMutex.Unlock()
+
+	if err := c.syncSubscription(); err != nil {
+		c.subMutex.Lock()
+		delete(c.items, handle)
+		c.subMutex.Unlock()
+		return fmt.Errorf("failed to subscribe %s: %v", node, err)
+	}
+
+	c.subMutex.Lock()
+	if !c.running {
+		c.running = true
+		go c.run()
+	}
+	c.subMutex.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.unsubscribe(handle)
+		case <-c.stopCh:
+		}
+	}()
+	return nil
+}
+
+// unsubscribe deletes the monitored item of handle, and cancels the subscription
+// after its last item is deleted
+func (c *Client) unsubscribe(handle uint32) {
+	c.syncMutex.Lock()
+	defer c.syncMutex.Unlock()
+
+	c.subMutex.Lock()
+	item, ok := c.items[handle]
+	delete(c.items, handle)
+	sub := c.sub
+	empty := len(c.items) == 0
+	if empty {
+		c.sub = nil
+		c.subClient = nil
+	}
+	c.subMutex.Unlock()
+	if !ok || sub == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+	var err error
+	switch {
+	case empty:
+		err = sub.Cancel(ctx)
+	case item.id != 0:
+		_, err = sub.Unmonitor(ctx, item.id)
+	}
+	if err != nil {
+		klog.V(4).Infof("failed to unsubscribe %s: %v", item.node, err)
+	}
+}
+
+// syncSubscription creates the subscription in the current gopcua client if it is
+// not there, and creates the monitored items which are not created yet
+func (c *Client) syncSubscription() error {
+	c.syncMutex.Lock()
+	defer c.syncMutex.Unlock()
+
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+
+	c.subMutex.Lock()
+	if c.stopped {
+		c.subMutex.Unlock()
+		return errClientStopped
+	}
+	if c.subClient != client {
+		// the subscription is lost with its client
+		c.sub = nil
+		for _, item := range c.items {
+			item.id = 0
+		}
+	}
+	sub := c.sub
+	var handles []uint32
+	var requests []*ua.MonitoredItemCreateRequest
+	for handle, item := range c.items {
+		if item.id != 0 {
+			continue
+		}
+		request := opcua.NewMonitoredItemCreateRequestWithDefaults(item.node, ua.AttributeIDValue, handle)
+		request.RequestedParameters.SamplingInterval = item.samplingInterval
+		request.RequestedParameters.QueueSize = 1
+		handles = append(handles, handle)
+		requests = append(requests, request)
+	}
+	c.subMutex.Unlock()
+	if len(requests) == 0 {
+		return nil
+	}
+
+	if sub == nil {
+		sub, err = client.Subscribe(ctx, &opcua.SubscriptionParameters{Interval: c.publishingInterval()}, c.notifyCh)
+		if err != nil {
+			return fmt.Errorf("failed to create subscription: %v", err)
+		}
+		c.subMutex.Lock()
+		if c.stopped {
+			c.subMutex.Unlock()
+			cancelSubscription(ctx, sub)
+			return errClientStopped
+		}
+		c.sub = sub
+		c.subClient = client
+		c.subMutex.Unlock()
+	}
+
+	resp, err := sub.Monitor(ctx, ua.TimestampsToReturnSource, requests...)
+	c.subMutex.Lock()
+	if c.stopped {
+		// the Client is stopped while the items are created, the subscription
+		// is dropped by stop and it is canceled here
+		c.subMutex.Unlock()
+		cancelSubscription(ctx, sub)
+		return errClientStopped
+	}
+	c.subMutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to create monitored items: %v", err)
+	}
+	if len(resp.Results) != len(requests) {
+		return fmt.Errorf("failed to create monitored items: %d results of %d items", len(resp.Results), len(requests))
+	}
+	var errs []error
+	var orphans []uint32
+	c.subMutex.Lock()
+	for i, r := range resp.Results {
+		item, ok := c.items[handles[i]]
+		if !ok {
+			// the item is deleted while it is created
+			if r.StatusCode == ua.StatusOK {
+				orphans = append(orphans, r.MonitoredItemID)
+			}
+			continue
+		}
+		if r.StatusCode != ua.StatusOK {
+			errs = append(errs, fmt.Errorf("%s: %v", item.node, r.StatusCode))
+			delete(c.items, handles[i])
+			continue
+		}
+		item.id = r.MonitoredItemID
+	}
+	c.subMutex.Unlock()
+	if len(orphans) > 0 {
+		if _, err := sub.Unmonitor(ctx, orphans...); err != nil {
+			klog.V(4).Infof("failed to delete monitored items of %s: %v", c.Endpoint, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// cancelSubscription cancels the subscription which is not kept by the Client
+func cancelSubscription(ctx context.Context, sub *opcua.Subscription) {
+	if err := sub.Cancel(ctx); err != nil {
+		klog.V(4).Infof("failed to cancel opcua subscription %d: %v", sub.SubscriptionID, err)
+	}
+}
+
+// run dispatches the notifications of the subscription until the Client is stopped,
+// and recreates the subscription after the gopcua client is closed
+func (c *Client) run() {
+	ticker := time.NewTicker(c.timeout())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case data := <-c.notifyCh:
+			c.dispatch(data)
+		case <-ticker.C:
+			c.resync()
+		}
+	}
+}
+
+// resync recreates the subscription if its gopcua client is closed
+func (c *Client) resync() {
+	c.subMutex.Lock()
+	lost := len(c.items) > 0 && (c.subClient == nil || c.subClient.State() == opcua.Closed)
+	c.subMutex.Unlock()
+	if !lost {
+		return
+	}
+	if err := c.syncSubscription(); err != nil {
+		klog.Errorf("opcua subscription of %s is broken: %v", c.Endpoint, err)
+		c.notifyError(err)
+	}
+}
+
+// dispatch calls the handlers of the notification. The monitored items are
+// matched by their client handles, the subscription id changes when gopcua
+// recreates the subscription.
+func (c *Client) dispatch(data *opcua.PublishNotificationData) {
+	type call struct {
+		handler DataHandler
+		value   interface{}
+		err     error
+	}
+	var calls []call
+	c.subMutex.Lock()
+	switch notification := data.Value.(type) {
+	case *ua.DataChangeNotification:
+		for _, n := range notification.MonitoredItems {
+			item, ok := c.items[n.ClientHandle]
+			if !ok || n.Value == nil {
+				continue
+			}
+			if n.Value.Status != ua.StatusOK {
+				calls = append(calls, call{handler: item.handler, err: fmt.Errorf("bad value of %s: %v", item.node, n.Value.Status)})
+				continue
+			}
+			var value interface{}
+			if n.Value.Value != nil {
+				value = n.Value.Value.Value()
+			}
+			value, err := convertValue(item.dataType, value)
+			calls = append(calls, call{handler: item.handler, value: value, err: err})
+		}
+	case *ua.StatusChangeNotification:
+		if notification.Status != ua.StatusOK {
+			klog.V(4).Infof("opcua subscription %d of %s is closed: %v", data.SubscriptionID, c.Endpoint, notification.Status)
+		}
+	}
+	c.subMutex.Unlock()
+
+	if data.Error != nil {
+		klog.V(4).Infof("opcua subscription %d of %s failed: %v", data.SubscriptionID, c.Endpoint, data.Error)
+		c.notifyError(data.Error)
+		return
+	}
+	for _, call := range calls {
+		call.handler(call.value, call.err)
+	}
+}
+
+// notifyError tells the handlers that the subscription is broken
+func (c *Client) notifyError(err error) {
+	c.subMutex.Lock()
+	handlers := make([]DataHandler, 0, len(c.items))
+	for _, item := range c.items {
+		handlers = append(handlers, item.handler)
+	}
+	c.subMutex.Unlock()
+	for _, handler := range handlers {
+		handler(nil, err)
+	}
+}
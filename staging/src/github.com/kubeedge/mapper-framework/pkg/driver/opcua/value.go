@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opcua
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+)
+
+// checkDataType returns an error if the data type of a visitor is unsupported
+func checkDataType(dataType string) error {
+	switch dataType {
+	case "", DataTypeInt, DataTypeFloat, DataTypeDouble, DataTypeBoolean, DataTypeString:
+		return nil
+	default:
+		return fmt.Errorf("unsupported data type %q", dataType)
+	}
+}
+
+// convertValue converts the value of a node to the data type of the visitor,
+// the value is returned as is without data type
+func convertValue(dataType string, value interface{}) (interface{}, error) {
+	switch dataType {
+	case "":
+		return value, nil
+	case DataTypeInt:
+		f, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case uint64:
+			if v > math.MaxInt64 {
+				return nil, fmt.Errorf("value %d overflows int64", v)
+			}
+			return int64(v), nil
+		}
+		return int64(math.Round(f)), nil
+	case DataTypeFloat, DataTypeDouble:
+		return toFloat64(value)
+	case DataTypeBoolean:
+		return toBool(value)
+	case DataTypeString:
+		return toString(value), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %q", dataType)
+	}
+}
+
+// toVariantValue converts data to the type of the current value of a node
+func toVariantValue(data interface{}, current interface{}) (interface{}, error) {
+	if current == nil {
+		// the type of a node without value is unknown, write data as is
+		if v, ok := data.(int); ok {
+			data = int64(v)
+		}
+		if _, err := ua.NewVariant(data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+	target := reflect.TypeOf(current)
+	if reflect.TypeOf(data) == target {
+		return data, nil
+	}
+	switch current.(type) {
+	case bool:
+		return toBool(data)
+	case string:
+		return toString(data), nil
+	case []byte:
+		return []byte(toString(data)), nil
+	case *ua.LocalizedText:
+		return &ua.LocalizedText{EncodingMask: ua.LocalizedTextText, Text: toString(data)}, nil
+	case time.Time:
+		s, ok := data.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot convert %T to DateTime", data)
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	}
+
+	f, err := toFloat64(data)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.New(target).Elem()
+	switch target.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if v.OverflowFloat(f) {
+			return nil, fmt.Errorf("value %v overflows %s", data, target)
+		}
+		v.SetFloat(f)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 || v.OverflowInt(int64(f)) {
+			return nil, fmt.Errorf("value %v overflows %s", data, target)
+		}
+		v.SetInt(int64(f))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 || v.OverflowUint(uint64(f)) {
+			return nil, fmt.Errorf("value %v overflows %s", data, target)
+		}
+		v.SetUint(uint64(f))
+	default:
+		return nil, fmt.Errorf("writing %T is unsupported", current)
+	}
+	return v.Interface(), nil
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case *ua.LocalizedText:
+		return v.Text
+	case *ua.QualifiedName:
+		return v.Name
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int8:
+		return float64(v), nil
+	case int16:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint8:
+		return float64(v), nil
+	case uint16:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case ua.StatusCode:
+		return float64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	default:
+		f, err := toFloat64(value)
+		if err != nil {
+			return false, err
+		}
+		return f != 0, nil
+	}
+}
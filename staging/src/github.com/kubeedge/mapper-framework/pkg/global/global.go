@@ -35,6 +35,22 @@ type DevPanel interface {
 	GetDeviceMethod(deviceID string) (map[string][]string, map[string]string, error)
 }
 
+// UnitDevPanel is an optional extension of DevPanel, which converts the property values between units.
+// The unit parameter of the REST API is rejected if the DevPanel does not implement it.
+type UnitDevPanel interface {
+	// WriteDeviceInUnit write value in the unit to the device, empty unit means the unit of the model property
+	WriteDeviceInUnit(deviceMethodName, deviceID, propertyName, data, unit string) error
+	// GetTwinResultInUnit get device's property value in the unit and datatype, empty unit means the unit of the model property
+	GetTwinResultInUnit(deviceID string, twinName string, unit string) (string, string, error)
+}
+
+// MethodDevPanel is an optional extension of DevPanel, which invokes the methods declared in the device models.
+// The CallDeviceMethod DMI call is unimplemented if the DevPanel does not implement it.
+type MethodDevPanel interface {
+	// CallDeviceMethod invoke a method declared in the device model and return its outputs
+	CallDeviceMethod(deviceID, methodName string, inputs map[string]string) (map[string]string, error)
+}
+
 // DataPanel defined push method, parse the push operation in CRD and execute it
 type DataPanel interface {
 	// TODO add more interface
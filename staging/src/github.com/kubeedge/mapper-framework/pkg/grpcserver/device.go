@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/avast/retry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
 )
 
@@ -162,3 +165,22 @@ func (s *Server) GetDevice(_ context.Context, request *dmiapi.GetDeviceRequest)
 	//res.Device.Status.State = common.DEVSTOK
 	return res, nil
 }
+
+func (s *Server) CallDeviceMethod(_ context.Context, request *dmiapi.CallDeviceMethodRequest) (*dmiapi.CallDeviceMethodResponse, error) {
+	if request.GetDeviceName() == "" {
+		return nil, errors.New("device name is nil")
+	}
+	if request.GetMethodName() == "" {
+		return nil, errors.New("method name is nil")
+	}
+	methodDevPanel, ok := s.devPanel.(global.MethodDevPanel)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "device methods are not supported by the mapper")
+	}
+	deviceID := parse.GetResourceID(request.GetDeviceNamespace(), request.GetDeviceName())
+	outputs, err := methodDevPanel.CallDeviceMethod(deviceID, request.GetMethodName(), request.GetInputs())
+	if err != nil {
+		return nil, err
+	}
+	return &dmiapi.CallDeviceMethodResponse{Outputs: outputs}, nil
+}
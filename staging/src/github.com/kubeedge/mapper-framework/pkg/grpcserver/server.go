@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"k8s.io/klog/v2"
 
@@ -46,6 +48,8 @@ func (s *Server) Start() error {
 	}
 	grpcServer := grpc.NewServer()
 	dmiapi.RegisterDeviceMapperServiceServer(grpcServer, s)
+	// edgecore probes the mapper with the standard health service to detect a dead mapper
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
 	reflection.Register(grpcServer)
 	klog.V(2).Info("start grpc server")
 	return grpcServer.Serve(s.lis)
@@ -9,6 +9,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
 )
 
@@ -26,7 +27,18 @@ func (rs *RestServer) DeviceRead(writer http.ResponseWriter, request *http.Reque
 	deviceName := urlItem[len(urlItem)-2]
 	propertyName := urlItem[len(urlItem)-1]
 	deviceID := parse.GetResourceID(deviceNamespace, deviceName)
-	res, dataType, err := rs.devPanel.GetTwinResult(deviceID, propertyName)
+	var res, dataType string
+	var err error
+	if unit := request.URL.Query().Get("unit"); unit != "" {
+		unitDevPanel, ok := rs.devPanel.(global.UnitDevPanel)
+		if !ok {
+			http.Error(writer, "Get device data error: the unit parameter is not supported by the mapper", http.StatusBadRequest)
+			return
+		}
+		res, dataType, err = unitDevPanel.GetTwinResultInUnit(deviceID, propertyName, unit)
+	} else {
+		res, dataType, err = rs.devPanel.GetTwinResult(deviceID, propertyName)
+	}
 	if err != nil {
 		http.Error(writer, fmt.Sprintf("Get device data error: %v", err), http.StatusInternalServerError)
 	} else {
@@ -111,7 +123,17 @@ func (rs *RestServer) DeviceWrite(writer http.ResponseWriter, request *http.Requ
 
 	// Call device write command
 	deviceID := parse.GetResourceID(deviceNamespace, deviceName)
-	err := rs.devPanel.WriteDevice(deviceMethodName, deviceID, propertyName, data)
+	var err error
+	if unit := request.URL.Query().Get("unit"); unit != "" {
+		unitDevPanel, ok := rs.devPanel.(global.UnitDevPanel)
+		if !ok {
+			http.Error(writer, "Write device data error: the unit parameter is not supported by the mapper", http.StatusBadRequest)
+			return
+		}
+		err = unitDevPanel.WriteDeviceInUnit(deviceMethodName, deviceID, propertyName, data, unit)
+	} else {
+		err = rs.devPanel.WriteDevice(deviceMethodName, deviceID, propertyName, data)
+	}
 	if err != nil {
 		http.Error(writer, fmt.Sprintf("Write device data error: %v", err), http.StatusInternalServerError)
 	} else {
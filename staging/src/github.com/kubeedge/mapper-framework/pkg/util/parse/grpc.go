@@ -267,7 +267,11 @@ func GetDeviceModelFromGrpc(model *dmiapi.DeviceModel) common.DeviceModel {
 		Name:      model.GetName(),
 		Namespace: model.GetNamespace(),
 	}
-	if model.GetSpec() == nil || len(model.GetSpec().GetProperties()) == 0 {
+	if model.GetSpec() == nil {
+		return cur
+	}
+	cur.Methods = buildModelMethodsFromGrpc(model)
+	if len(model.GetSpec().GetProperties()) == 0 {
 		return cur
 	}
 	properties := make([]common.ModelProperty, 0, len(model.Spec.Properties))
@@ -287,6 +291,40 @@ func GetDeviceModelFromGrpc(model *dmiapi.DeviceModel) common.DeviceModel {
 	return cur
 }
 
+// buildModelMethodsFromGrpc parse the methods declared by device model from grpc
+func buildModelMethodsFromGrpc(model *dmiapi.DeviceModel) []common.ModelMethod {
+	if len(model.Spec.Methods) == 0 {
+		return nil
+	}
+	res := make([]common.ModelMethod, 0, len(model.Spec.Methods))
+	for _, method := range model.Spec.Methods {
+		res = append(res, common.ModelMethod{
+			Name:        method.GetName(),
+			Description: method.GetDescription(),
+			Inputs:      buildMethodParametersFromGrpc(method.GetInputs()),
+			Outputs:     buildMethodParametersFromGrpc(method.GetOutputs()),
+		})
+	}
+	return res
+}
+
+func buildMethodParametersFromGrpc(params []*dmiapi.MethodParameter) []common.MethodParameter {
+	if len(params) == 0 {
+		return nil
+	}
+	res := make([]common.MethodParameter, 0, len(params))
+	for _, param := range params {
+		res = append(res, common.MethodParameter{
+			Name:        param.GetName(),
+			Description: param.GetDescription(),
+			DataType:    param.GetType(),
+			Required:    param.GetRequired(),
+			Unit:        param.GetUnit(),
+		})
+	}
+	return res
+}
+
 func GetDeviceFromGrpc(device *dmiapi.Device, commonModel *common.DeviceModel) (*common.DeviceInstance, error) {
 	protocolName, err := getProtocolNameFromGrpc(device)
 	if err != nil {